@@ -0,0 +1,4 @@
+data "genesyscloud_architect_ivr_dnis_report" "dnis-report" {
+  dnis             = ["+13175550001", "+13175550002"]
+  fail_on_conflict = true
+}
@@ -0,0 +1,6 @@
+data "genesyscloud_architect_schedulegroup_evaluation" "holiday-season" {
+  schedule_group_id = genesyscloud_architect_schedulegroups.sample_schedule_groups.id
+  start             = "2024-12-20T00:00:00.000000"
+  end               = "2025-01-05T00:00:00.000000"
+  fail_on_overlap   = true
+}
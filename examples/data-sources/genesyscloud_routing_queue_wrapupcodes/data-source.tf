@@ -0,0 +1,3 @@
+data "genesyscloud_routing_queue_wrapupcodes" "example_queue_wrapupcodes" {
+  queue_id = genesyscloud_routing_queue.example_queue.id
+}
@@ -0,0 +1,3 @@
+data "genesyscloud_routing_skill_group_members" "series6_members" {
+  skill_group_id = genesyscloud_routing_skill_group.skillgroup.id
+}
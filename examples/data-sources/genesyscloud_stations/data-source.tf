@@ -0,0 +1,5 @@
+data "genesyscloud_stations" "available_webrtc" {
+  site_id           = genesyscloud_telephony_providers_edges_site.site.id
+  type              = "inin_webrtc_softphone"
+  association_state = "AVAILABLE"
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+data "genesyscloud_telephony_did_inventory" "inventory" {
+  did_pool_ids       = [genesyscloud_telephony_providers_edges_did_pool.pool.id]
+  referenced_numbers = ["+13175550123", "+13175550199"]
+}
+
+output "unassigned_numbers" {
+  value = [for n in data.genesyscloud_telephony_did_inventory.inventory.numbers : n.number if !n.assigned]
+}
\ No newline at end of file
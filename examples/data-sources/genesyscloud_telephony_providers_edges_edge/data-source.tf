@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_providers_edges_edge" "edge" {
+  name    = "Example Edge"
+  site_id = genesyscloud_telephony_providers_edges_site.site.id
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+data "genesyscloud_telephony_providers_edges_edge_status" "status" {
+  edge_id = genesyscloud_telephony_providers_edges_edge.edge.id
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_providers_edges_extension_pool_free_extensions" "next_extensions" {
+  extension_pool_id = genesyscloud_telephony_providers_edges_extension_pool.pool.id
+  extension_count   = 5
+}
\ No newline at end of file
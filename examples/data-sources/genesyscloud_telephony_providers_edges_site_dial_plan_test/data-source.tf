@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_providers_edges_site_dial_plan_test" "dial_plan" {
+  site_id        = genesyscloud_telephony_providers_edges_site.site.id
+  dialed_numbers = ["911", "3175551234", "+442071838750"]
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_providers_edges_trunk_status" "edge_trunks" {
+  edge_id    = genesyscloud_telephony_providers_edges_edge.edge.id
+  trunk_type = "EXTERNAL"
+}
\ No newline at end of file
@@ -0,0 +1,7 @@
+data "genesyscloud_users" "support_agents" {
+  division_id = genesyscloud_auth_division.support.id
+  department  = "Support"
+  skill_id    = genesyscloud_routing_skill.billing.id
+  state       = "active"
+  title_regex = "(?i)agent$"
+}
@@ -12,4 +12,5 @@ resource "genesyscloud_architect_user_prompt" "welcome_greeting" {
     filename          = "jp-welcome-greeting.wav"
     file_content_hash = filesha256("jp-welcome-greeting.wav")
   }
+  required_language_ivr_ids = [genesyscloud_architect_ivr.main_ivr.id]
 }
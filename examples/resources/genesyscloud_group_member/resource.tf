@@ -0,0 +1,4 @@
+resource "genesyscloud_group_member" "example_group_member" {
+  group_id = genesyscloud_group.example_group.id
+  user_id  = genesyscloud_user.example_user.id
+}
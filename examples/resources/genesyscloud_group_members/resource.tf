@@ -0,0 +1,5 @@
+resource "genesyscloud_group_members" "example_group_members" {
+  group_id   = genesyscloud_group.example_group.id
+  mode       = "additive"
+  member_ids = [genesyscloud_user.example_user.id, genesyscloud_user.example_user2.id]
+}
@@ -0,0 +1,6 @@
+resource "genesyscloud_outbound_contact_list_contacts" "contacts" {
+  contact_list_id     = genesyscloud_outbound_contact_list.contact-list.id
+  filepath            = "${path.module}/contacts.csv"
+  file_content_hash   = filesha256("${path.module}/contacts.csv")
+  clear_before_import = true
+}
\ No newline at end of file
@@ -3,4 +3,12 @@ resource "genesyscloud_outbound_dnclist" "dnc_list" {
   dnc_source_type = "dnc.com"
   login_id        = "1VC392SER23T1534DS23TGFR43JS63D7FS78G88TR9A9"
   dnc_codes       = ["B", "F", "S"]
+}
+
+resource "genesyscloud_outbound_dnclist" "internal_dnc_list" {
+  name                      = "Example Internal DNC List"
+  dnc_source_type           = "rds"
+  contact_method            = "Phone"
+  entries_filepath          = "${path.module}/dnc_entries.csv"
+  entries_file_content_hash = filesha256("${path.module}/dnc_entries.csv")
 }
\ No newline at end of file
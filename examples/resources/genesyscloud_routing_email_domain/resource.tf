@@ -3,4 +3,5 @@ resource "genesyscloud_routing_email_domain" "example-domain-com" {
   subdomain             = false
   mail_from_domain      = "example.com"
   custom_smtp_server_id = "99490182-2695-47db-a17d-0bf2ef230827"
+  wait_for_verification = true
 }
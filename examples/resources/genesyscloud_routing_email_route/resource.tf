@@ -7,7 +7,6 @@ resource "genesyscloud_routing_email_route" "support-route" {
   priority     = 5
   skill_ids    = [genesyscloud_routing_skill.support.id]
   language_id  = genesyscloud_routing_language.english.id
-  flow_id      = data.genesyscloud_flow.flow.id
   spam_flow_id = data.genesyscloud_flow.spam_flow.id
   reply_email_address {
     domain_id = "example.domain.com"
@@ -0,0 +1,4 @@
+resource "genesyscloud_routing_queue_wrapupcode" "example_queue_wrapupcodes" {
+  queue_id     = genesyscloud_routing_queue.example_queue.id
+  wrapup_codes = [genesyscloud_routing_wrapupcode.win.id, genesyscloud_routing_wrapupcode.loss.id]
+}
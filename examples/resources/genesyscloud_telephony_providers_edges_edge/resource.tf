@@ -0,0 +1,7 @@
+resource "genesyscloud_telephony_providers_edges_edge" "edge" {
+  name                 = "Example Edge"
+  description          = "Example virtual Edge"
+  site_id              = genesyscloud_telephony_providers_edges_site.site.id
+  physical_edge        = false
+  edge_deployment_type = "DDM"
+}
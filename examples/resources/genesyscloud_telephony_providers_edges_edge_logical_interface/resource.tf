@@ -0,0 +1,30 @@
+resource "genesyscloud_telephony_providers_edges_edge_logical_interface" "wan" {
+  edge_id               = genesyscloud_telephony_providers_edges_edge.edge.id
+  name                  = "WAN"
+  physical_adapter_id   = genesyscloud_telephony_providers_edges_edge.edge.physical_interfaces[0].id
+  vlan_tag_id           = 0
+  use_for_wan_interface = true
+
+  ipv4_capabilities {
+    enabled      = true
+    dhcp         = false
+    ping_enabled = true
+  }
+
+  addresses {
+    address = "10.0.0.10/24"
+    family  = 2
+  }
+
+  routes {
+    prefix  = "0.0.0.0/0"
+    nexthop = "10.0.0.1"
+    metric  = 1
+    family  = 2
+  }
+
+  external_trunk_base_assignments {
+    trunk_base_id = genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings.id
+    family        = 2
+  }
+}
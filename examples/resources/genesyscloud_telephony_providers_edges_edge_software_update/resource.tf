@@ -0,0 +1,8 @@
+resource "genesyscloud_telephony_providers_edges_edge_software_update" "update" {
+  edge_id                         = genesyscloud_telephony_providers_edges_edge.edge.id
+  version                         = "Edge Software 1.0.0.0"
+  execute_start_time              = "2024-06-01T02:00:00Z"
+  execute_stop_time               = "2024-06-01T05:00:00Z"
+  execute_on_idle                 = true
+  call_draining_wait_time_seconds = 600
+}
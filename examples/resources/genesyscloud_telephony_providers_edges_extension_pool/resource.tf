@@ -2,4 +2,6 @@ resource "genesyscloud_telephony_providers_edges_extension_pool" "example_extens
   start_number = "1000"
   end_number   = "1099"
   description  = "Description of the Extension range"
+
+  min_free_extensions = 10
 }
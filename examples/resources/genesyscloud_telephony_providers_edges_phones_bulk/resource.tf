@@ -0,0 +1,5 @@
+resource "genesyscloud_telephony_providers_edges_phones_bulk" "hq_phones" {
+  filepath          = "${path.module}/phones.csv"
+  file_content_hash = filesha256("${path.module}/phones.csv")
+  batch_size        = 10
+}
@@ -10,6 +10,9 @@ resource "genesyscloud_user" "example_user" {
   acd_auto_answer = true
   profile_skills  = ["Java", "Go"]
   certifications  = ["Certified Developer"]
+  # Keep the user in the org as an inactive user when it is removed from the configuration
+  deletion_behavior  = "deactivate"
+  restore_if_deleted = true
   addresses {
     other_emails {
       address = "john@gmail.com"
@@ -0,0 +1,26 @@
+resource "genesyscloud_user_routing_utilization" "agent_utilization" {
+  user_id = genesyscloud_user.example_user.id
+  call {
+    maximum_capacity          = 1
+    include_non_acd           = true
+    interruptible_media_types = ["email"]
+  }
+  email {
+    maximum_capacity = 3
+    include_non_acd  = false
+  }
+  label_utilizations {
+    label_id         = genesyscloud_routing_utilization_label.red_label.id
+    maximum_capacity = 4
+  }
+  label_utilizations {
+    label_id               = genesyscloud_routing_utilization_label.blue_label.id
+    maximum_capacity       = 4
+    interrupting_label_ids = [genesyscloud_routing_utilization_label.red_label.id]
+  }
+}
+
+resource "genesyscloud_user_routing_utilization" "org_defaults" {
+  user_id              = genesyscloud_user.example_user2.id
+  inherit_org_defaults = true
+}
@@ -0,0 +1,4 @@
+resource "genesyscloud_user_station" "user_station" {
+  user_id            = genesyscloud_user.example_user.id
+  default_station_id = data.genesyscloud_station.desk.id
+}
@@ -0,0 +1,4 @@
+resource "genesyscloud_users_bulk" "agents" {
+  filepath          = "${path.module}/users.csv"
+  file_content_hash = filesha256("${path.module}/users.csv")
+}
@@ -22,6 +22,7 @@ type registerTestInstance struct {
 // registerTestResources registers all resources used in the tests
 func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_architect_datatable_row"] = ResourceArchitectDatatableRow()
+	providerResources["genesyscloud_architect_datatable_rows"] = ResourceArchitectDatatableRows()
 	providerResources["genesyscloud_architect_datatable"] = dt.ResourceArchitectDatatable()
 }
 
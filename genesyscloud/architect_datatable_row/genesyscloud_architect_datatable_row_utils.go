@@ -86,17 +86,8 @@ func customizeDatatableRowDiff(ctx context.Context, diff *schema.ResourceDiff, m
 			}
 			if _, set := configMap[name]; !set {
 				// Property in schema not set. Override diff with expected default.
-				if prop.Default != nil {
-					configMap[name] = *prop.Default
-				} else if *prop.VarType == "boolean" {
-					// Booleans default to false
-					configMap[name] = false
-				} else if *prop.VarType == "string" {
-					// Strings default to empty
-					configMap[name] = ""
-				} else if *prop.VarType == "integer" || *prop.VarType == "number" {
-					// Numbers default to 0
-					configMap[name] = 0
+				if defaultValue := getDatatablePropertyDefault(prop); defaultValue != nil {
+					configMap[name] = defaultValue
 				}
 			}
 		}
@@ -112,6 +103,28 @@ func customizeDatatableRowDiff(ctx context.Context, diff *schema.ResourceDiff, m
 	return nil
 }
 
+// getDatatablePropertyDefault returns the value the API assigns to a property when a row does not set it
+func getDatatablePropertyDefault(prop Datatableproperty) interface{} {
+	if prop.Default != nil {
+		return *prop.Default
+	}
+	if prop.VarType == nil {
+		return nil
+	}
+	switch *prop.VarType {
+	case "boolean":
+		// Booleans default to false
+		return false
+	case "string":
+		// Strings default to empty
+		return ""
+	case "integer", "number":
+		// Numbers default to 0
+		return 0
+	}
+	return nil
+}
+
 // Prevent getting the architect_datatable schema on every row diff
 // by caching the results for the duration of the TF run
 var archDatatableCache sync.Map
@@ -0,0 +1,451 @@
+package architect_datatable_row
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+)
+
+// maxReportedRowErrors limits the number of row level validation errors returned for a single file
+const maxReportedRowErrors = 25
+
+// datatableFileRow is a single row read from a rows file along with its position in the file for error reporting
+type datatableFileRow struct {
+	position string
+	values   map[string]interface{}
+}
+
+// datatableRowsFile holds the raw contents of a CSV or JSON rows file
+type datatableRowsFile struct {
+	columns []string
+	rows    []datatableFileRow
+}
+
+// datatableRowsDiff describes the changes a sync will make to a datatable
+type datatableRowsDiff struct {
+	added   []string
+	updated []string
+	deleted []string
+}
+
+// readDatatableRowsFile reads a CSV or JSON rows file. The format is determined by the file extension.
+func readDatatableRowsFile(filePath string) (*datatableRowsFile, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	if strings.EqualFold(filepath.Ext(filePath), ".json") {
+		return parseDatatableRowsJson(reader)
+	}
+	return parseDatatableRowsCsv(reader)
+}
+
+// parseDatatableRowsCsv parses a CSV file with a header row of property names. Empty cells are treated as unset.
+func parseDatatableRowsCsv(reader io.Reader) (*datatableRowsFile, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("rows file is empty, a header row is required")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %v", err)
+	}
+	if len(header) > 0 {
+		// Strip a UTF-8 byte order mark written by spreadsheet tools
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	result := &datatableRowsFile{columns: header}
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read line %d: %v", line, err)
+		}
+
+		values := make(map[string]interface{})
+		for i, cell := range record {
+			if cell != "" {
+				values[header[i]] = cell
+			}
+		}
+		result.rows = append(result.rows, datatableFileRow{position: fmt.Sprintf("line %d", line), values: values})
+	}
+	return result, nil
+}
+
+// parseDatatableRowsJson parses a JSON file containing an array of row objects keyed by property name
+func parseDatatableRowsJson(reader io.Reader) (*datatableRowsFile, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	var rawRows []map[string]interface{}
+	if err := decoder.Decode(&rawRows); err != nil {
+		return nil, fmt.Errorf("rows file must contain a JSON array of objects: %v", err)
+	}
+
+	result := &datatableRowsFile{}
+	seenColumns := make(map[string]bool)
+	for i, rawRow := range rawRows {
+		values := make(map[string]interface{})
+		for name, value := range rawRow {
+			if !seenColumns[name] {
+				seenColumns[name] = true
+				result.columns = append(result.columns, name)
+			}
+			if value != nil {
+				values[name] = value
+			}
+		}
+		result.rows = append(result.rows, datatableFileRow{position: fmt.Sprintf("row %d", i+1), values: values})
+	}
+	sort.Strings(result.columns)
+	return result, nil
+}
+
+// buildDatatableRowsFromFile reads a rows file and validates it against the datatable schema.
+// The result is keyed by row key with every property in the schema set to a normalized value.
+func buildDatatableRowsFromFile(datatable *Datatable, filePath string) (map[string]map[string]interface{}, error) {
+	rowsFile, err := readDatatableRowsFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rows file %s: %v", filePath, err)
+	}
+	rows, err := validateDatatableRows(datatable, rowsFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rows file %s: %v", filePath, err)
+	}
+	return rows, nil
+}
+
+// validateDatatableRows checks the columns and values of a rows file against the datatable schema
+func validateDatatableRows(datatable *Datatable, rowsFile *datatableRowsFile) (map[string]map[string]interface{}, error) {
+	properties := getDatatableProperties(datatable)
+
+	var unknownColumns []string
+	hasKeyColumn := false
+	for _, column := range rowsFile.columns {
+		if column == "key" {
+			hasKeyColumn = true
+		}
+		if _, ok := properties[column]; !ok {
+			unknownColumns = append(unknownColumns, strconv.Quote(column))
+		}
+	}
+	if len(unknownColumns) > 0 {
+		return nil, fmt.Errorf("columns %s are not properties of datatable %s", strings.Join(unknownColumns, ", "), getDatatableName(datatable))
+	}
+	if !hasKeyColumn {
+		return nil, fmt.Errorf(`a "key" column is required`)
+	}
+
+	var rowErrors []string
+	rows := make(map[string]map[string]interface{}, len(rowsFile.rows))
+	firstPositions := make(map[string]string, len(rowsFile.rows))
+	for _, fileRow := range rowsFile.rows {
+		keyStr, ok := datatableRowKeyString(fileRow.values["key"])
+		if !ok || keyStr == "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: key must be a non-empty string", fileRow.position))
+			continue
+		}
+		if firstPosition, exists := firstPositions[keyStr]; exists {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: duplicate key %q, first defined at %s", fileRow.position, keyStr, firstPosition))
+			continue
+		}
+		firstPositions[keyStr] = fileRow.position
+
+		row, errs := normalizeDatatableRow(properties, fileRow.values)
+		for _, err := range errs {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s (key %q): %v", fileRow.position, keyStr, err))
+		}
+		rows[keyStr] = row
+	}
+
+	if len(rowErrors) > 0 {
+		if len(rowErrors) > maxReportedRowErrors {
+			remaining := len(rowErrors) - maxReportedRowErrors
+			rowErrors = append(rowErrors[:maxReportedRowErrors], fmt.Sprintf("... and %d more errors", remaining))
+		}
+		return nil, fmt.Errorf("\n%s", strings.Join(rowErrors, "\n"))
+	}
+	return rows, nil
+}
+
+// normalizeDatatableRow converts each value in a row to the Go type of its schema property and fills in defaults
+func normalizeDatatableRow(properties map[string]Datatableproperty, values map[string]interface{}) (map[string]interface{}, []error) {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	row := make(map[string]interface{}, len(properties))
+	for _, name := range names {
+		prop := properties[name]
+		if name == "key" {
+			keyStr, _ := datatableRowKeyString(values[name])
+			row[name] = keyStr
+			continue
+		}
+
+		propType := ""
+		if prop.VarType != nil {
+			propType = *prop.VarType
+		}
+
+		value, set := values[name]
+		if !set || value == nil {
+			value = getDatatablePropertyDefault(prop)
+		}
+		normalized, err := normalizeDatatableValue(propType, value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("property %q %v", name, err))
+			continue
+		}
+		row[name] = normalized
+	}
+	return row, errs
+}
+
+// normalizeDatatableValue converts a value read from a file or the API into int64, float64, bool or string
+// depending on the datatable property type, so values from different sources can be compared
+func normalizeDatatableValue(propType string, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch propType {
+	case "integer":
+		switch v := value.(type) {
+		case string:
+			i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("expects an integer value, got %q", v)
+			}
+			return i, nil
+		case json.Number:
+			i, err := v.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("expects an integer value, got %s", v)
+			}
+			return i, nil
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("expects an integer value, got %v", v)
+			}
+			return int64(v), nil
+		case int:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		}
+		return nil, fmt.Errorf("expects an integer value, got %v", value)
+	case "number":
+		switch v := value.(type) {
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("expects a numeric value, got %q", v)
+			}
+			return f, nil
+		case json.Number:
+			f, err := v.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("expects a numeric value, got %s", v)
+			}
+			return f, nil
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		}
+		return nil, fmt.Errorf("expects a numeric value, got %v", value)
+	case "boolean":
+		switch v := value.(type) {
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, fmt.Errorf("expects a boolean value, got %q", v)
+			}
+			return b, nil
+		case bool:
+			return v, nil
+		}
+		return nil, fmt.Errorf("expects a boolean value, got %v", value)
+	default:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+		return nil, fmt.Errorf("expects a string value, got %v", value)
+	}
+}
+
+// normalizeExistingDatatableRows converts rows returned by the API into the same form as buildDatatableRowsFromFile
+func normalizeExistingDatatableRows(datatable *Datatable, existingRows []map[string]interface{}) map[string]map[string]interface{} {
+	properties := getDatatableProperties(datatable)
+	rows := make(map[string]map[string]interface{}, len(existingRows))
+	for _, existingRow := range existingRows {
+		keyStr, ok := datatableRowKeyString(existingRow["key"])
+		if !ok {
+			continue
+		}
+		// Values returned by the API always match the schema, so any conversion errors can be ignored
+		row, _ := normalizeDatatableRow(properties, existingRow)
+		rows[keyStr] = row
+	}
+	return rows
+}
+
+// diffDatatableRows compares the rows currently in a datatable to the desired rows
+func diffDatatableRows(current, desired map[string]map[string]interface{}) datatableRowsDiff {
+	var diff datatableRowsDiff
+	for key, desiredRow := range desired {
+		currentRow, exists := current[key]
+		if !exists {
+			diff.added = append(diff.added, key)
+		} else if !reflect.DeepEqual(currentRow, desiredRow) {
+			diff.updated = append(diff.updated, key)
+		}
+	}
+	for key := range current {
+		if _, exists := desired[key]; !exists {
+			diff.deleted = append(diff.deleted, key)
+		}
+	}
+	sort.Strings(diff.added)
+	sort.Strings(diff.updated)
+	sort.Strings(diff.deleted)
+	return diff
+}
+
+func (d datatableRowsDiff) isEmpty() bool {
+	return len(d.added) == 0 && len(d.updated) == 0 && len(d.deleted) == 0
+}
+
+// writeDatatableRowsCsv writes rows as a CSV file in the format expected by the datatable import job.
+// Columns are written key first and then in display order, rows are sorted by key.
+func writeDatatableRowsCsv(datatable *Datatable, rows map[string]map[string]interface{}, writer io.Writer) error {
+	columns := getDatatableColumnOrder(datatable)
+
+	keys := make([]string, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(columns); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i] = formatDatatableValue(rows[key][column])
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// writeDatatableRowsImportFile writes rows to a temporary CSV file for upload. The caller must remove the file.
+func writeDatatableRowsImportFile(datatable *Datatable, rows map[string]map[string]interface{}) (string, error) {
+	file, err := os.CreateTemp("", "datatable-rows-*.csv")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if err := writeDatatableRowsCsv(datatable, rows, file); err != nil {
+		_ = os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func formatDatatableValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// getDatatableColumnOrder returns the datatable property names with the key first followed by display order
+func getDatatableColumnOrder(datatable *Datatable) []string {
+	properties := getDatatableProperties(datatable)
+	columns := make([]string, 0, len(properties))
+	for name := range properties {
+		if name != "key" {
+			columns = append(columns, name)
+		}
+	}
+	// Properties without a display order are written last
+	sort.Slice(columns, func(i, j int) bool {
+		orderI, orderJ := properties[columns[i]].DisplayOrder, properties[columns[j]].DisplayOrder
+		if (orderI == nil) != (orderJ == nil) {
+			return orderI != nil
+		}
+		if orderI != nil && *orderI != *orderJ {
+			return *orderI < *orderJ
+		}
+		return columns[i] < columns[j]
+	})
+	return append([]string{"key"}, columns...)
+}
+
+func getDatatableProperties(datatable *Datatable) map[string]Datatableproperty {
+	if datatable == nil || datatable.Schema == nil || datatable.Schema.Properties == nil {
+		return map[string]Datatableproperty{}
+	}
+	return *datatable.Schema.Properties
+}
+
+func getDatatableName(datatable *Datatable) string {
+	if datatable.Name != nil {
+		return *datatable.Name
+	}
+	if datatable.Id != nil {
+		return *datatable.Id
+	}
+	return ""
+}
+
+func datatableRowKeyString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	}
+	return "", false
+}
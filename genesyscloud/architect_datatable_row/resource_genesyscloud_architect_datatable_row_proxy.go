@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
+	"os"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
 )
@@ -20,6 +23,9 @@ type getArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatabl
 type createArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, row *map[string]interface{}) (*map[string]interface{}, *platformclientv2.APIResponse, error)
 type updateArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, key string, row *map[string]interface{}) (*map[string]interface{}, *platformclientv2.APIResponse, error)
 type deleteArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error)
+type createArchitectDatatableImportJobFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error)
+type getArchitectDatatableImportJobFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error)
+type uploadArchitectDatatableImportFileFunc func(ctx context.Context, p *architectDatatableRowProxy, uploadUri string, filePath string) error
 
 type architectDatatableRowProxy struct {
 	clientConfig                     *platformclientv2.Configuration
@@ -31,6 +37,10 @@ type architectDatatableRowProxy struct {
 	getArchitectDatatableRowAttr     getArchitectDatatableRowFunc
 	updateArchitectDatatableRowAttr  updateArchitectDatatableRowFunc
 	deleteArchitectDatatableRowAttr  deleteArchitectDatatableRowFunc
+
+	createArchitectDatatableImportJobAttr  createArchitectDatatableImportJobFunc
+	getArchitectDatatableImportJobAttr     getArchitectDatatableImportJobFunc
+	uploadArchitectDatatableImportFileAttr uploadArchitectDatatableImportFileFunc
 }
 
 func newArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowProxy {
@@ -45,6 +55,10 @@ func newArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration)
 		createArchitectDatatableRowAttr:  createArchitectDatatableRowFn,
 		updateArchitectDatatableRowAttr:  updateArchitectDatatableRowFn,
 		deleteArchitectDatatableRowAttr:  deleteArchitectDatatableRowFn,
+
+		createArchitectDatatableImportJobAttr:  createArchitectDatatableImportJobFn,
+		getArchitectDatatableImportJobAttr:     getArchitectDatatableImportJobFn,
+		uploadArchitectDatatableImportFileAttr: uploadArchitectDatatableImportFileFn,
 	}
 }
 
@@ -84,6 +98,18 @@ func (p *architectDatatableRowProxy) deleteArchitectDatatableRow(ctx context.Con
 	return p.deleteArchitectDatatableRowAttr(ctx, p, tableId, rowId)
 }
 
+func (p *architectDatatableRowProxy) createArchitectDatatableImportJob(ctx context.Context, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.createArchitectDatatableImportJobAttr(ctx, p, tableId, importMode)
+}
+
+func (p *architectDatatableRowProxy) getArchitectDatatableImportJob(ctx context.Context, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.getArchitectDatatableImportJobAttr(ctx, p, tableId, jobId)
+}
+
+func (p *architectDatatableRowProxy) uploadArchitectDatatableImportFile(ctx context.Context, uploadUri string, filePath string) error {
+	return p.uploadArchitectDatatableImportFileAttr(ctx, p, uploadUri, filePath)
+}
+
 func getAllArchitectDatatableFn(ctx context.Context, p *architectDatatableRowProxy) (*[]platformclientv2.Datatable, error) {
 	var totalRecords []platformclientv2.Datatable
 
@@ -205,3 +231,29 @@ func updateArchitectDatatableRowFn(ctx context.Context, p *architectDatatableRow
 func deleteArchitectDatatableRowFn(ctx context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error) {
 	return p.architectApi.DeleteFlowsDatatableRow(tableId, rowId)
 }
+
+func createArchitectDatatableImportJobFn(ctx context.Context, p *architectDatatableRowProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.PostFlowsDatatableImportJobs(tableId, platformclientv2.Datatableimportjob{ImportMode: &importMode})
+}
+
+func getArchitectDatatableImportJobFn(ctx context.Context, p *architectDatatableRowProxy, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.GetFlowsDatatableImportJob(tableId, jobId)
+}
+
+// uploadArchitectDatatableImportFileFn uploads a CSV file to the uploadURI returned when an import job is created
+func uploadArchitectDatatableImportFileFn(ctx context.Context, p *architectDatatableRowProxy, uploadUri string, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+
+	formData := make(map[string]io.Reader)
+	formData["file"] = file
+
+	headers := make(map[string]string)
+	headers["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, http.MethodPost, uploadUri)
+	_, err = s3Uploader.Upload()
+	return err
+}
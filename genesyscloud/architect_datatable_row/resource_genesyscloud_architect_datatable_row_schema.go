@@ -7,13 +7,18 @@ import (
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 )
 
-const resourceName = "genesyscloud_architect_datatable_row"
+const (
+	resourceName     = "genesyscloud_architect_datatable_row"
+	rowsResourceName = "genesyscloud_architect_datatable_rows"
+)
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(resourceName, ResourceArchitectDatatableRow())
 	//No Datasource defined
 	regInstance.RegisterExporter(resourceName, ArchitectDatatableRowExporter())
+	regInstance.RegisterResource(rowsResourceName, ResourceArchitectDatatableRows())
+	regInstance.RegisterExporter(rowsResourceName, ArchitectDatatableRowsExporter())
 }
 
 func ArchitectDatatableRowExporter() *resourceExporter.ResourceExporter {
@@ -26,6 +31,22 @@ func ArchitectDatatableRowExporter() *resourceExporter.ResourceExporter {
 	}
 }
 
+func ArchitectDatatableRowsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: genesyscloud.GetAllWithPooledClient(getAllArchitectDatatableRowSets),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"datatable_id": {RefType: "genesyscloud_architect_datatable"},
+		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: ArchitectDatatableRowsResolver,
+			SubDirectory:              "datatable_rows",
+		},
+		// The rows of a datatable are exported as genesyscloud_architect_datatable_row by default.
+		// Exporting both would give the same rows two owners.
+		ExportOnlyWhenIncluded: true,
+	}
+}
+
 func ResourceArchitectDatatableRow() *schema.Resource {
 	return &schema.Resource{
 		Description: "Genesys Cloud Architect Datatable Row",
@@ -62,3 +83,58 @@ func ResourceArchitectDatatableRow() *schema.Resource {
 		CustomizeDiff: customizeDatatableRowDiff,
 	}
 }
+
+func ResourceArchitectDatatableRows() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Architect Datatable Rows. Manages the full set of rows in a datatable from a CSV or JSON file. Rows that are not in the file are deleted from the datatable. Do not use this resource together with genesyscloud_architect_datatable_row for the same datatable. This resource is only exported when it is named in include_filter_resources.",
+
+		CreateContext: genesyscloud.CreateWithPooledClient(createArchitectDatatableRows),
+		ReadContext:   genesyscloud.ReadWithPooledClient(readArchitectDatatableRows),
+		UpdateContext: genesyscloud.UpdateWithPooledClient(updateArchitectDatatableRows),
+		DeleteContext: genesyscloud.DeleteWithPooledClient(deleteArchitectDatatableRows),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"datatable_id": {
+				Description: "Datatable ID that contains the rows. If this is changed, the rows are synced to the new datatable.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filepath": {
+				Description:  "Path to a CSV or JSON file containing every row of the datatable. Files ending in `.json` must contain an array of objects keyed by property name. Any other file is read as CSV with a header row of property names. A `key` column is required. Empty or missing values are set to the property default.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: genesyscloud.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the rows file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"row_count": {
+				Description: "Number of rows in the datatable.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"rows_added": {
+				Description: "Number of rows added to the datatable by the most recent sync. Calculated during plan and not refreshed by later reads, so it always describes the last sync rather than the current difference between the file and the datatable.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"rows_updated": {
+				Description: "Number of existing rows changed by the most recent sync. Calculated during plan and not refreshed by later reads, so it always describes the last sync rather than the current difference between the file and the datatable.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"rows_deleted": {
+				Description: "Number of rows deleted from the datatable by the most recent sync. Calculated during plan and not refreshed by later reads, so it always describes the last sync rather than the current difference between the file and the datatable.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+		CustomizeDiff: customizeDatatableRowsDiff,
+	}
+}
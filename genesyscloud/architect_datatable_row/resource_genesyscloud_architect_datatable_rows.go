@@ -0,0 +1,368 @@
+package architect_datatable_row
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+const (
+	// Rows that are not in the import file are removed from the datatable
+	datatableRowsImportMode = "ReplaceAll"
+
+	datatableImportJobTimeout = 30 * time.Minute
+
+	// datatableRowsDeleteConcurrency is the number of rows deleted in parallel when the resource is destroyed
+	datatableRowsDeleteConcurrency = 10
+)
+
+func getAllArchitectDatatableRowSets(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	archProxy := getArchitectDatatableRowProxy(clientConfig)
+
+	tables, err := archProxy.getAllArchitectDatatable(ctx)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	for _, table := range *tables {
+		resources[*table.Id] = &resourceExporter.ResourceMeta{Name: *table.Name}
+	}
+
+	return resources, nil
+}
+
+func createArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableId := d.Get("datatable_id").(string)
+
+	log.Printf("Creating rows for Datatable %s", tableId)
+	if diagErr := syncArchitectDatatableRows(ctx, d, meta, tableId); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(tableId)
+
+	log.Printf("Created rows for Datatable %s", d.Id())
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+func readArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*genesyscloud.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+
+	log.Printf("Reading rows for Datatable %s", d.Id())
+
+	return genesyscloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		datatable, resp, getErr := archProxy.getArchitectDatatable(ctx, d.Id(), "schema")
+		if getErr != nil {
+			if genesyscloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read Datatable %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read Datatable %s: %s", d.Id(), getErr))
+		}
+
+		rows, getErr := archProxy.getAllArchitectDatatableRows(ctx, d.Id())
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read rows for Datatable %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceArchitectDatatableRows())
+		_ = d.Set("datatable_id", d.Id())
+		_ = d.Set("row_count", len(*rows))
+
+		// Clear the file hash if the rows were changed outside of Terraform so the next plan syncs them again
+		if filePath, _ := d.Get("filepath").(string); filePath != "" {
+			desiredRows, err := buildDatatableRowsFromFile(datatable, filePath)
+			if err != nil {
+				log.Printf("Unable to compare rows for Datatable %s with %s: %s", d.Id(), filePath, err)
+			} else if rowsDiff := diffDatatableRows(normalizeExistingDatatableRows(datatable, *rows), desiredRows); !rowsDiff.isEmpty() {
+				log.Printf("Rows for Datatable %s do not match %s: %d missing, %d changed, %d unexpected", d.Id(), filePath, len(rowsDiff.added), len(rowsDiff.updated), len(rowsDiff.deleted))
+				_ = d.Set("file_content_hash", "")
+			}
+		}
+
+		log.Printf("Read %d rows for Datatable %s", len(*rows), d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating rows for Datatable %s", d.Id())
+	if diagErr := syncArchitectDatatableRows(ctx, d, meta, d.Id()); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated rows for Datatable %s", d.Id())
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+func deleteArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*genesyscloud.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+
+	rows, err := archProxy.getAllArchitectDatatableRows(ctx, d.Id())
+	if err != nil {
+		if _, resp, getErr := archProxy.getArchitectDatatable(ctx, d.Id(), ""); getErr != nil && genesyscloud.IsStatus404(resp) {
+			// Parent architect_datatable was probably deleted which caused the rows to be deleted
+			log.Printf("Datatable %s already deleted", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to read rows for Datatable %s: %s", d.Id(), err)
+	}
+
+	var keys []string
+	for _, row := range *rows {
+		if keyStr, ok := datatableRowKeyString(row["key"]); ok {
+			keys = append(keys, keyStr)
+		}
+	}
+
+	log.Printf("Deleting %d rows from Datatable %s", len(keys), d.Id())
+	if err := deleteDatatableRowsInBatches(ctx, archProxy, d.Id(), keys); err != nil {
+		return diag.Errorf("Failed to delete rows from Datatable %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Deleted rows from Datatable %s", d.Id())
+	return nil
+}
+
+// deleteDatatableRowsInBatches deletes rows running up to datatableRowsDeleteConcurrency deletes in parallel.
+// Rows that are already deleted are ignored. Deleting stops after the first batch with a failure.
+func deleteDatatableRowsInBatches(ctx context.Context, archProxy *architectDatatableRowProxy, tableId string, keys []string) error {
+	deleted := 0
+	for _, batch := range chunks.ChunkBy(keys, datatableRowsDeleteConcurrency) {
+		var (
+			wg     sync.WaitGroup
+			mu     sync.Mutex
+			errors []string
+		)
+		for _, key := range batch {
+			wg.Add(1)
+			go func(key string) {
+				defer wg.Done()
+				resp, err := archProxy.deleteArchitectDatatableRow(ctx, tableId, key)
+				if err != nil && !genesyscloud.IsStatus404(resp) {
+					mu.Lock()
+					errors = append(errors, fmt.Sprintf("row %s: %v", key, err))
+					mu.Unlock()
+				}
+			}(key)
+		}
+		wg.Wait()
+		if len(errors) > 0 {
+			sort.Strings(errors)
+			return fmt.Errorf("%s", strings.Join(errors, "; "))
+		}
+		deleted += len(batch)
+		log.Printf("Deleted %d of %d rows from Datatable %s", deleted, len(keys), tableId)
+	}
+	return nil
+}
+
+// syncArchitectDatatableRows replaces the full row set of a datatable with the contents of the rows file using an import job
+func syncArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}, tableId string) diag.Diagnostics {
+	sdkConfig := meta.(*genesyscloud.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+	filePath := d.Get("filepath").(string)
+
+	// Always read the latest schema since the datatable may have been changed earlier in this apply
+	datatable, _, err := archProxy.getArchitectDatatable(ctx, tableId, "schema")
+	if err != nil {
+		return diag.Errorf("Failed to read Datatable %s: %s", tableId, err)
+	}
+
+	rows, err := buildDatatableRowsFromFile(datatable, filePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The change counts are normally calculated during plan. They are unknown when the datatable is created in the same apply.
+	if !d.GetRawPlan().IsNull() && !d.GetRawPlan().GetAttr("rows_added").IsKnown() {
+		existingRows, err := archProxy.getAllArchitectDatatableRows(ctx, tableId)
+		if err != nil {
+			return diag.Errorf("Failed to read rows for Datatable %s: %s", tableId, err)
+		}
+		setDatatableRowsDiffCounts(d, diffDatatableRows(normalizeExistingDatatableRows(datatable, *existingRows), rows))
+	}
+
+	importFilePath, err := writeDatatableRowsImportFile(datatable, rows)
+	if err != nil {
+		return diag.Errorf("Failed to write import file for Datatable %s: %s", tableId, err)
+	}
+	defer os.Remove(importFilePath)
+
+	job, _, err := archProxy.createArchitectDatatableImportJob(ctx, tableId, datatableRowsImportMode)
+	if err != nil {
+		return diag.Errorf("Failed to create import job for Datatable %s: %s", tableId, err)
+	}
+	if job.UploadURI == nil {
+		return diag.Errorf("Import job %s for Datatable %s did not return an upload URI", *job.Id, tableId)
+	}
+
+	log.Printf("Uploading %d rows from %s to Datatable %s", len(rows), filePath, tableId)
+	if err := archProxy.uploadArchitectDatatableImportFile(ctx, *job.UploadURI, importFilePath); err != nil {
+		return diag.Errorf("Failed to upload rows for Datatable %s: %s", tableId, err)
+	}
+
+	return waitForDatatableImportJob(ctx, archProxy, tableId, *job.Id)
+}
+
+// waitForDatatableImportJob polls an import job until it has finished processing
+func waitForDatatableImportJob(ctx context.Context, archProxy *architectDatatableRowProxy, tableId string, jobId string) diag.Diagnostics {
+	return genesyscloud.WithRetries(ctx, datatableImportJobTimeout, func() *retry.RetryError {
+		job, _, err := archProxy.getArchitectDatatableImportJob(ctx, tableId, jobId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read import job %s for Datatable %s: %s", jobId, tableId, err))
+		}
+
+		status := ""
+		if job.Status != nil {
+			status = *job.Status
+		}
+
+		switch status {
+		case "Succeeded":
+			if job.CountRecordsFailed != nil && *job.CountRecordsFailed > 0 {
+				return retry.NonRetryableError(fmt.Errorf("Import job %s for Datatable %s failed to import %d rows", jobId, tableId, *job.CountRecordsFailed))
+			}
+			log.Printf("Import job %s for Datatable %s succeeded", jobId, tableId)
+			return nil
+		case "Failed":
+			message := ""
+			if job.ErrorInformation != nil && job.ErrorInformation.Message != nil {
+				message = *job.ErrorInformation.Message
+			}
+			return retry.NonRetryableError(fmt.Errorf("Import job %s for Datatable %s failed: %s", jobId, tableId, message))
+		}
+
+		if job.CountRecordsUpdated != nil {
+			log.Printf("Import job %s for Datatable %s is %s. %d rows processed", jobId, tableId, status, *job.CountRecordsUpdated)
+		}
+		return retry.RetryableError(fmt.Errorf("Import job %s for Datatable %s is still %s", jobId, tableId, status))
+	})
+}
+
+// customizeDatatableRowsDiff validates the rows file against the datatable schema and reports the
+// number of rows the sync will add, update and delete
+func customizeDatatableRowsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("filepath") || !diff.NewValueKnown("file_content_hash") || !diff.NewValueKnown("datatable_id") {
+		// The datatable or file is not known until apply. The rows will be validated then.
+		return setDatatableRowsDiffComputed(diff)
+	}
+
+	if diff.Id() != "" && !diff.HasChange("file_content_hash") && !diff.HasChange("filepath") {
+		return nil
+	}
+
+	tableId := diff.Get("datatable_id").(string)
+	filePath := diff.Get("filepath").(string)
+
+	sdkConfig := meta.(*genesyscloud.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+
+	datatable, err := getArchitectDatatableCached(ctx, tableId, sdkConfig)
+	if err != nil {
+		return err
+	}
+
+	rows, err := buildDatatableRowsFromFile(datatable, filePath)
+	if err != nil {
+		return err
+	}
+
+	existingRows, err := archProxy.getAllArchitectDatatableRows(ctx, tableId)
+	if err != nil {
+		return fmt.Errorf("Failed to read rows for Datatable %s: %s", tableId, err)
+	}
+
+	rowsDiff := diffDatatableRows(normalizeExistingDatatableRows(datatable, *existingRows), rows)
+	log.Printf("Syncing %s to Datatable %s will add %d, update %d and delete %d rows", filePath, tableId, len(rowsDiff.added), len(rowsDiff.updated), len(rowsDiff.deleted))
+
+	for attr, value := range datatableRowsDiffCounts(rowsDiff) {
+		if err := diff.SetNew(attr, value); err != nil {
+			return err
+		}
+	}
+	return diff.SetNew("row_count", len(rows))
+}
+
+func setDatatableRowsDiffComputed(diff *schema.ResourceDiff) error {
+	for attr := range datatableRowsDiffCounts(datatableRowsDiff{}) {
+		if err := diff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return diff.SetNewComputed("row_count")
+}
+
+func setDatatableRowsDiffCounts(d *schema.ResourceData, rowsDiff datatableRowsDiff) {
+	for attr, value := range datatableRowsDiffCounts(rowsDiff) {
+		_ = d.Set(attr, value)
+	}
+}
+
+func datatableRowsDiffCounts(rowsDiff datatableRowsDiff) map[string]int {
+	return map[string]int{
+		"rows_added":   len(rowsDiff.added),
+		"rows_updated": len(rowsDiff.updated),
+		"rows_deleted": len(rowsDiff.deleted),
+	}
+}
+
+// ArchitectDatatableRowsResolver writes the rows of a datatable to a CSV file in the export directory
+func ArchitectDatatableRowsResolver(tableId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	sdkConfig := meta.(*genesyscloud.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+	ctx := context.Background()
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	datatable, _, err := archProxy.getArchitectDatatable(ctx, tableId, "schema")
+	if err != nil {
+		return err
+	}
+
+	existingRows, err := archProxy.getAllArchitectDatatableRows(ctx, tableId)
+	if err != nil {
+		return err
+	}
+
+	exportFileName := fmt.Sprintf("datatable-%s.csv", tableId)
+	file, err := os.Create(path.Join(fullPath, exportFileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := writeDatatableRowsCsv(datatable, normalizeExistingDatatableRows(datatable, *existingRows), file); err != nil {
+		return err
+	}
+
+	// Update filepath field in configMap to point to exported rows file
+	configMap["filepath"] = path.Join(subDirectory, exportFileName)
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDirectory, exportFileName))
+	for attr := range datatableRowsDiffCounts(datatableRowsDiff{}) {
+		delete(configMap, attr)
+	}
+	delete(configMap, "row_count")
+
+	return nil
+}
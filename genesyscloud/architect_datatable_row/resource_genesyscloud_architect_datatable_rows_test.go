@@ -0,0 +1,118 @@
+package architect_datatable_row
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func TestAccResourceArchitectDatatableRows(t *testing.T) {
+	var (
+		tableResource1 = "arch-table1"
+		rowsResource1  = "table-rows-1"
+		tableName1     = "Terraform Table Rows-" + uuid.NewString()
+
+		rowsFile1 = filepath.Join(t.TempDir(), "rows1.csv")
+		rowsFile2 = filepath.Join(t.TempDir(), "rows2.json")
+
+		tableConfig = generateArchitectDatatableResource(
+			tableResource1,
+			tableName1,
+			genesyscloud.NullValue,
+			generateArchitectDatatableProperty("key", "string", strconv.Quote("key"), genesyscloud.NullValue),
+			generateArchitectDatatableProperty("count", "integer", strconv.Quote("count"), strconv.Quote("10")),
+			generateArchitectDatatableProperty("vip", "boolean", strconv.Quote("vip"), genesyscloud.NullValue),
+		)
+	)
+
+	if err := os.WriteFile(rowsFile1, []byte("key,count,vip\nalice,1,true\nbob,2,false\ncarol,,true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(rowsFile2, []byte(`[{"key": "alice", "count": 5, "vip": true}, {"key": "dave"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { genesyscloud.TestAccPreCheck(t) },
+		ProviderFactories: genesyscloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Load three rows from a CSV file
+				Config: tableConfig + generateArchitectDatatableRowsResource(
+					rowsResource1,
+					"genesyscloud_architect_datatable."+tableResource1+".id",
+					rowsFile1,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_architect_datatable_rows."+rowsResource1, "datatable_id", "genesyscloud_architect_datatable."+tableResource1, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_architect_datatable_rows."+rowsResource1, "row_count", "3"),
+					resource.TestCheckResourceAttr("genesyscloud_architect_datatable_rows."+rowsResource1, "rows_added", "3"),
+				),
+			},
+			{
+				// Replace the rows with a JSON file. One row is changed, one added and two deleted.
+				Config: tableConfig + generateArchitectDatatableRowsResource(
+					rowsResource1,
+					"genesyscloud_architect_datatable."+tableResource1+".id",
+					rowsFile2,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_architect_datatable_rows."+rowsResource1, "row_count", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_architect_datatable_rows."+rowsResource1, "rows_added", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_architect_datatable_rows."+rowsResource1, "rows_updated", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_architect_datatable_rows."+rowsResource1, "rows_deleted", "2"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_architect_datatable_rows." + rowsResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filepath", "file_content_hash", "rows_added", "rows_updated", "rows_deleted"},
+			},
+		},
+		CheckDestroy: testVerifyDatatableRowSetsDestroyed,
+	})
+}
+
+func generateArchitectDatatableRowsResource(
+	resourceID string,
+	tableID string,
+	filePath string) string {
+	return fmt.Sprintf(`resource "genesyscloud_architect_datatable_rows" "%s" {
+		datatable_id = %s
+		filepath = "%s"
+		file_content_hash = filesha256("%s")
+	}
+	`, resourceID, tableID, filePath, filePath)
+}
+
+func testVerifyDatatableRowSetsDestroyed(state *terraform.State) error {
+	archAPI := platformclientv2.NewArchitectApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_architect_datatable_rows" {
+			continue
+		}
+
+		rows, resp, err := archAPI.GetFlowsDatatableRows(rs.Primary.ID, 1, 1, false, "")
+		if genesyscloud.IsStatus404(resp) {
+			// Datatable not found as expected
+			continue
+		} else if err != nil {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		} else if rows.Entities != nil && len(*rows.Entities) > 0 {
+			return fmt.Errorf("Datatable (%s) still has rows", rs.Primary.ID)
+		}
+	}
+	// Success. All Datatable Rows destroyed
+	return nil
+}
@@ -0,0 +1,287 @@
+package architect_datatable_row
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestDatatable(tableId string) *Datatable {
+	var (
+		keyType  = "string"
+		intType  = "integer"
+		boolType = "boolean"
+		numType  = "number"
+		strType  = "string"
+
+		order1 = 1
+		order2 = 2
+		order3 = 3
+
+		defaultInt interface{} = float64(100)
+		name                   = "Unit Test Table"
+	)
+	return &Datatable{
+		Id:   &tableId,
+		Name: &name,
+		Schema: &Jsonschemadocument{
+			Properties: &map[string]Datatableproperty{
+				"key":     {VarType: &keyType},
+				"count":   {VarType: &intType, DisplayOrder: &order1, Default: &defaultInt},
+				"vip":     {VarType: &boolType, DisplayOrder: &order2},
+				"balance": {VarType: &numType, DisplayOrder: &order3},
+				"address": {VarType: &strType},
+			},
+		},
+	}
+}
+
+func TestUnitDatatableRowsCsvValidation(t *testing.T) {
+	datatable := buildTestDatatable(uuid.NewString())
+
+	rowsFile, err := parseDatatableRowsCsv(strings.NewReader("key,count,vip,balance,address\nalice,5,true,1.5,1 Main St\nbob,,false,,\n"))
+	assert.Nil(t, err)
+
+	rows, err := validateDatatableRows(datatable, rowsFile)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rows))
+	assert.Equal(t, map[string]interface{}{"key": "alice", "count": int64(5), "vip": true, "balance": 1.5, "address": "1 Main St"}, rows["alice"])
+
+	// Empty cells are set to the property default
+	assert.Equal(t, map[string]interface{}{"key": "bob", "count": int64(100), "vip": false, "balance": float64(0), "address": ""}, rows["bob"])
+}
+
+func TestUnitDatatableRowsJsonValidation(t *testing.T) {
+	datatable := buildTestDatatable(uuid.NewString())
+
+	rowsFile, err := parseDatatableRowsJson(strings.NewReader(`[{"key": "alice", "count": 7, "vip": true}, {"key": "bob", "balance": 2.25}]`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"balance", "count", "key", "vip"}, rowsFile.columns)
+
+	rows, err := validateDatatableRows(datatable, rowsFile)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), rows["alice"]["count"])
+	assert.Equal(t, 2.25, rows["bob"]["balance"])
+	assert.Equal(t, int64(100), rows["bob"]["count"])
+}
+
+func TestUnitDatatableRowsValidationErrors(t *testing.T) {
+	datatable := buildTestDatatable(uuid.NewString())
+
+	testCases := []struct {
+		name     string
+		contents string
+		expected []string
+	}{
+		{
+			name:     "unknown column",
+			contents: "key,count,vipp\nalice,1,true\n",
+			expected: []string{`columns "vipp" are not properties of datatable Unit Test Table`},
+		},
+		{
+			name:     "missing key column",
+			contents: "count,vip\n1,true\n",
+			expected: []string{`a "key" column is required`},
+		},
+		{
+			name:     "invalid values",
+			contents: "key,count,vip,balance\nalice,1.5,maybe,abc\n,1,true,1\n",
+			expected: []string{
+				`line 2 (key "alice"): property "balance" expects a numeric value, got "abc"`,
+				`line 2 (key "alice"): property "count" expects an integer value, got "1.5"`,
+				`line 2 (key "alice"): property "vip" expects a boolean value, got "maybe"`,
+				`line 3: key must be a non-empty string`,
+			},
+		},
+		{
+			name:     "duplicate keys",
+			contents: "key,count\nalice,1\nbob,2\nalice,3\n",
+			expected: []string{`line 4: duplicate key "alice", first defined at line 2`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rowsFile, err := parseDatatableRowsCsv(strings.NewReader(tc.contents))
+			assert.Nil(t, err)
+
+			_, err = validateDatatableRows(datatable, rowsFile)
+			if assert.NotNil(t, err) {
+				for _, expected := range tc.expected {
+					assert.Contains(t, err.Error(), expected)
+				}
+			}
+		})
+	}
+}
+
+func TestUnitDatatableRowsDiff(t *testing.T) {
+	datatable := buildTestDatatable(uuid.NewString())
+
+	existingRows := []map[string]interface{}{
+		{"key": "alice", "count": float64(5), "vip": true, "balance": 1.5, "address": "1 Main St"},
+		{"key": "bob", "count": float64(100), "vip": false, "balance": float64(0), "address": ""},
+		{"key": "carol", "count": float64(1), "vip": false, "balance": float64(0), "address": ""},
+	}
+
+	rowsFile, err := parseDatatableRowsCsv(strings.NewReader("key,count,vip,balance,address\nalice,5,true,1.5,1 Main St\nbob,2,,,\ndave,,,,\n"))
+	assert.Nil(t, err)
+	desiredRows, err := validateDatatableRows(datatable, rowsFile)
+	assert.Nil(t, err)
+
+	rowsDiff := diffDatatableRows(normalizeExistingDatatableRows(datatable, existingRows), desiredRows)
+	assert.Equal(t, []string{"dave"}, rowsDiff.added)
+	assert.Equal(t, []string{"bob"}, rowsDiff.updated)
+	assert.Equal(t, []string{"carol"}, rowsDiff.deleted)
+	assert.False(t, rowsDiff.isEmpty())
+
+	unchanged := diffDatatableRows(normalizeExistingDatatableRows(datatable, existingRows), normalizeExistingDatatableRows(datatable, existingRows))
+	assert.True(t, unchanged.isEmpty())
+}
+
+func TestUnitDatatableRowsWriteCsv(t *testing.T) {
+	datatable := buildTestDatatable(uuid.NewString())
+
+	rows := map[string]map[string]interface{}{
+		"bob":   {"key": "bob", "count": int64(2), "vip": false, "balance": float64(0), "address": "2 Side St, Apt 4"},
+		"alice": {"key": "alice", "count": int64(5), "vip": true, "balance": 1.5, "address": ""},
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, writeDatatableRowsCsv(datatable, rows, &buf))
+	assert.Equal(t, "key,count,vip,balance,address\nalice,5,true,1.5,\nbob,2,false,0,\"2 Side St, Apt 4\"\n", buf.String())
+
+	// The written file must read back to the same rows
+	rowsFile, err := parseDatatableRowsCsv(&buf)
+	assert.Nil(t, err)
+	readRows, err := validateDatatableRows(datatable, rowsFile)
+	assert.Nil(t, err)
+	assert.Equal(t, rows, readRows)
+}
+
+func TestUnitResourceArchitectDatatableRowsCreate(t *testing.T) {
+	tTableId := uuid.NewString()
+	tJobId := uuid.NewString()
+	tUploadUri := "https://example.com/uploads/v2/datatables"
+
+	rowsFilePath := filepath.Join(t.TempDir(), "rows.csv")
+	assert.Nil(t, os.WriteFile(rowsFilePath, []byte("key,count,vip\nalice,5,true\nbob,6,false\n"), 0644))
+
+	var uploadedContents string
+	archProxy := &architectDatatableRowProxy{}
+	archProxy.getArchitectDatatableAttr = func(ctx context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tTableId, datatableId)
+		return buildTestDatatable(tTableId), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	archProxy.getAllArchitectDatatableRowsAttr = func(ctx context.Context, p *architectDatatableRowProxy, tableId string) (*[]map[string]interface{}, error) {
+		rows := []map[string]interface{}{}
+		if uploadedContents != "" {
+			rows = append(rows,
+				map[string]interface{}{"key": "alice", "count": float64(5), "vip": true, "balance": float64(0), "address": ""},
+				map[string]interface{}{"key": "bob", "count": float64(6), "vip": false, "balance": float64(0), "address": ""},
+			)
+		}
+		return &rows, nil
+	}
+	archProxy.createArchitectDatatableImportJobAttr = func(ctx context.Context, p *architectDatatableRowProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tTableId, tableId)
+		assert.Equal(t, datatableRowsImportMode, importMode)
+		return &platformclientv2.Datatableimportjob{Id: &tJobId, UploadURI: &tUploadUri}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	archProxy.uploadArchitectDatatableImportFileAttr = func(ctx context.Context, p *architectDatatableRowProxy, uploadUri string, filePath string) error {
+		assert.Equal(t, tUploadUri, uploadUri)
+		contents, err := os.ReadFile(filePath)
+		uploadedContents = string(contents)
+		return err
+	}
+	archProxy.getArchitectDatatableImportJobAttr = func(ctx context.Context, p *architectDatatableRowProxy, tableId string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tJobId, jobId)
+		status := "Succeeded"
+		return &platformclientv2.Datatableimportjob{Id: &tJobId, Status: &status}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = archProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := map[string]interface{}{
+		"datatable_id":      tTableId,
+		"filepath":          rowsFilePath,
+		"file_content_hash": "abc",
+	}
+	d := schema.TestResourceDataRaw(t, ResourceArchitectDatatableRows().Schema, resourceDataMap)
+
+	diag := createArchitectDatatableRows(ctx, d, gcloud)
+	assert.False(t, diag.HasError())
+	assert.Equal(t, tTableId, d.Id())
+	assert.Equal(t, "key,count,vip,balance,address\nalice,5,true,0,\nbob,6,false,0,\n", uploadedContents)
+	assert.Equal(t, 2, d.Get("row_count").(int))
+	assert.Equal(t, "abc", d.Get("file_content_hash").(string))
+}
+
+func TestUnitResourceArchitectDatatableRowsDelete(t *testing.T) {
+	tTableId := uuid.NewString()
+
+	rowCount := datatableRowsDeleteConcurrency*2 + 3
+	var (
+		mu          sync.Mutex
+		deletedKeys []string
+	)
+	archProxy := &architectDatatableRowProxy{}
+	archProxy.getAllArchitectDatatableRowsAttr = func(ctx context.Context, p *architectDatatableRowProxy, tableId string) (*[]map[string]interface{}, error) {
+		rows := []map[string]interface{}{}
+		for i := 0; i < rowCount; i++ {
+			rows = append(rows, map[string]interface{}{"key": fmt.Sprintf("row-%d", i)})
+		}
+		return &rows, nil
+	}
+	archProxy.deleteArchitectDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tTableId, tableId)
+		mu.Lock()
+		defer mu.Unlock()
+		deletedKeys = append(deletedKeys, rowId)
+		if rowId == "row-0" {
+			// Rows that are already deleted are ignored
+			return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	internalProxy = archProxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceArchitectDatatableRows().Schema, map[string]interface{}{"datatable_id": tTableId})
+	d.SetId(tTableId)
+
+	diag := deleteArchitectDatatableRows(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diag.HasError())
+	assert.Equal(t, rowCount, len(deletedKeys))
+
+	// A failed delete is reported and stops deleting after the current batch
+	deletedKeys = nil
+	archProxy.deleteArchitectDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		deletedKeys = append(deletedKeys, rowId)
+		if rowId == "row-1" {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusInternalServerError}, fmt.Errorf("server error")
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	diag = deleteArchitectDatatableRows(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.True(t, diag.HasError())
+	assert.Contains(t, diag[0].Summary, "row row-1: server error")
+	assert.Equal(t, datatableRowsDeleteConcurrency, len(deletedKeys))
+}
@@ -0,0 +1,423 @@
+package architect_grammar_language
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+/*
+The genesyscloud_architect_grammar_language_parser.go file contains the syntax checks run on grammar files during plan.
+GRXML files must be well formed SRGS XML grammars whose root rule and local rule references are defined. Gram files
+are read as Nuance GSL grammars: brackets must be balanced and every referenced grammar must be defined in the file.
+*/
+
+const (
+	fileTypeGram  = "Gram"
+	fileTypeGrxml = "Grxml"
+)
+
+// grammarDiagnostic is a problem found in a grammar file at a line and column
+type grammarDiagnostic struct {
+	line    int
+	column  int
+	message string
+}
+
+func (d grammarDiagnostic) String() string {
+	return fmt.Sprintf("line %d, column %d: %s", d.line, d.column, d.message)
+}
+
+// grammarFileError holds every problem found in a grammar file
+type grammarFileError struct {
+	fileName    string
+	diagnostics []grammarDiagnostic
+}
+
+func (e *grammarFileError) Error() string {
+	lines := make([]string, 0, len(e.diagnostics))
+	for _, diagnostic := range e.diagnostics {
+		lines = append(lines, fmt.Sprintf("%s: %s", e.fileName, diagnostic))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// validateGrammarFile reads a local grammar file and checks its syntax for the file type
+func validateGrammarFile(fileName string, fileType string) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("failed to read grammar file %s: %v", fileName, err)
+	}
+
+	var diagnostics []grammarDiagnostic
+	switch fileType {
+	case fileTypeGrxml:
+		diagnostics = parseGrxmlGrammar(content)
+	case fileTypeGram:
+		diagnostics = parseGslGrammar(content)
+	default:
+		return nil
+	}
+
+	if len(diagnostics) > 0 {
+		return &grammarFileError{fileName: fileName, diagnostics: diagnostics}
+	}
+	return nil
+}
+
+type grxmlRuleRef struct {
+	ruleId string
+	line   int
+	column int
+}
+
+// parseGrxmlGrammar checks that the content is a well formed GRXML grammar with a defined root rule and
+// that every local rule reference points to a rule of the grammar
+func parseGrxmlGrammar(content []byte) []grammarDiagnostic {
+	if len(bytes.TrimSpace(content)) == 0 {
+		return []grammarDiagnostic{{line: 1, column: 1, message: "grammar file is empty"}}
+	}
+
+	var (
+		diagnostics []grammarDiagnostic
+		rules       = map[string]bool{}
+		refs        []grxmlRuleRef
+		root        string
+		rootLine    int
+		rootColumn  int
+		depth       int
+		hasGrammar  bool
+	)
+
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.Strict = true
+	for {
+		line, column := decoder.InputPos()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				// Point at the start of the token, unless the error was found on a later line of it
+				if syntaxErr.Line != line {
+					_, column = decoder.InputPos()
+				}
+				return append(diagnostics, grammarDiagnostic{line: syntaxErr.Line, column: column, message: "invalid XML: " + syntaxErr.Msg})
+			}
+			return append(diagnostics, grammarDiagnostic{line: line, column: column, message: "invalid XML: " + err.Error()})
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 1 {
+				if element.Name.Local != "grammar" {
+					return append(diagnostics, grammarDiagnostic{line: line, column: column, message: fmt.Sprintf("root element must be <grammar>, found <%s>", element.Name.Local)})
+				}
+				hasGrammar = true
+				root = getXmlAttr(element, "root")
+				rootLine, rootColumn = line, column
+				continue
+			}
+
+			switch element.Name.Local {
+			case "rule":
+				id := getXmlAttr(element, "id")
+				if id == "" {
+					diagnostics = append(diagnostics, grammarDiagnostic{line: line, column: column, message: "<rule> has no id attribute"})
+				} else if rules[id] {
+					diagnostics = append(diagnostics, grammarDiagnostic{line: line, column: column, message: fmt.Sprintf("rule %q is defined more than once", id)})
+				}
+				rules[id] = true
+			case "ruleref":
+				uri := getXmlAttr(element, "uri")
+				special := getXmlAttr(element, "special")
+				if uri == "" && special == "" {
+					diagnostics = append(diagnostics, grammarDiagnostic{line: line, column: column, message: "<ruleref> has no uri or special attribute"})
+				}
+				// Only local references can be checked, external grammars are resolved by the platform
+				if strings.HasPrefix(uri, "#") {
+					refs = append(refs, grxmlRuleRef{ruleId: strings.TrimPrefix(uri, "#"), line: line, column: column})
+				}
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if !hasGrammar {
+		return append(diagnostics, grammarDiagnostic{line: 1, column: 1, message: "grammar file has no <grammar> element"})
+	}
+	if root == "" {
+		diagnostics = append(diagnostics, grammarDiagnostic{line: rootLine, column: rootColumn, message: "<grammar> has no root attribute"})
+	} else if !rules[root] {
+		diagnostics = append(diagnostics, grammarDiagnostic{line: rootLine, column: rootColumn, message: fmt.Sprintf("root rule %q is not defined", root)})
+	}
+	for _, ref := range refs {
+		if !rules[ref.ruleId] {
+			diagnostics = append(diagnostics, grammarDiagnostic{line: ref.line, column: ref.column, message: fmt.Sprintf("<ruleref> references undefined rule %q", ref.ruleId)})
+		}
+	}
+	return diagnostics
+}
+
+func getXmlAttr(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name && (attr.Name.Space == "" || attr.Name.Space == element.Name.Space) {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+type gslTokenKind int
+
+const (
+	gslWord gslTokenKind = iota
+	gslString
+	gslOpen
+	gslClose
+	gslOperator
+	gslCommand
+	gslProbability
+)
+
+type gslToken struct {
+	kind   gslTokenKind
+	value  string
+	line   int
+	column int
+}
+
+// tokenizeGsl splits a GSL grammar into tokens. Comments start with a semicolon and run to the end of the line.
+func tokenizeGsl(content []byte) ([]gslToken, []grammarDiagnostic) {
+	var (
+		tokens []gslToken
+		runes  = []rune(string(content))
+		line   = 1
+		column = 1
+		i      = 0
+	)
+
+	advance := func() rune {
+		r := runes[i]
+		i++
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+		return r
+	}
+
+	for i < len(runes) {
+		r := runes[i]
+		startLine, startColumn := line, column
+		switch {
+		case unicode.IsSpace(r):
+			advance()
+		case r == ';':
+			for i < len(runes) && runes[i] != '\n' {
+				advance()
+			}
+		case r == '(' || r == '[':
+			advance()
+			tokens = append(tokens, gslToken{kind: gslOpen, value: string(r), line: startLine, column: startColumn})
+		case r == ')' || r == ']':
+			advance()
+			tokens = append(tokens, gslToken{kind: gslClose, value: string(r), line: startLine, column: startColumn})
+		case r == '?' || r == '*' || r == '+':
+			advance()
+			tokens = append(tokens, gslToken{kind: gslOperator, value: string(r), line: startLine, column: startColumn})
+		case r == '"':
+			advance()
+			var value strings.Builder
+			for i < len(runes) && runes[i] != '"' && runes[i] != '\n' {
+				value.WriteRune(advance())
+			}
+			if i >= len(runes) || runes[i] != '"' {
+				return tokens, []grammarDiagnostic{{line: startLine, column: startColumn, message: "unterminated string"}}
+			}
+			advance()
+			tokens = append(tokens, gslToken{kind: gslString, value: value.String(), line: startLine, column: startColumn})
+		case r == '{':
+			// Commands are not parsed, only their braces have to be balanced
+			nesting := 0
+			var value strings.Builder
+			for i < len(runes) {
+				c := advance()
+				value.WriteRune(c)
+				if c == '{' {
+					nesting++
+				} else if c == '}' {
+					nesting--
+					if nesting == 0 {
+						break
+					}
+				}
+			}
+			if nesting != 0 {
+				return tokens, []grammarDiagnostic{{line: startLine, column: startColumn, message: "unclosed '{'"}}
+			}
+			tokens = append(tokens, gslToken{kind: gslCommand, value: value.String(), line: startLine, column: startColumn})
+		case r == '}':
+			return tokens, []grammarDiagnostic{{line: startLine, column: startColumn, message: "unexpected '}'"}}
+		case r == '~':
+			advance()
+			var value strings.Builder
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				value.WriteRune(advance())
+			}
+			if value.Len() == 0 {
+				return tokens, []grammarDiagnostic{{line: startLine, column: startColumn, message: "'~' must be followed by a probability"}}
+			}
+			tokens = append(tokens, gslToken{kind: gslProbability, value: value.String(), line: startLine, column: startColumn})
+		default:
+			var value strings.Builder
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(";()[]?*+\"{}~", runes[i]) {
+				value.WriteRune(advance())
+			}
+			tokens = append(tokens, gslToken{kind: gslWord, value: value.String(), line: startLine, column: startColumn})
+		}
+	}
+	return tokens, nil
+}
+
+// gslRuleName returns the grammar name of a word without the leading dot of public grammars and the
+// variable name of a return value, for example .MAIN or DIGIT:d
+func gslRuleName(word string) string {
+	name := strings.TrimPrefix(word, ".")
+	if index := strings.Index(name, ":"); index >= 0 {
+		name = name[:index]
+	}
+	return name
+}
+
+// isGslRuleName is true for words naming a grammar. Grammar names start with an upper case letter while
+// words of the vocabulary are lower case.
+func isGslRuleName(word string) bool {
+	name := gslRuleName(word)
+	if name == "" {
+		return false
+	}
+	first := []rune(name)[0]
+	return unicode.IsUpper(first)
+}
+
+type gslParser struct {
+	tokens      []gslToken
+	position    int
+	diagnostics []grammarDiagnostic
+	references  []gslToken
+}
+
+var gslClosers = map[string]string{"(": ")", "[": "]"}
+
+// parseGslGrammar checks that the content is a list of GSL grammar definitions with balanced brackets
+// and that every referenced grammar is defined
+func parseGslGrammar(content []byte) []grammarDiagnostic {
+	tokens, diagnostics := tokenizeGsl(content)
+	if len(diagnostics) > 0 {
+		return diagnostics
+	}
+	if len(tokens) == 0 {
+		return []grammarDiagnostic{{line: 1, column: 1, message: "grammar file is empty"}}
+	}
+
+	p := &gslParser{tokens: tokens}
+	definitions := map[string]bool{}
+	for p.position < len(p.tokens) {
+		nameToken := p.tokens[p.position]
+		if nameToken.kind != gslWord || !isGslRuleName(nameToken.value) {
+			p.addDiagnostic(nameToken, fmt.Sprintf("expected a grammar name starting with an upper case letter, found %q", nameToken.value))
+			return p.diagnostics
+		}
+		p.position++
+
+		name := gslRuleName(nameToken.value)
+		if definitions[name] {
+			p.addDiagnostic(nameToken, fmt.Sprintf("grammar %s is defined more than once", name))
+		}
+		definitions[name] = true
+
+		if p.position >= len(p.tokens) {
+			p.addDiagnostic(nameToken, fmt.Sprintf("grammar %s has no definition", name))
+			return p.diagnostics
+		}
+		if !p.parseExpression() {
+			return p.diagnostics
+		}
+	}
+
+	for _, reference := range p.references {
+		if !definitions[gslRuleName(reference.value)] {
+			p.addDiagnostic(reference, fmt.Sprintf("grammar %s is not defined", gslRuleName(reference.value)))
+		}
+	}
+	return p.diagnostics
+}
+
+func (p *gslParser) addDiagnostic(token gslToken, message string) {
+	p.diagnostics = append(p.diagnostics, grammarDiagnostic{line: token.line, column: token.column, message: message})
+}
+
+// parseExpression reads a single expression with its operators, probability and commands. It returns false
+// when the grammar cannot be read any further.
+func (p *gslParser) parseExpression() bool {
+	for p.position < len(p.tokens) && p.tokens[p.position].kind == gslOperator {
+		p.position++
+	}
+	if p.position >= len(p.tokens) {
+		p.addDiagnostic(p.tokens[len(p.tokens)-1], "operator is not followed by an expression")
+		return false
+	}
+
+	token := p.tokens[p.position]
+	p.position++
+	switch token.kind {
+	case gslWord:
+		if isGslRuleName(token.value) {
+			p.references = append(p.references, token)
+		}
+	case gslString:
+	case gslOpen:
+		closer := gslClosers[token.value]
+		for {
+			if p.position >= len(p.tokens) {
+				p.addDiagnostic(token, fmt.Sprintf("unclosed '%s'", token.value))
+				return false
+			}
+			next := p.tokens[p.position]
+			if next.kind == gslClose {
+				p.position++
+				if next.value != closer {
+					p.addDiagnostic(next, fmt.Sprintf("expected '%s' to close '%s' at line %d, column %d, found '%s'", closer, token.value, token.line, token.column, next.value))
+					return false
+				}
+				break
+			}
+			if !p.parseExpression() {
+				return false
+			}
+		}
+	case gslClose:
+		p.addDiagnostic(token, fmt.Sprintf("unexpected '%s'", token.value))
+		return false
+	default:
+		p.addDiagnostic(token, fmt.Sprintf("expected an expression, found %q", token.value))
+		return false
+	}
+
+	for p.position < len(p.tokens) && (p.tokens[p.position].kind == gslProbability || p.tokens[p.position].kind == gslCommand) {
+		p.position++
+	}
+	return true
+}
@@ -16,6 +16,14 @@ const (
 	Voice
 )
 
+// String returns the name used for the file type in exported file names
+func (f FileType) String() string {
+	if f == Voice {
+		return "voice"
+	}
+	return "dtmf"
+}
+
 /*
 The genesyscloud_architect_grammar_language_proxy.go file contains the proxy structures and methods that interact
 with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
@@ -92,6 +92,37 @@ func readArchitectGrammarLanguage(ctx context.Context, d *schema.ResourceData, m
 	})
 }
 
+// customizeArchitectGrammarLanguageDiff parses the voice and dtmf grammar files so syntax errors are reported during plan
+func customizeArchitectGrammarLanguageDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var errs []string
+	for _, key := range []string{"voice_file_data", "dtmf_file_data"} {
+		if !diff.NewValueKnown(key) {
+			// The file depends on other resources. It will be checked by the API when uploaded.
+			continue
+		}
+		fileData, ok := diff.Get(key).([]interface{})
+		if !ok || len(fileData) == 0 {
+			continue
+		}
+		fileDataMap, ok := fileData[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fileName, _ := fileDataMap["file_name"].(string)
+		fileType, _ := fileDataMap["file_type"].(string)
+		if fileName == "" {
+			continue
+		}
+		if err := validateGrammarFile(fileName, fileType); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", key, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid grammar files for language %s:\n%s", diff.Get("language").(string), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
 func splitLanguageId(languageId string) (string, string) {
 	split := strings.SplitN(languageId, ":", 2)
 	if len(split) == 2 {
@@ -30,7 +30,7 @@ func ResourceArchitectGrammarLanguage() *schema.Resource {
 	fileMetadataResource := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			`file_name`: {
-				Description: "The name of the file as defined by the user.",
+				Description: "Path to the grammar file. Local GRXML and Gram (GSL) files are parsed during plan and syntax errors are reported with their line and column.",
 				Required:    true,
 				Type:        schema.TypeString,
 			},
@@ -55,6 +55,7 @@ func ResourceArchitectGrammarLanguage() *schema.Resource {
 		ReadContext:   gcloud.ReadWithPooledClient(readArchitectGrammarLanguage),
 		UpdateContext: gcloud.UpdateWithPooledClient(updateArchitectGrammarLanguage),
 		DeleteContext: gcloud.DeleteWithPooledClient(deleteArchitectGrammarLanguage),
+		CustomizeDiff: customizeArchitectGrammarLanguageDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
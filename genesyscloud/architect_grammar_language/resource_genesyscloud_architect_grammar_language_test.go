@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	architectGrammar "terraform-provider-genesyscloud/genesyscloud/architect_grammar"
@@ -134,6 +135,40 @@ func TestAccResourceArchitectGrammarLanguage(t *testing.T) {
 	})
 }
 
+func TestAccResourceArchitectGrammarLanguageInvalidFile(t *testing.T) {
+	var (
+		grammarResourceId = "grammar" + uuid.NewString()
+		grammarResource   = architectGrammar.GenerateGrammarResource(
+			grammarResourceId,
+			"Test grammar"+uuid.NewString(),
+			"",
+		)
+		languageResource = "language" + uuid.NewString()
+		invalidGrxml     = generateFilePath("voice-grxml-invalid.grxml")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Undefined rule references are reported during plan
+				Config: grammarResource + generateGrammarLanguageResource(
+					languageResource,
+					"genesyscloud_architect_grammar."+grammarResourceId+".id",
+					"en-us",
+					generateFileVoiceFileDataBlock(
+						invalidGrxml,
+						"Grxml",
+					),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`line 14, column 10: <ruleref> references undefined rule "ACCOUNT"`),
+			},
+		},
+	})
+}
+
 func generateGrammarLanguageResource(
 	resourceId string,
 	grammarId string,
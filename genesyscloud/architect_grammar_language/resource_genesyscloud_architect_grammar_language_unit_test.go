@@ -0,0 +1,243 @@
+package architect_grammar_language
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseGrxmlGrammar(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "valid grammar",
+			content: `<?xml version="1.0" encoding="UTF-8"?>
+<grammar xmlns="http://www.w3.org/2001/06/grammar" version="1.0" root="ROOT">
+   <rule id="ROOT" scope="public">
+      <ruleref uri="#DIGIT"/>
+      <ruleref special="GARBAGE"/>
+      <ruleref uri="builtin:grammar/digits"/>
+   </rule>
+   <rule id="DIGIT"><one-of><item>1</item><item>2</item></one-of></rule>
+</grammar>`,
+		},
+		{
+			name:     "empty file",
+			content:  " \n",
+			expected: []string{"line 1, column 1: grammar file is empty"},
+		},
+		{
+			name: "unclosed element",
+			content: `<grammar root="ROOT">
+   <rule id="ROOT">
+      <item>1
+   </rule>
+</grammar>`,
+			expected: []string{"line 4, column 4: invalid XML: element <item> closed by </rule>"},
+		},
+		{
+			name:     "wrong root element",
+			content:  `<rule id="ROOT"></rule>`,
+			expected: []string{"line 1, column 1: root element must be <grammar>, found <rule>"},
+		},
+		{
+			name: "missing root attribute",
+			content: `<grammar>
+   <rule id="ROOT"><item>1</item></rule>
+</grammar>`,
+			expected: []string{"line 1, column 1: <grammar> has no root attribute"},
+		},
+		{
+			name: "undefined root and rule references",
+			content: `<grammar root="MAIN">
+   <rule id="ROOT">
+      <ruleref uri="#DIGIT"/>
+   </rule>
+   <rule id="ROOT"/>
+</grammar>`,
+			expected: []string{
+				`line 5, column 4: rule "ROOT" is defined more than once`,
+				`line 1, column 1: root rule "MAIN" is not defined`,
+				`line 3, column 7: <ruleref> references undefined rule "DIGIT"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, diagnosticStrings(parseGrxmlGrammar([]byte(testCase.content))))
+		})
+	}
+}
+
+func TestUnitParseGslGrammar(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "valid grammar",
+			content: `; Account grammar
+.MAIN [
+   (?ARTICLE CHECKING ?account) {<account "checking">}
+   (?ARTICLE savings ?account)~0.4 {<account "savings">}
+   (DIGIT:d +DIGIT) {<account $d>}
+]
+ARTICLE [a the "my"]
+CHECKING [checking check]
+DIGIT [one two]`,
+		},
+		{
+			name:     "empty file",
+			content:  "; only a comment\n",
+			expected: []string{"line 1, column 1: grammar file is empty"},
+		},
+		{
+			name:     "missing grammar name",
+			content:  "checking [a b]",
+			expected: []string{`line 1, column 1: expected a grammar name starting with an upper case letter, found "checking"`},
+		},
+		{
+			name:     "missing definition",
+			content:  ".MAIN",
+			expected: []string{"line 1, column 1: grammar MAIN has no definition"},
+		},
+		{
+			name:     "unclosed bracket",
+			content:  ".MAIN [\n   (a b\n]",
+			expected: []string{"line 3, column 1: expected ')' to close '(' at line 2, column 4, found ']'"},
+		},
+		{
+			name:     "unclosed group at end of file",
+			content:  ".MAIN [\n   a b\n",
+			expected: []string{"line 1, column 7: unclosed '['"},
+		},
+		{
+			name:     "unexpected closing bracket",
+			content:  ".MAIN a )",
+			expected: []string{`line 1, column 9: expected a grammar name starting with an upper case letter, found ")"`},
+		},
+		{
+			name:     "unclosed command",
+			content:  ".MAIN [a {<slot a>]",
+			expected: []string{"line 1, column 10: unclosed '{'"},
+		},
+		{
+			name:     "unterminated string",
+			content:  ".MAIN [\"a b]\n",
+			expected: []string{"line 1, column 8: unterminated string"},
+		},
+		{
+			name:    "undefined and duplicate grammars",
+			content: ".MAIN [\n   (?ARTICLE NUMBER)\n]\nARTICLE [a the]\nARTICLE [my]",
+			expected: []string{
+				"line 5, column 1: grammar ARTICLE is defined more than once",
+				"line 2, column 14: grammar NUMBER is not defined",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, diagnosticStrings(parseGslGrammar([]byte(testCase.content))))
+		})
+	}
+}
+
+func TestUnitValidateGrammarFile(t *testing.T) {
+	for _, fileName := range []string{"voice-grxml-01.grxml", "voice-grxml-02.grxml", "dtmf-grxml-01.grxml", "dtmf-grxml-02.grxml"} {
+		assert.NoError(t, validateGrammarFile(generateFilePath(fileName), fileTypeGrxml), fileName)
+	}
+	for _, fileName := range []string{"voice-gram-01.gram", "voice-gram-02.gram", "dtmf-gram-01.gram", "dtmf-gram-02.gram"} {
+		assert.NoError(t, validateGrammarFile(generateFilePath(fileName), fileTypeGram), fileName)
+	}
+
+	invalidFile := generateFilePath("voice-grxml-invalid.grxml")
+	err := validateGrammarFile(invalidFile, fileTypeGrxml)
+	assert.EqualError(t, err, invalidFile+`: line 14, column 10: <ruleref> references undefined rule "ACCOUNT"`)
+
+	err = validateGrammarFile(generateFilePath("missing.grxml"), fileTypeGrxml)
+	assert.ErrorContains(t, err, "failed to read grammar file")
+}
+
+func TestUnitArchitectGrammarLanguageResolver(t *testing.T) {
+	grammarId := uuid.NewString()
+	languageCode := "en-us"
+	voiceContent := `<grammar root="ROOT"><rule id="ROOT"><item>yes</item></rule></grammar>`
+	dtmfContent := ".MAIN [dtmf-1 dtmf-2]"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/voice":
+			fmt.Fprint(w, voiceContent)
+		case "/dtmf":
+			fmt.Fprint(w, dtmfContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	internalProxy = &architectGrammarLanguageProxy{
+		getArchitectGrammarLanguageByIdAttr: func(ctx context.Context, p *architectGrammarLanguageProxy, id string, code string) (*platformclientv2.Grammarlanguage, int, error) {
+			assert.Equal(t, grammarId, id)
+			assert.Equal(t, languageCode, code)
+			return &platformclientv2.Grammarlanguage{
+				GrammarId:         &grammarId,
+				Language:          &languageCode,
+				VoiceFileUrl:      platformclientv2.String(server.URL + "/voice"),
+				VoiceFileMetadata: &platformclientv2.Grammarlanguagefilemetadata{FileType: platformclientv2.String(fileTypeGrxml)},
+				DtmfFileUrl:       platformclientv2.String(server.URL + "/dtmf"),
+				DtmfFileMetadata:  &platformclientv2.Grammarlanguagefilemetadata{FileType: platformclientv2.String(fileTypeGram)},
+			}, http.StatusOK, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+
+	exportDirectory := t.TempDir()
+	subDirectory := "language_files"
+	configMap := map[string]interface{}{
+		"voice_file_data": []interface{}{map[string]interface{}{"file_name": "voice.grxml", "file_type": fileTypeGrxml}},
+		"dtmf_file_data":  []interface{}{map[string]interface{}{"file_name": "dtmf.gram", "file_type": fileTypeGram}},
+	}
+	meta := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	err := ArchitectGrammarLanguageResolver(grammarId+":"+languageCode, exportDirectory, subDirectory, configMap, meta)
+	assert.NoError(t, err)
+
+	expectedFiles := map[string]string{
+		"voice_file_data": path.Join(subDirectory, fmt.Sprintf("en-us-voice-%s.grxml", grammarId)),
+		"dtmf_file_data":  path.Join(subDirectory, fmt.Sprintf("en-us-dtmf-%s.gram", grammarId)),
+	}
+	expectedContent := map[string]string{"voice_file_data": voiceContent, "dtmf_file_data": dtmfContent}
+	for key, fileName := range expectedFiles {
+		fileData := configMap[key].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, fileName, fileData["file_name"])
+		assert.Equal(t, fmt.Sprintf(`${filesha256("%s")}`, fileName), fileData["file_content_hash"])
+
+		content, err := os.ReadFile(path.Join(exportDirectory, fileName))
+		assert.NoError(t, err)
+		assert.Equal(t, expectedContent[key], string(content))
+	}
+}
+
+func diagnosticStrings(diagnostics []grammarDiagnostic) []string {
+	var result []string
+	for _, diagnostic := range diagnostics {
+		result = append(result, diagnostic.String())
+	}
+	return result
+}
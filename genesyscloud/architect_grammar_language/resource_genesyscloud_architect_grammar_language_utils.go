@@ -105,18 +105,16 @@ func ArchitectGrammarLanguageResolver(languageId, exportDirectory, subDirectory
 	}
 
 	if language.VoiceFileMetadata != nil && language.VoiceFileUrl != nil {
-		if language.VoiceFileMetadata.FileType != nil {
-			downloadFiles(grammarId, fullPath, *language.Language, *language.VoiceFileUrl, *language.VoiceFileMetadata.FileType)
-		} else {
-			downloadFiles(grammarId, fullPath, *language.Language, *language.VoiceFileUrl, "")
+		fileName := getGrammarExportFileName(grammarId, *language.Language, language.VoiceFileMetadata, Voice)
+		if err := files.DownloadExportFile(fullPath, fileName, *language.VoiceFileUrl); err != nil {
+			return fmt.Errorf("failed to download voice file of grammar language %s: %v", languageId, err)
 		}
 	}
 
 	if language.DtmfFileMetadata != nil && language.DtmfFileUrl != nil {
-		if language.DtmfFileMetadata.FileType != nil {
-			downloadFiles(grammarId, fullPath, *language.Language, *language.DtmfFileUrl, *language.DtmfFileMetadata.FileType)
-		} else {
-			downloadFiles(grammarId, fullPath, *language.Language, *language.DtmfFileUrl, "")
+		fileName := getGrammarExportFileName(grammarId, *language.Language, language.DtmfFileMetadata, Dtmf)
+		if err := files.DownloadExportFile(fullPath, fileName, *language.DtmfFileUrl); err != nil {
+			return fmt.Errorf("failed to download dtmf file of grammar language %s: %v", languageId, err)
 		}
 	}
 
@@ -124,16 +122,13 @@ func ArchitectGrammarLanguageResolver(languageId, exportDirectory, subDirectory
 	return nil
 }
 
-func downloadFiles(grammarId string, fullPath string, languageCode string, fileUrl string, fileTypeSdk string) error {
-	fileType := ""
-	if fileTypeSdk != "" {
-		fileType = strings.ToLower(fileTypeSdk)
+// getGrammarExportFileName returns the name of the file a grammar language file is downloaded to during export
+func getGrammarExportFileName(grammarId string, languageCode string, fileMetadata *platformclientv2.Grammarlanguagefilemetadata, fileType FileType) string {
+	fileExtension := ""
+	if fileMetadata != nil && fileMetadata.FileType != nil {
+		fileExtension = strings.ToLower(*fileMetadata.FileType)
 	}
-	dtmfFileName := fmt.Sprintf("%s-dtmf-%s.%s", languageCode, grammarId, fileType)
-	if err := files.DownloadExportFile(fullPath, dtmfFileName, fileUrl); err != nil {
-		return err
-	}
-	return nil
+	return fmt.Sprintf("%s-%s-%s.%s", languageCode, fileType, grammarId, fileExtension)
 }
 
 func updateFilenamesInExportConfigMap(configMap map[string]interface{}, grammarId string, language platformclientv2.Grammarlanguage, subDir string) {
@@ -146,19 +141,25 @@ func updateFilenamesInExportConfigMap(configMap map[string]interface{}, grammarI
 }
 
 func setExporterFileData(fileDataMap []interface{}, grammarId string, language platformclientv2.Grammarlanguage, subDir string, fileType FileType) {
+	if len(fileDataMap) == 0 {
+		return
+	}
+	fileMetadata := language.VoiceFileMetadata
+	fileUrl := language.VoiceFileUrl
+	if fileType == Dtmf {
+		fileMetadata = language.DtmfFileMetadata
+		fileUrl = language.DtmfFileUrl
+	}
+	if fileMetadata == nil || fileUrl == nil {
+		// Nothing was downloaded for this file
+		return
+	}
+
 	//Set file name and content hash in the exporter map
 	if fileData, ok := fileDataMap[0].(map[string]interface{}); ok {
-		fileExtension := ""
-		if fileType == Voice && language.VoiceFileMetadata.FileType != nil {
-			fileExtension = strings.ToLower(*language.VoiceFileMetadata.FileType)
-		}
-		if fileType == Dtmf && language.DtmfFileMetadata.FileType != nil {
-			fileExtension = strings.ToLower(*language.DtmfFileMetadata.FileType)
-		}
-
-		fileName := fmt.Sprintf("%s-%v-%s.%s", *language.Language, fileType, grammarId, fileExtension)
-		fileData["file_name"] = path.Join(subDir, fileName)
-		fileData["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDir, fileName))
+		fileName := path.Join(subDir, getGrammarExportFileName(grammarId, *language.Language, fileMetadata, fileType))
+		fileData["file_name"] = fileName
+		fileData["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, fileName)
 		if fileData["file_type"] == nil {
 			fileData["file_type"] = ""
 		}
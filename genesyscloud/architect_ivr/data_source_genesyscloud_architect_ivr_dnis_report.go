@@ -0,0 +1,81 @@
+package architect_ivr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceIvrDnisReportRead cross checks DNIS numbers against all IVRs and DID pools
+func dataSourceIvrDnisReportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*gcloud.ProviderMeta).ClientConfig
+	ap := getArchitectIvrProxy(sdkConfig)
+
+	var numbers []string
+	if dnis, ok := d.Get("dnis").(*schema.Set); ok && dnis != nil {
+		numbers = *lists.SetToStringList(dnis)
+	}
+
+	log.Printf("Reading IVRs and DID pools for DNIS report")
+	ivrs, err := ap.getAllArchitectIvrs(ctx, "")
+	if err != nil {
+		return diag.Errorf("failed to read IVRs: %v", err)
+	}
+	didPools, err := ap.getAllDidPools(ctx)
+	if err != nil {
+		return diag.Errorf("failed to read DID pools: %v", err)
+	}
+
+	report := buildDnisReport(*ivrs, *didPools, numbers)
+
+	var (
+		entries    = make([]interface{}, 0, len(report))
+		collisions = make([]string, 0)
+		unowned    = make([]string, 0)
+		malformed  = make([]string, 0)
+		conflicts  []string
+	)
+	for _, entry := range report {
+		entries = append(entries, map[string]interface{}{
+			"dnis":        entry.dnis,
+			"e164":        entry.e164,
+			"ivr_ids":     entry.ivrIds,
+			"did_pool_id": entry.didPoolId,
+			"collision":   entry.collision(),
+			"unowned":     entry.unowned(),
+			"malformed":   entry.malformed,
+		})
+		if entry.collision() {
+			collisions = append(collisions, entry.dnis)
+			conflicts = append(conflicts, fmt.Sprintf("DNIS %s is assigned to IVRs %s", entry.dnis, strings.Join(entry.ivrIds, ", ")))
+		}
+		if entry.unowned() {
+			unowned = append(unowned, entry.dnis)
+			conflicts = append(conflicts, fmt.Sprintf("DNIS %s is not in the range of any DID pool", entry.dnis))
+		}
+		if entry.malformed {
+			malformed = append(malformed, entry.dnis)
+			_, e164Err := toE164(entry.dnis)
+			conflicts = append(conflicts, e164Err.Error())
+		}
+	}
+
+	if d.Get("fail_on_conflict").(bool) && len(conflicts) > 0 {
+		return diag.Errorf("DNIS report found %d conflicts:\n%s", len(conflicts), strings.Join(conflicts, "\n"))
+	}
+
+	d.SetId("ivr-dnis-report")
+	_ = d.Set("entries", entries)
+	_ = d.Set("collisions", collisions)
+	_ = d.Set("unowned_dnis", unowned)
+	_ = d.Set("malformed_dnis", malformed)
+
+	log.Printf("Checked %d DNIS numbers: %d collisions, %d unowned, %d malformed", len(report), len(collisions), len(unowned), len(malformed))
+	return nil
+}
@@ -0,0 +1,141 @@
+package architect_ivr
+
+import (
+	"context"
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceArchitectIvrDnisReport(t *testing.T) {
+	var (
+		ivrResource      = "arch-ivr"
+		didPoolResource  = "did-pool"
+		reportDataSource = "dnis-report"
+		name             = "IVR " + uuid.NewString()
+		number1          = "+14175550021"
+		number2          = "+14175550022"
+		unownedNumber    = "+14175550099"
+	)
+
+	// did pool cleanup
+	defer func() {
+		if _, err := gcloud.AuthorizeSdk(); err != nil {
+			return
+		}
+		_, _ = didPool.DeleteDidPoolWithStartAndEndNumber(context.TODO(), number1, number2)
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: didPool.GenerateDidPoolResource(&didPool.DidPoolStruct{
+					ResourceID:       didPoolResource,
+					StartPhoneNumber: number1,
+					EndPhoneNumber:   number2,
+					Description:      gcloud.NullValue,
+					Comments:         gcloud.NullValue,
+					PoolProvider:     gcloud.NullValue,
+				}) + GenerateIvrConfigResource(&IvrConfigStruct{
+					ResourceID:  ivrResource,
+					Name:        name,
+					Description: "Sample IVR by CX as Code",
+					Dnis:        []string{number1},
+					DependsOn:   "genesyscloud_telephony_providers_edges_did_pool." + didPoolResource,
+				}) + generateIvrDnisReportDataSource(
+					reportDataSource,
+					[]string{number1, unownedNumber},
+					resourceName+"."+ivrResource,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data."+dnisReportDataSourceName+"."+reportDataSource, "entries.#", "2"),
+					resource.TestCheckResourceAttr("data."+dnisReportDataSourceName+"."+reportDataSource, "entries.0.dnis", number1),
+					resource.TestCheckResourceAttrPair("data."+dnisReportDataSourceName+"."+reportDataSource, "entries.0.ivr_ids.0", resourceName+"."+ivrResource, "id"),
+					resource.TestCheckResourceAttrPair("data."+dnisReportDataSourceName+"."+reportDataSource, "entries.0.did_pool_id", "genesyscloud_telephony_providers_edges_did_pool."+didPoolResource, "id"),
+					resource.TestCheckResourceAttr("data."+dnisReportDataSourceName+"."+reportDataSource, "collisions.#", "0"),
+					resource.TestCheckResourceAttr("data."+dnisReportDataSourceName+"."+reportDataSource, "unowned_dnis.#", "1"),
+					resource.TestCheckResourceAttr("data."+dnisReportDataSourceName+"."+reportDataSource, "unowned_dnis.0", unownedNumber),
+				),
+			},
+		},
+		CheckDestroy: testVerifyIvrConfigsDestroyed,
+	})
+}
+
+/*
+This is a unit test to test whether the DNIS report flags collisions, unowned numbers and malformed numbers
+*/
+func TestUnitDataSourceArchitectIvrDnisReport(t *testing.T) {
+	ivrId1 := uuid.NewString()
+	ivrId2 := uuid.NewString()
+	didPoolId := uuid.NewString()
+
+	archProxy := &architectIvrProxy{}
+	archProxy.getAllArchitectIvrsAttr = func(ctx context.Context, a *architectIvrProxy, name string) (*[]platformclientv2.Ivr, error) {
+		return &[]platformclientv2.Ivr{
+			{Id: &ivrId1, Name: platformclientv2.String("IVR 1"), Dnis: &[]string{"+13175550001", "+13175550002"}},
+			{Id: &ivrId2, Name: platformclientv2.String("IVR 2"), Dnis: &[]string{"+1 317-555-0002", "+13175550100"}},
+		}, nil
+	}
+	archProxy.getAllDidPoolsAttr = func(ctx context.Context, a *architectIvrProxy) (*[]platformclientv2.Didpool, error) {
+		return &[]platformclientv2.Didpool{
+			{Id: &didPoolId, StartPhoneNumber: platformclientv2.String("+13175550000"), EndPhoneNumber: platformclientv2.String("+13175550099")},
+			{Id: platformclientv2.String(uuid.NewString()), StartPhoneNumber: platformclientv2.String("+13175550100"), EndPhoneNumber: platformclientv2.String("+13175550199"), State: platformclientv2.String("deleted")},
+		}, nil
+	}
+	internalProxy = archProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectIvrDnisReport().Schema, map[string]interface{}{})
+	diag := dataSourceIvrDnisReportRead(ctx, d, gcloud)
+	assert.False(t, diag.HasError())
+
+	assert.Equal(t, 3, d.Get("entries.#").(int))
+	assert.Equal(t, "+13175550001", d.Get("entries.0.dnis").(string))
+	assert.Equal(t, didPoolId, d.Get("entries.0.did_pool_id").(string))
+	assert.False(t, d.Get("entries.0.collision").(bool))
+
+	assert.Equal(t, "+13175550002", d.Get("entries.1.e164").(string))
+	assert.True(t, d.Get("entries.1.collision").(bool))
+	assert.Equal(t, 2, d.Get("entries.1.ivr_ids.#").(int))
+
+	assert.Equal(t, []interface{}{"+13175550002"}, d.Get("collisions").([]interface{}))
+	assert.Equal(t, []interface{}{"+13175550100"}, d.Get("unowned_dnis").([]interface{}))
+	assert.Equal(t, []interface{}{}, d.Get("malformed_dnis").([]interface{}))
+
+	// Numbers given as input are reported even when no IVR uses them
+	d = schema.TestResourceDataRaw(t, DataSourceArchitectIvrDnisReport().Schema, map[string]interface{}{
+		"dnis":             []interface{}{"+13175550050", "+1 317 555 0051"},
+		"fail_on_conflict": true,
+	})
+	diag = dataSourceIvrDnisReportRead(ctx, d, gcloud)
+	assert.True(t, diag.HasError())
+	assert.Contains(t, diag[0].Summary, "phone number +1 317 555 0051 is not in an E.164 format, expected +13175550051")
+}
+
+func generateIvrDnisReportDataSource(resourceID string, dnis []string, dependsOnResource string) string {
+	var quotedDnis string
+	for i, number := range dnis {
+		if i > 0 {
+			quotedDnis += ", "
+		}
+		quotedDnis += fmt.Sprintf("%q", number)
+	}
+	return fmt.Sprintf(`data "%s" "%s" {
+		dnis       = [%s]
+		depends_on = [%s]
+	}
+	`, dnisReportDataSourceName, resourceID, quotedDnis, dependsOnResource)
+}
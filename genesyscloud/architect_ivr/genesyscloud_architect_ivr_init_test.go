@@ -43,6 +43,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceArchitectIvr()
+	providerDataSources[dnisReportDataSourceName] = DataSourceArchitectIvrDnisReport()
 }
 
 // initTestResources initializes all test resources and data sources.
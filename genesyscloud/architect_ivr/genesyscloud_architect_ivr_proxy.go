@@ -40,11 +40,13 @@ type updateArchitectIvrFunc func(context.Context, *architectIvrProxy, string, pl
 type deleteArchitectIvrFunc func(context.Context, *architectIvrProxy, string) (*platformclientv2.APIResponse, error)
 type getAllArchitectIvrsFunc func(context.Context, *architectIvrProxy, string) (*[]platformclientv2.Ivr, error)
 type getArchitectIvrIdByNameFunc func(context.Context, *architectIvrProxy, string) (id string, retryable bool, err error)
+type getAllDidPoolsFunc func(context.Context, *architectIvrProxy) (*[]platformclientv2.Didpool, error)
 
 // architectIvrProxy contains all methods that call genesys cloud APIs.
 type architectIvrProxy struct {
 	clientConfig *platformclientv2.Configuration
 	api          *platformclientv2.ArchitectApi
+	telephonyApi *platformclientv2.TelephonyProvidersEdgeApi
 
 	createArchitectIvrAttr      createArchitectIvrFunc
 	getArchitectIvrAttr         getArchitectIvrFunc
@@ -52,6 +54,7 @@ type architectIvrProxy struct {
 	deleteArchitectIvrAttr      deleteArchitectIvrFunc
 	getAllArchitectIvrsAttr     getAllArchitectIvrsFunc
 	getArchitectIvrIdByNameAttr getArchitectIvrIdByNameFunc
+	getAllDidPoolsAttr          getAllDidPoolsFunc
 
 	maxDnisPerRequest int
 
@@ -63,9 +66,11 @@ type architectIvrProxy struct {
 // newArchitectIvrProxy initializes the proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *architectIvrProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
 	return &architectIvrProxy{
 		clientConfig: clientConfig,
 		api:          api,
+		telephonyApi: telephonyApi,
 
 		createArchitectIvrAttr:      createArchitectIvrFn,
 		getArchitectIvrAttr:         getArchitectIvrFn,
@@ -73,6 +78,7 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 		deleteArchitectIvrAttr:      deleteArchitectIvrFn,
 		getAllArchitectIvrsAttr:     getAllArchitectIvrsFn,
 		getArchitectIvrIdByNameAttr: getArchitectIvrIdByNameFn,
+		getAllDidPoolsAttr:          getAllDidPoolsFn,
 
 		maxDnisPerRequest: maxDnisPerRequest,
 
@@ -100,6 +106,11 @@ func (a *architectIvrProxy) getArchitectIvrIdByName(ctx context.Context, name st
 	return a.getArchitectIvrIdByNameAttr(ctx, a, name)
 }
 
+// getAllDidPools retrieves all Genesys Cloud DID pools
+func (a *architectIvrProxy) getAllDidPools(ctx context.Context) (*[]platformclientv2.Didpool, error) {
+	return a.getAllDidPoolsAttr(ctx, a)
+}
+
 // createArchitectIvr creates a Genesys Cloud Architect IVR
 func (a *architectIvrProxy) createArchitectIvr(ctx context.Context, ivr platformclientv2.Ivr) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
 	return a.createArchitectIvrAttr(ctx, a, ivr)
@@ -197,6 +208,35 @@ func getAllArchitectIvrsFn(_ context.Context, a *architectIvrProxy, name string)
 	return &allIvrs, nil
 }
 
+// getAllDidPoolsFn is an implementation function for retrieving all Genesys Cloud DID pools
+func getAllDidPoolsFn(_ context.Context, a *architectIvrProxy) (*[]platformclientv2.Didpool, error) {
+	var allDidPools []platformclientv2.Didpool
+	const pageSize = 100
+
+	didPools, _, err := a.telephonyApi.GetTelephonyProvidersEdgesDidpools(pageSize, 1, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting page of did pools: %v", err)
+	}
+	if didPools.Entities != nil {
+		allDidPools = append(allDidPools, *didPools.Entities...)
+	}
+	if didPools.PageCount == nil {
+		return &allDidPools, nil
+	}
+
+	for pageNum := 2; pageNum <= *didPools.PageCount; pageNum++ {
+		didPools, _, err := a.telephonyApi.GetTelephonyProvidersEdgesDidpools(pageSize, pageNum, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("error requesting page of did pools: %v", err)
+		}
+		if didPools.Entities == nil || len(*didPools.Entities) == 0 {
+			break
+		}
+		allDidPools = append(allDidPools, *didPools.Entities...)
+	}
+	return &allDidPools, nil
+}
+
 // getArchitectIvrIdByNameFn is an implementation function for retrieving a Genesys Cloud Architect IVR ID by name
 func getArchitectIvrIdByNameFn(ctx context.Context, a *architectIvrProxy, name string) (string, bool, error) {
 	ivrs, err := getAllArchitectIvrsFn(ctx, a, name)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
@@ -35,6 +37,86 @@ func getAllIvrConfigs(ctx context.Context, clientConfig *platformclientv2.Config
 	return resources, nil
 }
 
+// importIvrConfig sets require_did_pool to its default. It only changes how plans are checked, so it cannot be read from the IVR.
+func importIvrConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("require_did_pool", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// customizeIvrConfigDiff checks that the DNIS numbers added to the IVR are in an E.164 format, are not already
+// assigned to another IVR and, when require_did_pool is true, are in the range of a DID pool
+func customizeIvrConfigDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("dnis") || !diff.HasChange("dnis") {
+		return nil
+	}
+	oldDnis, newDnis := diff.GetChange("dnis")
+	oldSet, _ := oldDnis.(*schema.Set)
+	newSet, ok := newDnis.(*schema.Set)
+	if !ok || newSet.Len() == 0 {
+		return nil
+	}
+	addedDnis := newSet
+	if oldSet != nil {
+		addedDnis = newSet.Difference(oldSet)
+	}
+	if addedDnis.Len() == 0 {
+		return nil
+	}
+
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ap := getArchitectIvrProxy(sdkConfig)
+
+	ivrs, err := ap.getAllArchitectIvrs(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to read IVRs to check for DNIS collisions: %v", err)
+	}
+	didPools := &[]platformclientv2.Didpool{}
+	requireDidPool := diff.Get("require_did_pool").(bool)
+	if requireDidPool {
+		if didPools, err = ap.getAllDidPools(ctx); err != nil {
+			return fmt.Errorf("failed to read DID pools to check DNIS ownership: %v", err)
+		}
+	}
+
+	errs := validateIvrDnis(*ivrs, *didPools, *lists.SetToStringList(addedDnis), diff.Id(), requireDidPool)
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid DNIS for IVR %s:\n%s", diff.Get("name").(string), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// validateIvrDnis reports the numbers that are malformed, assigned to an IVR other than ivrId or, when requireDidPool
+// is set, not in the range of any DID pool
+func validateIvrDnis(ivrs []platformclientv2.Ivr, didPools []platformclientv2.Didpool, numbers []string, ivrId string, requireDidPool bool) []string {
+	ivrNames := make(map[string]string)
+	for _, ivr := range ivrs {
+		if ivr.Id != nil && ivr.Name != nil {
+			ivrNames[*ivr.Id] = *ivr.Name
+		}
+	}
+
+	var errs []string
+	for _, entry := range buildDnisReport(ivrs, didPools, numbers) {
+		if entry.malformed {
+			if _, err := toE164(entry.dnis); err != nil {
+				errs = append(errs, fmt.Sprintf("DNIS %s: %v", entry.dnis, err))
+			}
+			continue
+		}
+		for _, otherIvrId := range entry.ivrIds {
+			if otherIvrId == ivrId {
+				continue
+			}
+			errs = append(errs, fmt.Sprintf("DNIS %s is already assigned to IVR %s (%s)", entry.dnis, ivrNames[otherIvrId], otherIvrId))
+		}
+		if requireDidPool && entry.unowned() {
+			errs = append(errs, fmt.Sprintf("DNIS %s is not in the range of any DID pool. Create the DID pool in an earlier apply or set require_did_pool to false", entry.dnis))
+		}
+	}
+	sort.Strings(errs)
+	return errs
+}
+
 // createIvrConfig is used by the resource to create a Genesys Cloud Architect IVR
 func createIvrConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
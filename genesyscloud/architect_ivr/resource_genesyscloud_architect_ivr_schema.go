@@ -9,13 +9,15 @@ import (
 )
 
 const (
-	resourceName      = "genesyscloud_architect_ivr"
-	maxDnisPerRequest = 50
+	resourceName             = "genesyscloud_architect_ivr"
+	dnisReportDataSourceName = "genesyscloud_architect_ivr_dnis_report"
+	maxDnisPerRequest        = 50
 )
 
 // SetRegistrar registers all resources, data sources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceArchitectIvr())
+	l.RegisterDataSource(dnisReportDataSourceName, DataSourceArchitectIvrDnisReport())
 	l.RegisterResource(resourceName, ResourceArchitectIvrConfig())
 	l.RegisterExporter(resourceName, ArchitectIvrExporter())
 }
@@ -43,8 +45,9 @@ func ResourceArchitectIvrConfig() *schema.Resource {
 		ReadContext:   gcloud.ReadWithPooledClient(readIvrConfig),
 		UpdateContext: gcloud.UpdateWithPooledClient(updateIvrConfig),
 		DeleteContext: gcloud.DeleteWithPooledClient(deleteIvrConfig),
+		CustomizeDiff: customizeIvrConfigDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importIvrConfig,
 		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -60,12 +63,18 @@ func ResourceArchitectIvrConfig() *schema.Resource {
 				Optional:    true,
 			},
 			"dnis": {
-				Description: fmt.Sprintf("The phone number(s) to contact the IVR by. Each phone number in the array must be in an E.164 number format. (Note: An array with a length greater than %v will be broken into chunks and uploaded in subsequent PUT requests.)", maxDnisPerRequest),
+				Description: fmt.Sprintf("The phone number(s) to contact the IVR by. Each phone number in the array must be in an E.164 number format. Numbers added to the IVR must not be assigned to another IVR. (Note: An array with a length greater than %v will be broken into chunks and uploaded in subsequent PUT requests.)", maxDnisPerRequest),
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: gcloud.ValidatePhoneNumber},
 			},
+			"require_did_pool": {
+				Description: "When true, planning fails for DNIS numbers added to the IVR that are not in the range of an existing DID pool. Only enable this when the DID pools of the numbers are created before the IVR is planned, since a DID pool created in the same apply does not exist yet when the plan is made.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"open_hours_flow_id": {
 				Description: "ID of inbound call flow for open hours.",
 				Type:        schema.TypeString,
@@ -110,3 +119,90 @@ func DataSourceArchitectIvr() *schema.Resource {
 		},
 	}
 }
+
+var dnisReportEntryResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"dnis": {
+			Description: "The DNIS number as assigned to the IVRs or given in `dnis`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"e164": {
+			Description: "The DNIS number in an E.164 format. Empty if the number cannot be parsed.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ivr_ids": {
+			Description: "IDs of the IVRs the number is assigned to.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"did_pool_id": {
+			Description: "ID of the DID pool whose range contains the number. Empty if no DID pool owns the number.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"collision": {
+			Description: "True if the number is assigned to more than one IVR.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"unowned": {
+			Description: "True if the number is not in the range of any DID pool.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"malformed": {
+			Description: "True if the number is not in an E.164 format.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceArchitectIvrDnisReport registers the genesyscloud_architect_ivr_dnis_report data source
+func DataSourceArchitectIvrDnisReport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Cross checks DNIS numbers against the IVRs and the DID pool ranges of the org. Reports numbers assigned to more than one IVR, numbers not owned by any DID pool and numbers that are not in an E.164 format.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceIvrDnisReportRead),
+		Schema: map[string]*schema.Schema{
+			"dnis": {
+				Description: "DNIS numbers to check, for example numbers about to be assigned to an IVR. If not set, every DNIS of every IVR is checked.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"fail_on_conflict": {
+				Description: "Fail when a collision, an unowned number or a malformed number is found.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"entries": {
+				Description: "The checked DNIS numbers, ordered by number.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dnisReportEntryResource,
+			},
+			"collisions": {
+				Description: "DNIS numbers assigned to more than one IVR.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"unowned_dnis": {
+				Description: "DNIS numbers not in the range of any DID pool.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"malformed_dnis": {
+				Description: "DNIS numbers that are not in an E.164 format.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
@@ -87,6 +88,82 @@ func TestAccResourceIvrConfigBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceIvrConfigRequireDidPool(t *testing.T) {
+	ivrConfigResource1 := "test-ivrconfig-did-pool"
+	ivrConfigName := "terraform-ivrconfig-" + uuid.NewString()
+	ivrConfigDescription := "Terraform IVR config"
+	number1 := "+14175550031"
+	number2 := "+14175550032"
+	unownedNumber := "+14175550039"
+	didPoolResource1 := "test-didpool-require"
+
+	// did pool cleanup
+	defer func() {
+		if _, err := gcloud.AuthorizeSdk(); err != nil {
+			return
+		}
+		ctx := context.TODO()
+		_, _ = didPool.DeleteDidPoolWithStartAndEndNumber(ctx, number1, number2)
+	}()
+
+	didPoolConfig := didPool.GenerateDidPoolResource(&didPool.DidPoolStruct{
+		ResourceID:       didPoolResource1,
+		StartPhoneNumber: number1,
+		EndPhoneNumber:   number2,
+		Description:      gcloud.NullValue, // No description
+		Comments:         gcloud.NullValue, // No comments
+		PoolProvider:     gcloud.NullValue, // No provider
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The DID pool has to exist before the IVR is planned
+				Config: didPoolConfig,
+			},
+			{
+				// Numbers outside of every DID pool are rejected during plan
+				Config: didPoolConfig + GenerateIvrConfigResource(&IvrConfigStruct{
+					ResourceID:     ivrConfigResource1,
+					Name:           ivrConfigName,
+					Description:    ivrConfigDescription,
+					Dnis:           []string{number1, unownedNumber},
+					DependsOn:      "genesyscloud_telephony_providers_edges_did_pool." + didPoolResource1,
+					RequireDidPool: gcloud.TrueValue,
+				}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf("DNIS \\%s is not in the range of any DID pool", unownedNumber)),
+			},
+			{
+				// Numbers in the DID pool are accepted
+				Config: didPoolConfig + GenerateIvrConfigResource(&IvrConfigStruct{
+					ResourceID:     ivrConfigResource1,
+					Name:           ivrConfigName,
+					Description:    ivrConfigDescription,
+					Dnis:           []string{number1, number2},
+					DependsOn:      "genesyscloud_telephony_providers_edges_did_pool." + didPoolResource1,
+					RequireDidPool: gcloud.TrueValue,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"."+ivrConfigResource1, "require_did_pool", gcloud.TrueValue),
+					gcloud.ValidateStringInArray(resourceName+"."+ivrConfigResource1, "dnis", number1),
+					gcloud.ValidateStringInArray(resourceName+"."+ivrConfigResource1, "dnis", number2),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            resourceName + "." + ivrConfigResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"require_did_pool"},
+			},
+		},
+		CheckDestroy: testVerifyIvrConfigsDestroyed,
+	})
+}
+
 func TestAccResourceIvrConfigDivision(t *testing.T) {
 	ivrConfigResource1 := "test-ivrconfig1"
 	ivrConfigName := "terraform-ivrconfig-" + uuid.NewString()
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
 	"github.com/stretchr/testify/assert"
 )
@@ -338,6 +339,95 @@ func TestUnitResourceArchitectUpdate(t *testing.T) {
 	assert.Equal(t, tDescription, d.Get("description").(string))
 }
 
+func TestUnitResourceArchitectIvrDnisCollision(t *testing.T) {
+	tId := uuid.NewString()
+	tOtherId := uuid.NewString()
+	tName := "My Unit Test IVR"
+
+	archProxy := &architectIvrProxy{}
+	archProxy.getAllArchitectIvrsAttr = func(ctx context.Context, a *architectIvrProxy, name string) (*[]platformclientv2.Ivr, error) {
+		return &[]platformclientv2.Ivr{
+			{Id: &tId, Name: &tName, Dnis: &[]string{"+13175550001"}},
+			{Id: &tOtherId, Name: platformclientv2.String("Other IVR"), Dnis: &[]string{"+13175550002"}},
+		}, nil
+	}
+	archProxy.getAllDidPoolsAttr = func(ctx context.Context, a *architectIvrProxy) (*[]platformclientv2.Didpool, error) {
+		return &[]platformclientv2.Didpool{
+			{Id: platformclientv2.String(uuid.NewString()), StartPhoneNumber: platformclientv2.String("+13175550000"), EndPhoneNumber: platformclientv2.String("+13175550009")},
+		}, nil
+	}
+	internalProxy = archProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	state := &terraform.InstanceState{
+		ID: tId,
+		Attributes: map[string]string{
+			"id":     tId,
+			"name":   tName,
+			"dnis.#": "1",
+			"dnis.0": "+13175550001",
+		},
+	}
+
+	// Numbers already assigned to this IVR are not collisions
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": tName,
+		"dnis": []interface{}{"+13175550001", "+13175550003"},
+	})
+	_, err := ResourceArchitectIvrConfig().Diff(ctx, state, config, gcloud)
+	assert.NoError(t, err)
+
+	// Numbers assigned to another IVR are collisions
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": tName,
+		"dnis": []interface{}{"+13175550001", "+13175550002"},
+	})
+	_, err = ResourceArchitectIvrConfig().Diff(ctx, state, config, gcloud)
+	assert.ErrorContains(t, err, fmt.Sprintf("DNIS +13175550002 is already assigned to IVR Other IVR (%s)", tOtherId))
+
+	// DID pools are not checked by default
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": tName,
+		"dnis": []interface{}{"+13175550001", "+13175550010"},
+	})
+	_, err = ResourceArchitectIvrConfig().Diff(ctx, state, config, gcloud)
+	assert.NoError(t, err)
+
+	// Numbers outside of every DID pool are unowned when require_did_pool is true
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             tName,
+		"dnis":             []interface{}{"+13175550001", "+13175550010"},
+		"require_did_pool": true,
+	})
+	_, err = ResourceArchitectIvrConfig().Diff(ctx, state, config, gcloud)
+	assert.ErrorContains(t, err, "DNIS +13175550010 is not in the range of any DID pool")
+
+	// Numbers in a DID pool are owned
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             tName,
+		"dnis":             []interface{}{"+13175550001", "+13175550003"},
+		"require_did_pool": true,
+	})
+	_, err = ResourceArchitectIvrConfig().Diff(ctx, state, config, gcloud)
+	assert.NoError(t, err)
+}
+
+func TestUnitValidateIvrDnis(t *testing.T) {
+	tId := uuid.NewString()
+	ivrs := []platformclientv2.Ivr{{Id: &tId, Name: platformclientv2.String("IVR"), Dnis: &[]string{"+13175550001"}}}
+	didPools := []platformclientv2.Didpool{
+		{Id: platformclientv2.String(uuid.NewString()), StartPhoneNumber: platformclientv2.String("+13175550000"), EndPhoneNumber: platformclientv2.String("+13175550009")},
+	}
+
+	errs := validateIvrDnis(ivrs, didPools, []string{"+13175550002", "3175550003", "+13175550001"}, "", true)
+	assert.Equal(t, []string{
+		"DNIS +13175550001 is already assigned to IVR IVR (" + tId + ")",
+		"DNIS 3175550003: phone number 3175550003 is not in an E.164 format, expected +13175550003",
+	}, errs)
+}
+
 func buildIvrResourceMap(tId string, tName string, tDescription string, tIDnis []interface{}, tOpenHoursFlowId string, tClosedHoursFlowId string, tHolidayHoursFlowId string, tScheduleGroupId string, tDivisionId string) map[string]interface{} {
 	resourceDataMap := map[string]interface{}{
 		"id":                    tId,
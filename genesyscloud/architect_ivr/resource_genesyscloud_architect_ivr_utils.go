@@ -2,6 +2,7 @@ package architect_ivr
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/nyaruka/phonenumbers"
 )
 
 type IvrConfigStruct struct {
@@ -18,6 +20,8 @@ type IvrConfigStruct struct {
 	Dnis        []string
 	DependsOn   string
 	DivisionId  string
+	// RequireDidPool is the HCL value of require_did_pool. It is left unset when empty.
+	RequireDidPool string
 }
 
 // GenerateIvrConfigResource returns an ivr resource as a string based on the IvrConfigStruct struct
@@ -34,12 +38,18 @@ func GenerateIvrConfigResource(ivrConfig *IvrConfigStruct) string {
 		divisionId = "null"
 	}
 
+	requireDidPool := ""
+	if ivrConfig.RequireDidPool != "" {
+		requireDidPool = "require_did_pool = " + ivrConfig.RequireDidPool
+	}
+
 	return fmt.Sprintf(`resource "%s" "%s" {
 		name        = "%s"
 		description = "%s"
 		dnis        = [%s]
 		depends_on  = [%s]
 		division_id = %s
+		%s
 	}
 	`, resourceName,
 		ivrConfig.ResourceID,
@@ -48,6 +58,7 @@ func GenerateIvrConfigResource(ivrConfig *IvrConfigStruct) string {
 		strings.Join(quotedDnsSlice, ","),
 		ivrConfig.DependsOn,
 		divisionId,
+		requireDidPool,
 	)
 }
 
@@ -83,3 +94,142 @@ func buildArchitectIvrFromResourceData(d *schema.ResourceData) *platformclientv2
 
 	return &ivrBody
 }
+
+// dnisReportEntry describes the IVRs and the DID pool a DNIS number belongs to
+type dnisReportEntry struct {
+	dnis      string
+	e164      string
+	ivrIds    []string
+	didPoolId string
+	malformed bool
+}
+
+// collision is true when the number is assigned to more than one IVR
+func (e *dnisReportEntry) collision() bool {
+	return len(e.ivrIds) > 1
+}
+
+// unowned is true when a valid number is not in the range of any DID pool
+func (e *dnisReportEntry) unowned() bool {
+	return !e.malformed && e.didPoolId == ""
+}
+
+// didPoolRange is the range of E.164 numbers of a DID pool
+type didPoolRange struct {
+	id    string
+	start string
+	end   string
+}
+
+// toE164 formats a phone number as E.164. The error is set when the number is not already in an E.164 format.
+func toE164(number string) (string, error) {
+	phoneNumber, err := phonenumbers.Parse(number, "US")
+	if err != nil {
+		return "", fmt.Errorf("failed to parse phone number %s: %v", number, err)
+	}
+	formatted := phonenumbers.Format(phoneNumber, phonenumbers.E164)
+	if formatted != number {
+		return formatted, fmt.Errorf("phone number %s is not in an E.164 format, expected %s", number, formatted)
+	}
+	return formatted, nil
+}
+
+// getDidPoolRanges returns the number ranges of the DID pools that are not deleted
+func getDidPoolRanges(didPools []platformclientv2.Didpool) []didPoolRange {
+	var ranges []didPoolRange
+	for _, didPool := range didPools {
+		if didPool.Id == nil || didPool.StartPhoneNumber == nil || didPool.EndPhoneNumber == nil {
+			continue
+		}
+		if didPool.State != nil && *didPool.State == "deleted" {
+			continue
+		}
+		ranges = append(ranges, didPoolRange{id: *didPool.Id, start: *didPool.StartPhoneNumber, end: *didPool.EndPhoneNumber})
+	}
+	return ranges
+}
+
+// findDidPool returns the ID of the DID pool whose range contains the E.164 number. E.164 numbers of the
+// same length compare the same way as strings and as integers.
+func findDidPool(ranges []didPoolRange, e164 string) string {
+	for _, didPoolRange := range ranges {
+		if len(didPoolRange.start) != len(e164) || len(didPoolRange.end) != len(e164) {
+			continue
+		}
+		if didPoolRange.start <= e164 && e164 <= didPoolRange.end {
+			return didPoolRange.id
+		}
+	}
+	return ""
+}
+
+// dnisKey is the key numbers are compared by. Malformed numbers that can be parsed are compared by their E.164 format.
+func dnisKey(dnis string) string {
+	if e164, _ := toE164(dnis); e164 != "" {
+		return e164
+	}
+	return dnis
+}
+
+// getIvrIdsByDnis maps every DNIS of the IVRs to the IDs of the IVRs it is assigned to
+func getIvrIdsByDnis(ivrs []platformclientv2.Ivr) map[string][]string {
+	ivrIdsByDnis := make(map[string][]string)
+	for _, ivr := range ivrs {
+		if ivr.Id == nil || ivr.Dnis == nil || (ivr.State != nil && *ivr.State == "deleted") {
+			continue
+		}
+		for _, dnis := range *ivr.Dnis {
+			key := dnisKey(dnis)
+			if !lists.ItemInSlice(*ivr.Id, ivrIdsByDnis[key]) {
+				ivrIdsByDnis[key] = append(ivrIdsByDnis[key], *ivr.Id)
+			}
+		}
+	}
+	return ivrIdsByDnis
+}
+
+// buildDnisReport cross checks the DNIS numbers against the IVRs and DID pools. When numbers is empty, every
+// DNIS of the IVRs is reported.
+func buildDnisReport(ivrs []platformclientv2.Ivr, didPools []platformclientv2.Didpool, numbers []string) []*dnisReportEntry {
+	ivrIdsByDnis := getIvrIdsByDnis(ivrs)
+	ranges := getDidPoolRanges(didPools)
+
+	if len(numbers) == 0 {
+		for _, ivr := range ivrs {
+			if ivr.Dnis == nil || (ivr.State != nil && *ivr.State == "deleted") {
+				continue
+			}
+			numbers = append(numbers, *ivr.Dnis...)
+		}
+	}
+
+	entries := make(map[string]*dnisReportEntry)
+	for _, number := range numbers {
+		key := dnisKey(number)
+		if _, exists := entries[key]; exists {
+			continue
+		}
+		entry := &dnisReportEntry{dnis: number}
+		e164, err := toE164(number)
+		entry.e164 = e164
+		entry.malformed = err != nil
+		if !entry.malformed {
+			entry.didPoolId = findDidPool(ranges, e164)
+		}
+		entry.ivrIds = append(entry.ivrIds, ivrIdsByDnis[key]...)
+		sort.Strings(entry.ivrIds)
+		entries[key] = entry
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	report := make([]*dnisReportEntry, 0, len(keys))
+	for _, key := range keys {
+		report = append(report, entries[key])
+	}
+	return report
+}
@@ -0,0 +1,120 @@
+package architect_schedulegroup_evaluation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func dataSourceArchitectSchedulegroupEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getScheduleGroupEvaluationProxy(sdkConfig)
+
+	scheduleGroupId := d.Get("schedule_group_id").(string)
+	rangeStart, err := time.Parse(resourcedata.TimeParseFormat, d.Get("start").(string))
+	if err != nil {
+		return diag.Errorf("Failed to parse start %s: %s", d.Get("start").(string), err)
+	}
+	rangeEnd, err := time.Parse(resourcedata.TimeParseFormat, d.Get("end").(string))
+	if err != nil {
+		return diag.Errorf("Failed to parse end %s: %s", d.Get("end").(string), err)
+	}
+	if !rangeEnd.After(rangeStart) {
+		return diag.Errorf("end %s must be after start %s", d.Get("end").(string), d.Get("start").(string))
+	}
+	if rangeEnd.Sub(rangeStart) > maxEvaluationRange {
+		return diag.Errorf("time range from %s to %s is longer than 366 days", d.Get("start").(string), d.Get("end").(string))
+	}
+
+	log.Printf("Evaluating schedule group %s", scheduleGroupId)
+	scheduleGroup, _, err := proxy.getScheduleGroup(ctx, scheduleGroupId)
+	if err != nil {
+		return diag.Errorf("Failed to read schedule group %s: %s", scheduleGroupId, err)
+	}
+
+	timeZone := "UTC"
+	if scheduleGroup.TimeZone != nil && *scheduleGroup.TimeZone != "" {
+		timeZone = *scheduleGroup.TimeZone
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return diag.Errorf("Failed to load time zone %s of schedule group %s: %s", timeZone, scheduleGroupId, err)
+	}
+
+	schedules, diagErr := getGroupSchedules(ctx, proxy, scheduleGroupId, scheduleGroup)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	var neverMatching []string
+	for _, schedule := range schedules {
+		if schedule.neverMatches() {
+			neverMatching = append(neverMatching, fmt.Sprintf("%s (%s schedule, rrule %q)", schedule.name, schedule.state, schedule.rrule))
+		}
+	}
+	if len(neverMatching) > 0 {
+		return diag.Errorf("Schedule group %s has schedules that never match: %s", scheduleGroupId, strings.Join(neverMatching, ", "))
+	}
+
+	intervals, overlaps := evaluateScheduleGroup(schedules, rangeStart, rangeEnd)
+
+	var diags diag.Diagnostics
+	for _, overlap := range overlaps {
+		summary := fmt.Sprintf("Open and closed schedules of schedule group %s overlap from %s to %s: %s",
+			scheduleGroupId,
+			inLocation(overlap.start, location).Format(time.RFC3339),
+			inLocation(overlap.end, location).Format(time.RFC3339),
+			getScheduleNames(schedules, overlap.scheduleIds))
+		if d.Get("fail_on_overlap").(bool) {
+			return append(diags, diag.Diagnostic{Severity: diag.Error, Summary: summary})
+		}
+		diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: summary})
+	}
+
+	d.SetId(scheduleGroupId)
+	_ = d.Set("time_zone", timeZone)
+	_ = d.Set("intervals", flattenScheduleGroupIntervals(intervals, location, true))
+	_ = d.Set("overlaps", flattenScheduleGroupIntervals(overlaps, location, false))
+
+	log.Printf("Evaluated schedule group %s into %d intervals", scheduleGroupId, len(intervals))
+	return diags
+}
+
+// getGroupSchedules reads every open, closed and holiday schedule of the schedule group
+func getGroupSchedules(ctx context.Context, proxy *scheduleGroupEvaluationProxy, scheduleGroupId string, scheduleGroup *platformclientv2.Schedulegroup) ([]*groupSchedule, diag.Diagnostics) {
+	var schedules []*groupSchedule
+	scheduleRefs := map[string]*[]platformclientv2.Domainentityref{
+		scheduleStateOpen:    scheduleGroup.OpenSchedules,
+		scheduleStateClosed:  scheduleGroup.ClosedSchedules,
+		scheduleStateHoliday: scheduleGroup.HolidaySchedules,
+	}
+	for _, state := range []string{scheduleStateOpen, scheduleStateClosed, scheduleStateHoliday} {
+		if scheduleRefs[state] == nil {
+			continue
+		}
+		for _, scheduleRef := range *scheduleRefs[state] {
+			if scheduleRef.Id == nil {
+				continue
+			}
+			schedule, _, err := proxy.getSchedule(ctx, *scheduleRef.Id)
+			if err != nil {
+				return nil, diag.Errorf("Failed to read schedule %s: %s", *scheduleRef.Id, err)
+			}
+			groupSchedule, err := newGroupSchedule(schedule, state)
+			if err != nil {
+				return nil, diag.Errorf("Failed to evaluate schedule group %s: %s", scheduleGroupId, err)
+			}
+			schedules = append(schedules, groupSchedule)
+		}
+	}
+	return schedules, nil
+}
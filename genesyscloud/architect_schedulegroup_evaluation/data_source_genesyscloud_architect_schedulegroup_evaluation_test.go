@@ -0,0 +1,76 @@
+package architect_schedulegroup_evaluation
+
+import (
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceArchitectSchedulegroupEvaluation(t *testing.T) {
+	var (
+		openSchedResource    = "open-sched"
+		holidaySchedResource = "holiday-sched"
+		schedGroupResource   = "sched-group"
+		evaluationDataSource = "sched-group-evaluation"
+
+		openSchedName    = "Open Schedule " + uuid.NewString()
+		holidaySchedName = "Holiday Schedule " + uuid.NewString()
+		schedGroupName   = "Schedule Group " + uuid.NewString()
+	)
+
+	config := generateScheduleResource(openSchedResource, openSchedName, "2024-01-01T08:00:00.000000", "2024-01-01T17:00:00.000000", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR") +
+		generateScheduleResource(holidaySchedResource, holidaySchedName, "2024-12-25T00:00:00.000000", "2024-12-26T00:00:00.000000", "FREQ=YEARLY") +
+		fmt.Sprintf(`resource "genesyscloud_architect_schedulegroups" "%s" {
+		name = "%s"
+		time_zone = "America/New_York"
+		open_schedules_id = [genesyscloud_architect_schedules.%s.id]
+		holiday_schedules_id = [genesyscloud_architect_schedules.%s.id]
+	}
+	`, schedGroupResource, schedGroupName, openSchedResource, holidaySchedResource)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: config + generateScheduleGroupEvaluationDataSource(
+					evaluationDataSource,
+					"genesyscloud_architect_schedulegroups."+schedGroupResource+".id",
+					"2025-12-24T00:00:00.000000",
+					"2025-12-26T00:00:00.000000",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_architect_schedulegroup_evaluation."+evaluationDataSource, "time_zone", "America/New_York"),
+					resource.TestCheckResourceAttr("data.genesyscloud_architect_schedulegroup_evaluation."+evaluationDataSource, "intervals.#", "4"),
+					resource.TestCheckResourceAttr("data.genesyscloud_architect_schedulegroup_evaluation."+evaluationDataSource, "intervals.1.state", "open"),
+					resource.TestCheckResourceAttr("data.genesyscloud_architect_schedulegroup_evaluation."+evaluationDataSource, "intervals.1.start", "2025-12-24T08:00:00-05:00"),
+					resource.TestCheckResourceAttr("data.genesyscloud_architect_schedulegroup_evaluation."+evaluationDataSource, "intervals.3.state", "holiday"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_architect_schedulegroup_evaluation."+evaluationDataSource, "intervals.3.schedule_ids.0", "genesyscloud_architect_schedules."+holidaySchedResource, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_architect_schedulegroup_evaluation."+evaluationDataSource, "overlaps.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func generateScheduleResource(resourceID string, name string, start string, end string, rrule string) string {
+	return fmt.Sprintf(`resource "genesyscloud_architect_schedules" "%s" {
+		name = "%s"
+		start = "%s"
+		end = "%s"
+		rrule = "%s"
+	}
+	`, resourceID, name, start, end, rrule)
+}
+
+func generateScheduleGroupEvaluationDataSource(resourceID string, scheduleGroupId string, start string, end string) string {
+	return fmt.Sprintf(`data "genesyscloud_architect_schedulegroup_evaluation" "%s" {
+		schedule_group_id = %s
+		start = "%s"
+		end = "%s"
+	}
+	`, resourceID, scheduleGroupId, start, end)
+}
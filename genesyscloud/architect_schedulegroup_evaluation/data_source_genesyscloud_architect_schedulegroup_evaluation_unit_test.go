@@ -0,0 +1,148 @@
+package architect_schedulegroup_evaluation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseLocal(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse("2006-01-02T15:04", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestUnitScheduleNeverMatches(t *testing.T) {
+	start := mustParseLocal(t, "2024-01-01T00:00")
+	end := mustParseLocal(t, "2024-01-02T00:00")
+	never := "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30"
+	sometimes := "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29"
+
+	schedule, err := newGroupSchedule(&platformclientv2.Schedule{Start: &start, End: &end, Rrule: &never}, scheduleStateHoliday)
+	assert.Nil(t, err)
+	assert.True(t, schedule.neverMatches())
+
+	schedule, err = newGroupSchedule(&platformclientv2.Schedule{Start: &start, End: &end, Rrule: &sometimes}, scheduleStateHoliday)
+	assert.Nil(t, err)
+	assert.False(t, schedule.neverMatches())
+
+	_, err = newGroupSchedule(&platformclientv2.Schedule{Start: &end, End: &start}, scheduleStateOpen)
+	assert.NotNil(t, err)
+}
+
+func TestUnitDataSourceArchitectSchedulegroupEvaluation(t *testing.T) {
+	var (
+		groupId     = uuid.NewString()
+		openId      = "open-" + uuid.NewString()
+		lunchId     = "lunch-" + uuid.NewString()
+		holidayId   = "holiday-" + uuid.NewString()
+		timeZone    = "America/New_York"
+		openRrule   = "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"
+		lunchRrule  = "FREQ=DAILY"
+		holidayRule = "FREQ=YEARLY"
+	)
+
+	schedules := map[string]*platformclientv2.Schedule{
+		openId:    buildTestSchedule(t, openId, "2024-01-01T08:00", "2024-01-01T17:00", openRrule),
+		lunchId:   buildTestSchedule(t, lunchId, "2024-01-01T12:00", "2024-01-01T13:00", lunchRrule),
+		holidayId: buildTestSchedule(t, holidayId, "2023-12-25T00:00", "2023-12-26T00:00", holidayRule),
+	}
+
+	proxy := &scheduleGroupEvaluationProxy{}
+	proxy.getScheduleGroupAttr = func(ctx context.Context, p *scheduleGroupEvaluationProxy, id string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, groupId, id)
+		return &platformclientv2.Schedulegroup{
+			Id:               &groupId,
+			TimeZone:         &timeZone,
+			OpenSchedules:    &[]platformclientv2.Domainentityref{{Id: &openId}},
+			ClosedSchedules:  &[]platformclientv2.Domainentityref{{Id: &lunchId}},
+			HolidaySchedules: &[]platformclientv2.Domainentityref{{Id: &holidayId}},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getScheduleAttr = func(ctx context.Context, p *scheduleGroupEvaluationProxy, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+		schedule, ok := schedules[id]
+		if !ok {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("schedule %s not found", id)
+		}
+		return schedule, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	// Tuesday 24th to Wednesday 25th December 2024
+	resourceDataMap := map[string]interface{}{
+		"schedule_group_id": groupId,
+		"start":             "2024-12-24T00:00:00.000000",
+		"end":               "2024-12-26T00:00:00.000000",
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectSchedulegroupEvaluation().Schema, resourceDataMap)
+
+	diags := dataSourceArchitectSchedulegroupEvaluationRead(ctx, d, gcloud)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, groupId, d.Id())
+	assert.Equal(t, timeZone, d.Get("time_zone").(string))
+
+	expected := []map[string]interface{}{
+		{"state": "closed", "start": "2024-12-24T00:00:00-05:00", "end": "2024-12-24T08:00:00-05:00", "schedule_ids": []interface{}{}},
+		{"state": "open", "start": "2024-12-24T08:00:00-05:00", "end": "2024-12-24T12:00:00-05:00", "schedule_ids": []interface{}{openId}},
+		{"state": "closed", "start": "2024-12-24T12:00:00-05:00", "end": "2024-12-24T13:00:00-05:00", "schedule_ids": []interface{}{lunchId}},
+		{"state": "open", "start": "2024-12-24T13:00:00-05:00", "end": "2024-12-24T17:00:00-05:00", "schedule_ids": []interface{}{openId}},
+		{"state": "closed", "start": "2024-12-24T17:00:00-05:00", "end": "2024-12-25T00:00:00-05:00", "schedule_ids": []interface{}{}},
+		{"state": "holiday", "start": "2024-12-25T00:00:00-05:00", "end": "2024-12-26T00:00:00-05:00", "schedule_ids": []interface{}{holidayId}},
+	}
+	intervals := d.Get("intervals").([]interface{})
+	if assert.Equal(t, len(expected), len(intervals)) {
+		for i, interval := range intervals {
+			assert.Equal(t, expected[i], interval.(map[string]interface{}), fmt.Sprintf("interval %d", i))
+		}
+	}
+
+	// The lunch schedule overlaps the open schedule on the 24th. Reported as a warning by default.
+	overlaps := d.Get("overlaps").([]interface{})
+	assert.Equal(t, 1, len(overlaps))
+	assert.Equal(t, "2024-12-24T12:00:00-05:00", overlaps[0].(map[string]interface{})["start"])
+	assert.Equal(t, 1, len(diags))
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+
+	// Overlaps fail the read when requested
+	resourceDataMap["fail_on_overlap"] = true
+	d = schema.TestResourceDataRaw(t, DataSourceArchitectSchedulegroupEvaluation().Schema, resourceDataMap)
+	diags = dataSourceArchitectSchedulegroupEvaluationRead(ctx, d, gcloud)
+	assert.True(t, diags.HasError())
+
+	// A schedule that can never match fails the read
+	neverRrule := "FREQ=YEARLY;BYMONTH=4;BYMONTHDAY=31"
+	schedules[holidayId].Rrule = &neverRrule
+	resourceDataMap["fail_on_overlap"] = false
+	d = schema.TestResourceDataRaw(t, DataSourceArchitectSchedulegroupEvaluation().Schema, resourceDataMap)
+	diags = dataSourceArchitectSchedulegroupEvaluationRead(ctx, d, gcloud)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Summary, "never match")
+	}
+}
+
+func buildTestSchedule(t *testing.T, id string, start string, end string, rrule string) *platformclientv2.Schedule {
+	startTime := mustParseLocal(t, start)
+	endTime := mustParseLocal(t, end)
+	return &platformclientv2.Schedule{
+		Id:    &id,
+		Name:  &id,
+		Start: &startTime,
+		End:   &endTime,
+		Rrule: &rrule,
+	}
+}
@@ -0,0 +1,64 @@
+package architect_schedulegroup_evaluation
+
+import (
+	"sync"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_architect_schedulegroup_evaluation_init_test.go file is used to initialize the data sources and resources
+   used in testing the architect_schedulegroup_evaluation data source.
+
+   Please make sure you register ALL resources and data sources your test cases will use.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+// providerResources holds a map of all registered resources
+var providerResources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+
+	providerResources["genesyscloud_architect_schedules"] = gcloud.ResourceArchitectSchedules()
+	providerResources["genesyscloud_architect_schedulegroups"] = gcloud.ResourceArchitectScheduleGroups()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+
+	providerDataSources[resourceName] = DataSourceArchitectSchedulegroupEvaluation()
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestResources()
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for architect_schedulegroup_evaluation package
+	initTestResources()
+
+	// Run the test suite for the architect_schedulegroup_evaluation package
+	m.Run()
+}
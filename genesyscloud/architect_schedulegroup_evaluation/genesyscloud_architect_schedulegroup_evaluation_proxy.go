@@ -0,0 +1,68 @@
+package architect_schedulegroup_evaluation
+
+import (
+	"context"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_architect_schedulegroup_evaluation_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *scheduleGroupEvaluationProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getScheduleGroupFunc func(ctx context.Context, p *scheduleGroupEvaluationProxy, id string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error)
+type getScheduleFunc func(ctx context.Context, p *scheduleGroupEvaluationProxy, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error)
+
+// scheduleGroupEvaluationProxy contains all of the methods that call genesys cloud APIs.
+type scheduleGroupEvaluationProxy struct {
+	clientConfig         *platformclientv2.Configuration
+	architectApi         *platformclientv2.ArchitectApi
+	getScheduleGroupAttr getScheduleGroupFunc
+	getScheduleAttr      getScheduleFunc
+}
+
+// newScheduleGroupEvaluationProxy initializes the proxy with all of the data needed to communicate with Genesys Cloud
+func newScheduleGroupEvaluationProxy(clientConfig *platformclientv2.Configuration) *scheduleGroupEvaluationProxy {
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	return &scheduleGroupEvaluationProxy{
+		clientConfig:         clientConfig,
+		architectApi:         api,
+		getScheduleGroupAttr: getScheduleGroupFn,
+		getScheduleAttr:      getScheduleFn,
+	}
+}
+
+// getScheduleGroupEvaluationProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getScheduleGroupEvaluationProxy(clientConfig *platformclientv2.Configuration) *scheduleGroupEvaluationProxy {
+	if internalProxy == nil {
+		internalProxy = newScheduleGroupEvaluationProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getScheduleGroup returns a single Genesys Cloud schedule group by ID
+func (p *scheduleGroupEvaluationProxy) getScheduleGroup(ctx context.Context, id string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error) {
+	return p.getScheduleGroupAttr(ctx, p, id)
+}
+
+// getSchedule returns a single Genesys Cloud schedule by ID
+func (p *scheduleGroupEvaluationProxy) getSchedule(ctx context.Context, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+	return p.getScheduleAttr(ctx, p, id)
+}
+
+// getScheduleGroupFn is an implementation of the function to get a Genesys Cloud schedule group by ID
+func getScheduleGroupFn(_ context.Context, p *scheduleGroupEvaluationProxy, id string) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error) {
+	return p.architectApi.GetArchitectSchedulegroup(id)
+}
+
+// getScheduleFn is an implementation of the function to get a Genesys Cloud schedule by ID
+func getScheduleFn(_ context.Context, p *scheduleGroupEvaluationProxy, id string) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+	return p.architectApi.GetArchitectSchedule(id)
+}
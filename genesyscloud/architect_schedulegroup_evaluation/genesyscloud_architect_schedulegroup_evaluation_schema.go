@@ -0,0 +1,120 @@
+package architect_schedulegroup_evaluation
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+genesyscloud_architect_schedulegroup_evaluation_schema.go holds two functions within it:
+
+1.  The registration code that registers the Datasource for the package.
+2.  The datasource schema definitions for the architect_schedulegroup_evaluation datasource.
+*/
+const resourceName = "genesyscloud_architect_schedulegroup_evaluation"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(resourceName, DataSourceArchitectSchedulegroupEvaluation())
+}
+
+var scheduleGroupIntervalResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"state": {
+			Description: "State of the schedule group during the interval. Valid values: open, closed, holiday.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start": {
+			Description: "Start of the interval as an RFC 3339 date time in the time zone of the schedule group.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end": {
+			Description: "End of the interval as an RFC 3339 date time in the time zone of the schedule group. The end is exclusive.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"schedule_ids": {
+			Description: "IDs of the schedules that set the state of the interval. Empty for closed intervals where no schedule matches.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	},
+}
+
+var scheduleGroupOverlapResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"start": {
+			Description: "Start of the overlap as an RFC 3339 date time in the time zone of the schedule group.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end": {
+			Description: "End of the overlap as an RFC 3339 date time in the time zone of the schedule group. The end is exclusive.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"schedule_ids": {
+			Description: "IDs of the open and closed schedules that overlap.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	},
+}
+
+// DataSourceArchitectSchedulegroupEvaluation registers the genesyscloud_architect_schedulegroup_evaluation data source
+func DataSourceArchitectSchedulegroupEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Evaluates a Genesys Cloud schedule group over a time range. The schedules of the group are expanded locally in the time zone of the group. " +
+			"Holiday schedules take precedence over closed schedules, and closed schedules take precedence over open schedules. Times not matched by any schedule are closed. " +
+			"Reading the data source fails if a schedule of the group can never match.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceArchitectSchedulegroupEvaluationRead),
+		Schema: map[string]*schema.Schema{
+			"schedule_group_id": {
+				Description: "ID of the schedule group to evaluate.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"start": {
+				Description:      "Start of the time range in the time zone of the schedule group. Date time is represented as an ISO-8601 string without a timezone. For example: 2006-01-02T15:04:05.000000.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: gcloud.ValidateLocalDateTimes,
+			},
+			"end": {
+				Description:      "End of the time range in the time zone of the schedule group. Must be after start and at most 366 days later. Date time is represented as an ISO-8601 string without a timezone. For example: 2006-01-02T15:04:05.000000.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: gcloud.ValidateLocalDateTimes,
+			},
+			"fail_on_overlap": {
+				Description: "Fail when an open schedule overlaps a closed schedule in the time range. When false, overlaps are reported as warnings.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"time_zone": {
+				Description: "Time zone of the schedule group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"intervals": {
+				Description: "Consecutive intervals covering the time range, each with the resulting state of the schedule group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        scheduleGroupIntervalResource,
+			},
+			"overlaps": {
+				Description: "Intervals outside of holidays where an open schedule and a closed schedule are active at the same time. The closed schedule wins.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        scheduleGroupOverlapResource,
+			},
+		},
+	}
+}
@@ -0,0 +1,235 @@
+package architect_schedulegroup_evaluation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+const (
+	scheduleStateOpen    = "open"
+	scheduleStateClosed  = "closed"
+	scheduleStateHoliday = "holiday"
+
+	// Longest time range that can be evaluated
+	maxEvaluationRange = 366 * 24 * time.Hour
+)
+
+// groupSchedule is a schedule of a schedule group with the state it applies to the group
+type groupSchedule struct {
+	id       string
+	name     string
+	state    string
+	start    time.Time
+	end      time.Time
+	rrule    string
+	duration time.Duration
+	rule     *rrule.Rule
+}
+
+type scheduleOccurrence struct {
+	schedule *groupSchedule
+	start    time.Time
+	end      time.Time
+}
+
+// scheduleGroupInterval is a period of wall clock time where the state of the schedule group does not change
+type scheduleGroupInterval struct {
+	state       string
+	start       time.Time
+	end         time.Time
+	scheduleIds []string
+}
+
+// newGroupSchedule reads the recurrence of an Architect schedule
+func newGroupSchedule(schedule *platformclientv2.Schedule, state string) (*groupSchedule, error) {
+	result := &groupSchedule{state: state}
+	if schedule.Id != nil {
+		result.id = *schedule.Id
+	}
+	result.name = result.id
+	if schedule.Name != nil {
+		result.name = *schedule.Name
+	}
+	if schedule.Start == nil || schedule.End == nil {
+		return nil, fmt.Errorf("schedule %s has no start or end", result.name)
+	}
+	// The API returns schedule times without a time zone. They are wall clock times in the time zone of the group.
+	result.start = *schedule.Start
+	result.end = *schedule.End
+	result.duration = result.end.Sub(result.start)
+	if result.duration <= 0 {
+		return nil, fmt.Errorf("schedule %s ends before it starts", result.name)
+	}
+
+	if schedule.Rrule != nil && *schedule.Rrule != "" {
+		rule, err := rrule.Parse(*schedule.Rrule)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s has an invalid rrule %q: %v", result.name, *schedule.Rrule, err)
+		}
+		result.rrule = *schedule.Rrule
+		result.rule = rule
+	}
+	return result, nil
+}
+
+// neverMatches is true when a recurring schedule has no occurrences at all
+func (s *groupSchedule) neverMatches() bool {
+	if s.rule == nil {
+		return false
+	}
+	return s.rule.NeverMatches(s.start)
+}
+
+// occurrencesBetween returns the occurrences of the schedule that overlap the range, clipped to the range
+func (s *groupSchedule) occurrencesBetween(rangeStart time.Time, rangeEnd time.Time) []scheduleOccurrence {
+	starts := []time.Time{s.start}
+	if s.rule != nil {
+		starts = s.rule.Occurrences(s.start, rangeEnd, 0)
+	}
+
+	var result []scheduleOccurrence
+	for _, start := range starts {
+		end := start.Add(s.duration)
+		if !end.After(rangeStart) || !start.Before(rangeEnd) {
+			continue
+		}
+		if start.Before(rangeStart) {
+			start = rangeStart
+		}
+		if end.After(rangeEnd) {
+			end = rangeEnd
+		}
+		result = append(result, scheduleOccurrence{schedule: s, start: start, end: end})
+	}
+	return result
+}
+
+// evaluateScheduleGroup calculates the state of the schedule group over the range. It returns the intervals
+// covering the range and the intervals where open and closed schedules overlap.
+func evaluateScheduleGroup(schedules []*groupSchedule, rangeStart time.Time, rangeEnd time.Time) ([]scheduleGroupInterval, []scheduleGroupInterval) {
+	var occurrences []scheduleOccurrence
+	boundarySet := map[time.Time]bool{rangeStart: true, rangeEnd: true}
+	for _, schedule := range schedules {
+		for _, occurrence := range schedule.occurrencesBetween(rangeStart, rangeEnd) {
+			occurrences = append(occurrences, occurrence)
+			boundarySet[occurrence.start] = true
+			boundarySet[occurrence.end] = true
+		}
+	}
+
+	boundaries := make([]time.Time, 0, len(boundarySet))
+	for boundary := range boundarySet {
+		boundaries = append(boundaries, boundary)
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].start.Before(occurrences[j].start) })
+
+	var (
+		intervals []scheduleGroupInterval
+		overlaps  []scheduleGroupInterval
+		active    []scheduleOccurrence
+		next      int
+	)
+	for i := 0; i < len(boundaries)-1; i++ {
+		segmentStart, segmentEnd := boundaries[i], boundaries[i+1]
+
+		// Drop occurrences that have ended and add the ones starting at this boundary
+		stillActive := active[:0]
+		for _, occurrence := range active {
+			if occurrence.end.After(segmentStart) {
+				stillActive = append(stillActive, occurrence)
+			}
+		}
+		active = stillActive
+		for next < len(occurrences) && !occurrences[next].start.After(segmentStart) {
+			active = append(active, occurrences[next])
+			next++
+		}
+
+		activeIds := map[string][]string{}
+		for _, occurrence := range active {
+			activeIds[occurrence.schedule.state] = appendUnique(activeIds[occurrence.schedule.state], occurrence.schedule.id)
+		}
+
+		state := scheduleStateClosed
+		var scheduleIds []string
+		switch {
+		case len(activeIds[scheduleStateHoliday]) > 0:
+			state, scheduleIds = scheduleStateHoliday, activeIds[scheduleStateHoliday]
+		case len(activeIds[scheduleStateClosed]) > 0:
+			scheduleIds = activeIds[scheduleStateClosed]
+		case len(activeIds[scheduleStateOpen]) > 0:
+			state, scheduleIds = scheduleStateOpen, activeIds[scheduleStateOpen]
+		}
+		sort.Strings(scheduleIds)
+		intervals = appendInterval(intervals, scheduleGroupInterval{state: state, start: segmentStart, end: segmentEnd, scheduleIds: scheduleIds})
+
+		// Overlaps during a holiday do not change the state of the group
+		if state != scheduleStateHoliday && len(activeIds[scheduleStateOpen]) > 0 && len(activeIds[scheduleStateClosed]) > 0 {
+			overlapIds := append(append([]string{}, activeIds[scheduleStateOpen]...), activeIds[scheduleStateClosed]...)
+			sort.Strings(overlapIds)
+			overlaps = appendInterval(overlaps, scheduleGroupInterval{start: segmentStart, end: segmentEnd, scheduleIds: overlapIds})
+		}
+	}
+	return intervals, overlaps
+}
+
+// appendInterval adds an interval to the list, extending the last interval if it continues it with the same state and schedules
+func appendInterval(intervals []scheduleGroupInterval, interval scheduleGroupInterval) []scheduleGroupInterval {
+	if len(intervals) > 0 {
+		last := &intervals[len(intervals)-1]
+		if last.end.Equal(interval.start) && last.state == interval.state && strings.Join(last.scheduleIds, ",") == strings.Join(interval.scheduleIds, ",") {
+			last.end = interval.end
+			return intervals
+		}
+	}
+	return append(intervals, interval)
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, item := range list {
+		if item == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+// inLocation reads a wall clock time in the time zone of the schedule group
+func inLocation(wallClock time.Time, location *time.Location) time.Time {
+	return time.Date(wallClock.Year(), wallClock.Month(), wallClock.Day(), wallClock.Hour(), wallClock.Minute(), wallClock.Second(), wallClock.Nanosecond(), location)
+}
+
+func flattenScheduleGroupIntervals(intervals []scheduleGroupInterval, location *time.Location, includeState bool) []interface{} {
+	result := make([]interface{}, 0, len(intervals))
+	for _, interval := range intervals {
+		intervalMap := map[string]interface{}{
+			"start":        inLocation(interval.start, location).Format(time.RFC3339),
+			"end":          inLocation(interval.end, location).Format(time.RFC3339),
+			"schedule_ids": interval.scheduleIds,
+		}
+		if includeState {
+			intervalMap["state"] = interval.state
+		}
+		result = append(result, intervalMap)
+	}
+	return result
+}
+
+func getScheduleNames(schedules []*groupSchedule, ids []string) string {
+	var names []string
+	for _, id := range ids {
+		for _, schedule := range schedules {
+			if schedule.id == id {
+				names = append(names, fmt.Sprintf("%s (%s)", schedule.name, schedule.state))
+				break
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
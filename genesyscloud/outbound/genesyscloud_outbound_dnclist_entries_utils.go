@@ -0,0 +1,377 @@
+package outbound
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+const (
+	// dncPhoneNumberColumn and dncExpirationDateColumn are the columns of a DNC entries file
+	dncPhoneNumberColumn    = "phone_number"
+	dncExpirationDateColumn = "expiration_date"
+
+	// dncExportPhoneNumberColumn and dncExportExpirationDateColumn are the documented columns of a DNC list export
+	dncExportPhoneNumberColumn    = "dnc_phone_number"
+	dncExportExpirationDateColumn = "expiration_date_time"
+
+	// dncExpirationDateLayout is the format of expiration dates expected by the DNC list API
+	dncExpirationDateLayout = "2006-01-02T15:04Z"
+
+	// maxReportedDncEntryErrors limits the number of row level validation errors returned for a single file
+	maxReportedDncEntryErrors = 25
+
+	// dncPhoneNumbersChunkSize is the number of phone numbers sent in a single request to the DNC list API
+	dncPhoneNumbersChunkSize = 1000
+
+	// dncListExportTimeout is the time to wait for an export of the current entries of a DNC list
+	dncListExportTimeout = 15 * time.Minute
+)
+
+// dncExportExpirationLayouts are the formats of expiration dates found in DNC list exports
+var dncExportExpirationLayouts = []string{
+	dncExpirationDateLayout,
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// dncEntry is a phone number of a DNC list and the optional date at which it expires
+type dncEntry struct {
+	phoneNumber    string
+	expirationDate string
+}
+
+// dncEntriesDiff holds the changes needed to bring the entries of a DNC list in line with an entries file
+type dncEntriesDiff struct {
+	// additions holds the phone numbers to add, grouped by expiration date
+	additions map[string][]string
+	removals  []string
+}
+
+func (diff *dncEntriesDiff) additionCount() int {
+	count := 0
+	for _, phoneNumbers := range diff.additions {
+		count += len(phoneNumbers)
+	}
+	return count
+}
+
+// readDncEntriesFile reads a DNC entries CSV file from a local path or URL
+func readDncEntriesFile(filePath string) ([]dncEntry, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read DNC entries file %s: %v", filePath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	entries, err := parseDncEntriesCsv(reader)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNC entries file %s: %v", filePath, err)
+	}
+	return entries, nil
+}
+
+// parseDncEntriesCsv parses a CSV file with a phone_number column and an optional expiration_date column.
+// Phone numbers are normalised to the E.164 format and must be unique.
+func parseDncEntriesCsv(reader io.Reader) ([]dncEntry, error) {
+	csvReader := csv.NewReader(reader)
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("file is empty, a header row is required")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %v", err)
+	}
+	// Strip a UTF-8 byte order mark written by spreadsheet tools
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	phoneNumberIndex, expirationDateIndex := -1, -1
+	for i, column := range header {
+		switch {
+		case column == dncPhoneNumberColumn && phoneNumberIndex < 0:
+			phoneNumberIndex = i
+		case column == dncExpirationDateColumn && expirationDateIndex < 0:
+			expirationDateIndex = i
+		case column == dncPhoneNumberColumn || column == dncExpirationDateColumn:
+			return nil, fmt.Errorf("column %q appears more than once in the header row", column)
+		default:
+			return nil, fmt.Errorf("unknown column %q. Expected columns are %s and %s", column, dncPhoneNumberColumn, dncExpirationDateColumn)
+		}
+	}
+	if phoneNumberIndex < 0 {
+		return nil, fmt.Errorf("the header row must contain a %s column", dncPhoneNumberColumn)
+	}
+
+	var (
+		entries    []dncEntry
+		rowErrors  []string
+		firstLines = make(map[string]int)
+	)
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read line %d: %v", line, err)
+		}
+
+		entry := dncEntry{phoneNumber: normalizeDncPhoneNumber(record[phoneNumberIndex])}
+		if entry.phoneNumber == "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("line %d: %s must not be empty", line, dncPhoneNumberColumn))
+			continue
+		}
+		if diagErr := gcloud.ValidatePhoneNumber(entry.phoneNumber, nil); diagErr.HasError() {
+			rowErrors = append(rowErrors, fmt.Sprintf("line %d: %s", line, diagErr[0].Summary))
+			continue
+		}
+		if firstLine, exists := firstLines[entry.phoneNumber]; exists {
+			rowErrors = append(rowErrors, fmt.Sprintf("line %d: duplicate phone number %s, first defined at line %d", line, entry.phoneNumber, firstLine))
+			continue
+		}
+		firstLines[entry.phoneNumber] = line
+
+		if expirationDateIndex >= 0 && record[expirationDateIndex] != "" {
+			entry.expirationDate = record[expirationDateIndex]
+			if _, err := time.Parse(dncExpirationDateLayout, entry.expirationDate); err != nil {
+				rowErrors = append(rowErrors, fmt.Sprintf("line %d: %s %q is not in yyyy-MM-ddTHH:mmZ format", line, dncExpirationDateColumn, entry.expirationDate))
+				continue
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(rowErrors) > 0 {
+		if len(rowErrors) > maxReportedDncEntryErrors {
+			remaining := len(rowErrors) - maxReportedDncEntryErrors
+			rowErrors = append(rowErrors[:maxReportedDncEntryErrors], fmt.Sprintf("... and %d more errors", remaining))
+		}
+		return nil, fmt.Errorf("\n%s", strings.Join(rowErrors, "\n"))
+	}
+	return entries, nil
+}
+
+// parseDncListExport parses the CSV export of a DNC list into a map of phone numbers to expiration dates.
+// Phone numbers are normalised the same way as those of an entries file so the two can be compared.
+func parseDncListExport(reader io.Reader) (map[string]string, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row of DNC list export: %v", err)
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	phoneNumberIndex, expirationDateIndex := -1, -1
+	for i, column := range header {
+		switch strings.TrimSpace(column) {
+		case dncExportPhoneNumberColumn:
+			phoneNumberIndex = i
+		case dncExportExpirationDateColumn:
+			expirationDateIndex = i
+		}
+	}
+	if phoneNumberIndex < 0 {
+		return nil, fmt.Errorf("DNC list export has no %s column in its header row %q", dncExportPhoneNumberColumn, strings.Join(header, ","))
+	}
+
+	entries := make(map[string]string)
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DNC list export: %v", err)
+		}
+		if phoneNumberIndex >= len(record) {
+			continue
+		}
+		phoneNumber := normalizeDncPhoneNumber(record[phoneNumberIndex])
+		if phoneNumber == "" {
+			continue
+		}
+		expirationDate := ""
+		if expirationDateIndex >= 0 && expirationDateIndex < len(record) {
+			expirationDate = normalizeDncExpirationDate(record[expirationDateIndex])
+		}
+		entries[phoneNumber] = expirationDate
+	}
+	return entries, nil
+}
+
+// normalizeDncPhoneNumber strips the formatting characters of a phone number of an entries file or a DNC list export
+func normalizeDncPhoneNumber(value string) string {
+	return gcloud.SanitizeE164Number(strings.TrimSpace(value))
+}
+
+// normalizeDncExpirationDate converts an expiration date of a DNC list export to the yyyy-MM-ddTHH:mmZ format
+// so it can be compared with the expiration dates of an entries file
+func normalizeDncExpirationDate(value string) string {
+	for _, layout := range dncExportExpirationLayouts {
+		if expirationDate, err := time.Parse(layout, value); err == nil {
+			return expirationDate.UTC().Format(dncExpirationDateLayout)
+		}
+	}
+	return value
+}
+
+// diffDncEntries determines the phone numbers to add to and remove from a DNC list. Phone numbers whose
+// expiration date changed are added again with the new expiration date and are not removed, so they are
+// never missing from the list.
+func diffDncEntries(entries []dncEntry, current map[string]string) *dncEntriesDiff {
+	diff := &dncEntriesDiff{additions: make(map[string][]string)}
+	desired := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		desired[entry.phoneNumber] = true
+		if currentExpirationDate, exists := current[entry.phoneNumber]; exists && currentExpirationDate == entry.expirationDate {
+			continue
+		}
+		diff.additions[entry.expirationDate] = append(diff.additions[entry.expirationDate], entry.phoneNumber)
+	}
+	for phoneNumber := range current {
+		if !desired[phoneNumber] {
+			diff.removals = append(diff.removals, phoneNumber)
+		}
+	}
+	sort.Strings(diff.removals)
+	return diff
+}
+
+// syncDncListEntriesFile adds and removes phone numbers of a DNC list so that it holds exactly the entries of the file
+func syncDncListEntriesFile(ctx context.Context, proxy *outboundDncListProxy, dncListId string, filePath string) diag.Diagnostics {
+	entries, err := readDncEntriesFile(filePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dncList, _, err := proxy.getOutboundDncList(ctx, dncListId, true)
+	if err != nil {
+		return diag.Errorf("Failed to read Outbound DNC list %s: %s", dncListId, err)
+	}
+	current := make(map[string]string)
+	if dncList.Size != nil && *dncList.Size > 0 {
+		if current, err = getDncListEntries(ctx, proxy, dncListId); err != nil {
+			return diag.Errorf("Failed to export entries of Outbound DNC list %s: %s", dncListId, err)
+		}
+	}
+
+	diff := diffDncEntries(entries, current)
+	log.Printf("Syncing Outbound DNC list %s with %s: adding %d and removing %d phone numbers", dncListId, filePath, diff.additionCount(), len(diff.removals))
+
+	// Phone numbers are added before any are removed so a sync that fails part way never leaves out numbers of the file
+	expirationDates := make([]string, 0, len(diff.additions))
+	for expirationDate := range diff.additions {
+		expirationDates = append(expirationDates, expirationDate)
+	}
+	sort.Strings(expirationDates)
+
+	addAction := "Add"
+	added, additionCount := 0, diff.additionCount()
+	for _, expirationDate := range expirationDates {
+		expirationDate := expirationDate
+		diagErr := chunks.ProcessChunks(chunks.ChunkBy(diff.additions[expirationDate], dncPhoneNumbersChunkSize), func(phoneNumbers []string) diag.Diagnostics {
+			body := platformclientv2.Dncpatchphonenumbersrequest{
+				Action:       &addAction,
+				PhoneNumbers: &phoneNumbers,
+			}
+			if expirationDate != "" {
+				body.ExpirationDateTime = &expirationDate
+			}
+			if _, err := proxy.patchOutboundDncListPhoneNumbers(ctx, dncListId, body); err != nil {
+				return diag.Errorf("Failed to add phone numbers to Outbound DNC list %s: %s", dncListId, err)
+			}
+			added += len(phoneNumbers)
+			log.Printf("Added %d of %d phone numbers to Outbound DNC list %s", added, additionCount, dncListId)
+			return nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	removeAction := "Remove"
+	removed := 0
+	diagErr := chunks.ProcessChunks(chunks.ChunkBy(diff.removals, dncPhoneNumbersChunkSize), func(phoneNumbers []string) diag.Diagnostics {
+		if len(phoneNumbers) == 0 {
+			return nil
+		}
+		if _, err := proxy.patchOutboundDncListPhoneNumbers(ctx, dncListId, platformclientv2.Dncpatchphonenumbersrequest{
+			Action:       &removeAction,
+			PhoneNumbers: &phoneNumbers,
+		}); err != nil {
+			return diag.Errorf("Failed to remove phone numbers from Outbound DNC list %s: %s", dncListId, err)
+		}
+		removed += len(phoneNumbers)
+		log.Printf("Removed %d of %d phone numbers from Outbound DNC list %s", removed, len(diff.removals), dncListId)
+		return nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Synced Outbound DNC list %s with %s", dncListId, filePath)
+	return nil
+}
+
+// getDncListEntries exports a DNC list and returns its phone numbers mapped to their expiration dates
+func getDncListEntries(ctx context.Context, proxy *outboundDncListProxy, dncListId string) (map[string]string, error) {
+	// An earlier export may still be available. Wait for one that is newer.
+	var previousExportTimestamp *time.Time
+	if previousExport, _, err := proxy.getOutboundDncListExport(ctx, dncListId); err == nil && previousExport != nil {
+		previousExportTimestamp = previousExport.ExportTimestamp
+	}
+
+	if _, err := proxy.initiateOutboundDncListExport(ctx, dncListId); err != nil {
+		return nil, err
+	}
+
+	var exportUri string
+	diagErr := gcloud.WithRetries(ctx, dncListExportTimeout, func() *retry.RetryError {
+		export, resp, err := proxy.getOutboundDncListExport(ctx, dncListId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("export of Outbound DNC list %s is not ready", dncListId))
+			}
+			return retry.NonRetryableError(err)
+		}
+		if export.Uri == nil || export.ExportTimestamp == nil ||
+			(previousExportTimestamp != nil && !export.ExportTimestamp.After(*previousExportTimestamp)) {
+			return retry.RetryableError(fmt.Errorf("export of Outbound DNC list %s is not ready", dncListId))
+		}
+		exportUri = *export.Uri
+		return nil
+	})
+	if diagErr != nil {
+		return nil, fmt.Errorf("%v", diagErr)
+	}
+
+	reader, err := proxy.downloadOutboundDncListExport(ctx, exportUri)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return parseDncListExport(reader)
+}
@@ -0,0 +1,116 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *outboundDncListProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getOutboundDncListFunc func(ctx context.Context, p *outboundDncListProxy, dncListId string, includeSize bool) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error)
+type patchOutboundDncListPhoneNumbersFunc func(ctx context.Context, p *outboundDncListProxy, dncListId string, body platformclientv2.Dncpatchphonenumbersrequest) (*platformclientv2.APIResponse, error)
+type initiateOutboundDncListExportFunc func(ctx context.Context, p *outboundDncListProxy, dncListId string) (*platformclientv2.APIResponse, error)
+type getOutboundDncListExportFunc func(ctx context.Context, p *outboundDncListProxy, dncListId string) (*platformclientv2.Exporturi, *platformclientv2.APIResponse, error)
+type downloadOutboundDncListExportFunc func(ctx context.Context, p *outboundDncListProxy, uri string) (io.ReadCloser, error)
+
+// outboundDncListProxy contains all of the methods that call genesys cloud APIs.
+type outboundDncListProxy struct {
+	clientConfig *platformclientv2.Configuration
+	outboundApi  *platformclientv2.OutboundApi
+
+	getOutboundDncListAttr               getOutboundDncListFunc
+	patchOutboundDncListPhoneNumbersAttr patchOutboundDncListPhoneNumbersFunc
+	initiateOutboundDncListExportAttr    initiateOutboundDncListExportFunc
+	getOutboundDncListExportAttr         getOutboundDncListExportFunc
+	downloadOutboundDncListExportAttr    downloadOutboundDncListExportFunc
+}
+
+// newOutboundDncListProxy initializes the proxy with all of the data needed to communicate with Genesys Cloud
+func newOutboundDncListProxy(clientConfig *platformclientv2.Configuration) *outboundDncListProxy {
+	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+	return &outboundDncListProxy{
+		clientConfig:                         clientConfig,
+		outboundApi:                          api,
+		getOutboundDncListAttr:               getOutboundDncListFn,
+		patchOutboundDncListPhoneNumbersAttr: patchOutboundDncListPhoneNumbersFn,
+		initiateOutboundDncListExportAttr:    initiateOutboundDncListExportFn,
+		getOutboundDncListExportAttr:         getOutboundDncListExportFn,
+		downloadOutboundDncListExportAttr:    downloadOutboundDncListExportFn,
+	}
+}
+
+// getOutboundDncListProxy acts as a singleton to for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getOutboundDncListProxy(clientConfig *platformclientv2.Configuration) *outboundDncListProxy {
+	if internalProxy == nil {
+		internalProxy = newOutboundDncListProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getOutboundDncList retrieves a DNC list, optionally including the number of entries in it
+func (p *outboundDncListProxy) getOutboundDncList(ctx context.Context, dncListId string, includeSize bool) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error) {
+	return p.getOutboundDncListAttr(ctx, p, dncListId, includeSize)
+}
+
+// patchOutboundDncListPhoneNumbers adds phone numbers to or removes phone numbers from a DNC list
+func (p *outboundDncListProxy) patchOutboundDncListPhoneNumbers(ctx context.Context, dncListId string, body platformclientv2.Dncpatchphonenumbersrequest) (*platformclientv2.APIResponse, error) {
+	return p.patchOutboundDncListPhoneNumbersAttr(ctx, p, dncListId, body)
+}
+
+// initiateOutboundDncListExport starts an export of the entries of a DNC list
+func (p *outboundDncListProxy) initiateOutboundDncListExport(ctx context.Context, dncListId string) (*platformclientv2.APIResponse, error) {
+	return p.initiateOutboundDncListExportAttr(ctx, p, dncListId)
+}
+
+// getOutboundDncListExport retrieves the URI of the most recent export of a DNC list
+func (p *outboundDncListProxy) getOutboundDncListExport(ctx context.Context, dncListId string) (*platformclientv2.Exporturi, *platformclientv2.APIResponse, error) {
+	return p.getOutboundDncListExportAttr(ctx, p, dncListId)
+}
+
+// downloadOutboundDncListExport downloads the CSV file of a DNC list export. The caller must close the reader.
+func (p *outboundDncListProxy) downloadOutboundDncListExport(ctx context.Context, uri string) (io.ReadCloser, error) {
+	return p.downloadOutboundDncListExportAttr(ctx, p, uri)
+}
+
+func getOutboundDncListFn(ctx context.Context, p *outboundDncListProxy, dncListId string, includeSize bool) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundDnclist(dncListId, false, includeSize)
+}
+
+func patchOutboundDncListPhoneNumbersFn(ctx context.Context, p *outboundDncListProxy, dncListId string, body platformclientv2.Dncpatchphonenumbersrequest) (*platformclientv2.APIResponse, error) {
+	return p.outboundApi.PatchOutboundDnclistPhonenumbers(dncListId, body)
+}
+
+func initiateOutboundDncListExportFn(ctx context.Context, p *outboundDncListProxy, dncListId string) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.outboundApi.PostOutboundDnclistExport(dncListId)
+	return resp, err
+}
+
+func getOutboundDncListExportFn(ctx context.Context, p *outboundDncListProxy, dncListId string) (*platformclientv2.Exporturi, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundDnclistExport(dncListId, "false")
+}
+
+// downloadOutboundDncListExportFn downloads an export file. The export URI is only accessible with the access token of the client.
+func downloadOutboundDncListExportFn(ctx context.Context, p *outboundDncListProxy, uri string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.clientConfig.AccessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		resp.Body.Close()
+		return nil, fmt.Errorf("HTTP Error downloading file: %v", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
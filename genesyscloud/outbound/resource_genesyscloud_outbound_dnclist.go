@@ -113,9 +113,10 @@ func ResourceOutboundDncList() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{`rds`, `dnc.com`, `gryphon`}, false),
 			},
 			`entries`: {
-				Description: `Rows to add to the DNC list. To emulate removing phone numbers, you can set expiration_date to a date in the past.`,
-				Optional:    true,
-				Type:        schema.TypeList,
+				Description:   `Rows to add to the DNC list. To emulate removing phone numbers, you can set expiration_date to a date in the past.`,
+				Optional:      true,
+				Type:          schema.TypeList,
+				ConflictsWith: []string{"entries_filepath"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						`expiration_date`: {
@@ -136,7 +137,36 @@ func ResourceOutboundDncList() *schema.Resource {
 					},
 				},
 			},
+			`entries_filepath`: {
+				Description:  `Path or URL of a CSV file holding every entry of the DNC list. The header row must contain a phone_number column and may contain an expiration_date column in yyyy-MM-ddTHH:mmZ format. Phone numbers are normalised to the E.164 format. Phone numbers that are not in the file are removed from the DNC list. Only possible if the dncSourceType is rds.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: gcloud.ValidatePath,
+				RequiredWith: []string{"entries_file_content_hash"},
+			},
+			`entries_file_content_hash`: {
+				Description:  `Hash value of the DNC entries file content. Used to detect changes.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				RequiredWith: []string{"entries_filepath"},
+			},
+			`entry_count`: {
+				Description: `Number of phone numbers in the DNC list. Only read when entries_filepath is set.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			`synced_entries_file_content_hash`: {
+				Description: `The entries_file_content_hash of the file that was last synced to the DNC list.`,
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			`synced_entry_count`: {
+				Description: `Number of phone numbers in the DNC list after the file was last synced. When entry_count no longer matches, the file is synced again on the next apply.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
 		},
+		CustomizeDiff: customizeOutboundDncListDiff,
 	}
 }
 
@@ -149,6 +179,7 @@ func createOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 	dncSourceType := d.Get("dnc_source_type").(string)
 	dncCodes := lists.InterfaceListToStrings(d.Get("dnc_codes").([]interface{}))
 	entries := d.Get("entries").([]interface{})
+	entriesFilePath := d.Get("entries_filepath").(string)
 
 	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
 	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
@@ -185,6 +216,12 @@ func createOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(*outboundDncList.Id)
 
+	if entriesFilePath != "" {
+		if diagErr := syncDncListEntriesFile(ctx, getOutboundDncListProxy(sdkConfig), d.Id(), entriesFilePath); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	if len(entries) > 0 {
 		if *sdkDncListCreate.DncSourceType == "rds" {
 			for _, entry := range entries {
@@ -199,6 +236,9 @@ func createOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("Created Outbound DNC list %s %s", name, *outboundDncList.Id)
+	if entriesFilePath != "" {
+		return readSyncedOutboundDncList(ctx, d, meta)
+	}
 	return readOutboundDncList(ctx, d, meta)
 }
 
@@ -268,18 +308,42 @@ func updateOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 		return diagErr
 	}
 
+	// synced_entries_file_content_hash is only planned to change when the file must be synced again
+	entriesFilePath := d.Get("entries_filepath").(string)
+	syncEntries := entriesFilePath != "" && d.HasChanges("entries_filepath", "entries_file_content_hash", "synced_entries_file_content_hash")
+	if syncEntries {
+		if diagErr := syncDncListEntriesFile(ctx, getOutboundDncListProxy(sdkConfig), d.Id(), entriesFilePath); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Updated Outbound DNC list %s", name)
+	if syncEntries {
+		return readSyncedOutboundDncList(ctx, d, meta)
+	}
 	return readOutboundDncList(ctx, d, meta)
 }
 
+// readSyncedOutboundDncList reads the DNC list after its entries file has been synced and records the file and the
+// number of phone numbers it left in the DNC list
+func readSyncedOutboundDncList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diagErr := readOutboundDncList(ctx, d, meta); diagErr.HasError() {
+		return diagErr
+	}
+	_ = d.Set("synced_entries_file_content_hash", d.Get("entries_file_content_hash").(string))
+	_ = d.Set("synced_entry_count", d.Get("entry_count").(int))
+	return nil
+}
+
 func readOutboundDncList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
-	outboundApi := platformclientv2.NewOutboundApiWithConfig(sdkConfig)
+	proxy := getOutboundDncListProxy(sdkConfig)
+	entriesFilePath := d.Get("entries_filepath").(string)
 
 	log.Printf("Reading Outbound DNC list %s", d.Id())
 
 	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
-		sdkDncList, resp, getErr := outboundApi.GetOutboundDnclist(d.Id(), false, false)
+		sdkDncList, resp, getErr := proxy.getOutboundDncList(ctx, d.Id(), entriesFilePath != "")
 		if getErr != nil {
 			if gcloud.IsStatus404(resp) {
 				return retry.RetryableError(fmt.Errorf("failed to read Outbound DNC list %s: %s", d.Id(), getErr))
@@ -319,6 +383,10 @@ func readOutboundDncList(ctx context.Context, d *schema.ResourceData, meta inter
 		if sdkDncList.Division != nil && sdkDncList.Division.Id != nil {
 			_ = d.Set("division_id", *sdkDncList.Division.Id)
 		}
+
+		if sdkDncList.Size != nil {
+			_ = d.Set("entry_count", *sdkDncList.Size)
+		}
 		log.Printf("Read Outbound DNC list %s %s", d.Id(), *sdkDncList.Name)
 		return cc.CheckState()
 	})
@@ -374,6 +442,46 @@ func uploadPhoneEntriesToDncList(api *platformclientv2.OutboundApi, dncList *pla
 	return nil, nil
 }
 
+// customizeOutboundDncListDiff validates the DNC entries file during plan when its path and content hash are known.
+// The file is synced again when phone numbers were added or removed outside of Terraform or the last sync failed.
+func customizeOutboundDncListDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("entries_filepath") || !diff.NewValueKnown("entries_file_content_hash") || !diff.NewValueKnown("dnc_source_type") {
+		// The file is not known until apply. It will be validated then.
+		return setDncListEntriesSyncComputed(diff)
+	}
+
+	entriesFilePath := diff.Get("entries_filepath").(string)
+	if entriesFilePath == "" {
+		return nil
+	}
+	changedOutside := diff.Id() != "" && diff.Get("entry_count").(int) != diff.Get("synced_entry_count").(int)
+	syncFailed := diff.Id() != "" && diff.Get("entries_file_content_hash").(string) != diff.Get("synced_entries_file_content_hash").(string)
+	if diff.Id() != "" && !changedOutside && !syncFailed && !diff.HasChanges("entries_filepath", "entries_file_content_hash") {
+		return nil
+	}
+	if changedOutside {
+		log.Printf("Outbound DNC list %s has %d phone numbers but had %d after the last sync", diff.Id(), diff.Get("entry_count").(int), diff.Get("synced_entry_count").(int))
+	}
+	if diff.Get("dnc_source_type").(string) != "rds" {
+		return fmt.Errorf("phone numbers can only be uploaded to internal DNC lists")
+	}
+
+	if _, err := readDncEntriesFile(entriesFilePath); err != nil {
+		return err
+	}
+	return setDncListEntriesSyncComputed(diff)
+}
+
+// setDncListEntriesSyncComputed marks the attributes set by syncing the entries file as unknown until apply
+func setDncListEntriesSyncComputed(diff *schema.ResourceDiff) error {
+	for _, attr := range []string{"entry_count", "synced_entries_file_content_hash", "synced_entry_count"} {
+		if err := diff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func GenerateOutboundDncListBasic(resourceId string, name string) string {
 	return fmt.Sprintf(`
 resource "genesyscloud_outbound_dnclist" "%s" {
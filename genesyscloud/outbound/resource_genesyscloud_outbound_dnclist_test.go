@@ -3,6 +3,7 @@ package outbound
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -121,6 +122,74 @@ func TestAccResourceOutboundDncListRdsListType(t *testing.T) {
 	})
 }
 
+func TestAccResourceOutboundDncListEntriesFile(t *testing.T) {
+	t.Parallel()
+	var (
+		resourceID   = "dnc_list"
+		name         = "Test DNC List " + uuid.NewString()
+		entriesFile1 = filepath.Join(t.TempDir(), "dnc1.csv")
+		entriesFile2 = filepath.Join(t.TempDir(), "dnc2.csv")
+	)
+
+	if err := os.WriteFile(entriesFile1, []byte("phone_number,expiration_date\n+353747474747,\n+353 (11) 222-2222,\n+353221111111,2099-01-01T00:00Z\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(entriesFile2, []byte("phone_number\n+353747474747\n+353808080808\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Load three entries into a new DNC list
+				Config: generateOutboundDncList(
+					resourceID,
+					name,
+					"rds",
+					strconv.Quote("Phone"),
+					NullValue,
+					NullValue,
+					NullValue,
+					[]string{},
+					generateOutboundDncListEntriesFileAttrs(entriesFile1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_dnclist."+resourceID, "entries_filepath", entriesFile1),
+					checkPhoneNumbersAddedToDncList("genesyscloud_outbound_dnclist."+resourceID, 3),
+				),
+			},
+			{
+				// Remove two entries and add one
+				Config: generateOutboundDncList(
+					resourceID,
+					name,
+					"rds",
+					strconv.Quote("Phone"),
+					NullValue,
+					NullValue,
+					NullValue,
+					[]string{},
+					generateOutboundDncListEntriesFileAttrs(entriesFile2),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_dnclist."+resourceID, "entries_filepath", entriesFile2),
+					checkPhoneNumbersAddedToDncList("genesyscloud_outbound_dnclist."+resourceID, 2),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_outbound_dnclist." + resourceID,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"entries_filepath", "entries_file_content_hash", "entry_count", "synced_entries_file_content_hash", "synced_entry_count"},
+			},
+		},
+		CheckDestroy: testVerifyDncListDestroyed,
+	})
+}
+
 func TestAccResourceOutboundDncListDncListType(t *testing.T) {
 	t.Parallel()
 	dncLoginId, present := os.LookupEnv("TEST_DNCCOM_LICENSE_KEY")
@@ -284,6 +353,13 @@ func generateOutboundDncListEntriesBlock(phoneNumbers []string, expirationDate s
 `, expirationDate, strings.Join(phoneNumbers, ", "))
 }
 
+func generateOutboundDncListEntriesFileAttrs(filePath string) string {
+	return fmt.Sprintf(`
+	entries_filepath          = "%s"
+	entries_file_content_hash = filesha256("%s")
+`, filePath, filePath)
+}
+
 func checkPhoneNumbersAddedToDncList(resource string, numberOfPhoneNumbersAdded int) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		r := state.RootModule().Resources[resource]
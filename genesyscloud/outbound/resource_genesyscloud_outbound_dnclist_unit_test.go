@@ -0,0 +1,186 @@
+package outbound
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDncListEntriesCsvParsing(t *testing.T) {
+	entries, err := parseDncEntriesCsv(strings.NewReader("\ufeffexpiration_date,phone_number\n,+1 (317) 555-0001\n2030-01-02T15:04Z,+13175550002\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []dncEntry{
+		{phoneNumber: "+13175550001"},
+		{phoneNumber: "+13175550002", expirationDate: "2030-01-02T15:04Z"},
+	}, entries)
+
+	testCases := []struct {
+		name          string
+		contents      string
+		expectedError string
+	}{
+		{
+			name:          "empty file",
+			contents:      "",
+			expectedError: "a header row is required",
+		},
+		{
+			name:          "missing phone number column",
+			contents:      "expiration_date\n2030-01-02T15:04Z\n",
+			expectedError: "the header row must contain a phone_number column",
+		},
+		{
+			name:          "unknown column",
+			contents:      "phone_number,name\n+13175550001,John\n",
+			expectedError: `unknown column "name"`,
+		},
+		{
+			name:          "duplicate column",
+			contents:      "phone_number,phone_number\n+13175550001,+13175550002\n",
+			expectedError: `column "phone_number" appears more than once`,
+		},
+		{
+			name:          "empty phone number",
+			contents:      "phone_number\n\"\"\n",
+			expectedError: "line 2: phone_number must not be empty",
+		},
+		{
+			name:          "not e164",
+			contents:      "phone_number\n317-555-0001\n",
+			expectedError: "line 2: Failed to parse number in an E.164 format.  Passed 3175550001 and expected: +13175550001",
+		},
+		{
+			name:          "duplicate after normalisation",
+			contents:      "phone_number\n+13175550001\n+1 317 555 0001\n",
+			expectedError: "line 3: duplicate phone number +13175550001, first defined at line 2",
+		},
+		{
+			name:          "invalid expiration date",
+			contents:      "phone_number,expiration_date\n+13175550001,tomorrow\n",
+			expectedError: `line 2: expiration_date "tomorrow" is not in yyyy-MM-ddTHH:mmZ format`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseDncEntriesCsv(strings.NewReader(tc.contents))
+			assert.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+
+	// Row errors are limited to maxReportedDncEntryErrors
+	_, err = parseDncEntriesCsv(strings.NewReader("phone_number\n" + strings.Repeat("12\n", maxReportedDncEntryErrors+5)))
+	assert.ErrorContains(t, err, "... and 5 more errors")
+}
+
+func TestUnitDncListEntriesDiff(t *testing.T) {
+	current, err := parseDncListExport(strings.NewReader("dnc_phone_number,expiration_date_time\n+13175550001,\n+13175550002,2030-01-02T15:04:00.000Z\n+13175550003,2030-01-02T15:04:00Z\n+1 (317) 555-0004,\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"+13175550001": "",
+		"+13175550002": "2030-01-02T15:04Z",
+		"+13175550003": "2030-01-02T15:04Z",
+		"+13175550004": "",
+	}, current)
+
+	diff := diffDncEntries([]dncEntry{
+		{phoneNumber: "+13175550001"},
+		{phoneNumber: "+13175550002", expirationDate: "2030-01-02T15:04Z"},
+		{phoneNumber: "+13175550003", expirationDate: "2031-01-02T15:04Z"},
+		{phoneNumber: "+13175550005"},
+		{phoneNumber: "+13175550006", expirationDate: "2031-01-02T15:04Z"},
+	}, current)
+
+	// Changed expiration dates are added again without being removed
+	assert.Equal(t, []string{"+13175550004"}, diff.removals)
+	assert.Equal(t, map[string][]string{
+		"":                  {"+13175550005"},
+		"2031-01-02T15:04Z": {"+13175550003", "+13175550006"},
+	}, diff.additions)
+	assert.Equal(t, 3, diff.additionCount())
+
+	// An export without the documented phone number column cannot be compared with the file
+	_, err = parseDncListExport(strings.NewReader("phone_number,expiration_date_time\n+13175550001,\n"))
+	assert.ErrorContains(t, err, "DNC list export has no dnc_phone_number column")
+}
+
+func TestUnitSyncDncListEntriesFile(t *testing.T) {
+	tDncListId := uuid.NewString()
+	tExportUri := "https://example.com/export.csv"
+
+	entriesFilePath := filepath.Join(t.TempDir(), "dnc.csv")
+	contents := "phone_number,expiration_date\n+13175550001,\n+13175550002,2030-01-02T15:04Z\n"
+	for i := 0; i <= dncPhoneNumbersChunkSize; i++ {
+		contents += fmt.Sprintf("+1317666%04d,\n", i)
+	}
+	assert.Nil(t, os.WriteFile(entriesFilePath, []byte(contents), 0644))
+
+	previousExport := time.Now().Add(-time.Hour)
+	var (
+		exportInitiated    bool
+		removed            []string
+		added              = make(map[string][]string)
+		patchRequests      int
+		addedBeforeRemoval bool
+	)
+	proxy := &outboundDncListProxy{}
+	proxy.getOutboundDncListAttr = func(ctx context.Context, p *outboundDncListProxy, dncListId string, includeSize bool) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tDncListId, dncListId)
+		assert.True(t, includeSize)
+		return &platformclientv2.Dnclist{Id: &dncListId, Size: platformclientv2.Int(2)}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.initiateOutboundDncListExportAttr = func(ctx context.Context, p *outboundDncListProxy, dncListId string) (*platformclientv2.APIResponse, error) {
+		exportInitiated = true
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getOutboundDncListExportAttr = func(ctx context.Context, p *outboundDncListProxy, dncListId string) (*platformclientv2.Exporturi, *platformclientv2.APIResponse, error) {
+		exportTimestamp := previousExport
+		if exportInitiated {
+			exportTimestamp = time.Now()
+		}
+		return &platformclientv2.Exporturi{Uri: &tExportUri, ExportTimestamp: &exportTimestamp}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.downloadOutboundDncListExportAttr = func(ctx context.Context, p *outboundDncListProxy, uri string) (io.ReadCloser, error) {
+		assert.True(t, exportInitiated, "the previous export must not be used")
+		assert.Equal(t, tExportUri, uri)
+		return io.NopCloser(strings.NewReader("dnc_phone_number,expiration_date_time\n+13175550001,\n+13175550009,\n")), nil
+	}
+	proxy.patchOutboundDncListPhoneNumbersAttr = func(ctx context.Context, p *outboundDncListProxy, dncListId string, body platformclientv2.Dncpatchphonenumbersrequest) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tDncListId, dncListId)
+		assert.LessOrEqual(t, len(*body.PhoneNumbers), dncPhoneNumbersChunkSize)
+		patchRequests++
+		switch *body.Action {
+		case "Remove":
+			addedBeforeRemoval = len(added) > 0
+			removed = append(removed, *body.PhoneNumbers...)
+		case "Add":
+			expirationDate := ""
+			if body.ExpirationDateTime != nil {
+				expirationDate = *body.ExpirationDateTime
+			}
+			added[expirationDate] = append(added[expirationDate], *body.PhoneNumbers...)
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	diagErr := syncDncListEntriesFile(context.Background(), proxy, tDncListId, entriesFilePath)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, []string{"+13175550009"}, removed)
+	assert.True(t, addedBeforeRemoval, "phone numbers must be added before any are removed")
+	assert.Equal(t, []string{"+13175550002"}, added["2030-01-02T15:04Z"])
+	assert.Equal(t, dncPhoneNumbersChunkSize+1, len(added[""]))
+	// One removal, two chunks without an expiration date and one with an expiration date
+	assert.Equal(t, 4, patchRequests)
+}
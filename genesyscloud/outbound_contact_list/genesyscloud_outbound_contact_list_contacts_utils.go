@@ -0,0 +1,242 @@
+package outbound_contact_list
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// maxReportedContactErrors limits the number of row level validation errors returned for a single file
+const maxReportedContactErrors = 25
+
+// contactTimestampLayouts are the formats accepted for values of TIMESTAMP columns
+var contactTimestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// contactListFile holds the contents of a contacts CSV file
+type contactListFile struct {
+	columns []string
+	rows    [][]string
+}
+
+// readContactListFile reads a contacts CSV file from a local path or URL
+func readContactListFile(filePath string) (*contactListFile, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	return parseContactListCsv(reader)
+}
+
+// parseContactListCsv parses a CSV file with a header row of contact list column names
+func parseContactListCsv(reader io.Reader) (*contactListFile, error) {
+	csvReader := csv.NewReader(reader)
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("contacts file is empty, a header row is required")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %v", err)
+	}
+	// Strip a UTF-8 byte order mark written by spreadsheet tools
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	seenColumns := make(map[string]bool, len(header))
+	for _, column := range header {
+		if seenColumns[column] {
+			return nil, fmt.Errorf("column %q appears more than once in the header row", column)
+		}
+		seenColumns[column] = true
+	}
+
+	result := &contactListFile{columns: header}
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read line %d: %v", line, err)
+		}
+		result.rows = append(result.rows, record)
+	}
+	return result, nil
+}
+
+// buildContactListFile reads a contacts file and validates it against the columns of the contact list
+func buildContactListFile(contactList *platformclientv2.Contactlist, filePath string, contactIdName string) (*contactListFile, error) {
+	contactsFile, err := readContactListFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read contacts file %s: %v", filePath, err)
+	}
+	if err := validateContactListFile(contactList, contactsFile, contactIdName); err != nil {
+		return nil, fmt.Errorf("invalid contacts file %s: %v", filePath, err)
+	}
+	return contactsFile, nil
+}
+
+// validateContactListFile checks the header of a contacts file against the columns of the contact list and
+// the values of every row against the data type specifications of the columns
+func validateContactListFile(contactList *platformclientv2.Contactlist, contactsFile *contactListFile, contactIdName string) error {
+	listColumns := make(map[string]bool)
+	if contactList.ColumnNames != nil {
+		for _, column := range *contactList.ColumnNames {
+			listColumns[column] = true
+		}
+	}
+
+	var unknownColumns []string
+	fileColumns := make(map[string]bool, len(contactsFile.columns))
+	for _, column := range contactsFile.columns {
+		fileColumns[column] = true
+		if !listColumns[column] {
+			unknownColumns = append(unknownColumns, strconv.Quote(column))
+		}
+	}
+	if len(unknownColumns) > 0 {
+		return fmt.Errorf("columns %s are not columns of contact list %s", strings.Join(unknownColumns, ", "), getContactListName(contactList))
+	}
+
+	var missingColumns []string
+	if contactList.ColumnNames != nil {
+		for _, column := range *contactList.ColumnNames {
+			if !fileColumns[column] {
+				missingColumns = append(missingColumns, strconv.Quote(column))
+			}
+		}
+	}
+	if len(missingColumns) > 0 {
+		return fmt.Errorf("columns %s of contact list %s are missing from the header row", strings.Join(missingColumns, ", "), getContactListName(contactList))
+	}
+
+	contactIdIndex := -1
+	if contactIdName != "" {
+		if !fileColumns[contactIdName] {
+			return fmt.Errorf("contact_id_name %q is not a column of contact list %s", contactIdName, getContactListName(contactList))
+		}
+		for i, column := range contactsFile.columns {
+			if column == contactIdName {
+				contactIdIndex = i
+			}
+		}
+	}
+
+	specsByColumn := make(map[string]platformclientv2.Columndatatypespecification)
+	if contactList.ColumnDataTypeSpecifications != nil {
+		for _, spec := range *contactList.ColumnDataTypeSpecifications {
+			if spec.ColumnName != nil {
+				specsByColumn[*spec.ColumnName] = spec
+			}
+		}
+	}
+
+	var rowErrors []string
+	firstLines := make(map[string]int)
+	for i, record := range contactsFile.rows {
+		line := i + 2
+		if contactIdIndex >= 0 {
+			contactId := record[contactIdIndex]
+			if contactId == "" {
+				rowErrors = append(rowErrors, fmt.Sprintf("line %d: %s must not be empty", line, contactIdName))
+			} else if firstLine, exists := firstLines[contactId]; exists {
+				rowErrors = append(rowErrors, fmt.Sprintf("line %d: duplicate %s %q, first defined at line %d", line, contactIdName, contactId, firstLine))
+			} else {
+				firstLines[contactId] = line
+			}
+		}
+		for columnIndex, column := range contactsFile.columns {
+			spec, ok := specsByColumn[column]
+			if !ok {
+				continue
+			}
+			if err := validateContactValue(spec, record[columnIndex]); err != nil {
+				rowErrors = append(rowErrors, fmt.Sprintf("line %d: column %s: %v", line, column, err))
+			}
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		if len(rowErrors) > maxReportedContactErrors {
+			remaining := len(rowErrors) - maxReportedContactErrors
+			rowErrors = append(rowErrors[:maxReportedContactErrors], fmt.Sprintf("... and %d more errors", remaining))
+		}
+		return fmt.Errorf("\n%s", strings.Join(rowErrors, "\n"))
+	}
+	return nil
+}
+
+// validateContactValue checks a value against the data type specification of its column. Empty values are allowed.
+func validateContactValue(spec platformclientv2.Columndatatypespecification, value string) error {
+	if value == "" || spec.ColumnDataType == nil {
+		return nil
+	}
+
+	switch *spec.ColumnDataType {
+	case "NUMERIC":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		if spec.Min != nil && number < float64(*spec.Min) {
+			return fmt.Errorf("%s is less than the minimum of %d", value, *spec.Min)
+		}
+		if spec.Max != nil && number > float64(*spec.Max) {
+			return fmt.Errorf("%s is greater than the maximum of %d", value, *spec.Max)
+		}
+	case "TEXT":
+		if spec.MaxLength != nil && utf8.RuneCountInString(value) > *spec.MaxLength {
+			return fmt.Errorf("%q is longer than the maximum length of %d", value, *spec.MaxLength)
+		}
+	case "TIMESTAMP":
+		for _, layout := range contactTimestampLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not a timestamp. Expected a format such as 2006-01-02 15:04", value)
+	}
+	return nil
+}
+
+// writeContactListImportFile writes the contacts to a temporary CSV file for upload. The caller must remove the file.
+func writeContactListImportFile(contactsFile *contactListFile) (string, error) {
+	file, err := os.CreateTemp("", "contact-list-contacts-*.csv")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	csvWriter := csv.NewWriter(file)
+	err = csvWriter.Write(contactsFile.columns)
+	if err == nil {
+		err = csvWriter.WriteAll(contactsFile.rows)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func getContactListName(contactList *platformclientv2.Contactlist) string {
+	if contactList.Name == nil {
+		return ""
+	}
+	return *contactList.Name
+}
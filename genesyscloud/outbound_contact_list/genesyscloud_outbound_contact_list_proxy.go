@@ -0,0 +1,111 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *outboundContactListProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getOutboundContactListFunc func(ctx context.Context, p *outboundContactListProxy, contactListId string, includeSize bool) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error)
+type clearOutboundContactListFunc func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.APIResponse, error)
+type getOutboundContactListImportStatusFunc func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error)
+type uploadOutboundContactListFileFunc func(ctx context.Context, p *outboundContactListProxy, contactListId string, contactIdName string, filePath string) error
+
+// outboundContactListProxy contains all of the methods that call genesys cloud APIs.
+type outboundContactListProxy struct {
+	clientConfig *platformclientv2.Configuration
+	outboundApi  *platformclientv2.OutboundApi
+	basePath     string
+
+	getOutboundContactListAttr             getOutboundContactListFunc
+	clearOutboundContactListAttr           clearOutboundContactListFunc
+	getOutboundContactListImportStatusAttr getOutboundContactListImportStatusFunc
+	uploadOutboundContactListFileAttr      uploadOutboundContactListFileFunc
+}
+
+// newOutboundContactListProxy initializes the proxy with all of the data needed to communicate with Genesys Cloud
+func newOutboundContactListProxy(clientConfig *platformclientv2.Configuration) *outboundContactListProxy {
+	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+	return &outboundContactListProxy{
+		clientConfig:                           clientConfig,
+		outboundApi:                            api,
+		basePath:                               strings.Replace(api.Configuration.BasePath, "api", "apps", -1),
+		getOutboundContactListAttr:             getOutboundContactListFn,
+		clearOutboundContactListAttr:           clearOutboundContactListFn,
+		getOutboundContactListImportStatusAttr: getOutboundContactListImportStatusFn,
+		uploadOutboundContactListFileAttr:      uploadOutboundContactListFileFn,
+	}
+}
+
+// getOutboundContactListProxy acts as a singleton to for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getOutboundContactListProxy(clientConfig *platformclientv2.Configuration) *outboundContactListProxy {
+	if internalProxy == nil {
+		internalProxy = newOutboundContactListProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getOutboundContactList retrieves a contact list, optionally including the number of contacts in it
+func (p *outboundContactListProxy) getOutboundContactList(ctx context.Context, contactListId string, includeSize bool) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+	return p.getOutboundContactListAttr(ctx, p, contactListId, includeSize)
+}
+
+// clearOutboundContactList removes every contact from a contact list
+func (p *outboundContactListProxy) clearOutboundContactList(ctx context.Context, contactListId string) (*platformclientv2.APIResponse, error) {
+	return p.clearOutboundContactListAttr(ctx, p, contactListId)
+}
+
+// getOutboundContactListImportStatus retrieves the status of the most recent contact import into a contact list
+func (p *outboundContactListProxy) getOutboundContactListImportStatus(ctx context.Context, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+	return p.getOutboundContactListImportStatusAttr(ctx, p, contactListId)
+}
+
+// uploadOutboundContactListFile uploads a CSV file of contacts into a contact list
+func (p *outboundContactListProxy) uploadOutboundContactListFile(ctx context.Context, contactListId string, contactIdName string, filePath string) error {
+	return p.uploadOutboundContactListFileAttr(ctx, p, contactListId, contactIdName, filePath)
+}
+
+func getOutboundContactListFn(ctx context.Context, p *outboundContactListProxy, contactListId string, includeSize bool) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundContactlist(contactListId, false, includeSize)
+}
+
+func clearOutboundContactListFn(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.APIResponse, error) {
+	return p.outboundApi.PostOutboundContactlistClear(contactListId)
+}
+
+func getOutboundContactListImportStatusFn(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundContactlistImportstatus(contactListId)
+}
+
+// uploadOutboundContactListFileFn posts a CSV file to the contact list upload endpoint, which starts an import into the contact list
+func uploadOutboundContactListFileFn(ctx context.Context, p *outboundContactListProxy, contactListId string, contactIdName string, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+
+	formData := make(map[string]io.Reader)
+	formData["file"] = file
+	formData["fileType"] = strings.NewReader("contactlist")
+	formData["id"] = strings.NewReader(contactListId)
+	if contactIdName != "" {
+		formData["contact-id-name"] = strings.NewReader(contactIdName)
+	}
+
+	headers := make(map[string]string)
+	headers["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, http.MethodPost, p.basePath+"/uploads/v2/contactlist")
+	_, err = s3Uploader.Upload()
+	return err
+}
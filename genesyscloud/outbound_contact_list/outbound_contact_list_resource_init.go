@@ -7,5 +7,6 @@ import (
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterDataSource("genesyscloud_outbound_contact_list", DataSourceOutboundContactList())
 	regInstance.RegisterResource("genesyscloud_outbound_contact_list", ResourceOutboundContactList())
+	regInstance.RegisterResource("genesyscloud_outbound_contact_list_contacts", ResourceOutboundContactListContacts())
 	regInstance.RegisterExporter("genesyscloud_outbound_contact_list", OutboundContactListExporter())
 }
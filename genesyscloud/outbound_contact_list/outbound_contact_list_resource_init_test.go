@@ -26,6 +26,7 @@ type registerTestInstance struct {
 
 func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_outbound_contact_list"] = ResourceOutboundContactList()
+	providerResources["genesyscloud_outbound_contact_list_contacts"] = ResourceOutboundContactListContacts()
 	providerResources["genesyscloud_outbound_attempt_limit"] = obAttemptLimit.ResourceOutboundAttemptLimit()
 }
 
@@ -0,0 +1,326 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const contactListContactsJobTimeout = 30 * time.Minute
+
+func ResourceOutboundContactListContacts() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Outbound Contact List Contacts. Loads the contacts of a contact list from a CSV file using the contact list import. The file is validated against the columns of the contact list before it is uploaded. Destroying this resource clears every contact from the contact list.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createOutboundContactListContacts),
+		ReadContext:   gcloud.ReadWithPooledClient(readOutboundContactListContacts),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateOutboundContactListContacts),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteOutboundContactListContacts),
+		Importer: &schema.ResourceImporter{
+			StateContext: importOutboundContactListContacts,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			`contact_list_id`: {
+				Description: `The ID of the contact list the contacts are loaded into. If this is changed, the contacts are loaded into the new contact list.`,
+				Required:    true,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+			},
+			`filepath`: {
+				Description:  `Path to a CSV file of contacts. The header row must contain every column of the contact list and no other columns. Values of columns with a data type specification are checked against it. TIMESTAMP values must use a format such as 2006-01-02 15:04.`,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: gcloud.ValidatePath,
+			},
+			`file_content_hash`: {
+				Description: `Hash value of the contacts file content. Used to detect changes.`,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			`contact_id_name`: {
+				Description: `The column that uniquely identifies a contact. Contacts in the file with the same value as an existing contact replace it. If not set, every row is imported as a new contact.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`clear_before_import`: {
+				Description: `Remove every contact from the contact list before the file is imported, so the contact list only contains the contacts in the file.`,
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
+			`contact_count`: {
+				Description: `Number of contacts in the contact list.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			`synced_file_content_hash`: {
+				Description: `The file_content_hash of the file that was last loaded into the contact list.`,
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			`synced_contact_count`: {
+				Description: `Number of contacts in the contact list after the file was last loaded. Rows rejected or merged by the import are not counted. When clear_before_import is true and contact_count no longer matches, the file is loaded again on the next apply.`,
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+		},
+		CustomizeDiff: customizeOutboundContactListContactsDiff,
+	}
+}
+
+func createOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contactListId := d.Get("contact_list_id").(string)
+
+	log.Printf("Loading contacts into Outbound Contact List %s", contactListId)
+	if diagErr := syncOutboundContactListContacts(ctx, d, meta, contactListId); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(contactListId)
+
+	log.Printf("Loaded contacts into Outbound Contact List %s", d.Id())
+	return readSyncedOutboundContactListContacts(ctx, d, meta)
+}
+
+// importOutboundContactListContacts records the current contacts of the contact list as synced, since the file that loaded them is not known
+func importOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundContactListProxy(sdkConfig)
+
+	contactList, _, err := proxy.getOutboundContactList(ctx, d.Id(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import contacts of Outbound Contact List %s: %s", d.Id(), err)
+	}
+	contactCount := 0
+	if contactList.Size != nil {
+		contactCount = *contactList.Size
+	}
+	_ = d.Set("synced_contact_count", contactCount)
+	return []*schema.ResourceData{d}, nil
+}
+
+func readOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundContactListProxy(sdkConfig)
+
+	log.Printf("Reading contacts of Outbound Contact List %s", d.Id())
+
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		contactList, resp, getErr := proxy.getOutboundContactList(ctx, d.Id(), true)
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("failed to read Outbound Contact List %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("failed to read Outbound Contact List %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceOutboundContactListContacts())
+		contactCount := 0
+		if contactList.Size != nil {
+			contactCount = *contactList.Size
+		}
+		_ = d.Set("contact_list_id", d.Id())
+		_ = d.Set("contact_count", contactCount)
+
+		log.Printf("Read %d contacts of Outbound Contact List %s", contactCount, d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Loading contacts into Outbound Contact List %s", d.Id())
+	if diagErr := syncOutboundContactListContacts(ctx, d, meta, d.Id()); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Loaded contacts into Outbound Contact List %s", d.Id())
+	return readSyncedOutboundContactListContacts(ctx, d, meta)
+}
+
+// readSyncedOutboundContactListContacts reads the contacts after the file has been loaded and records the file and
+// the number of contacts it left in the contact list
+func readSyncedOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diagErr := readOutboundContactListContacts(ctx, d, meta); diagErr.HasError() {
+		return diagErr
+	}
+	_ = d.Set("synced_file_content_hash", d.Get("file_content_hash").(string))
+	_ = d.Set("synced_contact_count", d.Get("contact_count").(int))
+	return nil
+}
+
+func deleteOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundContactListProxy(sdkConfig)
+
+	if _, resp, err := proxy.getOutboundContactList(ctx, d.Id(), false); err != nil {
+		if gcloud.IsStatus404(resp) {
+			// Parent contact list was probably deleted which removed the contacts
+			log.Printf("Outbound Contact List %s already deleted", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to read Outbound Contact List %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Clearing contacts of Outbound Contact List %s", d.Id())
+	return clearOutboundContactListContacts(ctx, proxy, d.Id())
+}
+
+// syncOutboundContactListContacts validates the contacts file and imports it into the contact list
+func syncOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}, contactListId string) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundContactListProxy(sdkConfig)
+	filePath := d.Get("filepath").(string)
+	contactIdName := d.Get("contact_id_name").(string)
+
+	// Always read the latest columns since the contact list may have been changed earlier in this apply
+	contactList, _, err := proxy.getOutboundContactList(ctx, contactListId, false)
+	if err != nil {
+		return diag.Errorf("Failed to read Outbound Contact List %s: %s", contactListId, err)
+	}
+
+	contactsFile, err := buildContactListFile(contactList, filePath, contactIdName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("clear_before_import").(bool) {
+		log.Printf("Clearing contacts of Outbound Contact List %s before import", contactListId)
+		if diagErr := clearOutboundContactListContacts(ctx, proxy, contactListId); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	if len(contactsFile.rows) == 0 {
+		log.Printf("%s contains no contacts to import into Outbound Contact List %s", filePath, contactListId)
+		return nil
+	}
+
+	importFilePath, err := writeContactListImportFile(contactsFile)
+	if err != nil {
+		return diag.Errorf("Failed to write import file for Outbound Contact List %s: %s", contactListId, err)
+	}
+	defer os.Remove(importFilePath)
+
+	log.Printf("Uploading %d contacts from %s to Outbound Contact List %s", len(contactsFile.rows), filePath, contactListId)
+	if err := proxy.uploadOutboundContactListFile(ctx, contactListId, contactIdName, importFilePath); err != nil {
+		return diag.Errorf("Failed to upload contacts to Outbound Contact List %s: %s", contactListId, err)
+	}
+
+	return waitForContactListImport(ctx, proxy, contactListId, len(contactsFile.rows))
+}
+
+// waitForContactListImport polls the import status of a contact list until the upload of expectedRecords contacts has finished.
+// A successful status is only accepted once the import has been seen in progress or reports the expected number of records,
+// so the status of an earlier import is not mistaken for the status of this one.
+func waitForContactListImport(ctx context.Context, proxy *outboundContactListProxy, contactListId string, expectedRecords int) diag.Diagnostics {
+	seenInProgress := false
+	return gcloud.WithRetries(ctx, contactListContactsJobTimeout, func() *retry.RetryError {
+		status, _, err := proxy.getOutboundContactListImportStatus(ctx, contactListId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read import status of Outbound Contact List %s: %s", contactListId, err))
+		}
+
+		state := ""
+		if status.State != nil {
+			state = *status.State
+		}
+
+		switch state {
+		case "SUCCESS":
+			if seenInProgress || status.TotalRecords == nil || *status.TotalRecords == expectedRecords {
+				log.Printf("Import of %d contacts into Outbound Contact List %s succeeded", expectedRecords, contactListId)
+				return nil
+			}
+		case "FAILURE":
+			reason := ""
+			if status.FailureReason != nil {
+				reason = *status.FailureReason
+			}
+			return retry.NonRetryableError(fmt.Errorf("Import of contacts into Outbound Contact List %s failed: %s", contactListId, reason))
+		case "IN_PROGRESS":
+			seenInProgress = true
+			if status.CompletedRecords != nil && status.TotalRecords != nil {
+				percentComplete := 0
+				if status.PercentComplete != nil {
+					percentComplete = *status.PercentComplete
+				}
+				log.Printf("Importing contacts into Outbound Contact List %s: %d of %d records (%d%%)", contactListId, *status.CompletedRecords, *status.TotalRecords, percentComplete)
+			}
+		}
+		return retry.RetryableError(fmt.Errorf("Import of contacts into Outbound Contact List %s has not finished", contactListId))
+	})
+}
+
+// clearOutboundContactListContacts removes every contact from a contact list and waits until the contact list is empty
+func clearOutboundContactListContacts(ctx context.Context, proxy *outboundContactListProxy, contactListId string) diag.Diagnostics {
+	if _, err := proxy.clearOutboundContactList(ctx, contactListId); err != nil {
+		return diag.Errorf("Failed to clear Outbound Contact List %s: %s", contactListId, err)
+	}
+
+	return gcloud.WithRetries(ctx, contactListContactsJobTimeout, func() *retry.RetryError {
+		contactList, _, err := proxy.getOutboundContactList(ctx, contactListId, true)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read Outbound Contact List %s: %s", contactListId, err))
+		}
+		if contactList.Size != nil && *contactList.Size > 0 {
+			return retry.RetryableError(fmt.Errorf("Outbound Contact List %s still has %d contacts", contactListId, *contactList.Size))
+		}
+		log.Printf("Cleared contacts of Outbound Contact List %s", contactListId)
+		return nil
+	})
+}
+
+// customizeOutboundContactListContactsDiff validates the contacts file against the contact list columns during plan.
+// The file is loaded again when the contact list is cleared before the import and its contacts were changed outside of Terraform.
+func customizeOutboundContactListContactsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("filepath") || !diff.NewValueKnown("file_content_hash") || !diff.NewValueKnown("contact_list_id") || !diff.NewValueKnown("contact_id_name") {
+		// The contact list or file is not known until apply. The file will be validated then.
+		return setContactListContactsSyncComputed(diff)
+	}
+
+	changedOutside := diff.Id() != "" && diff.Get("clear_before_import").(bool) &&
+		diff.Get("contact_count").(int) != diff.Get("synced_contact_count").(int)
+	if diff.Id() != "" && !changedOutside && !diff.HasChanges("filepath", "file_content_hash", "contact_id_name", "clear_before_import") {
+		return nil
+	}
+	if changedOutside {
+		log.Printf("Outbound Contact List %s has %d contacts but had %d after the last import", diff.Id(), diff.Get("contact_count").(int), diff.Get("synced_contact_count").(int))
+	}
+
+	contactListId := diff.Get("contact_list_id").(string)
+	filePath := diff.Get("filepath").(string)
+
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getOutboundContactListProxy(sdkConfig)
+
+	contactList, _, err := proxy.getOutboundContactList(ctx, contactListId, false)
+	if err != nil {
+		return fmt.Errorf("Failed to read Outbound Contact List %s: %s", contactListId, err)
+	}
+
+	if _, err := buildContactListFile(contactList, filePath, diff.Get("contact_id_name").(string)); err != nil {
+		return err
+	}
+	return setContactListContactsSyncComputed(diff)
+}
+
+// setContactListContactsSyncComputed marks the attributes set by loading the file as unknown until apply. The number of
+// contacts is only known after the import, as it may reject rows or merge rows with the same contact_id_name.
+func setContactListContactsSyncComputed(diff *schema.ResourceDiff) error {
+	for _, attr := range []string{"contact_count", "synced_file_content_hash", "synced_contact_count"} {
+		if err := diff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
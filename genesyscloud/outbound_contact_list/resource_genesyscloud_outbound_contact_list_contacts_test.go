@@ -0,0 +1,105 @@
+package outbound_contact_list
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceOutboundContactListContacts(t *testing.T) {
+	t.Parallel()
+	var (
+		contactListResourceId = "contact-list"
+		contactsResourceId    = "contacts"
+		contactListName       = "Test Contact List " + uuid.NewString()
+
+		contactsFile1 = filepath.Join(t.TempDir(), "contacts1.csv")
+		contactsFile2 = filepath.Join(t.TempDir(), "contacts2.csv")
+
+		contactListConfig = GenerateOutboundContactList(
+			contactListResourceId,
+			contactListName,
+			gcloud.NullValue,
+			gcloud.NullValue,
+			[]string{},
+			[]string{strconv.Quote("Id"), strconv.Quote("Cell"), strconv.Quote("Balance")},
+			gcloud.FalseValue,
+			gcloud.NullValue,
+			gcloud.NullValue,
+			GeneratePhoneColumnsBlock("Cell", "cell", gcloud.NullValue),
+			GeneratePhoneColumnsDataTypeSpecBlock(strconv.Quote("Balance"), strconv.Quote("NUMERIC"), "0", "100000", "10"),
+		)
+	)
+
+	if err := os.WriteFile(contactsFile1, []byte("Id,Cell,Balance\n1,+13175550001,100\n2,+13175550002,250.5\n3,+13175550003,\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(contactsFile2, []byte("Cell,Id,Balance\n+13175550001,1,300\n+13175550004,4,0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Load three contacts into a new contact list
+				Config: contactListConfig + generateOutboundContactListContactsResource(
+					contactsResourceId,
+					"genesyscloud_outbound_contact_list."+contactListResourceId+".id",
+					contactsFile1,
+					strconv.Quote("Id"),
+					gcloud.FalseValue,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_outbound_contact_list_contacts."+contactsResourceId, "contact_list_id", "genesyscloud_outbound_contact_list."+contactListResourceId, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_outbound_contact_list_contacts."+contactsResourceId, "contact_count", "3"),
+				),
+			},
+			{
+				// Clear the contact list and load two contacts with the columns in a different order
+				Config: contactListConfig + generateOutboundContactListContactsResource(
+					contactsResourceId,
+					"genesyscloud_outbound_contact_list."+contactListResourceId+".id",
+					contactsFile2,
+					strconv.Quote("Id"),
+					gcloud.TrueValue,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_outbound_contact_list_contacts."+contactsResourceId, "contact_count", "2"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_outbound_contact_list_contacts." + contactsResourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filepath", "file_content_hash", "contact_id_name", "clear_before_import", "synced_file_content_hash"},
+			},
+		},
+		CheckDestroy: testVerifyContactListDestroyed,
+	})
+}
+
+func generateOutboundContactListContactsResource(
+	resourceId string,
+	contactListId string,
+	filePath string,
+	contactIdName string,
+	clearBeforeImport string) string {
+	return fmt.Sprintf(`resource "genesyscloud_outbound_contact_list_contacts" "%s" {
+	contact_list_id     = %s
+	filepath            = "%s"
+	file_content_hash   = filesha256("%s")
+	contact_id_name     = %s
+	clear_before_import = %s
+}
+`, resourceId, contactListId, filePath, filePath, contactIdName, clearBeforeImport)
+}
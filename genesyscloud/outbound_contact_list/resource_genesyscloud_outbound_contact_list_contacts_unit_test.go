@@ -0,0 +1,190 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestContactList(contactListId string) *platformclientv2.Contactlist {
+	return &platformclientv2.Contactlist{
+		Id:          platformclientv2.String(contactListId),
+		Name:        platformclientv2.String("Unit Test Contact List"),
+		ColumnNames: &[]string{"Id", "Cell", "Balance", "Notes", "Due"},
+		ColumnDataTypeSpecifications: &[]platformclientv2.Columndatatypespecification{
+			{ColumnName: platformclientv2.String("Balance"), ColumnDataType: platformclientv2.String("NUMERIC"), Min: platformclientv2.Int(0), Max: platformclientv2.Int(1000)},
+			{ColumnName: platformclientv2.String("Notes"), ColumnDataType: platformclientv2.String("TEXT"), MaxLength: platformclientv2.Int(5)},
+			{ColumnName: platformclientv2.String("Due"), ColumnDataType: platformclientv2.String("TIMESTAMP")},
+		},
+	}
+}
+
+func TestUnitContactListContactsCsvValidation(t *testing.T) {
+	contactList := buildTestContactList(uuid.NewString())
+
+	contactsFile, err := parseContactListCsv(strings.NewReader("\ufeffCell,Id,Balance,Notes,Due\n+13175550001,1,10.5,hello,2024-01-02 15:04\n+13175550002,2,,,\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Cell", "Id", "Balance", "Notes", "Due"}, contactsFile.columns)
+	assert.Equal(t, 2, len(contactsFile.rows))
+	assert.Nil(t, validateContactListFile(contactList, contactsFile, "Id"))
+
+	_, err = parseContactListCsv(strings.NewReader("Id,Id\n1,2\n"))
+	assert.ErrorContains(t, err, `column "Id" appears more than once`)
+
+	_, err = parseContactListCsv(strings.NewReader(""))
+	assert.ErrorContains(t, err, "a header row is required")
+}
+
+func TestUnitContactListContactsValidationErrors(t *testing.T) {
+	contactList := buildTestContactList(uuid.NewString())
+
+	testCases := []struct {
+		name          string
+		contents      string
+		contactIdName string
+		expectedError string
+	}{
+		{
+			name:          "unknown column",
+			contents:      "Id,Cell,Balance,Notes,Due,Email\n",
+			expectedError: `columns "Email" are not columns of contact list`,
+		},
+		{
+			name:          "missing column",
+			contents:      "Id,Cell,Balance,Notes\n",
+			expectedError: `columns "Due" of contact list Unit Test Contact List are missing`,
+		},
+		{
+			name:          "unknown contact id column",
+			contents:      "Id,Cell,Balance,Notes,Due\n",
+			contactIdName: "ContactId",
+			expectedError: `contact_id_name "ContactId" is not a column`,
+		},
+		{
+			name:          "duplicate contact id",
+			contents:      "Id,Cell,Balance,Notes,Due\n1,+13175550001,,,\n1,+13175550002,,,\n",
+			contactIdName: "Id",
+			expectedError: `line 3: duplicate Id "1", first defined at line 2`,
+		},
+		{
+			name:          "empty contact id",
+			contents:      "Id,Cell,Balance,Notes,Due\n,+13175550001,,,\n",
+			contactIdName: "Id",
+			expectedError: "line 2: Id must not be empty",
+		},
+		{
+			name:          "not a number",
+			contents:      "Id,Cell,Balance,Notes,Due\n1,+13175550001,ten,,\n",
+			expectedError: `line 2: column Balance: "ten" is not a number`,
+		},
+		{
+			name:          "number out of range",
+			contents:      "Id,Cell,Balance,Notes,Due\n1,+13175550001,1001,,\n",
+			expectedError: "line 2: column Balance: 1001 is greater than the maximum of 1000",
+		},
+		{
+			name:          "text too long",
+			contents:      "Id,Cell,Balance,Notes,Due\n1,+13175550001,,too long,\n",
+			expectedError: `line 2: column Notes: "too long" is longer than the maximum length of 5`,
+		},
+		{
+			name:          "invalid timestamp",
+			contents:      "Id,Cell,Balance,Notes,Due\n1,+13175550001,,,tomorrow\n",
+			expectedError: `line 2: column Due: "tomorrow" is not a timestamp`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contactsFile, err := parseContactListCsv(strings.NewReader(tc.contents))
+			assert.Nil(t, err)
+			assert.ErrorContains(t, validateContactListFile(contactList, contactsFile, tc.contactIdName), tc.expectedError)
+		})
+	}
+
+	// Row errors are limited to maxReportedContactErrors
+	contents := "Id,Cell,Balance,Notes,Due\n" + strings.Repeat("1,+13175550001,ten,,\n", maxReportedContactErrors+5)
+	contactsFile, err := parseContactListCsv(strings.NewReader(contents))
+	assert.Nil(t, err)
+	assert.ErrorContains(t, validateContactListFile(contactList, contactsFile, ""), "... and 5 more errors")
+}
+
+func TestUnitResourceOutboundContactListContactsCreate(t *testing.T) {
+	tContactListId := uuid.NewString()
+
+	contactsFilePath := filepath.Join(t.TempDir(), "contacts.csv")
+	assert.Nil(t, os.WriteFile(contactsFilePath, []byte("Id,Cell,Balance,Notes,Due\n1,+13175550001,5,,\n2,+13175550002,6,,\n"), 0644))
+
+	var (
+		cleared          bool
+		uploadedContents string
+		statusReads      int
+	)
+	proxy := &outboundContactListProxy{}
+	proxy.getOutboundContactListAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string, includeSize bool) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tContactListId, contactListId)
+		contactList := buildTestContactList(tContactListId)
+		size := 7
+		if uploadedContents != "" {
+			size = 2
+		} else if cleared {
+			size = 0
+		}
+		contactList.Size = &size
+		return contactList, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.clearOutboundContactListAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, "", uploadedContents, "contact list must be cleared before the upload")
+		cleared = true
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.uploadOutboundContactListFileAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string, contactIdName string, filePath string) error {
+		assert.Equal(t, tContactListId, contactListId)
+		assert.Equal(t, "Id", contactIdName)
+		contents, err := os.ReadFile(filePath)
+		uploadedContents = string(contents)
+		return err
+	}
+	proxy.getOutboundContactListImportStatusAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+		statusReads++
+		state := "IN_PROGRESS"
+		if statusReads > 1 {
+			state = "SUCCESS"
+		}
+		return &platformclientv2.Importstatus{State: &state, TotalRecords: platformclientv2.Int(2), CompletedRecords: platformclientv2.Int(statusReads - 1)}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := map[string]interface{}{
+		"contact_list_id":     tContactListId,
+		"filepath":            contactsFilePath,
+		"file_content_hash":   "abc",
+		"contact_id_name":     "Id",
+		"clear_before_import": true,
+	}
+	d := schema.TestResourceDataRaw(t, ResourceOutboundContactListContacts().Schema, resourceDataMap)
+
+	diag := createOutboundContactListContacts(ctx, d, gcloud)
+	assert.False(t, diag.HasError())
+	assert.True(t, cleared)
+	assert.Equal(t, tContactListId, d.Id())
+	assert.Equal(t, "Id,Cell,Balance,Notes,Due\n1,+13175550001,5,,\n2,+13175550002,6,,\n", uploadedContents)
+	assert.Equal(t, 2, statusReads)
+	assert.Equal(t, 2, d.Get("contact_count").(int))
+	assert.Equal(t, "abc", d.Get("file_content_hash").(string))
+	assert.Equal(t, "abc", d.Get("synced_file_content_hash").(string))
+	assert.Equal(t, 2, d.Get("synced_contact_count").(int))
+}
@@ -6,8 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 )
 
 /*
@@ -29,7 +29,7 @@ type registerTestInstance struct {
 // registerTestResources registers all resources used in the tests
 func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_outbound_ruleset"] = ResourceOutboundRuleset()
-	providerResources["genesyscloud_routing_queue"] = gcloud.ResourceRoutingQueue()
+	providerResources["genesyscloud_routing_queue"] = routingQueue.ResourceRoutingQueue()
 }
 
 // registerTestDataSources registers all data sources used in the tests.
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
@@ -63,7 +64,7 @@ func TestAccResourceOutboundRulesetNoRules(t *testing.T) {
 						"cell",
 						strconv.Quote("Cell"),
 					),
-				) + gcloud.GenerateRoutingQueueResourceBasic(
+				) + routingQueue.GenerateRoutingQueueResourceBasic(
 					queueResource1,
 					queueName1) + fmt.Sprintf(`resource "genesyscloud_outbound_ruleset" "%s" {
   name            = "%s"
@@ -91,7 +92,7 @@ func TestAccResourceOutboundRulesetNoRules(t *testing.T) {
 						"cell",
 						strconv.Quote("Cell"),
 					),
-				) + gcloud.GenerateRoutingQueueResourceBasic(
+				) + routingQueue.GenerateRoutingQueueResourceBasic(
 					queueResource2,
 					queueName2) + fmt.Sprintf(`resource "genesyscloud_outbound_ruleset" "%s" {
   name            = "%s"
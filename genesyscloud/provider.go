@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/usercache"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -160,6 +161,7 @@ type ProviderMeta struct {
 	Version      string
 	ClientConfig *platformclientv2.Configuration
 	Domain       string
+	UserCache    *usercache.UserCache
 }
 
 func configure(version string) schema.ConfigureContextFunc {
@@ -187,6 +189,7 @@ func configure(version string) schema.ConfigureContextFunc {
 			Version:      version,
 			ClientConfig: platformclientv2.GetDefaultConfiguration(),
 			Domain:       getRegionDomain(data.Get("aws_region").(string)),
+			UserCache:    &usercache.UserCache{},
 		}, nil
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	integration "terraform-provider-genesyscloud/genesyscloud/integration"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -147,7 +148,7 @@ func TestAccDataSourceRecordingMediaRetentionPolicy(t *testing.T) {
 					domainId,
 					gcloud.FalseValue, // Subdomain
 					gcloud.NullValue,
-				) + gcloud.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
+				) + routingQueue.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
 					gcloud.GenerateAuthRoleResource(
 						roleResource1,
 						roleName1,
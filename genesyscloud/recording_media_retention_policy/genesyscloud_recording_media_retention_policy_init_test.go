@@ -5,6 +5,7 @@ import (
 	"sync"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	integration "terraform-provider-genesyscloud/genesyscloud/integration"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 
 	"testing"
 
@@ -40,7 +41,7 @@ func (r *registerTestInstance) registerTestResources() {
 
 	providerResources[resourceName] = ResourceMediaRetentionPolicy()
 	providerResources["genesyscloud_routing_email_domain"] = gcloud.ResourceRoutingEmailDomain()
-	providerResources["genesyscloud_routing_queue"] = gcloud.ResourceRoutingQueue()
+	providerResources["genesyscloud_routing_queue"] = routingQueue.ResourceRoutingQueue()
 	providerResources["genesyscloud_auth_role"] = gcloud.ResourceAuthRole()
 	providerResources["genesyscloud_user_roles"] = gcloud.ResourceUserRoles()
 	providerResources["genesyscloud_user"] = gcloud.ResourceUser()
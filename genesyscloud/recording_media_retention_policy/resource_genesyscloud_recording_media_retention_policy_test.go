@@ -12,6 +12,7 @@ import (
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	integration "terraform-provider-genesyscloud/genesyscloud/integration"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -939,7 +940,7 @@ func TestAccResourceMediaRetentionPolicyBasic(t *testing.T) {
 					domainId,
 					gcloud.FalseValue, // Subdomain
 					gcloud.NullValue,
-				) + gcloud.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
+				) + routingQueue.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
 					gcloud.GenerateAuthRoleResource(
 						roleResource1,
 						roleName1,
@@ -1046,7 +1047,7 @@ func TestAccResourceMediaRetentionPolicyBasic(t *testing.T) {
 					domainId,
 					gcloud.FalseValue, // Subdomain
 					gcloud.NullValue,
-				) + gcloud.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
+				) + routingQueue.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
 					gcloud.GenerateAuthRoleResource(
 						roleResource1,
 						roleName1,
@@ -1153,7 +1154,7 @@ func TestAccResourceMediaRetentionPolicyBasic(t *testing.T) {
 					domainId,
 					gcloud.FalseValue, // Subdomain
 					gcloud.NullValue,
-				) + gcloud.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
+				) + routingQueue.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
 					gcloud.GenerateAuthRoleResource(
 						roleResource1,
 						roleName1,
@@ -1260,7 +1261,7 @@ func TestAccResourceMediaRetentionPolicyBasic(t *testing.T) {
 					domainId,
 					gcloud.FalseValue, // Subdomain
 					gcloud.NullValue,
-				) + gcloud.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
+				) + routingQueue.GenerateRoutingQueueResourceBasic(queueResource1, queueName, "") +
 					gcloud.GenerateAuthRoleResource(
 						roleResource1,
 						roleName1,
@@ -134,6 +134,9 @@ type ResourceExporter struct {
 
 	//This a place holder filter out specific resources from a filter.
 	FilterResource func(ResourceIDMetaMap, string, []string) ResourceIDMetaMap
+	// ExportOnlyWhenIncluded resource types are only exported when named in include_filter_resources or resource_types.
+	// It is set for resource types that manage the same objects as an attribute of another resource type.
+	ExportOnlyWhenIncluded bool
 	// Attributes that are mentioned with custom exports like e164 numbers,rrule  should be ensured to export in the correct format (remove hyphens, whitespace, etc.)
 	CustomValidateExports map[string][]string
 }
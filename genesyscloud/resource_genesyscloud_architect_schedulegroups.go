@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -64,6 +66,7 @@ func ResourceArchitectScheduleGroups() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeArchitectScheduleGroupsDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -88,7 +91,7 @@ func ResourceArchitectScheduleGroups() *schema.Resource {
 				Optional:    true,
 			},
 			"open_schedules_id": {
-				Description: "The schedules defining the hours an organization is open.",
+				Description: "The schedules defining the hours an organization is open. During plan, the plan fails if a schedule is used in more than one of open_schedules_id, closed_schedules_id and holiday_schedules_id, or if the rrule of a schedule never matches. Closed schedules are allowed to overlap open schedules. Use the genesyscloud_architect_schedulegroup_evaluation data source to review the overlaps.",
 				Type:        schema.TypeSet,
 				Required:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -287,3 +290,64 @@ func deleteArchitectScheduleGroups(ctx context.Context, d *schema.ResourceData,
 		return retry.RetryableError(fmt.Errorf("Schedule group %s still exists", d.Id()))
 	})
 }
+
+// customizeArchitectScheduleGroupsDiff checks the schedules of the group during plan. Overlapping open and
+// closed schedules are not an error since closed schedules are used to close during open hours.
+func customizeArchitectScheduleGroupsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	scheduleAttrs := []string{"open_schedules_id", "closed_schedules_id", "holiday_schedules_id"}
+	if diff.Id() != "" && !diff.HasChanges(scheduleAttrs...) {
+		return nil
+	}
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	var errs []string
+	scheduleAttr := make(map[string]string)
+	for _, attr := range scheduleAttrs {
+		if !diff.NewValueKnown(attr) {
+			// Schedules created in the same apply are checked by the API
+			continue
+		}
+		scheduleIds, ok := diff.Get(attr).(*schema.Set)
+		if !ok || scheduleIds == nil {
+			continue
+		}
+		for _, scheduleId := range *lists.SetToStringList(scheduleIds) {
+			if otherAttr, found := scheduleAttr[scheduleId]; found {
+				errs = append(errs, fmt.Sprintf("schedule %s is in both %s and %s", scheduleId, otherAttr, attr))
+				continue
+			}
+			scheduleAttr[scheduleId] = attr
+
+			schedule, _, err := archAPI.GetArchitectSchedule(scheduleId)
+			if err != nil {
+				return fmt.Errorf("failed to read schedule %s of schedule group %s: %v", scheduleId, diff.Get("name").(string), err)
+			}
+			if err := validateScheduleRrule(schedule); err != nil {
+				errs = append(errs, fmt.Sprintf("schedule %s (%s): %v", scheduleId, attr, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid schedules for schedule group %s:\n%s", diff.Get("name").(string), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// validateScheduleRrule checks the rrule of a schedule has at least one occurrence
+func validateScheduleRrule(schedule *platformclientv2.Schedule) error {
+	if schedule.Rrule == nil || *schedule.Rrule == "" || schedule.Start == nil {
+		return nil
+	}
+	rule, err := rrule.Parse(*schedule.Rrule)
+	if err != nil {
+		// Rules that cannot be expanded locally are checked by the API
+		log.Printf("Unable to check rrule %q: %v", *schedule.Rrule, err)
+		return nil
+	}
+	if rule.NeverMatches(*schedule.Start) {
+		return fmt.Errorf("rrule %q never matches", *schedule.Rrule)
+	}
+	return nil
+}
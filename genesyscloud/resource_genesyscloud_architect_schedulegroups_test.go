@@ -3,6 +3,7 @@ package genesyscloud
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -176,6 +177,54 @@ func TestAccResourceArchitectScheduleGroups(t *testing.T) {
 	})
 }
 
+func TestAccResourceArchitectScheduleGroupsInvalidSchedules(t *testing.T) {
+	var (
+		schedGroupResource = "arch-sched-group-invalid"
+		name               = "Schedule Group " + uuid.NewString()
+		schedResource      = "arch-sched-invalid"
+		schedName          = "Schedule " + uuid.NewString()
+		schedDesc          = "Sample Schedule by CX as Code"
+		start              = "2021-08-04T08:00:00.000000"
+		end                = "2021-08-04T17:00:00.000000"
+		rrule              = "FREQ=DAILY;INTERVAL=1"
+	)
+
+	schedule := generateArchitectSchedulesResource(
+		schedResource,
+		schedName,
+		NullValue,
+		schedDesc,
+		start,
+		end,
+		rrule,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: schedule,
+			},
+			{
+				// The same schedule cannot open and close the group
+				Config: schedule + generateArchitectScheduleGroupsResource(
+					schedGroupResource,
+					name,
+					NullValue,
+					"Sample Schedule Group by CX as Code",
+					"Asia/Singapore",
+					generateSchedules("open_schedules_id", "genesyscloud_architect_schedules."+schedResource+".id"),
+					generateSchedules("closed_schedules_id", "genesyscloud_architect_schedules."+schedResource+".id"),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is in both open_schedules_id and closed_schedules_id"),
+			},
+		},
+		CheckDestroy: testVerifyScheduleGroupsDestroyed,
+	})
+}
+
 func generateArchitectScheduleGroupsResource(
 	schedGroupResource1 string,
 	name string,
@@ -7,11 +7,13 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"mime/multipart"
 	"net/http"
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 	"time"
 
@@ -708,7 +710,7 @@ var userPromptResource = &schema.Resource{
 			Optional:    true,
 		},
 		"filename": {
-			Description: "Path or URL to the file to be uploaded as prompt.",
+			Description: "Path or URL to the file to be uploaded as prompt. Local files are checked during plan and must be WAV, MP3, Ogg, FLAC or MP4 audio. The codec, size and duration of the audio are checked by the API when the file is uploaded.",
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
@@ -751,6 +753,7 @@ func ArchitectUserPromptExporter() *resourceExporter.ResourceExporter {
 			RetrieveAndWriteFilesFunc: ArchitectPromptAudioResolver,
 			SubDirectory:              "audio_prompts",
 		},
+		ExcludedAttributes: []string{"audio_duration_ms"},
 	}
 }
 
@@ -765,6 +768,7 @@ func ResourceArchitectUserPrompt() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeUserPromptDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -786,6 +790,18 @@ func ResourceArchitectUserPrompt() *schema.Resource {
 				ConfigMode:  schema.SchemaConfigModeAttr,
 				Elem:        userPromptResource,
 			},
+			"required_language_ivr_ids": {
+				Description: "IDs of IVR configurations that play this prompt. During plan, the supported languages of the open hours, closed hours and holiday hours flows of each IVR are looked up and the plan fails if any of them has no prompt resource.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"audio_duration_ms": {
+				Description: "Duration in milliseconds of the audio for each prompt resource, keyed by language. Only resources with uploaded or generated audio are included.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 		},
 	}
 }
@@ -921,6 +937,7 @@ func readUserPrompt(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 
 		_ = d.Set("resources", flattenPromptResources(d, userPrompt.Resources))
+		_ = d.Set("audio_duration_ms", flattenPromptAudioDurations(userPrompt.Resources))
 
 		log.Printf("Read Audio Prompt %s %s", d.Id(), *userPrompt.Id)
 		return cc.CheckState()
@@ -983,6 +1000,121 @@ func deleteUserPrompt(ctx context.Context, d *schema.ResourceData, meta interfac
 	})
 }
 
+func customizeUserPromptDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("resources") {
+		// Resources depend on other resources. They will be validated when uploaded.
+		return nil
+	}
+
+	var (
+		errs      []string
+		languages []string
+	)
+	if resources, ok := diff.Get("resources").(*schema.Set); ok && resources != nil {
+		for _, promptResource := range resources.List() {
+			resourceMap, ok := promptResource.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			language, _ := resourceMap["language"].(string)
+			languages = append(languages, language)
+
+			filename, _ := resourceMap["filename"].(string)
+			if filename == "" || strings.HasPrefix(filename, "http://") || strings.HasPrefix(filename, "https://") {
+				// Remote files are only checked by the API after upload
+				continue
+			}
+			if err := validatePromptAudioFile(filename); err != nil {
+				errs = append(errs, fmt.Sprintf("resource %s (%s): %v", language, filename, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid audio for user prompt %s:\n%s", diff.Get("name").(string), strings.Join(errs, "\n"))
+	}
+
+	if diff.Id() != "" && diff.HasChange("resources") {
+		// Uploaded audio is transcoded by the API, so the durations are only known after apply
+		if err := diff.SetNewComputed("audio_duration_ms"); err != nil {
+			return err
+		}
+	}
+
+	if !diff.NewValueKnown("required_language_ivr_ids") {
+		return nil
+	}
+	ivrIds, ok := diff.Get("required_language_ivr_ids").(*schema.Set)
+	if !ok || ivrIds.Len() == 0 {
+		return nil
+	}
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	for _, ivrId := range ivrIds.List() {
+		if err := validatePromptLanguagesForIvr(ivrId.(string), languages, sdkConfig); err != nil {
+			return fmt.Errorf("user prompt %s: %v", diff.Get("name").(string), err)
+		}
+	}
+	return nil
+}
+
+// validatePromptAudioFile checks a local file is an audio file. The codec, size and duration of the audio
+// are checked by the API when it is uploaded.
+func validatePromptAudioFile(filename string) error {
+	_, err := files.ReadAudioFileInfo(filename)
+	return err
+}
+
+// validatePromptLanguagesForIvr checks every language supported by the flows of an IVR has a prompt resource
+func validatePromptLanguagesForIvr(ivrId string, promptLanguages []string, sdkConfig *platformclientv2.Configuration) error {
+	architectApi := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	ivr, _, err := architectApi.GetArchitectIvr(ivrId)
+	if err != nil {
+		return fmt.Errorf("failed to read IVR %s: %s", ivrId, err)
+	}
+
+	hasLanguage := make(map[string]bool)
+	for _, language := range promptLanguages {
+		hasLanguage[strings.ToLower(language)] = true
+	}
+
+	var missing []string
+	for _, flowRef := range []*platformclientv2.Domainentityref{ivr.OpenHoursFlow, ivr.ClosedHoursFlow, ivr.HolidayHoursFlow} {
+		if flowRef == nil || flowRef.Id == nil {
+			continue
+		}
+		flow, _, err := architectApi.GetFlow(*flowRef.Id, false)
+		if err != nil {
+			return fmt.Errorf("failed to read flow %s of IVR %s: %s", *flowRef.Id, ivrId, err)
+		}
+		if flow.SupportedLanguages == nil {
+			continue
+		}
+		for _, supportedLanguage := range *flow.SupportedLanguages {
+			if supportedLanguage.Language == nil {
+				continue
+			}
+			language := strings.ToLower(*supportedLanguage.Language)
+			if !hasLanguage[language] {
+				flowName := *flowRef.Id
+				if flow.Name != nil {
+					flowName = *flow.Name
+				}
+				missing = append(missing, fmt.Sprintf("%s (flow %s)", language, flowName))
+				// Only report each language once
+				hasLanguage[language] = true
+			}
+		}
+	}
+	if len(missing) > 0 {
+		ivrName := ivrId
+		if ivr.Name != nil {
+			ivrName = *ivr.Name
+		}
+		return fmt.Errorf("no prompt resource for languages used by IVR %s: %s", ivrName, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
 func uploadPrompt(uploadUri *string, filename *string, sdkConfig *platformclientv2.Configuration) error {
 	reader, file, err := files.DownloadOrOpenFile(*filename)
 	if file != nil {
@@ -1073,6 +1205,20 @@ func flattenPromptResources(d *schema.ResourceData, promptResources *[]platformc
 	return resourceSet
 }
 
+func flattenPromptAudioDurations(promptResources *[]platformclientv2.Promptasset) map[string]interface{} {
+	durations := make(map[string]interface{})
+	if promptResources == nil {
+		return durations
+	}
+	for _, promptResource := range *promptResources {
+		if promptResource.Language == nil || promptResource.DurationSeconds == nil {
+			continue
+		}
+		durations[*promptResource.Language] = int(math.Round(*promptResource.DurationSeconds * 1000))
+	}
+	return durations
+}
+
 func updatePromptResource(d *schema.ResourceData, architectApi *platformclientv2.ArchitectApi, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
 	name := d.Get("name").(string)
 
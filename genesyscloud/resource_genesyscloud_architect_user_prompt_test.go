@@ -2,6 +2,9 @@ package genesyscloud
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 
 	"strconv"
 	"strings"
@@ -159,6 +162,7 @@ func TestAccResourceUserPromptWavFile(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_architect_user_prompt."+userPromptResource1, "name", userPromptName1),
 					resource.TestCheckResourceAttr("genesyscloud_architect_user_prompt."+userPromptResource1, "description", userPromptDescription1),
 					resource.TestCheckResourceAttr("genesyscloud_architect_user_prompt."+userPromptResource1, "resources.0.filename", userPromptResourceFileName1),
+					resource.TestCheckResourceAttrSet("genesyscloud_architect_user_prompt."+userPromptResource1, "audio_duration_ms.en-us"),
 				),
 			},
 			{
@@ -264,6 +268,41 @@ func TestAccResourceUserPromptWavFileURL(t *testing.T) {
 	fileserver.ShutDown(srv, httpServerExitDone)
 }
 
+func TestAccResourceUserPromptInvalidAudioFile(t *testing.T) {
+	userPromptResource1 := "test-user_prompt_invalid_audio"
+	userPromptName1 := "TestUserPromptInvalid_1" + strings.Replace(uuid.NewString(), "-", "", -1)
+	userPromptResourceFileName1 := filepath.Join(t.TempDir(), "not-audio.wav")
+	if err := os.WriteFile(userPromptResourceFileName1, []byte("this is not an audio file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	userPromptAsset1 := UserPromptResourceStruct{
+		"en-us",
+		NullValue,
+		NullValue,
+		strconv.Quote(userPromptResourceFileName1),
+		userPromptResourceFileName1,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Audio file is rejected during plan
+				Config: GenerateUserPromptResource(&UserPromptStruct{
+					userPromptResource1,
+					userPromptName1,
+					NullValue,
+					[]*UserPromptResourceStruct{&userPromptAsset1},
+				}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("file is not a WAV, MP3, Ogg, FLAC or MP4 audio file"),
+			},
+		},
+	})
+}
+
 func testVerifyUserPromptsDestroyed(state *terraform.State) error {
 	architectAPI := platformclientv2.NewArchitectApi()
 	for _, rs := range state.RootModule().Resources {
@@ -137,7 +137,6 @@ func TestAccResourceAuthRoleBasic(t *testing.T) {
 func TestAccResourceAuthRoleConditions(t *testing.T) {
 	var (
 		roleResource1     = "auth-role1"
-		queueResource1    = "queue-resource1"
 		queueName1        = "Terraform Queue-" + uuid.NewString()
 		roleName1         = "Terraform Role-" + uuid.NewString()
 		roleDesc1         = "Terraform test condition role"
@@ -153,6 +152,9 @@ func TestAccResourceAuthRoleConditions(t *testing.T) {
 		valueCall         = "CALL"
 	)
 
+	// The queue used by the queue condition is created through the API
+	queueId := createTestQueue(t, queueName1)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { TestAccPreCheck(t) },
 		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
@@ -189,24 +191,23 @@ func TestAccResourceAuthRoleConditions(t *testing.T) {
 				),
 			},
 			{
-				// Create a queue and update with a queue condition
-				Config: GenerateRoutingQueueResourceBasic(queueResource1, queueName1) +
-					GenerateAuthRoleResource(
-						roleResource1,
-						roleName1,
-						roleDesc1,
-						generateRolePermPolicyCondition(
-							qualityDom,
-							calibrationEntity,
-							addAction,
-							conjAnd,
-							generateRolePermPolicyCondTerm(
-								varNameQueue,
-								opEq,
-								generateRoleCondValue(typeQueue, "queue_id", "genesyscloud_routing_queue."+queueResource1+".id"),
-							),
+				// Update with a queue condition
+				Config: GenerateAuthRoleResource(
+					roleResource1,
+					roleName1,
+					roleDesc1,
+					generateRolePermPolicyCondition(
+						qualityDom,
+						calibrationEntity,
+						addAction,
+						conjAnd,
+						generateRolePermPolicyCondTerm(
+							varNameQueue,
+							opEq,
+							generateRoleCondValue(typeQueue, "queue_id", strconv.Quote(queueId)),
 						),
 					),
+				),
 				Check: resource.ComposeTestCheckFunc(
 					validatePermPolicyCondition(
 						"genesyscloud_auth_role."+roleResource1,
@@ -216,7 +217,7 @@ func TestAccResourceAuthRoleConditions(t *testing.T) {
 						varNameQueue,
 						opEq,
 						typeQueue,
-						"genesyscloud_routing_queue."+queueResource1),
+						queueId),
 				),
 			},
 			{
@@ -517,13 +518,9 @@ func validatePermPolicyCondition(
 				}
 
 				if typeVar == "QUEUE" {
-					// Get the ID of the queue in the expected value and compare
+					// The expected value is the ID of the queue
 					stateQueue := roleAttrs["permission_policies."+strNum+".conditions.0.terms.0.operands.0.queue_id"]
-					queueResource, ok := state.RootModule().Resources[value]
-					if !ok {
-						return fmt.Errorf("Failed to find queue %s in state", value)
-					}
-					if stateQueue != queueResource.Primary.ID {
+					if stateQueue != value {
 						return fmt.Errorf("Condition operand value in role %s did not match queue ID: %v", roleResource.Primary.ID, stateQueue)
 					}
 				} else if typeVar == "USER" {
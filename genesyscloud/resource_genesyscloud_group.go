@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -22,6 +23,15 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
 )
 
+const maxGroupMembersPerRequest = 50
+
+// groupMemberCache holds the member IDs of the groups read by this provider, so that the members of a group are only
+// listed once for all of the genesyscloud_group_member resources of the group. Adding or removing members clears the group.
+var (
+	groupMemberCache      = make(map[string][]string)
+	groupMemberCacheMutex sync.Mutex
+)
+
 var (
 	groupPhoneType       = "PHONE"
 	groupAddressResource = &schema.Resource{
@@ -92,7 +102,7 @@ func ResourceGroup() *schema.Resource {
 		UpdateContext: UpdateWithPooledClient(updateGroup),
 		DeleteContext: DeleteWithPooledClient(deleteGroup),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importGroup,
 		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -141,12 +151,19 @@ func ResourceGroup() *schema.Resource {
 				MinItems:    1,
 			},
 			"member_ids": {
-				Description: "IDs of members assigned to the group. If not set, this resource will not manage group members.",
+				Description: "IDs of members assigned to the group. If not set, this resource will not manage group members. Do not use together with `genesyscloud_group_member` or `genesyscloud_group_members` for the same group.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"ignore_members": {
+				Description:   "If true, the members of the group are neither read nor managed by this resource and `member_ids` is left empty. Use this when the members are managed by `genesyscloud_group_member`, `genesyscloud_group_members` or an identity provider.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"member_ids"},
+			},
 		},
 	}
 }
@@ -229,11 +246,15 @@ func readGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("addresses", nil)
 		}
 
-		members, err := readGroupMembers(d.Id(), groupsAPI)
-		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("%v", err))
+		if d.Get("ignore_members").(bool) {
+			_ = d.Set("member_ids", nil)
+		} else {
+			members, err := readGroupMembers(d.Id(), groupsAPI)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("%v", err))
+			}
+			d.Set("member_ids", members)
 		}
-		d.Set("member_ids", members)
 
 		log.Printf("Read group %s %s", d.Id(), *group.Name)
 		return cc.CheckState()
@@ -435,6 +456,9 @@ func flattenGroupOwners(owners *[]platformclientv2.User) []interface{} {
 }
 
 func updateGroupMembers(d *schema.ResourceData, groupsAPI *platformclientv2.GroupsApi) diag.Diagnostics {
+	if d.Get("ignore_members").(bool) {
+		return nil
+	}
 	if d.HasChange("member_ids") {
 		if membersConfig := d.Get("member_ids"); membersConfig != nil {
 			configMemberIds := *lists.SetToStringList(membersConfig.(*schema.Set))
@@ -443,96 +467,115 @@ func updateGroupMembers(d *schema.ResourceData, groupsAPI *platformclientv2.Grou
 				return err
 			}
 
-			maxMembersPerRequest := 50
-			membersToRemoveList := lists.SliceDifference(existingMemberIds, configMemberIds)
-			chunkedMemberIdsDelete := chunks.ChunkBy(membersToRemoveList, maxMembersPerRequest)
-
-			chunkProcessor := func(membersToRemove []string) diag.Diagnostics {
-				if len(membersToRemove) > 0 {
-					if diagErr := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-						_, resp, err := groupsAPI.DeleteGroupMembers(d.Id(), strings.Join(membersToRemove, ","))
-						if err != nil {
-							return resp, diag.Errorf("Failed to remove members from group %s: %s", d.Id(), err)
-						}
-						return resp, nil
-					}); diagErr != nil {
-						return diagErr
-					}
-				}
-				return nil
+			if err := removeGroupMembers(d.Id(), lists.SliceDifference(existingMemberIds, configMemberIds), groupsAPI); err != nil {
+				return err
 			}
-
-			if err := chunks.ProcessChunks(chunkedMemberIdsDelete, chunkProcessor); err != nil {
+			if err := addGroupMembers(d.Id(), lists.SliceDifference(configMemberIds, existingMemberIds), groupsAPI); err != nil {
 				return err
 			}
+		}
+	}
+	return nil
+}
 
-			membersToAdd := lists.SliceDifference(configMemberIds, existingMemberIds)
-			if len(membersToAdd) < 1 {
-				return nil
+func readGroupMembers(groupID string, groupsAPI *platformclientv2.GroupsApi) (*schema.Set, diag.Diagnostics) {
+	memberIds, diagErr := getAllGroupMemberIds(groupID, groupsAPI)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	return lists.StringListToSet(memberIds), nil
+}
+
+func getGroupMemberIds(d *schema.ResourceData, groupsAPI *platformclientv2.GroupsApi) ([]string, diag.Diagnostics) {
+	return getAllGroupMemberIds(d.Id(), groupsAPI)
+}
+
+// importGroup sets ignore_members to its default. It only changes how the group is managed, so it cannot be read from the group.
+func importGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("ignore_members", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// addGroupMembers adds users to a group in chunks of the maximum number of members per request
+func addGroupMembers(groupID string, membersToAdd []string, groupsAPI *platformclientv2.GroupsApi) diag.Diagnostics {
+	defer invalidateGroupMemberCache(groupID)
+	for _, chunk := range lists.ChunkStringSlice(membersToAdd, maxGroupMembersPerRequest) {
+		chunk := chunk
+		if diagErr := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			// Need the current group version to add members
+			groupInfo, _, getErr := groupsAPI.GetGroup(groupID)
+			if getErr != nil {
+				return nil, diag.Errorf("Failed to read group %s: %s", groupID, getErr)
 			}
 
-			chunkedMemberIds := lists.ChunkStringSlice(membersToAdd, maxMembersPerRequest)
-			for _, chunk := range chunkedMemberIds {
-				if err := addGroupMembers(d, chunk, groupsAPI); err != nil {
-					return err
-				}
+			_, resp, postErr := groupsAPI.PostGroupMembers(groupID, platformclientv2.Groupmembersupdate{
+				MemberIds: &chunk,
+				Version:   groupInfo.Version,
+			})
+			if postErr != nil {
+				return resp, diag.Errorf("Failed to add group members %s: %s", groupID, postErr)
 			}
+			return resp, nil
+		}); diagErr != nil {
+			return diagErr
 		}
 	}
 	return nil
 }
 
-func readGroupMembers(groupID string, groupsAPI *platformclientv2.GroupsApi) (*schema.Set, diag.Diagnostics) {
-	members, _, err := groupsAPI.GetGroupIndividuals(groupID)
-	if err != nil {
-		return nil, diag.Errorf("Failed to read members for group %s: %s", groupID, err)
-	}
-
-	if members.Entities != nil {
-		interfaceList := make([]interface{}, len(*members.Entities))
-		for i, v := range *members.Entities {
-			interfaceList[i] = *v.Id
+// removeGroupMembers removes users from a group in chunks of the maximum number of members per request
+func removeGroupMembers(groupID string, membersToRemove []string, groupsAPI *platformclientv2.GroupsApi) diag.Diagnostics {
+	defer invalidateGroupMemberCache(groupID)
+	chunkProcessor := func(membersToRemove []string) diag.Diagnostics {
+		if len(membersToRemove) > 0 {
+			if diagErr := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+				_, resp, err := groupsAPI.DeleteGroupMembers(groupID, strings.Join(membersToRemove, ","))
+				if err != nil {
+					return resp, diag.Errorf("Failed to remove members from group %s: %s", groupID, err)
+				}
+				return resp, nil
+			}); diagErr != nil {
+				return diagErr
+			}
 		}
-		return schema.NewSet(schema.HashString, interfaceList), nil
+		return nil
 	}
-	return nil, nil
+
+	return chunks.ProcessChunks(chunks.ChunkBy(membersToRemove, maxGroupMembersPerRequest), chunkProcessor)
 }
 
-func getGroupMemberIds(d *schema.ResourceData, groupsAPI *platformclientv2.GroupsApi) ([]string, diag.Diagnostics) {
-	members, _, err := groupsAPI.GetGroupIndividuals(d.Id())
+// getAllGroupMemberIds returns the IDs of the individual members of a group. The members are read with the same API
+// as the member_ids of the group and are cached until members are added to or removed from the group.
+func getAllGroupMemberIds(groupID string, groupsAPI *platformclientv2.GroupsApi) ([]string, diag.Diagnostics) {
+	groupMemberCacheMutex.Lock()
+	defer groupMemberCacheMutex.Unlock()
+
+	if memberIds, ok := groupMemberCache[groupID]; ok {
+		return memberIds, nil
+	}
+
+	members, _, err := groupsAPI.GetGroupIndividuals(groupID)
 	if err != nil {
-		return nil, diag.FromErr(err)
+		return nil, diag.Errorf("Failed to read members for group %s: %s", groupID, err)
 	}
 
-	var existingMembers []string
+	memberIds := make([]string, 0)
 	if members.Entities != nil {
 		for _, member := range *members.Entities {
-			existingMembers = append(existingMembers, *member.Id)
+			if member.Id != nil {
+				memberIds = append(memberIds, *member.Id)
+			}
 		}
 	}
-	return existingMembers, nil
+	groupMemberCache[groupID] = memberIds
+	return memberIds, nil
 }
 
-func addGroupMembers(d *schema.ResourceData, membersToAdd []string, groupsAPI *platformclientv2.GroupsApi) diag.Diagnostics {
-	if diagErr := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-		// Need the current group version to add members
-		groupInfo, _, getErr := groupsAPI.GetGroup(d.Id())
-		if getErr != nil {
-			return nil, diag.Errorf("Failed to read group %s: %s", d.Id(), getErr)
-		}
-
-		_, resp, postErr := groupsAPI.PostGroupMembers(d.Id(), platformclientv2.Groupmembersupdate{
-			MemberIds: &membersToAdd,
-			Version:   groupInfo.Version,
-		})
-		if postErr != nil {
-			return resp, diag.Errorf("Failed to add group members %s: %s", d.Id(), postErr)
-		}
-		return resp, nil
-	}); diagErr != nil {
-		return diagErr
-	}
-	return nil
+// invalidateGroupMemberCache clears the cached members of a group so they are read again
+func invalidateGroupMemberCache(groupID string) {
+	groupMemberCacheMutex.Lock()
+	defer groupMemberCacheMutex.Unlock()
+	delete(groupMemberCache, groupID)
 }
 
 func GenerateBasicGroupResource(resourceID string, name string, nestedBlocks ...string) string {
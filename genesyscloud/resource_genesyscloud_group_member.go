@@ -0,0 +1,185 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// getAllGroupMembers retrieves the members of every group and is used for the exporter
+func getAllGroupMembers(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	groups, err := getAllGroups(ctx, clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for groupId, groupMeta := range groups {
+		memberIds, err := getAllGroupMemberIds(groupId, groupsAPI)
+		if err != nil {
+			return nil, err
+		}
+		for _, memberId := range memberIds {
+			resources[buildGroupMemberId(groupId, memberId)] = &resourceExporter.ResourceMeta{Name: groupMeta.Name + "_" + memberId}
+		}
+	}
+
+	return resources, nil
+}
+
+func GroupMemberExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: GetAllWithPooledClient(getAllGroupMembers),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"group_id": {RefType: "genesyscloud_group"},
+			"user_id":  {RefType: "genesyscloud_user"},
+		},
+		ExportOnlyWhenIncluded: true,
+	}
+}
+
+func ResourceGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Group Member manages the membership of a single user in a directory group.
+
+Members that are not managed by this resource, such as users added by an identity provider, are left untouched. Set ` + "`ignore_members`" + ` on the ` + "`genesyscloud_group`" + ` resource of the group so that the group definition and its members can be managed separately.
+
+This resource is only exported when it is named in ` + "`include_filter_resources`" + `.`,
+
+		CreateContext: CreateWithPooledClient(createGroupMember),
+		ReadContext:   ReadWithPooledClient(readGroupMember),
+		DeleteContext: DeleteWithPooledClient(deleteGroupMember),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "ID of the group. Changing the group_id attribute will cause the member to be removed from the old group and added to the new one.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "ID of the user. Changing the user_id attribute will cause the old user to be removed from the group and the new one to be added.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func createGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+	groupId := d.Get("group_id").(string)
+	userId := d.Get("user_id").(string)
+
+	log.Printf("Adding user %s to group %s", userId, groupId)
+	memberIds, diagErr := getAllGroupMemberIds(groupId, groupsAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+	if !lists.ItemInSlice(userId, memberIds) {
+		if diagErr := addGroupMembers(groupId, []string{userId}, groupsAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	d.SetId(buildGroupMemberId(groupId, userId))
+	log.Printf("Added user %s to group %s", userId, groupId)
+	return readGroupMember(ctx, d, meta)
+}
+
+func readGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+
+	groupId, userId, err := parseGroupMemberId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("Reading user %s of group %s", userId, groupId)
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		_, resp, getErr := groupsAPI.GetGroup(groupId)
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read group %s: %s", groupId, getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read group %s: %s", groupId, getErr))
+		}
+
+		memberIds, diagErr := getAllGroupMemberIds(groupId, groupsAPI)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+		if !lists.ItemInSlice(userId, memberIds) {
+			log.Printf("User %s is no longer a member of group %s", userId, groupId)
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceGroupMember())
+
+		_ = d.Set("group_id", groupId)
+		_ = d.Set("user_id", userId)
+
+		log.Printf("Read user %s of group %s", userId, groupId)
+		return cc.CheckState()
+	})
+}
+
+func deleteGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+	groupId := d.Get("group_id").(string)
+	userId := d.Get("user_id").(string)
+
+	log.Printf("Removing user %s from group %s", userId, groupId)
+	if _, resp, err := groupsAPI.GetGroup(groupId); err != nil {
+		if IsStatus404(resp) {
+			// The group and its members are already gone
+			return nil
+		}
+		return diag.Errorf("Failed to read group %s: %s", groupId, err)
+	}
+	if diagErr := removeGroupMembers(groupId, []string{userId}, groupsAPI); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Removed user %s from group %s", userId, groupId)
+	return nil
+}
+
+func buildGroupMemberId(groupId, userId string) string {
+	return groupId + ":" + userId
+}
+
+func parseGroupMemberId(id string) (groupId string, userId string, err error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("invalid group member ID %s, expected <group_id>:<user_id>", id)
+	}
+	return idParts[0], idParts[1], nil
+}
+
+func GenerateGroupMemberResource(resourceID string, groupId string, userId string) string {
+	return fmt.Sprintf(`resource "genesyscloud_group_member" "%s" {
+		group_id = %s
+		user_id = %s
+	}
+	`, resourceID, groupId, userId)
+}
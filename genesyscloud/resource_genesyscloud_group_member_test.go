@@ -0,0 +1,188 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+)
+
+func TestAccResourceGroupMember(t *testing.T) {
+	t.Parallel()
+	var (
+		groupResource  = "test-group-member-group"
+		groupName      = "Terraform Test Group Member-" + uuid.NewString()
+		memberResource = "test-group-member"
+		ownerResource  = "test-group-member-owner"
+		userResource   = "test-group-member-user"
+		ownerEmail     = "terraform1-" + uuid.NewString() + "@example.com"
+		userEmail      = "terraform2-" + uuid.NewString() + "@example.com"
+	)
+
+	config := GenerateBasicGroupResource(
+		groupResource,
+		groupName,
+		GenerateGroupOwners("genesyscloud_user."+ownerResource+".id"),
+		"ignore_members = true",
+	) + GenerateBasicUserResource(
+		ownerResource,
+		ownerEmail,
+		"Group Owner Terraform",
+	) + GenerateBasicUserResource(
+		userResource,
+		userEmail,
+		"Group Member Terraform",
+	) + GenerateGroupMemberResource(
+		memberResource,
+		"genesyscloud_group."+groupResource+".id",
+		"genesyscloud_user."+userResource+".id",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create. The group ignores the member added by the member resource.
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_group_member."+memberResource, "group_id", "genesyscloud_group."+groupResource, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_group_member."+memberResource, "user_id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_group."+groupResource, "member_ids.#", "0"),
+				),
+			},
+			{
+				// No drift on the group after the member was added
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_group_member." + memberResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyGroupsDestroyed,
+	})
+}
+
+func TestAccResourceGroupMembersModes(t *testing.T) {
+	t.Parallel()
+	var (
+		groupResource     = "test-group-members-group"
+		groupName         = "Terraform Test Group Members-" + uuid.NewString()
+		membersResource   = "test-group-members"
+		ownerResource     = "test-group-members-owner"
+		userResource1     = "test-group-members-user1"
+		userResource2     = "test-group-members-user2"
+		ownerEmail        = "terraform0-" + uuid.NewString() + "@example.com"
+		userEmail1        = "terraform1-" + uuid.NewString() + "@example.com"
+		userEmail2        = "terraform2-" + uuid.NewString() + "@example.com"
+		unmanagedUserId   string
+		groupIdFromState  string
+		membersResourceId = "genesyscloud_group_members." + membersResource
+	)
+
+	config := func(mode string) string {
+		return GenerateBasicGroupResource(
+			groupResource,
+			groupName,
+			GenerateGroupOwners("genesyscloud_user."+ownerResource+".id"),
+			"ignore_members = true",
+		) + GenerateBasicUserResource(
+			ownerResource,
+			ownerEmail,
+			"Group Owner Terraform",
+		) + GenerateBasicUserResource(
+			userResource1,
+			userEmail1,
+			"Henry Terraform",
+		) + GenerateBasicUserResource(
+			userResource2,
+			userEmail2,
+			"Amanda Terraform",
+		) + GenerateGroupMembersResource(
+			membersResource,
+			"genesyscloud_group."+groupResource+".id",
+			`"`+mode+`"`,
+			"genesyscloud_user."+userResource1+".id",
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create in additive mode
+				Config: config(groupMembersModeAdditive),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(membersResourceId, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(membersResourceId, "member_ids.*", "genesyscloud_user."+userResource1, "id"),
+					func(state *terraform.State) error {
+						// Add a member outside of Terraform. Additive mode must leave it in the group.
+						groupIdFromState = state.RootModule().Resources[membersResourceId].Primary.ID
+						unmanagedUserId = state.RootModule().Resources["genesyscloud_user."+userResource2].Primary.ID
+						return addGroupMemberOutsideTerraform(groupIdFromState, unmanagedUserId)
+					},
+				),
+			},
+			{
+				// Unmanaged member is not detected as drift in additive mode
+				Config: config(groupMembersModeAdditive),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(membersResourceId, "member_ids.#", "1"),
+					func(state *terraform.State) error {
+						return verifyGroupHasMember(groupIdFromState, unmanagedUserId, true)
+					},
+				),
+			},
+			{
+				// Switching to authoritative mode removes the unmanaged member
+				Config: config(groupMembersModeAuthoritative),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(membersResourceId, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(membersResourceId, "member_ids.*", "genesyscloud_user."+userResource1, "id"),
+					func(state *terraform.State) error {
+						return verifyGroupHasMember(groupIdFromState, unmanagedUserId, false)
+					},
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      membersResourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyGroupsDestroyed,
+	})
+}
+
+func addGroupMemberOutsideTerraform(groupId string, userId string) error {
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+	if diagErr := addGroupMembers(groupId, []string{userId}, groupsAPI); diagErr != nil {
+		return fmt.Errorf("failed to add user %s to group %s: %v", userId, groupId, diagErr)
+	}
+	// Give the group time to report the new member
+	time.Sleep(10 * time.Second)
+	return nil
+}
+
+func verifyGroupHasMember(groupId string, userId string, expected bool) error {
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+	memberIds, diagErr := getAllGroupMemberIds(groupId, groupsAPI)
+	if diagErr != nil {
+		return fmt.Errorf("%v", diagErr)
+	}
+	if found := lists.ItemInSlice(userId, memberIds); found != expected {
+		return fmt.Errorf("expected user %s to be a member of group %s: %v, got %v", userId, groupId, expected, found)
+	}
+	return nil
+}
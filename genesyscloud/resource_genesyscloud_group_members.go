@@ -0,0 +1,198 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+const (
+	groupMembersModeAuthoritative = "authoritative"
+	groupMembersModeAdditive      = "additive"
+)
+
+func GroupMembersExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: GetAllWithPooledClient(getAllGroups),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"group_id":   {RefType: "genesyscloud_group"},
+			"member_ids": {RefType: "genesyscloud_user"},
+		},
+		ExportOnlyWhenIncluded: true,
+	}
+}
+
+func ResourceGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Group Members manages the members of a directory group.
+
+In ` + "`authoritative`" + ` mode this resource owns all members of the group and removes any member that is not configured. In ` + "`additive`" + ` mode only the configured members are managed and members added outside of Terraform, such as users added by an identity provider, are left untouched. Set ` + "`ignore_members`" + ` on the ` + "`genesyscloud_group`" + ` resource of the group so that the group definition and its members can be managed separately.
+
+This resource is only exported when it is named in ` + "`include_filter_resources`" + `.`,
+
+		CreateContext: CreateWithPooledClient(createGroupMembers),
+		ReadContext:   ReadWithPooledClient(readGroupMembersResource),
+		UpdateContext: UpdateWithPooledClient(updateGroupMembersResource),
+		DeleteContext: DeleteWithPooledClient(deleteGroupMembers),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "ID of the group. Changing the group_id attribute will cause the members to be removed from the old group and added to the new one.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"mode": {
+				Description:  "Membership mode. `authoritative` removes every member of the group that is not configured. `additive` only adds and removes the configured members. Defaults to `authoritative`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      groupMembersModeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{groupMembersModeAuthoritative, groupMembersModeAdditive}, false),
+			},
+			"member_ids": {
+				Description: "IDs of the users in the group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func createGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupId := d.Get("group_id").(string)
+	d.SetId(groupId)
+	log.Printf("Creating members for group %s", groupId)
+	return updateGroupMembersResource(ctx, d, meta)
+}
+
+// readGroupMembersResource reads the members of a group. In additive mode only the configured users are read.
+func readGroupMembersResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+
+	log.Printf("Reading members of group %s", d.Id())
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		_, resp, getErr := groupsAPI.GetGroup(d.Id())
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read group %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read group %s: %s", d.Id(), getErr))
+		}
+
+		memberIds, diagErr := getAllGroupMemberIds(d.Id(), groupsAPI)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceGroupMembers())
+
+		mode := d.Get("mode").(string)
+		if mode == "" {
+			// Imported resources default to owning every member of the group
+			mode = groupMembersModeAuthoritative
+		}
+		if mode == groupMembersModeAdditive {
+			memberIds = filterGroupMemberIds(memberIds, *lists.SetToStringList(d.Get("member_ids").(*schema.Set)))
+		}
+
+		_ = d.Set("group_id", d.Id())
+		_ = d.Set("mode", mode)
+		_ = d.Set("member_ids", lists.StringListToSet(memberIds))
+
+		log.Printf("Read members of group %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+// updateGroupMembersResource applies the configured members to the group according to the membership mode
+func updateGroupMembersResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+
+	log.Printf("Updating members of group %s", d.Id())
+	existingMemberIds, diagErr := getAllGroupMemberIds(d.Id(), groupsAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	oldMembers, newMembers := d.GetChange("member_ids")
+	newMemberIds := *lists.SetToStringList(newMembers.(*schema.Set))
+
+	var membersToRemove []string
+	if d.Get("mode").(string) == groupMembersModeAuthoritative {
+		membersToRemove = lists.SliceDifference(existingMemberIds, newMemberIds)
+	} else {
+		// Only remove users that were configured before and are still members
+		removedMemberIds := lists.SliceDifference(*lists.SetToStringList(oldMembers.(*schema.Set)), newMemberIds)
+		membersToRemove = filterGroupMemberIds(existingMemberIds, removedMemberIds)
+	}
+
+	if diagErr := removeGroupMembers(d.Id(), membersToRemove, groupsAPI); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := addGroupMembers(d.Id(), lists.SliceDifference(newMemberIds, existingMemberIds), groupsAPI); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated members of group %s", d.Id())
+	return readGroupMembersResource(ctx, d, meta)
+}
+
+// deleteGroupMembers removes the members in state from the group
+func deleteGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+
+	log.Printf("Removing members of group %s", d.Id())
+	existingMemberIds, diagErr := getAllGroupMemberIds(d.Id(), groupsAPI)
+	if diagErr != nil {
+		if _, resp, err := groupsAPI.GetGroup(d.Id()); err != nil && IsStatus404(resp) {
+			// The group and its members are already gone
+			return nil
+		}
+		return diagErr
+	}
+
+	membersToRemove := filterGroupMemberIds(existingMemberIds, *lists.SetToStringList(d.Get("member_ids").(*schema.Set)))
+	if diagErr := removeGroupMembers(d.Id(), membersToRemove, groupsAPI); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Removed members of group %s", d.Id())
+	return nil
+}
+
+// filterGroupMemberIds returns the member IDs that are in the given user IDs
+func filterGroupMemberIds(memberIds []string, userIds []string) []string {
+	filtered := make([]string, 0)
+	for _, memberId := range memberIds {
+		if lists.ItemInSlice(memberId, userIds) {
+			filtered = append(filtered, memberId)
+		}
+	}
+	return filtered
+}
+
+func GenerateGroupMembersResource(resourceID string, groupId string, mode string, memberIds ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_group_members" "%s" {
+		group_id = %s
+		mode = %s
+		member_ids = [%s]
+	}
+	`, resourceID, groupId, mode, strings.Join(memberIds, ", "))
+}
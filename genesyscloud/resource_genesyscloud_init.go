@@ -14,7 +14,6 @@ func SetRegistrar(l registrar.Registrar) {
 func registerDataSources(l registrar.Registrar) {
 
 	l.RegisterDataSource("genesyscloud_routing_wrapupcode", DataSourceRoutingWrapupcode())
-	l.RegisterDataSource("genesyscloud_routing_queue", DataSourceRoutingQueue())
 	l.RegisterDataSource("genesyscloud_flow", DataSourceFlow())
 	l.RegisterDataSource("genesyscloud_location", DataSourceLocation())
 	l.RegisterDataSource("genesyscloud_auth_division_home", DataSourceAuthDivisionHome())
@@ -42,7 +41,6 @@ func registerDataSources(l registrar.Registrar) {
 	l.RegisterDataSource("genesyscloud_responsemanagement_response", dataSourceResponsemanagementResponse())
 	l.RegisterDataSource("genesyscloud_responsemanagement_responseasset", dataSourceResponseManagamentResponseAsset())
 	l.RegisterDataSource("genesyscloud_routing_language", dataSourceRoutingLanguage())
-	l.RegisterDataSource("genesyscloud_routing_queue", DataSourceRoutingQueue())
 	l.RegisterDataSource("genesyscloud_routing_settings", dataSourceRoutingSettings())
 	l.RegisterDataSource("genesyscloud_routing_skill", dataSourceRoutingSkill())
 	l.RegisterDataSource("genesyscloud_routing_skill_group", dataSourceRoutingSkillGroup())
@@ -55,7 +53,6 @@ func registerDataSources(l registrar.Registrar) {
 
 func registerResources(l registrar.Registrar) {
 
-	l.RegisterResource("genesyscloud_routing_queue", ResourceRoutingQueue())
 	l.RegisterResource("genesyscloud_flow", ResourceFlow())
 	l.RegisterResource("genesyscloud_location", ResourceLocation())
 	l.RegisterResource("genesyscloud_flow", ResourceFlow())
@@ -66,6 +63,8 @@ func registerResources(l registrar.Registrar) {
 	l.RegisterResource("genesyscloud_auth_division", ResourceAuthDivision())
 	l.RegisterResource("genesyscloud_employeeperformance_externalmetrics_definitions", ResourceEmployeeperformanceExternalmetricsDefinition())
 	l.RegisterResource("genesyscloud_group", ResourceGroup())
+	l.RegisterResource("genesyscloud_group_member", ResourceGroupMember())
+	l.RegisterResource("genesyscloud_group_members", ResourceGroupMembers())
 	l.RegisterResource("genesyscloud_group_roles", ResourceGroupRoles())
 	l.RegisterResource("genesyscloud_idp_adfs", ResourceIdpAdfs())
 	l.RegisterResource("genesyscloud_idp_generic", ResourceIdpGeneric())
@@ -95,7 +94,6 @@ func registerResources(l registrar.Registrar) {
 	l.RegisterResource("genesyscloud_routing_email_domain", ResourceRoutingEmailDomain())
 	l.RegisterResource("genesyscloud_routing_email_route", ResourceRoutingEmailRoute())
 	l.RegisterResource("genesyscloud_routing_language", ResourceRoutingLanguage())
-	l.RegisterResource("genesyscloud_routing_queue", ResourceRoutingQueue())
 	l.RegisterResource("genesyscloud_routing_skill", ResourceRoutingSkill())
 	l.RegisterResource("genesyscloud_routing_skill_group", ResourceRoutingSkillGroup())
 	l.RegisterResource("genesyscloud_routing_settings", ResourceRoutingSettings())
@@ -117,6 +115,8 @@ func registerExporters(l registrar.Registrar) {
 	l.RegisterExporter("genesyscloud_employeeperformance_externalmetrics_definitions", EmployeeperformanceExternalmetricsDefinitionExporter())
 	l.RegisterExporter("genesyscloud_flow", FlowExporter())
 	l.RegisterExporter("genesyscloud_group", GroupExporter())
+	l.RegisterExporter("genesyscloud_group_member", GroupMemberExporter())
+	l.RegisterExporter("genesyscloud_group_members", GroupMembersExporter())
 	l.RegisterExporter("genesyscloud_group_roles", GroupRolesExporter())
 	l.RegisterExporter("genesyscloud_idp_adfs", IdpAdfsExporter())
 	l.RegisterExporter("genesyscloud_idp_generic", IdpGenericExporter())
@@ -140,7 +140,6 @@ func registerExporters(l registrar.Registrar) {
 	l.RegisterExporter("genesyscloud_routing_email_domain", RoutingEmailDomainExporter())
 	l.RegisterExporter("genesyscloud_routing_email_route", RoutingEmailRouteExporter())
 	l.RegisterExporter("genesyscloud_routing_language", RoutingLanguageExporter())
-	l.RegisterExporter("genesyscloud_routing_queue", RoutingQueueExporter())
 	l.RegisterExporter("genesyscloud_routing_settings", RoutingSettingsExporter())
 	l.RegisterExporter("genesyscloud_routing_skill", RoutingSkillExporter())
 	l.RegisterExporter("genesyscloud_routing_skill_group", ResourceSkillGroupExporter())
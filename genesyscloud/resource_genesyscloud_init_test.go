@@ -4,6 +4,7 @@ import (
 	"log"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
@@ -26,7 +27,6 @@ func (r *registerTestInstance) registerTestResources() {
 	r.resourceMapMutex.Lock()
 	defer r.resourceMapMutex.Unlock()
 
-	providerResources["genesyscloud_routing_queue"] = ResourceRoutingQueue()
 	providerResources["genesyscloud_flow"] = ResourceFlow()
 	providerResources["genesyscloud_location"] = ResourceLocation()
 	providerResources["genesyscloud_flow"] = ResourceFlow()
@@ -37,6 +37,8 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_auth_division"] = ResourceAuthDivision()
 	providerResources["genesyscloud_employeeperformance_externalmetrics_definitions"] = ResourceEmployeeperformanceExternalmetricsDefinition()
 	providerResources["genesyscloud_group"] = ResourceGroup()
+	providerResources["genesyscloud_group_member"] = ResourceGroupMember()
+	providerResources["genesyscloud_group_members"] = ResourceGroupMembers()
 	providerResources["genesyscloud_group_roles"] = ResourceGroupRoles()
 	providerResources["genesyscloud_idp_adfs"] = ResourceIdpAdfs()
 	providerResources["genesyscloud_idp_generic"] = ResourceIdpGeneric()
@@ -64,7 +66,6 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_routing_email_domain"] = ResourceRoutingEmailDomain()
 	providerResources["genesyscloud_routing_email_route"] = ResourceRoutingEmailRoute()
 	providerResources["genesyscloud_routing_language"] = ResourceRoutingLanguage()
-	providerResources["genesyscloud_routing_queue"] = ResourceRoutingQueue()
 	providerResources["genesyscloud_routing_skill"] = ResourceRoutingSkill()
 	providerResources["genesyscloud_routing_skill_group"] = ResourceRoutingSkillGroup()
 	providerResources["genesyscloud_routing_settings"] = ResourceRoutingSettings()
@@ -81,7 +82,6 @@ func (r *registerTestInstance) registerTestDataSources() {
 	r.datasourceMapMutex.Lock()
 	defer r.datasourceMapMutex.Unlock()
 	providerDataSources["genesyscloud_routing_wrapupcode"] = DataSourceRoutingWrapupcode()
-	providerDataSources["genesyscloud_routing_queue"] = DataSourceRoutingQueue()
 	providerDataSources["genesyscloud_flow"] = DataSourceFlow()
 	providerDataSources["genesyscloud_location"] = DataSourceLocation()
 	providerDataSources["genesyscloud_auth_division_home"] = DataSourceAuthDivisionHome()
@@ -109,7 +109,6 @@ func (r *registerTestInstance) registerTestDataSources() {
 	providerDataSources["genesyscloud_responsemanagement_response"] = dataSourceResponsemanagementResponse()
 	providerDataSources["genesyscloud_responsemanagement_responseasset"] = dataSourceResponseManagamentResponseAsset()
 	providerDataSources["genesyscloud_routing_language"] = dataSourceRoutingLanguage()
-	providerDataSources["genesyscloud_routing_queue"] = DataSourceRoutingQueue()
 	providerDataSources["genesyscloud_routing_settings"] = dataSourceRoutingSettings()
 	providerDataSources["genesyscloud_routing_skill"] = dataSourceRoutingSkill()
 	providerDataSources["genesyscloud_routing_skill_group"] = dataSourceRoutingSkillGroup()
@@ -138,3 +137,21 @@ func TestMain(m *testing.M) {
 	// Run the test suite
 	m.Run()
 }
+
+// createTestQueue creates a routing queue through the API for tests that reference a queue. The queue resource is
+// registered in the routing_queue package, which cannot be imported by this package's tests.
+func createTestQueue(t *testing.T, name string) string {
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	queue, _, err := routingAPI.PostRoutingQueues(platformclientv2.Createqueuerequest{Name: &name})
+	if err != nil {
+		t.Fatalf("failed to create queue %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if _, err := routingAPI.DeleteRoutingQueue(*queue.Id, true); err != nil {
+			log.Printf("failed to delete queue %s: %v", *queue.Id, err)
+		}
+	})
+	// Newly created queues are not always available straight away
+	time.Sleep(5 * time.Second)
+	return *queue.Id
+}
@@ -18,6 +18,28 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
 )
 
+var (
+	emailDomainDnsRecordResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the DNS record.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "Type of the DNS record such as MX, TXT or CNAME.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"value": {
+				Description: "Value of the DNS record.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+)
+
 func getAllRoutingEmailDomains(_ context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
 	resources := make(resourceExporter.ResourceIDMetaMap)
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(clientConfig)
@@ -46,12 +68,15 @@ func RoutingEmailDomainExporter() *resourceExporter.ResourceExporter {
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"custom_smtp_server_id": ResourceRoutingEmailDomain().Schema["custom_smtp_server_id"],
 		},
+		ExcludedAttributes: []string{"mx_record_status", "mail_from_status", "dns_records"},
 	}
 }
 
 func ResourceRoutingEmailDomain() *schema.Resource {
 	return &schema.Resource{
-		Description: "Genesys Cloud Routing Email Domain",
+		Description: `Genesys Cloud Routing Email Domain
+
+When ` + "`wait_for_verification`" + ` is true, create and update wait until the MX record of the domain and the DNS records of the custom MAIL FROM domain have been verified. The records that must be published are exposed in ` + "`dns_records`" + `.`,
 
 		CreateContext: CreateWithPooledClient(createRoutingEmailDomain),
 		ReadContext:   ReadWithPooledClient(readRoutingEmailDomain),
@@ -60,6 +85,11 @@ func ResourceRoutingEmailDomain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeRoutingEmailDomainDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"domain_id": {
@@ -85,6 +115,28 @@ func ResourceRoutingEmailDomain() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"wait_for_verification": {
+				Description: "Wait during create and update until the MX record of the domain and the DNS records of the custom MAIL FROM domain are verified. The wait is limited by the create and update timeouts, and fails as soon as the MAIL FROM verification fails.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"mx_record_status": {
+				Description: "Status of the MX record of the domain such as VALID, INVALID or NOT_AVAILABLE.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mail_from_status": {
+				Description: "Verification status of the DNS records of the custom MAIL FROM domain.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"dns_records": {
+				Description: "DNS records that must exist for the custom MAIL FROM domain to be verified.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        emailDomainDnsRecordResource,
+			},
 		},
 	}
 }
@@ -118,9 +170,14 @@ func createRoutingEmailDomain(ctx context.Context, d *schema.ResourceData, meta
 	// Other settings must be updated in a PATCH update
 	if d.HasChanges("mail_from_domain", "custom_smtp_server_id") {
 		return updateRoutingEmailDomain(ctx, d, meta)
-	} else {
-		return readRoutingEmailDomain(ctx, d, meta)
 	}
+
+	if d.Get("wait_for_verification").(bool) {
+		if diagErr := waitForRoutingEmailDomainVerification(ctx, d, routingAPI, d.Timeout(schema.TimeoutCreate)); diagErr != nil {
+			return diagErr
+		}
+	}
+	return readRoutingEmailDomain(ctx, d, meta)
 }
 
 func readRoutingEmailDomain(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -164,6 +221,27 @@ func readRoutingEmailDomain(ctx context.Context, d *schema.ResourceData, meta in
 			d.Set("mail_from_domain", nil)
 		}
 
+		if domain.MxRecordStatus != nil {
+			d.Set("mx_record_status", *domain.MxRecordStatus)
+		} else {
+			d.Set("mx_record_status", nil)
+		}
+
+		if domain.MailFromSettings != nil && domain.MailFromSettings.Status != nil {
+			d.Set("mail_from_status", *domain.MailFromSettings.Status)
+		} else {
+			d.Set("mail_from_status", nil)
+		}
+
+		if domain.MailFromSettings != nil && domain.MailFromSettings.Records != nil {
+			d.Set("dns_records", flattenEmailDomainDnsRecords(*domain.MailFromSettings.Records))
+		} else {
+			d.Set("dns_records", nil)
+		}
+
+		// wait_for_verification only exists in the configuration
+		d.Set("wait_for_verification", d.Get("wait_for_verification").(bool))
+
 		log.Printf("Read routing email domain %s", d.Id())
 		return cc.CheckState()
 	})
@@ -174,28 +252,36 @@ func updateRoutingEmailDomain(ctx context.Context, d *schema.ResourceData, meta
 	mailFromDomain := d.Get("mail_from_domain").(string)
 	domainID := d.Get("domain_id").(string)
 
-	if !strings.Contains(mailFromDomain, domainID) || mailFromDomain == domainID {
-		return diag.Errorf("domain_id must be a subdomain of mail_from_domain")
-	}
-
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 
-	log.Printf("Updating routing email domain %s", d.Id())
+	if d.HasChanges("mail_from_domain", "custom_smtp_server_id") {
+		if !strings.Contains(mailFromDomain, domainID) || mailFromDomain == domainID {
+			return diag.Errorf("domain_id must be a subdomain of mail_from_domain")
+		}
 
-	_, _, err := routingAPI.PatchRoutingEmailDomain(d.Id(), platformclientv2.Inbounddomainpatchrequest{
-		MailFromSettings: &platformclientv2.Mailfromresult{
-			MailFromDomain: &mailFromDomain,
-		},
-		CustomSMTPServer: &platformclientv2.Domainentityref{
-			Id: &customSMTPServer,
-		},
-	})
-	if err != nil {
-		return diag.Errorf("Failed to update routing email domain %s: %s", d.Id(), err)
+		log.Printf("Updating routing email domain %s", d.Id())
+
+		_, _, err := routingAPI.PatchRoutingEmailDomain(d.Id(), platformclientv2.Inbounddomainpatchrequest{
+			MailFromSettings: &platformclientv2.Mailfromresult{
+				MailFromDomain: &mailFromDomain,
+			},
+			CustomSMTPServer: &platformclientv2.Domainentityref{
+				Id: &customSMTPServer,
+			},
+		})
+		if err != nil {
+			return diag.Errorf("Failed to update routing email domain %s: %s", d.Id(), err)
+		}
+
+		log.Printf("Updated routing email domain %s", d.Id())
 	}
 
-	log.Printf("Updated routing email domain %s", d.Id())
+	if d.Get("wait_for_verification").(bool) {
+		if diagErr := waitForRoutingEmailDomainVerification(ctx, d, routingAPI, d.Timeout(schema.TimeoutUpdate)); diagErr != nil {
+			return diagErr
+		}
+	}
 	return readRoutingEmailDomain(ctx, d, meta)
 }
 
@@ -225,6 +311,89 @@ func deleteRoutingEmailDomain(ctx context.Context, d *schema.ResourceData, meta
 	})
 }
 
+// customizeRoutingEmailDomainDiff marks the verification attributes as unknown when the MAIL FROM domain changes
+func customizeRoutingEmailDomainDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("mail_from_domain") {
+		return nil
+	}
+	if err := diff.SetNewComputed("mail_from_status"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("dns_records")
+}
+
+// waitForRoutingEmailDomainVerification waits until the DNS records of the domain have been verified
+func waitForRoutingEmailDomainVerification(ctx context.Context, d *schema.ResourceData, routingAPI *platformclientv2.RoutingApi, timeout time.Duration) diag.Diagnostics {
+	mailFromConfigured := d.Get("mail_from_domain").(string) != ""
+
+	log.Printf("Waiting for DNS verification of routing email domain %s", d.Id())
+	return WithRetries(ctx, timeout, func() *retry.RetryError {
+		domain, _, getErr := routingAPI.GetRoutingEmailDomain(d.Id())
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read routing email domain %s: %s", d.Id(), getErr))
+		}
+		if failed := failedEmailDomainVerifications(domain, mailFromConfigured); len(failed) > 0 {
+			return retry.NonRetryableError(fmt.Errorf("DNS verification of routing email domain %s failed: %s", d.Id(), strings.Join(failed, ", ")))
+		}
+		if pending := pendingEmailDomainVerifications(domain, mailFromConfigured); len(pending) > 0 {
+			return retry.RetryableError(fmt.Errorf("DNS verification of routing email domain %s is not complete: %s", d.Id(), strings.Join(pending, ", ")))
+		}
+		log.Printf("DNS verification of routing email domain %s is complete", d.Id())
+		return nil
+	})
+}
+
+// pendingEmailDomainVerifications returns a description of each DNS check of the domain that has not passed yet
+func pendingEmailDomainVerifications(domain *platformclientv2.Inbounddomain, mailFromConfigured bool) []string {
+	var pending []string
+	if domain.MxRecordStatus == nil || *domain.MxRecordStatus != "VALID" {
+		status := "unknown"
+		if domain.MxRecordStatus != nil {
+			status = *domain.MxRecordStatus
+		}
+		pending = append(pending, fmt.Sprintf("MX record status is %s", status))
+	}
+	if mailFromConfigured {
+		status := "unknown"
+		if domain.MailFromSettings != nil && domain.MailFromSettings.Status != nil {
+			status = *domain.MailFromSettings.Status
+		}
+		if !strings.EqualFold(status, "verified") {
+			pending = append(pending, fmt.Sprintf("MAIL FROM status is %s", status))
+		}
+	}
+	return pending
+}
+
+// failedEmailDomainVerifications returns a description of each DNS check of the domain that has failed and will not pass
+// without being started again. An invalid MX record is not a failure as it is reported until the DNS records propagate.
+func failedEmailDomainVerifications(domain *platformclientv2.Inbounddomain, mailFromConfigured bool) []string {
+	var failed []string
+	if mailFromConfigured && domain.MailFromSettings != nil && domain.MailFromSettings.Status != nil &&
+		strings.EqualFold(*domain.MailFromSettings.Status, "failed") {
+		failed = append(failed, fmt.Sprintf("MAIL FROM status is %s", *domain.MailFromSettings.Status))
+	}
+	return failed
+}
+
+func flattenEmailDomainDnsRecords(records []platformclientv2.Record) []interface{} {
+	recordList := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		recordMap := make(map[string]interface{})
+		if record.Name != nil {
+			recordMap["name"] = *record.Name
+		}
+		if record.VarType != nil {
+			recordMap["type"] = *record.VarType
+		}
+		if record.Value != nil {
+			recordMap["value"] = *record.Value
+		}
+		recordList = append(recordList, recordMap)
+	}
+	return recordList
+}
+
 func GenerateRoutingEmailDomainResource(
 	resourceID string,
 	domainID string,
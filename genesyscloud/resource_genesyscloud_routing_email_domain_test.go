@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceRoutingEmailDomainSub(t *testing.T) {
@@ -52,6 +53,67 @@ func TestAccResourceRoutingEmailDomainSub(t *testing.T) {
 	})
 }
 
+func TestUnitPendingEmailDomainVerifications(t *testing.T) {
+	valid := "VALID"
+	invalid := "INVALID"
+	verified := "Verified"
+	pending := "Pending"
+
+	testCases := []struct {
+		name               string
+		domain             platformclientv2.Inbounddomain
+		mailFromConfigured bool
+		expected           []string
+	}{
+		{
+			name:     "mx valid",
+			domain:   platformclientv2.Inbounddomain{MxRecordStatus: &valid},
+			expected: nil,
+		},
+		{
+			name:     "mx invalid",
+			domain:   platformclientv2.Inbounddomain{MxRecordStatus: &invalid},
+			expected: []string{"MX record status is INVALID"},
+		},
+		{
+			name:               "mail from pending",
+			domain:             platformclientv2.Inbounddomain{MxRecordStatus: &valid, MailFromSettings: &platformclientv2.Mailfromresult{Status: &pending}},
+			mailFromConfigured: true,
+			expected:           []string{"MAIL FROM status is Pending"},
+		},
+		{
+			name:               "mail from verified",
+			domain:             platformclientv2.Inbounddomain{MxRecordStatus: &valid, MailFromSettings: &platformclientv2.Mailfromresult{Status: &verified}},
+			mailFromConfigured: true,
+			expected:           nil,
+		},
+		{
+			name:               "nothing reported",
+			domain:             platformclientv2.Inbounddomain{},
+			mailFromConfigured: true,
+			expected:           []string{"MX record status is unknown", "MAIL FROM status is unknown"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, pendingEmailDomainVerifications(&tc.domain, tc.mailFromConfigured))
+		})
+	}
+}
+
+func TestUnitFailedEmailDomainVerifications(t *testing.T) {
+	invalid := "INVALID"
+	failed := "Failed"
+	pending := "Pending"
+
+	assert.Nil(t, failedEmailDomainVerifications(&platformclientv2.Inbounddomain{MxRecordStatus: &invalid}, false))
+	assert.Nil(t, failedEmailDomainVerifications(&platformclientv2.Inbounddomain{MailFromSettings: &platformclientv2.Mailfromresult{Status: &pending}}, true))
+	assert.Nil(t, failedEmailDomainVerifications(&platformclientv2.Inbounddomain{MailFromSettings: &platformclientv2.Mailfromresult{Status: &failed}}, false))
+	assert.Equal(t, []string{"MAIL FROM status is Failed"},
+		failedEmailDomainVerifications(&platformclientv2.Inbounddomain{MailFromSettings: &platformclientv2.Mailfromresult{Status: &failed}}, true))
+}
+
 func TestAccResourceRoutingEmailDomainCustom(t *testing.T) {
 	var (
 		domainRes       = "routing-domain1"
@@ -7,11 +7,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,9 +24,10 @@ var (
 	bccEmailResource = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"email": {
-				Description: "Email address.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Email address.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: ValidateEmailAddress,
 			},
 			"name": {
 				Description: "Name associated with the email.",
@@ -107,6 +110,7 @@ func ResourceRoutingEmailRoute() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: importRoutingEmailRoute,
 		},
+		CustomizeDiff: customizeRoutingEmailRouteDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"domain_id": {
@@ -116,7 +120,7 @@ func ResourceRoutingEmailRoute() *schema.Resource {
 				ForceNew:    true,
 			},
 			"pattern": {
-				Description: "The search pattern that the mailbox name should match.",
+				Description: "The search pattern that the mailbox name should match. The pattern must be unique within the domain.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
@@ -126,12 +130,13 @@ func ResourceRoutingEmailRoute() *schema.Resource {
 				Required:    true,
 			},
 			"from_email": {
-				Description: "The sender email to use for outgoing replies.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "The sender email to use for outgoing replies.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: ValidateEmailAddress,
 			},
 			"queue_id": {
-				Description: "The queue to route the emails to. This should not be set if a flow_id is specified.",
+				Description: "The queue to route the emails to. This cannot be set if a flow_id is specified.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
@@ -152,9 +157,10 @@ func ResourceRoutingEmailRoute() *schema.Resource {
 				Optional:    true,
 			},
 			"flow_id": {
-				Description: "The flow to use for processing the email. This should not be set if a queue_id is specified.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description: "The flow to use for processing the email. This cannot be set if a queue_id is specified. " +
+					"Earlier versions of the provider accepted both, so when upgrading remove queue_id from routes that set both, or remove flow_id to route the emails to the queue.",
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"reply_email_address": {
 				Description: "The route to use for email replies.",
@@ -200,6 +206,80 @@ func ResourceRoutingEmailRoute() *schema.Resource {
 	}
 }
 
+// customizeRoutingEmailRouteDiff checks that flow_id is not combined with queue_id, the reply email
+// address settings and that the pattern is not already used by another route in the domain
+func customizeRoutingEmailRouteDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if emailRouteHasQueueAndFlow(diff.GetRawConfig()) {
+		return fmt.Errorf("flow_id cannot be used with queue_id. Emails on a route with a flow_id are routed by the flow, so remove queue_id or remove flow_id to route the emails to the queue")
+	}
+
+	if diff.NewValueKnown("reply_email_address") {
+		replyEmailAddress := diff.Get("reply_email_address").([]interface{})
+		if len(replyEmailAddress) > 0 && replyEmailAddress[0] != nil && diff.NewValueKnown("reply_email_address.0.route_id") {
+			settingsMap := replyEmailAddress[0].(map[string]interface{})
+			if err := validateReplyEmailAddressSettings(settingsMap["route_id"].(string), settingsMap["self_reference_route"].(bool)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !diff.HasChanges("domain_id", "pattern") || !diff.NewValueKnown("domain_id") || !diff.NewValueKnown("pattern") {
+		return nil
+	}
+	domainID := diff.Get("domain_id").(string)
+	pattern := diff.Get("pattern").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	var routes []platformclientv2.Inboundroute
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		routesPage, resp, getErr := routingAPI.GetRoutingEmailDomainRoutes(domainID, pageSize, pageNum, pattern)
+		if getErr != nil {
+			if IsStatus404(resp) {
+				// The domain does not exist yet so there are no routes to collide with
+				return nil
+			}
+			return fmt.Errorf("failed to read routes of email domain %s to check for pattern collisions: %v", domainID, getErr)
+		}
+		if routesPage.Entities == nil || len(*routesPage.Entities) == 0 {
+			break
+		}
+		routes = append(routes, *routesPage.Entities...)
+		if routesPage.PageCount == nil || pageNum >= *routesPage.PageCount {
+			break
+		}
+	}
+
+	if collisions := emailRoutePatternCollisions(routes, diff.Id(), pattern); len(collisions) > 0 {
+		return fmt.Errorf("pattern %s is already used by email route %s in domain %s", pattern, strings.Join(collisions, ", "), domainID)
+	}
+	return nil
+}
+
+// emailRouteHasQueueAndFlow reports whether both queue_id and flow_id are configured
+func emailRouteHasQueueAndFlow(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	return !rawConfig.GetAttr("flow_id").IsNull() && !rawConfig.GetAttr("queue_id").IsNull()
+}
+
+// emailRoutePatternCollisions returns the IDs of the routes other than routeID that use the same pattern
+func emailRoutePatternCollisions(routes []platformclientv2.Inboundroute, routeID string, pattern string) []string {
+	var collisions []string
+	for _, route := range routes {
+		if route.Id == nil || route.Pattern == nil || *route.Id == routeID {
+			continue
+		}
+		if strings.EqualFold(*route.Pattern, pattern) {
+			collisions = append(collisions, *route.Id)
+		}
+	}
+	return collisions
+}
+
 func importRoutingEmailRoute(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	// Import must specify domain ID and route ID
 	idParts := strings.Split(d.Id(), "/")
@@ -367,7 +447,7 @@ func readRoutingEmailRoute(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if route.ReplyEmailAddress != nil {
-			flattenedEmails := flattenQueueEmailAddress(*route.ReplyEmailAddress)
+			flattenedEmails := flattenReplyEmailAddress(*route.ReplyEmailAddress)
 			_, _, selfReferenceRoute := extractReplyEmailAddressValue(d)
 
 			//Set the self_reference_route
@@ -487,18 +567,20 @@ func validateSdkReplyEmailAddress(d *schema.ResourceData) error {
 	if replyEmailAddress != nil && len(replyEmailAddress) > 0 {
 		settingsMap := replyEmailAddress[0].(map[string]interface{})
 
-		routeID := settingsMap["route_id"].(string)
-		selfReferenceRoute := settingsMap["self_reference_route"].(bool)
+		return validateReplyEmailAddressSettings(settingsMap["route_id"].(string), settingsMap["self_reference_route"].(bool))
+	}
 
-		if selfReferenceRoute && routeID != "" {
-			return fmt.Errorf("can not set a reply email address route id directly, if the self_reference_route value is set to true")
-		}
+	return nil
+}
 
-		if !selfReferenceRoute && routeID == "" {
-			return fmt.Errorf("you must provide reply email address route id if the self_reference_route value is set to false")
-		}
+func validateReplyEmailAddressSettings(routeID string, selfReferenceRoute bool) error {
+	if selfReferenceRoute && routeID != "" {
+		return fmt.Errorf("can not set a reply email address route id directly, if the self_reference_route value is set to true")
 	}
 
+	if !selfReferenceRoute && routeID == "" {
+		return fmt.Errorf("you must provide reply email address route id if the self_reference_route value is set to false")
+	}
 	return nil
 }
 
@@ -558,3 +640,15 @@ func flattenAutoBccEmailAddresses(addresses []platformclientv2.Emailaddress) *sc
 	}
 	return addressSet
 }
+
+func flattenReplyEmailAddress(settings platformclientv2.Queueemailaddress) map[string]interface{} {
+	settingsMap := make(map[string]interface{})
+	resourcedata.SetMapReferenceValueIfNotNil(settingsMap, "domain_id", settings.Domain)
+
+	if settings.Route != nil {
+		route := *settings.Route
+		settingsMap["route_id"] = *route.Id
+	}
+
+	return settingsMap
+}
@@ -3,19 +3,22 @@ package genesyscloud
 import (
 	"fmt"
 	"github.com/google/uuid"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccResourceRoutingEmailRoute(t *testing.T) {
 	var (
 		domainRes     = "routing-domain1"
 		domainId      = fmt.Sprintf("terraform.%s.com", strings.Replace(uuid.NewString(), "-", "", -1))
-		queueResource = "email-queue"
 		queueName     = "Terraform Email Queue-" + uuid.NewString()
 		langResource  = "email-lang"
 		langName      = "tflang" + uuid.NewString()
@@ -36,6 +39,7 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 	)
 
 	CleanupRoutingEmailDomains()
+	queueId := createTestQueue(t, queueName)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { TestAccPreCheck(t) },
@@ -72,9 +76,6 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 					domainId,
 					FalseValue,
 					NullValue,
-				) + GenerateRoutingQueueResourceBasic(
-					queueResource,
-					queueName,
 				) + GenerateRoutingLanguageResource(
 					langResource,
 					langName,
@@ -94,7 +95,7 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 						"genesyscloud_routing_email_route."+routeRes2+".id",
 					),
 					generateRoutingEmailQueueSettings(
-						"genesyscloud_routing_queue."+queueResource+".id",
+						strconv.Quote(queueId),
 						priority1,
 						"genesyscloud_routing_language."+langResource+".id",
 						"genesyscloud_routing_skill."+skillResource+".id",
@@ -112,7 +113,7 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "from_email", fromEmail2),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "auto_bcc.0.name", fromName2),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "auto_bcc.0.email", bccEmail2),
-					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "queue_id", "genesyscloud_routing_queue."+queueResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "queue_id", queueId),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "language_id", "genesyscloud_routing_language."+langResource, "id"),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "skill_ids.0", "genesyscloud_routing_skill."+skillResource, "id"),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "priority", priority1),
@@ -127,9 +128,6 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 					domainId,
 					FalseValue,
 					NullValue,
-				) + GenerateRoutingQueueResourceBasic(
-					queueResource,
-					queueName,
 				) + GenerateRoutingLanguageResource(
 					langResource,
 					langName,
@@ -149,7 +147,7 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 						"",
 					),
 					generateRoutingEmailQueueSettings(
-						"genesyscloud_routing_queue."+queueResource+".id",
+						strconv.Quote(queueId),
 						priority1,
 						"genesyscloud_routing_language."+langResource+".id",
 						"genesyscloud_routing_skill."+skillResource+".id",
@@ -167,7 +165,7 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "from_email", fromEmail2),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "auto_bcc.0.name", fromName2),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "auto_bcc.0.email", bccEmail2),
-					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "queue_id", "genesyscloud_routing_queue."+queueResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "queue_id", queueId),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "language_id", "genesyscloud_routing_language."+langResource, "id"),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "skill_ids.0", "genesyscloud_routing_skill."+skillResource, "id"),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "priority", priority1),
@@ -183,9 +181,6 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 					domainId,
 					FalseValue,
 					NullValue,
-				) + GenerateRoutingQueueResourceBasic(
-					queueResource,
-					queueName,
 				) + GenerateRoutingLanguageResource(
 					langResource,
 					langName,
@@ -205,7 +200,7 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 						"genesyscloud_routing_email_route."+routeRes2+".id",
 					),
 					generateRoutingEmailQueueSettings(
-						"genesyscloud_routing_queue."+queueResource+".id",
+						strconv.Quote(queueId),
 						priority1,
 						"genesyscloud_routing_language."+langResource+".id",
 						"genesyscloud_routing_skill."+skillResource+".id",
@@ -223,7 +218,7 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "from_email", fromEmail2),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "auto_bcc.0.name", fromName2),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "auto_bcc.0.email", bccEmail2),
-					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "queue_id", "genesyscloud_routing_queue."+queueResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "queue_id", queueId),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "language_id", "genesyscloud_routing_language."+langResource, "id"),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_email_route."+routeRes, "skill_ids.0", "genesyscloud_routing_skill."+skillResource, "id"),
 					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "priority", priority1),
@@ -244,6 +239,137 @@ func TestAccResourceRoutingEmailRoute(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingEmailRouteValidation(t *testing.T) {
+	var (
+		domainRes    = "routing-domain1"
+		domainId     = fmt.Sprintf("terraform.%s.com", strings.Replace(uuid.NewString(), "-", "", -1))
+		routeRes     = "email-route1"
+		routeRes2    = "email-route2"
+		routePattern = "terraform1"
+		fromEmail    = "terraform1@test.com"
+		fromName     = "John Terraform"
+	)
+
+	CleanupRoutingEmailDomains()
+
+	domainConfig := GenerateRoutingEmailDomainResource(
+		domainRes,
+		domainId,
+		FalseValue,
+		NullValue,
+	)
+	routeConfig := generateRoutingEmailRouteResource(
+		routeRes,
+		"genesyscloud_routing_email_domain."+domainRes+".id",
+		routePattern,
+		fromName,
+		fromEmail,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// A route cannot use both a queue and a flow
+				Config: domainConfig + generateRoutingEmailRouteResource(
+					routeRes,
+					"genesyscloud_routing_email_domain."+domainRes+".id",
+					routePattern,
+					fromName,
+					fromEmail,
+					"queue_id = "+strconv.Quote(uuid.NewString()),
+					"flow_id = "+strconv.Quote(uuid.NewString()),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("flow_id cannot be used with queue_id"),
+			},
+			{
+				// The from email must be a bare email address
+				Config: domainConfig + generateRoutingEmailRouteResource(
+					routeRes,
+					"genesyscloud_routing_email_domain."+domainRes+".id",
+					routePattern,
+					fromName,
+					"John Terraform <"+fromEmail+">",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must not contain a display name"),
+			},
+			{
+				// Create email domain and route
+				Config: domainConfig + routeConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_email_route."+routeRes, "pattern", routePattern),
+				),
+			},
+			{
+				// A second route with the same pattern is rejected during plan
+				Config: domainConfig + routeConfig + generateRoutingEmailRouteResource(
+					routeRes2,
+					"genesyscloud_routing_email_domain."+domainRes+".id",
+					routePattern,
+					fromName,
+					fromEmail,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is already used by email route"),
+			},
+		},
+		CheckDestroy: testVerifyRoutingEmailRouteDestroyed,
+	})
+}
+
+func TestUnitEmailRoutePatternCollisions(t *testing.T) {
+	routeId1 := uuid.NewString()
+	routeId2 := uuid.NewString()
+	pattern1 := "support"
+	pattern2 := "Sales"
+	routes := []platformclientv2.Inboundroute{
+		{Id: &routeId1, Pattern: &pattern1},
+		{Id: &routeId2, Pattern: &pattern2},
+	}
+
+	assert.Equal(t, []string{routeId1}, emailRoutePatternCollisions(routes, "", "support"))
+	assert.Equal(t, []string{routeId2}, emailRoutePatternCollisions(routes, routeId1, "sales"))
+	assert.Nil(t, emailRoutePatternCollisions(routes, routeId1, "support"))
+	assert.Nil(t, emailRoutePatternCollisions(routes, "", "billing"))
+}
+
+func TestUnitEmailRouteHasQueueAndFlow(t *testing.T) {
+	config := func(attrs map[string]cty.Value) cty.Value {
+		values := map[string]cty.Value{
+			"flow_id":     cty.NullVal(cty.String),
+			"queue_id":    cty.NullVal(cty.String),
+			"priority":    cty.NullVal(cty.Number),
+			"skill_ids":   cty.NullVal(cty.Set(cty.String)),
+			"language_id": cty.NullVal(cty.String),
+		}
+		for k, v := range attrs {
+			values[k] = v
+		}
+		return cty.ObjectVal(values)
+	}
+
+	assert.False(t, emailRouteHasQueueAndFlow(cty.NullVal(cty.EmptyObject)))
+	assert.False(t, emailRouteHasQueueAndFlow(config(map[string]cty.Value{"flow_id": cty.StringVal("flow")})))
+	assert.False(t, emailRouteHasQueueAndFlow(config(map[string]cty.Value{
+		"queue_id": cty.StringVal("queue"),
+		"priority": cty.NumberIntVal(5),
+	})))
+	// The queue routing settings are accepted with a flow
+	assert.False(t, emailRouteHasQueueAndFlow(config(map[string]cty.Value{
+		"flow_id":     cty.StringVal("flow"),
+		"priority":    cty.NumberIntVal(5),
+		"skill_ids":   cty.SetVal([]cty.Value{cty.StringVal("skill")}),
+		"language_id": cty.StringVal("language"),
+	})))
+	assert.True(t, emailRouteHasQueueAndFlow(config(map[string]cty.Value{
+		"flow_id":  cty.StringVal("flow"),
+		"queue_id": cty.StringVal("queue"),
+	})))
+}
+
 func generateRoutingEmailRouteResource(
 	resourceID string,
 	domainID string,
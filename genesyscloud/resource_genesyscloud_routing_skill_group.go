@@ -130,11 +130,13 @@ func ResourceRoutingSkillGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"skill_conditions": {
-				Description:      "JSON encoded array of rules that will be used to determine group membership.",
+				Description: "JSON encoded array of rules that will be used to determine group membership. " +
+					"The referenced routing skills and languages must exist, and a warning is returned if no active user in the member divisions matches the rules.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: SuppressEquivalentJsonDiffs,
+				ValidateDiagFunc: ValidateSkillConditions,
 			},
 			"member_division_ids": {
 				Description: "The IDs of member divisions to add or remove for this skill group. An empty array means all divisions will be removed, \"*\" means all divisions will be added.",
@@ -181,6 +183,10 @@ func createOrUpdateSkillGroups(ctx context.Context, d *schema.ResourceData, meta
 	path := routingAPI.Configuration.BasePath + route
 	headerParams := buildHeaderParams(routingAPI)
 
+	if diagErr := checkSkillGroupConditions(ctx, d, routingAPI); diagErr != nil {
+		return diagErr
+	}
+
 	/*
 	   Since API Client expects either a struct or map of maps (of json), convert the JSON string to a map
 	   and then pass it into API client
@@ -227,7 +233,10 @@ func createOrUpdateSkillGroups(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
-	return readSkillGroups(ctx, d, meta)
+	if diagErr = readSkillGroups(ctx, d, meta); diagErr.HasError() {
+		return diagErr
+	}
+	return append(diagErr, evaluateSkillGroupConditions(ctx, d, sdkConfig)...)
 }
 
 func postSkillGroupMemberDivisions(ctx context.Context, d *schema.ResourceData, meta interface{}, routingAPI *platformclientv2.RoutingApi, apiSkillGroupMemberDivisionIds []string, create bool) diag.Diagnostics {
@@ -0,0 +1,391 @@
+package genesyscloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The skill conditions of a skill group are a recursive JSON structure that is passed through to the API as is. The evaluator in
+this file parses the conditions so that mistakes are caught before the skill group is created:
+
+1.  Static checks (JSON structure, operations, comparators and proficiencies) run during plan through ValidateSkillConditions.
+2.  Skill and language references are checked against the org before the skill group is created or updated.
+3.  The conditions are evaluated against the users of the member divisions and a warning is returned when nobody matches.
+
+References and matches cannot be checked during plan because the skills, languages and users are often created in the same apply.
+*/
+
+var (
+	skillConditionOperations  = []string{"And", "Or"}
+	skillConditionComparators = []string{"EqualTo", "NotEqualTo", "GreaterThan", "GreaterThanOrEqualTo", "LessThan", "LessThanOrEqualTo"}
+)
+
+const (
+	skillConditionMinProficiency = 0
+	skillConditionMaxProficiency = 5
+)
+
+type skillGroupCondition struct {
+	RoutingSkillConditions  []skillGroupSkillCondition `json:"routingSkillConditions"`
+	LanguageSkillConditions []skillGroupSkillCondition `json:"languageSkillConditions"`
+	Operation               string                     `json:"operation"`
+}
+
+// skillGroupSkillCondition holds either a routing skill or a language skill condition
+type skillGroupSkillCondition struct {
+	RoutingSkill    string                `json:"routingSkill,omitempty"`
+	LanguageSkill   string                `json:"languageSkill,omitempty"`
+	Comparator      string                `json:"comparator"`
+	Proficiency     *int                  `json:"proficiency"`
+	ChildConditions []skillGroupCondition `json:"childConditions"`
+}
+
+// skillGroupCandidate holds the routing skill and language proficiencies of a user keyed by lower case name
+type skillGroupCandidate struct {
+	skills    map[string]float64
+	languages map[string]float64
+}
+
+func parseSkillConditions(skillConditionsJson string) ([]skillGroupCondition, error) {
+	var conditions []skillGroupCondition
+	if err := json.Unmarshal([]byte(skillConditionsJson), &conditions); err != nil {
+		return nil, fmt.Errorf("skill_conditions must be a JSON encoded array of conditions: %s", err)
+	}
+	return conditions, nil
+}
+
+// ValidateSkillConditions parses the skill_conditions JSON and checks the operations, comparators and proficiencies of every condition
+func ValidateSkillConditions(skillConditions interface{}, _ cty.Path) diag.Diagnostics {
+	skillConditionsJson, ok := skillConditions.(string)
+	if !ok {
+		return diag.Errorf("skill_conditions %v is not a string", skillConditions)
+	}
+	conditions, err := parseSkillConditions(skillConditionsJson)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if errs := validateSkillConditions(conditions, ""); len(errs) > 0 {
+		return diag.Errorf("invalid skill_conditions: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func validateSkillConditions(conditions []skillGroupCondition, path string) []string {
+	var errs []string
+	for i, condition := range conditions {
+		conditionPath := fmt.Sprintf("%s[%d]", path, i)
+		if !lists.ItemInSlice(condition.Operation, skillConditionOperations) {
+			errs = append(errs, fmt.Sprintf("%s: operation %q must be one of %s", conditionPath, condition.Operation, strings.Join(skillConditionOperations, ", ")))
+		}
+		for j, skillCondition := range condition.RoutingSkillConditions {
+			skillConditionPath := fmt.Sprintf("%s.routingSkillConditions[%d]", conditionPath, j)
+			if skillCondition.RoutingSkill == "" {
+				errs = append(errs, fmt.Sprintf("%s: routingSkill is required", skillConditionPath))
+			}
+			errs = append(errs, validateSkillCondition(skillCondition, skillConditionPath)...)
+		}
+		for j, languageCondition := range condition.LanguageSkillConditions {
+			languageConditionPath := fmt.Sprintf("%s.languageSkillConditions[%d]", conditionPath, j)
+			if languageCondition.LanguageSkill == "" {
+				errs = append(errs, fmt.Sprintf("%s: languageSkill is required", languageConditionPath))
+			}
+			errs = append(errs, validateSkillCondition(languageCondition, languageConditionPath)...)
+		}
+	}
+	return errs
+}
+
+func validateSkillCondition(skillCondition skillGroupSkillCondition, path string) []string {
+	var errs []string
+	if !lists.ItemInSlice(skillCondition.Comparator, skillConditionComparators) {
+		errs = append(errs, fmt.Sprintf("%s: comparator %q must be one of %s", path, skillCondition.Comparator, strings.Join(skillConditionComparators, ", ")))
+	}
+	if skillCondition.Proficiency == nil {
+		errs = append(errs, fmt.Sprintf("%s: proficiency is required", path))
+	} else if *skillCondition.Proficiency < skillConditionMinProficiency || *skillCondition.Proficiency > skillConditionMaxProficiency {
+		errs = append(errs, fmt.Sprintf("%s: proficiency %d must be between %d and %d", path, *skillCondition.Proficiency, skillConditionMinProficiency, skillConditionMaxProficiency))
+	}
+	return append(errs, validateSkillConditions(skillCondition.ChildConditions, path+".childConditions")...)
+}
+
+// skillConditionReferences returns the sorted names of the routing skills and languages used by the conditions
+func skillConditionReferences(conditions []skillGroupCondition) (skills []string, languages []string) {
+	skillNames := make(map[string]bool)
+	languageNames := make(map[string]bool)
+
+	var collect func(conditions []skillGroupCondition)
+	collect = func(conditions []skillGroupCondition) {
+		for _, condition := range conditions {
+			for _, skillCondition := range condition.RoutingSkillConditions {
+				skillNames[skillCondition.RoutingSkill] = true
+				collect(skillCondition.ChildConditions)
+			}
+			for _, languageCondition := range condition.LanguageSkillConditions {
+				languageNames[languageCondition.LanguageSkill] = true
+				collect(languageCondition.ChildConditions)
+			}
+		}
+	}
+	collect(conditions)
+
+	for name := range skillNames {
+		skills = append(skills, name)
+	}
+	for name := range languageNames {
+		languages = append(languages, name)
+	}
+	sort.Strings(skills)
+	sort.Strings(languages)
+	return skills, languages
+}
+
+// missingSkillConditionReferences returns the referenced names that are not in the existing names. Names are matched case insensitively.
+func missingSkillConditionReferences(referencedNames []string, existingNames []string) []string {
+	existing := make(map[string]bool, len(existingNames))
+	for _, name := range existingNames {
+		existing[strings.ToLower(name)] = true
+	}
+	var missing []string
+	for _, name := range referencedNames {
+		if !existing[strings.ToLower(name)] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// matchesSkillConditions returns true if the candidate matches any of the top level conditions
+func (c *skillGroupCandidate) matchesSkillConditions(conditions []skillGroupCondition) bool {
+	for _, condition := range conditions {
+		if c.matchesSkillCondition(condition) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesSkillCondition combines the routing and language skill conditions with the operation of the condition.
+// A condition without any skill conditions does not restrict the members.
+func (c *skillGroupCandidate) matchesSkillCondition(condition skillGroupCondition) bool {
+	results := make([]bool, 0, len(condition.RoutingSkillConditions)+len(condition.LanguageSkillConditions))
+	for _, skillCondition := range condition.RoutingSkillConditions {
+		results = append(results, c.matchesProficiency(c.skills, skillCondition.RoutingSkill, skillCondition))
+	}
+	for _, languageCondition := range condition.LanguageSkillConditions {
+		results = append(results, c.matchesProficiency(c.languages, languageCondition.LanguageSkill, languageCondition))
+	}
+	if len(results) == 0 {
+		return true
+	}
+
+	for _, result := range results {
+		if condition.Operation == "Or" && result {
+			return true
+		}
+		if condition.Operation != "Or" && !result {
+			return false
+		}
+	}
+	return condition.Operation != "Or"
+}
+
+// matchesProficiency checks the proficiency of a skill or language and all of the nested conditions of the skill condition
+func (c *skillGroupCandidate) matchesProficiency(proficiencies map[string]float64, name string, skillCondition skillGroupSkillCondition) bool {
+	proficiency, ok := proficiencies[strings.ToLower(name)]
+	if !ok || skillCondition.Proficiency == nil || !compareProficiency(proficiency, skillCondition.Comparator, float64(*skillCondition.Proficiency)) {
+		return false
+	}
+	for _, childCondition := range skillCondition.ChildConditions {
+		if !c.matchesSkillCondition(childCondition) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareProficiency(actual float64, comparator string, expected float64) bool {
+	switch comparator {
+	case "EqualTo":
+		return actual == expected
+	case "NotEqualTo":
+		return actual != expected
+	case "GreaterThan":
+		return actual > expected
+	case "GreaterThanOrEqualTo":
+		return actual >= expected
+	case "LessThan":
+		return actual < expected
+	case "LessThanOrEqualTo":
+		return actual <= expected
+	}
+	return false
+}
+
+// checkSkillGroupConditions checks that every skill and language referenced by the skill conditions exists. Skills and languages
+// created earlier in the same apply are not always listed straight away, so missing references are retried for a short time.
+func checkSkillGroupConditions(ctx context.Context, d *schema.ResourceData, routingAPI *platformclientv2.RoutingApi) diag.Diagnostics {
+	skillConditionsJson := d.Get("skill_conditions").(string)
+	if skillConditionsJson == "" || !d.HasChange("skill_conditions") {
+		return nil
+	}
+	conditions, err := parseSkillConditions(skillConditionsJson)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	referencedSkills, referencedLanguages := skillConditionReferences(conditions)
+
+	return WithRetries(ctx, 20*time.Second, func() *retry.RetryError {
+		var errs []string
+		if len(referencedSkills) > 0 {
+			skills, diagErr := getAllRoutingSkills(ctx, routingAPI.Configuration)
+			if diagErr != nil {
+				return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+			}
+			for _, name := range missingSkillConditionReferences(referencedSkills, resourceMetaNames(skills)) {
+				errs = append(errs, fmt.Sprintf("routing skill %q does not exist", name))
+			}
+		}
+		if len(referencedLanguages) > 0 {
+			languages, diagErr := getAllRoutingLanguages(ctx, routingAPI.Configuration)
+			if diagErr != nil {
+				return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+			}
+			for _, name := range missingSkillConditionReferences(referencedLanguages, resourceMetaNames(languages)) {
+				errs = append(errs, fmt.Sprintf("language %q does not exist", name))
+			}
+		}
+		if len(errs) > 0 {
+			return retry.RetryableError(fmt.Errorf("invalid skill_conditions of skill group %s: %s", d.Get("name").(string), strings.Join(errs, "; ")))
+		}
+		return nil
+	})
+}
+
+// evaluateSkillGroupConditions returns a warning if the skill conditions do not match any active user in the member divisions
+func evaluateSkillGroupConditions(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	skillConditionsJson := d.Get("skill_conditions").(string)
+	if skillConditionsJson == "" || !d.HasChanges("skill_conditions", "division_id", "member_division_ids") {
+		return nil
+	}
+	conditions, err := parseSkillConditions(skillConditionsJson)
+	if err != nil || len(conditions) == 0 {
+		return nil
+	}
+
+	divisionIds, diagErr := skillGroupMemberDivisions(d)
+	if diagErr != nil {
+		return diagErr
+	}
+	candidates, diagErr := getSkillGroupCandidates(sdkConfig, divisionIds)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	for _, candidate := range candidates {
+		if candidate.matchesSkillConditions(conditions) {
+			return nil
+		}
+	}
+
+	log.Printf("Skill conditions of skill group %s do not match any of %d users", d.Get("name").(string), len(candidates))
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Skill group %s has no members", d.Get("name").(string)),
+		Detail:   "The skill_conditions do not match any active user in the member divisions of the skill group.",
+	}}
+}
+
+// skillGroupMemberDivisions returns the divisions of the users that can become members, or nil if users of all divisions can
+func skillGroupMemberDivisions(d *schema.ResourceData) (map[string]bool, diag.Diagnostics) {
+	memberDivisionIds := lists.InterfaceListToStrings(d.Get("member_division_ids").([]interface{}))
+	if allMemberDivisionsSpecified(memberDivisionIds) {
+		return nil, nil
+	}
+
+	divisionId := d.Get("division_id").(string)
+	if divisionId == "" {
+		homeDivisionId, diagErr := GetHomeDivisionID()
+		if diagErr != nil {
+			return nil, diagErr
+		}
+		divisionId = homeDivisionId
+	}
+
+	divisionIds := map[string]bool{divisionId: true}
+	for _, id := range memberDivisionIds {
+		divisionIds[id] = true
+	}
+	return divisionIds, nil
+}
+
+// getSkillGroupCandidates returns the skills and languages of all active users in the divisions. A nil divisions map includes every user.
+func getSkillGroupCandidates(sdkConfig *platformclientv2.Configuration, divisionIds map[string]bool) ([]skillGroupCandidate, diag.Diagnostics) {
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	candidates := make([]skillGroupCandidate, 0)
+
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		users, _, err := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", []string{"skills", "languages"}, "", "active")
+		if err != nil {
+			return nil, diag.Errorf("Failed to get page of users: %s", err)
+		}
+		if users.Entities == nil || len(*users.Entities) == 0 {
+			break
+		}
+		for _, user := range *users.Entities {
+			if divisionIds != nil && (user.Division == nil || user.Division.Id == nil || !divisionIds[*user.Division.Id]) {
+				continue
+			}
+			candidates = append(candidates, newSkillGroupCandidate(user))
+		}
+		if users.PageCount == nil || pageNum >= *users.PageCount {
+			break
+		}
+	}
+	return candidates, nil
+}
+
+func newSkillGroupCandidate(user platformclientv2.User) skillGroupCandidate {
+	candidate := skillGroupCandidate{
+		skills:    make(map[string]float64),
+		languages: make(map[string]float64),
+	}
+	if user.Skills != nil {
+		for _, skill := range *user.Skills {
+			if skill.Name != nil && skill.Proficiency != nil {
+				candidate.skills[strings.ToLower(*skill.Name)] = *skill.Proficiency
+			}
+		}
+	}
+	if user.Languages != nil {
+		for _, language := range *user.Languages {
+			if language.Name != nil && language.Proficiency != nil {
+				candidate.languages[strings.ToLower(*language.Name)] = *language.Proficiency
+			}
+		}
+	}
+	return candidate
+}
+
+func resourceMetaNames(resources map[string]*resourceExporter.ResourceMeta) []string {
+	names := make([]string, 0, len(resources))
+	for _, meta := range resources {
+		names = append(names, meta.Name)
+	}
+	return names
+}
@@ -0,0 +1,158 @@
+package genesyscloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitValidateSkillConditions(t *testing.T) {
+	testCases := []struct {
+		name            string
+		skillConditions string
+		expectedErrors  []string
+	}{
+		{
+			name: "valid",
+			skillConditions: `[{
+				"routingSkillConditions": [{
+					"routingSkill": "Series 6", "comparator": "GreaterThan", "proficiency": 2,
+					"childConditions": [{"routingSkillConditions": [], "languageSkillConditions": [], "operation": "And"}]
+				}],
+				"languageSkillConditions": [{"languageSkill": "French", "comparator": "EqualTo", "proficiency": 5}],
+				"operation": "Or"
+			}]`,
+		},
+		{
+			name:            "not an array",
+			skillConditions: `{"operation": "And"}`,
+			expectedErrors:  []string{"skill_conditions must be a JSON encoded array of conditions"},
+		},
+		{
+			name:            "invalid operation",
+			skillConditions: `[{"operation": "Xor"}]`,
+			expectedErrors:  []string{`[0]: operation "Xor" must be one of And, Or`},
+		},
+		{
+			name:            "invalid comparator and proficiency",
+			skillConditions: `[{"routingSkillConditions": [{"routingSkill": "Series 6", "comparator": "Above", "proficiency": 6}], "operation": "And"}]`,
+			expectedErrors: []string{
+				`[0].routingSkillConditions[0]: comparator "Above" must be one of`,
+				"[0].routingSkillConditions[0]: proficiency 6 must be between 0 and 5",
+			},
+		},
+		{
+			name: "invalid child condition",
+			skillConditions: `[{"languageSkillConditions": [{
+				"comparator": "EqualTo", "proficiency": 1,
+				"childConditions": [{"routingSkillConditions": [{"routingSkill": "Series 6", "comparator": "EqualTo"}], "operation": "And"}]
+			}], "operation": "And"}]`,
+			expectedErrors: []string{
+				"[0].languageSkillConditions[0]: languageSkill is required",
+				"[0].languageSkillConditions[0].childConditions[0].routingSkillConditions[0]: proficiency is required",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := ValidateSkillConditions(tc.skillConditions, cty.Path{})
+			if len(tc.expectedErrors) == 0 {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+			assert.True(t, diags.HasError())
+			for _, expectedError := range tc.expectedErrors {
+				assert.Contains(t, diags[0].Summary, expectedError)
+			}
+		})
+	}
+}
+
+func TestUnitSkillConditionReferences(t *testing.T) {
+	conditions, err := parseSkillConditions(`[{
+		"routingSkillConditions": [{
+			"routingSkill": "Series 7", "comparator": "GreaterThan", "proficiency": 2,
+			"childConditions": [{"languageSkillConditions": [{"languageSkill": "German", "comparator": "EqualTo", "proficiency": 3}], "operation": "And"}]
+		}],
+		"languageSkillConditions": [{"languageSkill": "French", "comparator": "EqualTo", "proficiency": 5}],
+		"operation": "And"
+	}, {
+		"routingSkillConditions": [{"routingSkill": "Series 6", "comparator": "LessThan", "proficiency": 4}],
+		"operation": "And"
+	}]`)
+	assert.Nil(t, err)
+
+	skills, languages := skillConditionReferences(conditions)
+	assert.Equal(t, []string{"Series 6", "Series 7"}, skills)
+	assert.Equal(t, []string{"French", "German"}, languages)
+
+	assert.Equal(t, []string{"Series 7"}, missingSkillConditionReferences(skills, []string{"series 6", "Series 8"}))
+	assert.Nil(t, missingSkillConditionReferences(languages, []string{"French", "german"}))
+}
+
+func TestUnitSkillConditionsMatch(t *testing.T) {
+	conditions, err := parseSkillConditions(`[{
+		"routingSkillConditions": [{
+			"routingSkill": "Series 6", "comparator": "GreaterThan", "proficiency": 2,
+			"childConditions": [{
+				"languageSkillConditions": [
+					{"languageSkill": "French", "comparator": "GreaterThanOrEqualTo", "proficiency": 3},
+					{"languageSkill": "German", "comparator": "GreaterThanOrEqualTo", "proficiency": 3}
+				],
+				"operation": "Or"
+			}]
+		}],
+		"languageSkillConditions": [],
+		"operation": "And"
+	}, {
+		"routingSkillConditions": [{"routingSkill": "Series 7", "comparator": "EqualTo", "proficiency": 5}],
+		"operation": "And"
+	}]`)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		name      string
+		skills    map[string]float64
+		languages map[string]float64
+		matches   bool
+	}{
+		{
+			name:      "skill and nested language",
+			skills:    map[string]float64{"series 6": 2.5},
+			languages: map[string]float64{"german": 4},
+			matches:   true,
+		},
+		{
+			name:      "skill proficiency too low",
+			skills:    map[string]float64{"series 6": 2},
+			languages: map[string]float64{"french": 5},
+		},
+		{
+			name:   "nested language missing",
+			skills: map[string]float64{"series 6": 5},
+		},
+		{
+			name:    "second rule",
+			skills:  map[string]float64{"series 7": 5},
+			matches: true,
+		},
+		{
+			name: "no skills",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			candidate := &skillGroupCandidate{skills: tc.skills, languages: tc.languages}
+			if candidate.skills == nil {
+				candidate.skills = map[string]float64{}
+			}
+			if candidate.languages == nil {
+				candidate.languages = map[string]float64{}
+			}
+			assert.Equal(t, tc.matches, candidate.matchesSkillConditions(conditions))
+		})
+	}
+}
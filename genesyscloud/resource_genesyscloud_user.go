@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -29,6 +30,19 @@ type AgentUtilizationWithLabels struct {
 	Level             string                      `json:"level"`
 }
 
+const (
+	userDeletionBehaviorDelete     = "delete"
+	userDeletionBehaviorDeactivate = "deactivate"
+	userDeletionBehaviorRetain     = "retain"
+)
+
+// deactivatedUsers holds the IDs of the users deactivated by this provider. Only these users are reactivated
+// by restore_if_deleted, so inactive users that Terraform never managed are not adopted.
+var (
+	deactivatedUsers      = make(map[string]bool)
+	deactivatedUsersMutex sync.Mutex
+)
+
 var (
 	contactTypeEmail = "EMAIL"
 
@@ -263,6 +277,22 @@ func ResourceUser() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"deletion_behavior": {
+				Description: "What happens to the user when this resource is destroyed (delete | deactivate | retain). " +
+					"`delete` deletes the user, `deactivate` sets the user state to inactive and `retain` only removes the user from the Terraform state. Default is 'delete'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      userDeletionBehaviorDelete,
+				ValidateFunc: validation.StringInSlice([]string{userDeletionBehaviorDelete, userDeletionBehaviorDeactivate, userDeletionBehaviorRetain}, false),
+			},
+			"restore_if_deleted": {
+				Description: "Reactivate an inactive user with the same email instead of failing to create the user, when the user was deactivated by this provider earlier in the same run " +
+					"(for example when a resource with `deletion_behavior` set to `deactivate` is replaced). Inactive users that were not deactivated by this provider are never reactivated. " +
+					"A deleted user with the same email is always restored. Restored users keep their ID, so recordings and evaluations remain linked to them. Default is false.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"routing_skills": {
 				Description: "Skills and proficiencies for this user. If not set, this resource will not manage user skills.",
 				Type:        schema.TypeSet,
@@ -363,12 +393,13 @@ func ResourceUser() *schema.Resource {
 				},
 			},
 			"routing_utilization": {
-				Description: "The routing utilization settings for this user. If empty list, the org default settings are used. If not set, this resource will not manage the users's utilization settings.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed:    true,
-				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "The routing utilization settings for this user. If empty list, the org default settings are used. If not set, this resource will not manage the users's utilization settings. " +
+					"Use genesyscloud_user_routing_utilization instead to manage utilization separately from the user.",
+				Type:       schema.TypeList,
+				MaxItems:   1,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"call": {
@@ -441,22 +472,18 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	title := d.Get("title").(string)
 	manager := d.Get("manager").(string)
 	acdAutoAnswer := d.Get("acd_auto_answer").(bool)
+	restoreIfDeleted := d.Get("restore_if_deleted").(bool)
 
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	// Clear the shared user cache once the user has been written, even if a later step fails
+	defer meta.(*ProviderMeta).UserCache.Invalidate()
 
 	addresses, addrErr := buildSdkAddresses(d)
 	if addrErr != nil {
 		return addrErr
 	}
 
-	// Check for a deleted user before creating
-	id, _ := getDeletedUserId(email, usersAPI)
-	if id != nil {
-		d.SetId(*id)
-		return restoreDeletedUser(ctx, d, meta, usersAPI)
-	}
-
 	createUser := platformclientv2.Createuser{
 		Email:      &email,
 		Name:       &name,
@@ -488,6 +515,19 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 				d.SetId(*id)
 				return restoreDeletedUser(ctx, d, meta, usersAPI)
 			}
+
+			// Check for a user deactivated by this provider
+			if restoreIfDeleted {
+				id, diagErr = getUserIdByEmailAndState(email, "inactive", usersAPI)
+				if diagErr != nil {
+					return diagErr
+				}
+				if id != nil && takeDeactivatedUser(*id) {
+					log.Printf("Restoring inactive user %s", email)
+					d.SetId(*id)
+					return updateUser(ctx, d, meta)
+				}
+			}
 		}
 		return diag.Errorf("Failed to create user %s: %s", email, err)
 	}
@@ -623,6 +663,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	defer meta.(*ProviderMeta).UserCache.Invalidate()
 
 	addresses, err := buildSdkAddresses(d)
 	if err != nil {
@@ -689,9 +730,27 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	email := d.Get("email").(string)
+	deletionBehavior := d.Get("deletion_behavior").(string)
 
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	defer meta.(*ProviderMeta).UserCache.Invalidate()
+
+	switch deletionBehavior {
+	case userDeletionBehaviorRetain:
+		log.Printf("Retaining user %s. Removing it from the state only", email)
+		return nil
+	case userDeletionBehaviorDeactivate:
+		log.Printf("Deactivating user %s", email)
+		inactive := "inactive"
+		if diagErr := patchUser(d.Id(), platformclientv2.Updateuser{
+			State: &inactive,
+		}, usersAPI); diagErr != nil {
+			return diagErr
+		}
+		setDeactivatedUser(d.Id())
+		return nil
+	}
 
 	log.Printf("Deleting user %s", email)
 	err := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
@@ -721,6 +780,24 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	})
 }
 
+// setDeactivatedUser records that this provider deactivated the user
+func setDeactivatedUser(id string) {
+	deactivatedUsersMutex.Lock()
+	defer deactivatedUsersMutex.Unlock()
+	deactivatedUsers[id] = true
+}
+
+// takeDeactivatedUser reports whether this provider deactivated the user, and forgets the user so it is only reactivated once
+func takeDeactivatedUser(id string) bool {
+	deactivatedUsersMutex.Lock()
+	defer deactivatedUsersMutex.Unlock()
+	if !deactivatedUsers[id] {
+		return false
+	}
+	delete(deactivatedUsers, id)
+	return true
+}
+
 func patchUser(id string, update platformclientv2.Updateuser, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
 	return patchUserWithState(id, "", update, usersAPI)
 }
@@ -742,6 +819,10 @@ func patchUserWithState(id string, state string, update platformclientv2.Updateu
 }
 
 func getDeletedUserId(email string, usersAPI *platformclientv2.UsersApi) (*string, diag.Diagnostics) {
+	return getUserIdByEmailAndState(email, "deleted", usersAPI)
+}
+
+func getUserIdByEmailAndState(email string, state string, usersAPI *platformclientv2.UsersApi) (*string, diag.Diagnostics) {
 	exactType := "EXACT"
 	results, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
 		Query: &[]platformclientv2.Usersearchcriteria{
@@ -752,7 +833,7 @@ func getDeletedUserId(email string, usersAPI *platformclientv2.UsersApi) (*strin
 			},
 			{
 				Fields:  &[]string{"state"},
-				Values:  &[]string{"deleted"},
+				Values:  &[]string{state},
 				VarType: &exactType,
 			},
 		},
@@ -91,6 +91,8 @@ func readUserRoles(ctx context.Context, d *schema.ResourceData, meta interface{}
 func updateUserRoles(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+	// The cached users include their roles
+	defer meta.(*ProviderMeta).UserCache.Invalidate()
 
 	log.Printf("Updating roles for user %s", d.Id())
 	diagErr := updateSubjectRoles(ctx, d, authAPI, "PC_USER")
@@ -154,9 +154,10 @@ func TestAccResourceUserBasic(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_user." + userResource2,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_user." + userResource2,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_behavior", "restore_if_deleted"},
 			},
 		},
 		CheckDestroy: testVerifyUsersDestroyed,
@@ -215,9 +216,10 @@ func TestAccResourceUserAddresses(t *testing.T) {
 			},
 			{
 				// Import/Read
-				ResourceName:      "genesyscloud_user." + addrUserResource1,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "genesyscloud_user." + addrUserResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_behavior", "restore_if_deleted"},
 			},
 			{
 				// Update phone number and other email attributes
@@ -1103,6 +1105,73 @@ func TestAccResourceUserCreateWhenDestroyed(t *testing.T) {
 	})
 }
 
+func TestAccResourceUserDeletionBehavior(t *testing.T) {
+	t.Parallel()
+	var (
+		userResource1 = "test-user"
+		email1        = "terraform-" + uuid.NewString() + "@example.com"
+		userName1     = "Terraform Deactivate1"
+		userName2     = "Terraform Deactivate2"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create a user that is deactivated when destroyed
+				Config: GenerateUserWithCustomAttrs(userResource1, email1, userName1,
+					`deletion_behavior = "deactivate"`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "deletion_behavior", "deactivate"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "restore_if_deleted", "false"),
+				),
+			},
+			{
+				Config: GenerateUserWithCustomAttrs(userResource1, email1, userName1,
+					`deletion_behavior = "deactivate"`,
+				),
+				Destroy: true, // Deactivate the user
+				Check:   testVerifyUserState(email1, "inactive"),
+			},
+			{
+				// Reactivate the same user email but set a different name
+				Config: GenerateUserWithCustomAttrs(userResource1, email1, userName2,
+					`deletion_behavior = "delete"`,
+					`restore_if_deleted = true`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "email", email1),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "name", userName2),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "state", "active"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func testVerifyUserState(email string, expectedState string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		usersAPI := platformclientv2.NewUsersApi()
+		diagErr := WithRetries(context.Background(), 60*time.Second, func() *retry.RetryError {
+			id, diagErr := getUserIdByEmailAndState(email, expectedState, usersAPI)
+			if diagErr != nil {
+				return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+			}
+			if id == nil {
+				return retry.RetryableError(fmt.Errorf("User %s not yet in %s state", email, expectedState))
+			}
+			return nil
+		})
+		if diagErr != nil {
+			return fmt.Errorf("%v", diagErr)
+		}
+		return nil
+	}
+}
+
 func testVerifyUsersDestroyed(state *terraform.State) error {
 	usersAPI := platformclientv2.NewUsersApi()
 
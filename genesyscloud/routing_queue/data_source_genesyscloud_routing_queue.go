@@ -0,0 +1,38 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_routing_queue.go contains the data source implementation
+   for the resource.
+*/
+
+// dataSourceRoutingQueueRead retrieves by name the id in question
+func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		queueId, retryable, _, err := proxy.getRoutingQueueIdByName(ctx, name)
+		if err != nil && !retryable {
+			return retry.NonRetryableError(fmt.Errorf("error requesting queue %s: %s", name, err))
+		}
+		if retryable {
+			return retry.RetryableError(fmt.Errorf("no routing queues found with name %s", name))
+		}
+
+		d.SetId(queueId)
+		return nil
+	})
+}
@@ -1,8 +1,9 @@
-package genesyscloud
+package routing_queue
 
 import (
 	"fmt"
 	"strconv"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	"testing"
 
 	"github.com/google/uuid"
@@ -19,8 +20,8 @@ func TestAccDataSourceRoutingQueueBasic(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create
@@ -28,15 +29,15 @@ func TestAccDataSourceRoutingQueueBasic(t *testing.T) {
 					queueResource,
 					queueName,
 					queueDesc,
-					NullValue, // MANDATORY_TIMEOUT
-					"200000",  // acw_timeout
-					NullValue, // ALL
-					NullValue, // auto_answer_only true
-					NullValue, // No calling party name
-					NullValue, // No calling party number
-					NullValue, // enable_manual_assignment false
-					NullValue, //suppressCall_record_false
-					NullValue, // enable_transcription false
+					gcloud.NullValue, // MANDATORY_TIMEOUT
+					"200000",         // acw_timeout
+					gcloud.NullValue, // ALL
+					gcloud.NullValue, // auto_answer_only true
+					gcloud.NullValue, // No calling party name
+					gcloud.NullValue, // No calling party number
+					gcloud.NullValue, // enable_manual_assignment false
+					gcloud.NullValue, //suppressCall_record_false
+					gcloud.NullValue, // enable_transcription false
 				) + generateRoutingQueueDataSource(
 					queueDataSource,
 					"genesyscloud_routing_queue."+queueResource+".name",
@@ -67,8 +68,8 @@ func TestAccDataSourceRoutingQueueCaching(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				Config: generateRoutingQueueResourceBasic( // queue resource
@@ -0,0 +1,54 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The data_source_genesyscloud_routing_queue_wrapupcodes.go contains the data source implementation
+   for the wrapup codes of a queue.
+*/
+
+// dataSourceRoutingQueueWrapupCodesRead retrieves the wrapup codes assigned to a queue
+func dataSourceRoutingQueueWrapupCodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	queueId := d.Get("queue_id").(string)
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		codes, resp, err := proxy.getRoutingQueueWrapupCodes(ctx, queueId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("no routing queue found with id %s", queueId))
+			}
+			return retry.NonRetryableError(fmt.Errorf("error requesting wrapup codes of queue %s: %s", queueId, err))
+		}
+
+		codeIds := make([]string, 0)
+		wrapupCodes := make([]interface{}, 0)
+		for _, code := range *codes {
+			if code.Id == nil {
+				continue
+			}
+			codeMap := map[string]interface{}{"id": *code.Id}
+			if code.Name != nil {
+				codeMap["name"] = *code.Name
+			}
+			codeIds = append(codeIds, *code.Id)
+			wrapupCodes = append(wrapupCodes, codeMap)
+		}
+
+		d.SetId(queueId)
+		_ = d.Set("wrapup_code_ids", codeIds)
+		_ = d.Set("wrapup_codes", wrapupCodes)
+		return nil
+	})
+}
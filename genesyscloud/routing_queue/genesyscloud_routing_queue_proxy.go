@@ -0,0 +1,448 @@
+package routing_queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_queue_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+Each proxy implementation:
+
+1.  Should provide a private package level variable that holds a instance of a proxy class.
+2.  A New... constructor function to initialize the proxy object. This constructor should only be used within
+    the proxy.
+3.  A get private constructor function that the classes in the package can be used to retrieve
+    the proxy. This proxy should check to see if the package level proxy instance is nil and
+    should initialize it, otherwise it should return the instance
+4.  Type definitions for each function that will be used in the proxy.  We use composition here
+    so that we can easily provide mocks for testing.
+5.  A struct for the proxy that holds an attribute for each function type.
+6.  Wrapper methods on each of the elements on the struct.
+7.  Function implementations for each function type definition.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingQueueProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllRoutingQueuesFunc func(ctx context.Context, p *routingQueueProxy, name string) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type createRoutingQueueFunc func(ctx context.Context, p *routingQueueProxy, createReq *platformclientv2.Createqueuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type getRoutingQueueByIdFunc func(ctx context.Context, p *routingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type getRoutingQueueIdByNameFunc func(ctx context.Context, p *routingQueueProxy, name string) (queueId string, retryable bool, resp *platformclientv2.APIResponse, err error)
+type updateRoutingQueueFunc func(ctx context.Context, p *routingQueueProxy, queueId string, updateReq *platformclientv2.Queuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type deleteRoutingQueueFunc func(ctx context.Context, p *routingQueueProxy, queueId string, forceDelete bool) (*platformclientv2.APIResponse, error)
+type getRoutingQueueMembersFunc func(ctx context.Context, p *routingQueueProxy, queueId string, memberBy string, name string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error)
+type addOrRemoveRoutingQueueMembersFunc func(ctx context.Context, p *routingQueueProxy, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error)
+type updateRoutingQueueMemberFunc func(ctx context.Context, p *routingQueueProxy, queueId string, userId string, member platformclientv2.Queuemember) (*platformclientv2.APIResponse, error)
+type getUserFunc func(ctx context.Context, p *routingQueueProxy, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type getRoutingQueueWrapupCodesFunc func(ctx context.Context, p *routingQueueProxy, queueId string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error)
+type addRoutingQueueWrapupCodesFunc func(ctx context.Context, p *routingQueueProxy, queueId string, codes []platformclientv2.Wrapupcodereference) (*platformclientv2.APIResponse, error)
+type deleteRoutingQueueWrapupCodeFunc func(ctx context.Context, p *routingQueueProxy, queueId string, codeId string) (*platformclientv2.APIResponse, error)
+type updateRoutingQueueDivisionFunc func(ctx context.Context, p *routingQueueProxy, queueId string, divisionId string) (*platformclientv2.APIResponse, error)
+
+// routingQueueProxy contains all of the methods that call genesys cloud APIs.
+type routingQueueProxy struct {
+	clientConfig *platformclientv2.Configuration
+	routingApi   *platformclientv2.RoutingApi
+	usersApi     *platformclientv2.UsersApi
+	authApi      *platformclientv2.AuthorizationApi
+
+	getAllRoutingQueuesAttr            getAllRoutingQueuesFunc
+	createRoutingQueueAttr             createRoutingQueueFunc
+	getRoutingQueueByIdAttr            getRoutingQueueByIdFunc
+	getRoutingQueueIdByNameAttr        getRoutingQueueIdByNameFunc
+	updateRoutingQueueAttr             updateRoutingQueueFunc
+	deleteRoutingQueueAttr             deleteRoutingQueueFunc
+	getRoutingQueueMembersAttr         getRoutingQueueMembersFunc
+	addOrRemoveRoutingQueueMembersAttr addOrRemoveRoutingQueueMembersFunc
+	updateRoutingQueueMemberAttr       updateRoutingQueueMemberFunc
+	getUserAttr                        getUserFunc
+	getRoutingQueueWrapupCodesAttr     getRoutingQueueWrapupCodesFunc
+	addRoutingQueueWrapupCodesAttr     addRoutingQueueWrapupCodesFunc
+	deleteRoutingQueueWrapupCodeAttr   deleteRoutingQueueWrapupCodeFunc
+	updateRoutingQueueDivisionAttr     updateRoutingQueueDivisionFunc
+
+	// queueCache maps normalized queue names to queue IDs for name lookups
+	queueCache      map[string]string
+	queueCacheMutex sync.RWMutex
+
+	// memberUpdateDelay is how long to wait before checking new members against the group members of a queue
+	memberUpdateDelay time.Duration
+}
+
+// newRoutingQueueProxy initializes the routing queue proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingQueueProxy(clientConfig *platformclientv2.Configuration) *routingQueueProxy {
+	return &routingQueueProxy{
+		clientConfig: clientConfig,
+		routingApi:   platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		usersApi:     platformclientv2.NewUsersApiWithConfig(clientConfig),
+		authApi:      platformclientv2.NewAuthorizationApiWithConfig(clientConfig),
+
+		getAllRoutingQueuesAttr:            getAllRoutingQueuesFn,
+		createRoutingQueueAttr:             createRoutingQueueFn,
+		getRoutingQueueByIdAttr:            getRoutingQueueByIdFn,
+		getRoutingQueueIdByNameAttr:        getRoutingQueueIdByNameFn,
+		updateRoutingQueueAttr:             updateRoutingQueueFn,
+		deleteRoutingQueueAttr:             deleteRoutingQueueFn,
+		getRoutingQueueMembersAttr:         getRoutingQueueMembersFn,
+		addOrRemoveRoutingQueueMembersAttr: addOrRemoveRoutingQueueMembersFn,
+		updateRoutingQueueMemberAttr:       updateRoutingQueueMemberFn,
+		getUserAttr:                        getUserFn,
+		getRoutingQueueWrapupCodesAttr:     getRoutingQueueWrapupCodesFn,
+		addRoutingQueueWrapupCodesAttr:     addRoutingQueueWrapupCodesFn,
+		deleteRoutingQueueWrapupCodeAttr:   deleteRoutingQueueWrapupCodeFn,
+		updateRoutingQueueDivisionAttr:     updateRoutingQueueDivisionFn,
+
+		memberUpdateDelay: 10 * time.Second,
+	}
+}
+
+// getRoutingQueueProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingQueueProxy(clientConfig *platformclientv2.Configuration) *routingQueueProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingQueueProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllRoutingQueues retrieves all Genesys Cloud routing queues, optionally filtered by name
+func (p *routingQueueProxy) getAllRoutingQueues(ctx context.Context, name string) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getAllRoutingQueuesAttr(ctx, p, name)
+}
+
+// createRoutingQueue creates a Genesys Cloud routing queue
+func (p *routingQueueProxy) createRoutingQueue(ctx context.Context, createReq *platformclientv2.Createqueuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.createRoutingQueueAttr(ctx, p, createReq)
+}
+
+// getRoutingQueueById retrieves a Genesys Cloud routing queue by ID
+func (p *routingQueueProxy) getRoutingQueueById(ctx context.Context, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueByIdAttr(ctx, p, queueId)
+}
+
+// getRoutingQueueIdByName retrieves the ID of a Genesys Cloud routing queue by name
+func (p *routingQueueProxy) getRoutingQueueIdByName(ctx context.Context, name string) (string, bool, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueIdByNameAttr(ctx, p, name)
+}
+
+// updateRoutingQueue updates a Genesys Cloud routing queue
+func (p *routingQueueProxy) updateRoutingQueue(ctx context.Context, queueId string, updateReq *platformclientv2.Queuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.updateRoutingQueueAttr(ctx, p, queueId, updateReq)
+}
+
+// deleteRoutingQueue deletes a Genesys Cloud routing queue
+func (p *routingQueueProxy) deleteRoutingQueue(ctx context.Context, queueId string, forceDelete bool) (*platformclientv2.APIResponse, error) {
+	return p.deleteRoutingQueueAttr(ctx, p, queueId, forceDelete)
+}
+
+// getRoutingQueueMembers retrieves all members of a Genesys Cloud routing queue
+func (p *routingQueueProxy) getRoutingQueueMembers(ctx context.Context, queueId string, memberBy string, name string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueMembersAttr(ctx, p, queueId, memberBy, name)
+}
+
+// addOrRemoveRoutingQueueMembers adds members to or removes members from a Genesys Cloud routing queue
+func (p *routingQueueProxy) addOrRemoveRoutingQueueMembers(ctx context.Context, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	return p.addOrRemoveRoutingQueueMembersAttr(ctx, p, queueId, members, remove)
+}
+
+// updateRoutingQueueMember updates a member of a Genesys Cloud routing queue
+func (p *routingQueueProxy) updateRoutingQueueMember(ctx context.Context, queueId string, userId string, member platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
+	return p.updateRoutingQueueMemberAttr(ctx, p, queueId, userId, member)
+}
+
+// getUser retrieves a Genesys Cloud user by ID
+func (p *routingQueueProxy) getUser(ctx context.Context, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getUserAttr(ctx, p, userId)
+}
+
+// getRoutingQueueWrapupCodes retrieves all wrapup codes assigned to a Genesys Cloud routing queue
+func (p *routingQueueProxy) getRoutingQueueWrapupCodes(ctx context.Context, queueId string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueWrapupCodesAttr(ctx, p, queueId)
+}
+
+// addRoutingQueueWrapupCodes assigns wrapup codes to a Genesys Cloud routing queue
+func (p *routingQueueProxy) addRoutingQueueWrapupCodes(ctx context.Context, queueId string, codes []platformclientv2.Wrapupcodereference) (*platformclientv2.APIResponse, error) {
+	return p.addRoutingQueueWrapupCodesAttr(ctx, p, queueId, codes)
+}
+
+// deleteRoutingQueueWrapupCode removes a wrapup code from a Genesys Cloud routing queue
+func (p *routingQueueProxy) deleteRoutingQueueWrapupCode(ctx context.Context, queueId string, codeId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteRoutingQueueWrapupCodeAttr(ctx, p, queueId, codeId)
+}
+
+// updateRoutingQueueDivision moves a Genesys Cloud routing queue to another division
+func (p *routingQueueProxy) updateRoutingQueueDivision(ctx context.Context, queueId string, divisionId string) (*platformclientv2.APIResponse, error) {
+	return p.updateRoutingQueueDivisionAttr(ctx, p, queueId, divisionId)
+}
+
+// getAllRoutingQueuesFn is the implementation for retrieving all routing queues in Genesys Cloud
+func getAllRoutingQueuesFn(ctx context.Context, p *routingQueueProxy, name string) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var allQueues []platformclientv2.Queue
+
+	queues, resp, err := p.routingApi.GetRoutingQueues(1, pageSize, "", name, nil, nil, nil, false)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get first page of queues: %v", err)
+	}
+	if queues.Entities == nil || len(*queues.Entities) == 0 {
+		return &allQueues, resp, nil
+	}
+	allQueues = append(allQueues, *queues.Entities...)
+
+	if queues.PageCount == nil {
+		return &allQueues, resp, nil
+	}
+
+	for pageNum := 2; pageNum <= *queues.PageCount; pageNum++ {
+		queues, resp, err := p.routingApi.GetRoutingQueues(pageNum, pageSize, "", name, nil, nil, nil, false)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get page of queues: %v", err)
+		}
+		if queues.Entities == nil || len(*queues.Entities) == 0 {
+			break
+		}
+		allQueues = append(allQueues, *queues.Entities...)
+	}
+
+	return &allQueues, resp, nil
+}
+
+// createRoutingQueueFn is the implementation for creating a routing queue in Genesys Cloud
+func createRoutingQueueFn(ctx context.Context, p *routingQueueProxy, createReq *platformclientv2.Createqueuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	queue, resp, err := p.routingApi.PostRoutingQueues(*createReq)
+	if err != nil {
+		return nil, resp, err
+	}
+	p.updateQueueCacheEntry(queue)
+	return queue, resp, nil
+}
+
+// getRoutingQueueByIdFn is the implementation for retrieving a routing queue by ID in Genesys Cloud
+func getRoutingQueueByIdFn(ctx context.Context, p *routingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.routingApi.GetRoutingQueue(queueId)
+}
+
+// getRoutingQueueIdByNameFn is the implementation for retrieving a routing queue ID by name in Genesys Cloud.
+// The first lookup loads every queue into a name cache so that looking up many queues only lists the queues once.
+func getRoutingQueueIdByNameFn(ctx context.Context, p *routingQueueProxy, name string) (string, bool, *platformclientv2.APIResponse, error) {
+	p.queueCacheMutex.RLock()
+	cacheLoaded := p.queueCache != nil
+	queueId, found := p.queueCache[normalizeQueueName(name)]
+	p.queueCacheMutex.RUnlock()
+
+	if found {
+		log.Printf("found queue %s in cache", name)
+		return queueId, false, nil, nil
+	}
+
+	if !cacheLoaded {
+		queues, resp, err := p.getAllRoutingQueues(ctx, "")
+		if err != nil {
+			return "", false, resp, err
+		}
+		p.queueCacheMutex.Lock()
+		p.queueCache = make(map[string]string)
+		for _, queue := range *queues {
+			p.queueCache[normalizeQueueName(*queue.Name)] = *queue.Id
+		}
+		queueId, found = p.queueCache[normalizeQueueName(name)]
+		p.queueCacheMutex.Unlock()
+		if found {
+			return queueId, false, resp, nil
+		}
+	}
+
+	// The queue may have been created after the cache was loaded
+	log.Printf("could not find routing queue %s in cache. Will try API to find value", name)
+	queues, resp, err := p.getAllRoutingQueues(ctx, name)
+	if err != nil {
+		return "", false, resp, fmt.Errorf("error requesting queue %s: %v", name, err)
+	}
+	for _, queue := range *queues {
+		if queue.Name != nil && normalizeQueueName(*queue.Name) == normalizeQueueName(name) {
+			p.updateQueueCacheEntry(&queue)
+			return *queue.Id, false, resp, nil
+		}
+	}
+	return "", true, resp, fmt.Errorf("no routing queues found with name %s", name)
+}
+
+// updateRoutingQueueFn is the implementation for updating a routing queue in Genesys Cloud
+func updateRoutingQueueFn(ctx context.Context, p *routingQueueProxy, queueId string, updateReq *platformclientv2.Queuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	queue, resp, err := p.routingApi.PutRoutingQueue(queueId, *updateReq)
+	if err != nil {
+		return nil, resp, err
+	}
+	p.updateQueueCacheEntry(queue)
+	return queue, resp, nil
+}
+
+// deleteRoutingQueueFn is the implementation for deleting a routing queue in Genesys Cloud
+func deleteRoutingQueueFn(ctx context.Context, p *routingQueueProxy, queueId string, forceDelete bool) (*platformclientv2.APIResponse, error) {
+	resp, err := p.routingApi.DeleteRoutingQueue(queueId, forceDelete)
+	if err != nil {
+		return resp, err
+	}
+
+	p.queueCacheMutex.Lock()
+	for name, id := range p.queueCache {
+		if id == queueId {
+			delete(p.queueCache, name)
+		}
+	}
+	p.queueCacheMutex.Unlock()
+	return resp, nil
+}
+
+// getRoutingQueueMembersFn is the implementation for retrieving all members of a routing queue in Genesys Cloud
+func getRoutingQueueMembersFn(ctx context.Context, p *routingQueueProxy, queueId string, memberBy string, name string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var members []platformclientv2.Queuemember
+
+	for pageNum := 1; ; pageNum++ {
+		users, resp, err := sdkGetRoutingQueueMembers(queueId, memberBy, name, pageNum, pageSize, p.routingApi)
+		if err != nil {
+			return nil, resp, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, resp, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+		if users == nil || users.Entities == nil || len(*users.Entities) == 0 {
+			return &members, resp, nil
+		}
+		members = append(members, *users.Entities...)
+	}
+}
+
+// addOrRemoveRoutingQueueMembersFn is the implementation for adding or removing members of a routing queue in Genesys Cloud
+func addOrRemoveRoutingQueueMembersFn(ctx context.Context, p *routingQueueProxy, queueId string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.PostRoutingQueueMembers(queueId, members, remove)
+}
+
+// updateRoutingQueueMemberFn is the implementation for updating a member of a routing queue in Genesys Cloud
+func updateRoutingQueueMemberFn(ctx context.Context, p *routingQueueProxy, queueId string, userId string, member platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.PatchRoutingQueueMember(queueId, userId, member)
+}
+
+// getUserFn is the implementation for retrieving a user in Genesys Cloud
+func getUserFn(ctx context.Context, p *routingQueueProxy, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.usersApi.GetUser(userId, nil, "", "")
+}
+
+// getRoutingQueueWrapupCodesFn is the implementation for retrieving the wrapup codes of a routing queue in Genesys Cloud
+func getRoutingQueueWrapupCodesFn(ctx context.Context, p *routingQueueProxy, queueId string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var codes []platformclientv2.Wrapupcode
+
+	for pageNum := 1; ; pageNum++ {
+		codeResult, resp, err := p.routingApi.GetRoutingQueueWrapupcodes(queueId, pageSize, pageNum)
+		if err != nil {
+			return nil, resp, err
+		}
+		if codeResult == nil || codeResult.Entities == nil || len(*codeResult.Entities) == 0 {
+			return &codes, resp, nil
+		}
+		codes = append(codes, *codeResult.Entities...)
+	}
+}
+
+// addRoutingQueueWrapupCodesFn is the implementation for assigning wrapup codes to a routing queue in Genesys Cloud
+func addRoutingQueueWrapupCodesFn(ctx context.Context, p *routingQueueProxy, queueId string, codes []platformclientv2.Wrapupcodereference) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.routingApi.PostRoutingQueueWrapupcodes(queueId, codes)
+	return resp, err
+}
+
+// deleteRoutingQueueWrapupCodeFn is the implementation for removing a wrapup code from a routing queue in Genesys Cloud
+func deleteRoutingQueueWrapupCodeFn(ctx context.Context, p *routingQueueProxy, queueId string, codeId string) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.DeleteRoutingQueueWrapupcode(queueId, codeId)
+}
+
+// updateRoutingQueueDivisionFn is the implementation for moving a routing queue to another division in Genesys Cloud
+func updateRoutingQueueDivisionFn(ctx context.Context, p *routingQueueProxy, queueId string, divisionId string) (*platformclientv2.APIResponse, error) {
+	return p.authApi.PostAuthorizationDivisionObject(divisionId, "QUEUE", []string{queueId})
+}
+
+// updateQueueCacheEntry adds or updates the name cache entry of a queue if the cache has been loaded
+func (p *routingQueueProxy) updateQueueCacheEntry(queue *platformclientv2.Queue) {
+	if queue == nil || queue.Id == nil || queue.Name == nil {
+		return
+	}
+	p.queueCacheMutex.Lock()
+	defer p.queueCacheMutex.Unlock()
+	if p.queueCache == nil {
+		return
+	}
+	for name, id := range p.queueCache {
+		if id == *queue.Id {
+			delete(p.queueCache, name)
+		}
+	}
+	p.queueCache[normalizeQueueName(*queue.Name)] = *queue.Id
+}
+
+// normalizeQueueName normalizes a queue name for keys in the cache
+func normalizeQueueName(queueName string) string {
+	return strings.ToLower(queueName)
+}
+
+func sdkGetRoutingQueueMembers(queueID, memberBy, name string, pageNumber, pageSize int, api *platformclientv2.RoutingApi) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
+	// SDK does not support nil values for boolean query params yet, so we must manually construct this HTTP request for now
+	apiClient := &api.Configuration.APIClient
+
+	// create path and map variables
+	path := api.Configuration.BasePath + "/api/v2/routing/queues/{queueId}/members"
+	path = strings.Replace(path, "{queueId}", queueID, -1)
+
+	headerParams := make(map[string]string)
+	queryParams := make(map[string]string)
+	formParams := url.Values{}
+	var postBody interface{}
+	var postFileName string
+	var fileBytes []byte
+
+	// oauth required
+	if api.Configuration.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + api.Configuration.AccessToken
+	}
+	// add default headers if any
+	for key := range api.Configuration.DefaultHeader {
+		headerParams[key] = api.Configuration.DefaultHeader[key]
+	}
+
+	queryParams["pageSize"] = apiClient.ParameterToString(pageSize, "")
+	queryParams["pageNumber"] = apiClient.ParameterToString(pageNumber, "")
+	if memberBy != "" {
+		queryParams["memberBy"] = memberBy
+	}
+	if name != "" {
+		queryParams["name"] = name
+	}
+
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	var successPayload *platformclientv2.Queuememberentitylisting
+	response, err := apiClient.CallAPI(path, http.MethodGet, postBody, headerParams, queryParams, formParams, postFileName, fileBytes)
+	if err != nil {
+		// Nothing special to do here, but do avoid processing the response
+	} else if response.Error != nil {
+		err = fmt.Errorf(response.ErrorMessage)
+	} else {
+		err = json.Unmarshal([]byte(response.RawBody), &successPayload)
+	}
+	return successPayload, response, err
+}
@@ -0,0 +1,91 @@
+package routing_queue
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitGetRoutingQueueIdByNameCache(t *testing.T) {
+	var (
+		queue1Id   = uuid.NewString()
+		queue2Id   = uuid.NewString()
+		queue3Id   = uuid.NewString()
+		queue1Name = "Queue One"
+		queue2Name = "Queue Two"
+		queue3Name = "Queue Three"
+		calls      []string
+	)
+
+	queueProxy := newRoutingQueueProxy(&platformclientv2.Configuration{})
+	queueProxy.getAllRoutingQueuesAttr = func(ctx context.Context, p *routingQueueProxy, name string) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		calls = append(calls, name)
+		queues := []platformclientv2.Queue{
+			{Id: &queue1Id, Name: &queue1Name},
+			{Id: &queue2Id, Name: &queue2Name},
+		}
+		if name != "" {
+			queues = []platformclientv2.Queue{}
+			if name == queue3Name {
+				queues = append(queues, platformclientv2.Queue{Id: &queue3Id, Name: &queue3Name})
+			}
+		}
+		return &queues, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	ctx := context.Background()
+
+	// The first lookup loads every queue into the cache
+	id, retryable, _, err := queueProxy.getRoutingQueueIdByName(ctx, queue1Name)
+	assert.Nil(t, err)
+	assert.Equal(t, false, retryable)
+	assert.Equal(t, queue1Id, id)
+	assert.Equal(t, []string{""}, calls)
+
+	// Further lookups are served from the cache and ignore case
+	id, _, _, err = queueProxy.getRoutingQueueIdByName(ctx, "queue two")
+	assert.Nil(t, err)
+	assert.Equal(t, queue2Id, id)
+	assert.Equal(t, []string{""}, calls)
+
+	// A queue created after the cache was loaded is looked up by name and cached
+	id, _, _, err = queueProxy.getRoutingQueueIdByName(ctx, queue3Name)
+	assert.Nil(t, err)
+	assert.Equal(t, queue3Id, id)
+	assert.Equal(t, []string{"", queue3Name}, calls)
+
+	id, _, _, err = queueProxy.getRoutingQueueIdByName(ctx, queue3Name)
+	assert.Nil(t, err)
+	assert.Equal(t, queue3Id, id)
+	assert.Equal(t, []string{"", queue3Name}, calls)
+
+	// A missing queue can be retried
+	id, retryable, _, err = queueProxy.getRoutingQueueIdByName(ctx, "Missing Queue")
+	assert.NotNil(t, err)
+	assert.Equal(t, true, retryable)
+	assert.Equal(t, "", id)
+}
+
+func TestUnitRoutingQueueCacheUpdates(t *testing.T) {
+	var (
+		queueId      = uuid.NewString()
+		queueName    = "Original Queue"
+		renamedQueue = "Renamed Queue"
+	)
+
+	queueProxy := newRoutingQueueProxy(&platformclientv2.Configuration{})
+	queueProxy.queueCache = map[string]string{normalizeQueueName(queueName): queueId}
+
+	// Renaming a queue replaces its cache entry
+	queueProxy.updateQueueCacheEntry(&platformclientv2.Queue{Id: &queueId, Name: &renamedQueue})
+	assert.Equal(t, map[string]string{normalizeQueueName(renamedQueue): queueId}, queueProxy.queueCache)
+
+	// Entries are not added before the cache has been loaded
+	emptyProxy := newRoutingQueueProxy(&platformclientv2.Configuration{})
+	emptyProxy.updateQueueCacheEntry(&platformclientv2.Queue{Id: &queueId, Name: &queueName})
+	assert.Nil(t, emptyProxy.queueCache)
+}
@@ -0,0 +1,316 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// getAllRoutingQueues retrieves all of the routing queues via Terraform in the Genesys Cloud and is used for the exporter
+func getAllRoutingQueues(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingQueueProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	// Newly created resources often aren't returned unless there's a delay
+	time.Sleep(5 * time.Second)
+
+	queues, _, err := proxy.getAllRoutingQueues(ctx, "")
+	if err != nil {
+		return nil, diag.Errorf("Failed to get queues: %v", err)
+	}
+
+	for _, queue := range *queues {
+		resources[*queue.Id] = &resourceExporter.ResourceMeta{Name: *queue.Name}
+	}
+
+	return resources, nil
+}
+
+// createQueue is used by the routing_queue resource to create a Genesys Cloud routing queue
+func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	divisionID := d.Get("division_id").(string)
+	memberGroups := buildMemberGroups(d)
+
+	conditionalGroupRouting, diagErr := buildSdkConditionalGroupRouting(d)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	createQueue := platformclientv2.Createqueuerequest{
+		Name:                         platformclientv2.String(d.Get("name").(string)),
+		Description:                  platformclientv2.String(d.Get("description").(string)),
+		MediaSettings:                buildSdkMediaSettings(d),
+		RoutingRules:                 buildSdkRoutingRules(d),
+		Bullseye:                     buildSdkBullseyeSettings(d),
+		ConditionalGroupRouting:      conditionalGroupRouting,
+		AcwSettings:                  buildSdkAcwSettings(d),
+		SkillEvaluationMethod:        platformclientv2.String(d.Get("skill_evaluation_method").(string)),
+		QueueFlow:                    gcloud.BuildSdkDomainEntityRef(d, "queue_flow_id"),
+		EmailInQueueFlow:             gcloud.BuildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
+		MessageInQueueFlow:           gcloud.BuildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
+		WhisperPrompt:                gcloud.BuildSdkDomainEntityRef(d, "whisper_prompt_id"),
+		AutoAnswerOnly:               platformclientv2.Bool(d.Get("auto_answer_only").(bool)),
+		CallingPartyName:             platformclientv2.String(d.Get("calling_party_name").(string)),
+		CallingPartyNumber:           platformclientv2.String(d.Get("calling_party_number").(string)),
+		DefaultScripts:               buildSdkDefaultScriptsMap(d),
+		OutboundMessagingAddresses:   buildSdkQueueMessagingAddresses(d),
+		OutboundEmailAddress:         buildSdkQueueEmailAddress(d),
+		EnableTranscription:          platformclientv2.Bool(d.Get("enable_transcription").(bool)),
+		SuppressInQueueCallRecording: platformclientv2.Bool(d.Get("suppress_in_queue_call_recording").(bool)),
+		EnableManualAssignment:       platformclientv2.Bool(d.Get("enable_manual_assignment").(bool)),
+		DirectRouting:                buildSdkDirectRouting(d),
+		MemberGroups:                 &memberGroups,
+	}
+
+	if divisionID != "" {
+		createQueue.Division = &platformclientv2.Writabledivision{Id: &divisionID}
+	}
+
+	log.Printf("creating queue %s", *createQueue.Name)
+	queue, resp, err := proxy.createRoutingQueue(ctx, &createQueue)
+	if err != nil {
+		log.Printf("error while trying to create queue: %s. Err %s", *createQueue.Name, err)
+		return diag.Errorf("Failed to create queue %s: %s", *createQueue.Name, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return diag.Errorf("Failed to create queue %s: with httpStatus code: %d", *createQueue.Name, resp.StatusCode)
+	}
+
+	d.SetId(*queue.Id)
+
+	diagErr = updateQueueMembers(ctx, d, proxy)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateQueueWrapupCodes(ctx, d, proxy)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return readQueue(ctx, d, meta)
+}
+
+// readQueue is used by the routing_queue resource to read a Genesys Cloud routing queue
+func readQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Reading queue %s", d.Id())
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		currentQueue, resp, getErr := proxy.getRoutingQueueById(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueue())
+
+		resourcedata.SetNillableValue(d, "name", currentQueue.Name)
+		resourcedata.SetNillableValue(d, "description", currentQueue.Description)
+		resourcedata.SetNillableValue(d, "skill_evaluation_method", currentQueue.SkillEvaluationMethod)
+
+		resourcedata.SetNillableReferenceDivision(d, "division_id", currentQueue.Division)
+
+		_ = d.Set("acw_wrapup_prompt", nil)
+		_ = d.Set("acw_timeout_ms", nil)
+
+		if currentQueue.AcwSettings != nil {
+			resourcedata.SetNillableValue(d, "acw_wrapup_prompt", currentQueue.AcwSettings.WrapupPrompt)
+			resourcedata.SetNillableValue(d, "acw_timeout_ms", currentQueue.AcwSettings.TimeoutMs)
+		}
+
+		_ = d.Set("media_settings_call", nil)
+		_ = d.Set("media_settings_callback", nil)
+		_ = d.Set("media_settings_chat", nil)
+		_ = d.Set("media_settings_email", nil)
+		_ = d.Set("media_settings_message", nil)
+
+		if currentQueue.MediaSettings != nil {
+			resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "media_settings_call", currentQueue.MediaSettings.Call, flattenMediaSetting)
+			resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "media_settings_callback", currentQueue.MediaSettings.Callback, flattenMediaSettingCallback)
+			resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "media_settings_chat", currentQueue.MediaSettings.Chat, flattenMediaSetting)
+			resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "media_settings_email", currentQueue.MediaSettings.Email, flattenMediaSetting)
+			resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "media_settings_message", currentQueue.MediaSettings.Message, flattenMediaSetting)
+		}
+
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "routing_rules", currentQueue.RoutingRules, flattenRoutingRules)
+
+		if currentQueue.Bullseye != nil {
+			resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "bullseye_rings", currentQueue.Bullseye.Rings, flattenBullseyeRings)
+		}
+
+		resourcedata.SetNillableReference(d, "queue_flow_id", currentQueue.QueueFlow)
+		resourcedata.SetNillableReference(d, "message_in_queue_flow_id", currentQueue.MessageInQueueFlow)
+		resourcedata.SetNillableReference(d, "email_in_queue_flow_id", currentQueue.EmailInQueueFlow)
+		resourcedata.SetNillableReference(d, "whisper_prompt_id", currentQueue.WhisperPrompt)
+		resourcedata.SetNillableValue(d, "auto_answer_only", currentQueue.AutoAnswerOnly)
+		resourcedata.SetNillableValue(d, "enable_transcription", currentQueue.EnableTranscription)
+		resourcedata.SetNillableValue(d, "suppress_in_queue_call_recording", currentQueue.SuppressInQueueCallRecording)
+		resourcedata.SetNillableValue(d, "enable_manual_assignment", currentQueue.EnableManualAssignment)
+		resourcedata.SetNillableValue(d, "calling_party_name", currentQueue.CallingPartyName)
+		resourcedata.SetNillableValue(d, "calling_party_number", currentQueue.CallingPartyNumber)
+
+		if currentQueue.DefaultScripts != nil {
+			_ = d.Set("default_script_ids", flattenDefaultScripts(*currentQueue.DefaultScripts))
+		} else {
+			_ = d.Set("default_script_ids", nil)
+		}
+
+		if currentQueue.OutboundMessagingAddresses != nil && currentQueue.OutboundMessagingAddresses.SmsAddress != nil {
+			_ = d.Set("outbound_messaging_sms_address_id", *currentQueue.OutboundMessagingAddresses.SmsAddress.Id)
+		} else {
+			_ = d.Set("outbound_messaging_sms_address_id", nil)
+		}
+
+		if currentQueue.OutboundEmailAddress != nil && *currentQueue.OutboundEmailAddress != nil {
+			outboundEmailAddress := *currentQueue.OutboundEmailAddress
+			_ = d.Set("outbound_email_address", []interface{}{flattenQueueEmailAddress(*outboundEmailAddress)})
+		} else {
+			_ = d.Set("outbound_email_address", nil)
+		}
+
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "direct_routing", currentQueue.DirectRouting, flattenDirectRouting)
+
+		wrapupCodes, err := flattenQueueWrapupCodes(ctx, d.Id(), proxy)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", err))
+		}
+		_ = d.Set("wrapup_codes", wrapupCodes)
+
+		members, err := flattenQueueMembers(ctx, d.Id(), "user", proxy)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", err))
+		}
+		_ = d.Set("members", members)
+
+		skillGroup := "SKILLGROUP"
+		team := "TEAM"
+		group := "GROUP"
+
+		_ = d.Set("skill_groups", flattenQueueMemberGroupsList(currentQueue, &skillGroup))
+		_ = d.Set("teams", flattenQueueMemberGroupsList(currentQueue, &team))
+		_ = d.Set("groups", flattenQueueMemberGroupsList(currentQueue, &group))
+
+		_ = d.Set("conditional_group_routing_rules", flattenConditionalGroupRoutingRules(currentQueue))
+
+		log.Printf("Done reading queue %s %s", d.Id(), *currentQueue.Name)
+		return cc.CheckState()
+	})
+}
+
+// updateQueue is used by the routing_queue resource to update a Genesys Cloud routing queue
+func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	memberGroups := buildMemberGroups(d)
+
+	conditionalGroupRouting, diagErr := buildSdkConditionalGroupRouting(d)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	updateQueue := platformclientv2.Queuerequest{
+		Name:                         platformclientv2.String(d.Get("name").(string)),
+		Description:                  platformclientv2.String(d.Get("description").(string)),
+		MediaSettings:                buildSdkMediaSettings(d),
+		RoutingRules:                 buildSdkRoutingRules(d),
+		Bullseye:                     buildSdkBullseyeSettings(d),
+		ConditionalGroupRouting:      conditionalGroupRouting,
+		AcwSettings:                  buildSdkAcwSettings(d),
+		SkillEvaluationMethod:        platformclientv2.String(d.Get("skill_evaluation_method").(string)),
+		QueueFlow:                    gcloud.BuildSdkDomainEntityRef(d, "queue_flow_id"),
+		EmailInQueueFlow:             gcloud.BuildSdkDomainEntityRef(d, "email_in_queue_flow_id"),
+		MessageInQueueFlow:           gcloud.BuildSdkDomainEntityRef(d, "message_in_queue_flow_id"),
+		WhisperPrompt:                gcloud.BuildSdkDomainEntityRef(d, "whisper_prompt_id"),
+		AutoAnswerOnly:               platformclientv2.Bool(d.Get("auto_answer_only").(bool)),
+		CallingPartyName:             platformclientv2.String(d.Get("calling_party_name").(string)),
+		CallingPartyNumber:           platformclientv2.String(d.Get("calling_party_number").(string)),
+		DefaultScripts:               buildSdkDefaultScriptsMap(d),
+		OutboundMessagingAddresses:   buildSdkQueueMessagingAddresses(d),
+		OutboundEmailAddress:         buildSdkQueueEmailAddress(d),
+		EnableTranscription:          platformclientv2.Bool(d.Get("enable_transcription").(bool)),
+		SuppressInQueueCallRecording: platformclientv2.Bool(d.Get("suppress_in_queue_call_recording").(bool)),
+		EnableManualAssignment:       platformclientv2.Bool(d.Get("enable_manual_assignment").(bool)),
+		DirectRouting:                buildSdkDirectRouting(d),
+		MemberGroups:                 &memberGroups,
+	}
+
+	log.Printf("Updating queue %s", *updateQueue.Name)
+	_, _, err := proxy.updateRoutingQueue(ctx, d.Id(), &updateQueue)
+	if err != nil {
+		return diag.Errorf("Error updating queue %s: %s", *updateQueue.Name, err)
+	}
+
+	diagErr = updateQueueDivision(ctx, d, proxy)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateQueueMembers(ctx, d, proxy)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	diagErr = updateQueueWrapupCodes(ctx, d, proxy)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Finished updating queue %s", *updateQueue.Name)
+	return readQueue(ctx, d, meta)
+}
+
+// deleteQueue is used by the routing_queue resource to delete a Genesys Cloud routing queue
+func deleteQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Deleting queue %s", name)
+	_, err := proxy.deleteRoutingQueue(ctx, d.Id(), true)
+	if err != nil {
+		return diag.Errorf("Failed to delete queue %s: %s", name, err)
+	}
+
+	// Queue deletes are not immediate. Query until queue is no longer found
+	// Add a delay before the first request to reduce the likelihood of public API's cache
+	// re-populating the queue after the delete. Otherwise it may not expire for a minute.
+	time.Sleep(5 * time.Second)
+
+	//DEVTOOLING-238- Increasing this to a 120 seconds to see if we can temporarily mitigate a problem for a customer
+	return gcloud.WithRetries(ctx, 120*time.Second, func() *retry.RetryError {
+		_, resp, err := proxy.getRoutingQueueById(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				// Queue deleted
+				log.Printf("Queue %s deleted", name)
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting queue %s: %s", d.Id(), err))
+		}
+		return retry.RetryableError(fmt.Errorf("Queue %s still exists", d.Id()))
+	})
+}
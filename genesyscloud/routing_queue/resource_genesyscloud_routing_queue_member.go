@@ -0,0 +1,180 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// getAllRoutingQueueMembers retrieves the user members of every queue and is used for the exporter
+func getAllRoutingQueueMembers(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingQueueProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	queues, err := getAllRoutingQueues(ctx, clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for queueId, queueMeta := range queues {
+		members, err := getRoutingQueueMembers(ctx, proxy, queueId, "user")
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			memberName := *member.Id
+			if member.Name != nil {
+				memberName = *member.Name
+			}
+			resources[buildQueueMemberId(queueId, *member.Id)] = &resourceExporter.ResourceMeta{Name: queueMeta.Name + "_" + memberName}
+		}
+	}
+
+	return resources, nil
+}
+
+// createRoutingQueueMember adds a single user to a queue
+func createRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+	userId := d.Get("user_id").(string)
+
+	log.Printf("Adding user %s to queue %s", userId, queueId)
+	if err := updateQueueUserMembers(ctx, proxy, queueId, map[string]int{userId: d.Get("ring_num").(int)}, nil, false); err != nil {
+		return err
+	}
+
+	// The read removes members that are not listed, so wait for the new member to be listed first
+	if err := waitForQueueUserMember(ctx, proxy, queueId, userId); err != nil {
+		return err
+	}
+
+	d.SetId(buildQueueMemberId(queueId, userId))
+	log.Printf("Added user %s to queue %s", userId, queueId)
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+// readRoutingQueueMember reads the ring number of a single user in a queue
+func readRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	queueId, userId, err := parseQueueMemberId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("Reading user %s of queue %s", userId, queueId)
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		_, resp, getErr := proxy.getRoutingQueueById(ctx, queueId)
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read queue %s: %s", queueId, getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", queueId, getErr))
+		}
+
+		member, diagErr := getQueueUserMember(ctx, proxy, queueId, userId)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+		if member == nil {
+			// The user was removed from the queue outside of Terraform
+			log.Printf("User %s is not a member of queue %s", userId, queueId)
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueueMember())
+
+		_ = d.Set("queue_id", queueId)
+		_ = d.Set("user_id", userId)
+		_ = d.Set("ring_num", *member.RingNumber)
+
+		log.Printf("Read user %s of queue %s", userId, queueId)
+		return cc.CheckState()
+	})
+}
+
+// getQueueUserMember returns the queue member of a user. It is nil when the user is not a member of the queue.
+func getQueueUserMember(ctx context.Context, proxy *routingQueueProxy, queueId string, userId string) (*platformclientv2.Queuemember, diag.Diagnostics) {
+	members, diagErr := getRoutingQueueMembers(ctx, proxy, queueId, "user")
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	for i := range members {
+		if members[i].Id != nil && *members[i].Id == userId {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForQueueUserMember waits for a user added to a queue to be listed as a member of the queue
+func waitForQueueUserMember(ctx context.Context, proxy *routingQueueProxy, queueId string, userId string) diag.Diagnostics {
+	return gcloud.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
+		member, diagErr := getQueueUserMember(ctx, proxy, queueId, userId)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+		if member == nil {
+			return retry.RetryableError(fmt.Errorf("User %s is not yet listed as a member of queue %s", userId, queueId))
+		}
+		return nil
+	})
+}
+
+// updateRoutingQueueMember updates the ring number of a single user in a queue
+func updateRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+	userId := d.Get("user_id").(string)
+
+	if d.HasChange("ring_num") {
+		log.Printf("Updating ring number of user %s in queue %s", userId, queueId)
+		if err := updateQueueUserRingNum(ctx, proxy, queueId, userId, d.Get("ring_num").(int)); err != nil {
+			return err
+		}
+		log.Printf("Updated ring number of user %s in queue %s", userId, queueId)
+	}
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+// deleteRoutingQueueMember removes a single user from a queue
+func deleteRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+	userId := d.Get("user_id").(string)
+
+	log.Printf("Removing user %s from queue %s", userId, queueId)
+	if err := updateQueueUserMembers(ctx, proxy, queueId, nil, []string{userId}, false); err != nil {
+		return err
+	}
+	log.Printf("Removed user %s from queue %s", userId, queueId)
+	return nil
+}
+
+func buildQueueMemberId(queueId, userId string) string {
+	return queueId + ":" + userId
+}
+
+func parseQueueMemberId(id string) (queueId string, userId string, err error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("invalid queue member ID %s, expected <queue_id>:<user_id>", id)
+	}
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,209 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func TestAccResourceRoutingQueueMember(t *testing.T) {
+	var (
+		queueResource       = "test-queue-member-queue"
+		queueName           = "Terraform Test Queue Member-" + uuid.NewString()
+		memberResource      = "test-queue-member"
+		userResource        = "test-queue-member-user"
+		userEmail           = "terraform-" + uuid.NewString() + "@example.com"
+		userName            = "Queue Member Terraform"
+		defaultQueueRingNum = "1"
+		queueRingNum        = "4"
+	)
+
+	config := func(ringNum string) string {
+		return GenerateRoutingQueueResourceBasic(
+			queueResource,
+			queueName,
+		) + gcloud.GenerateBasicUserResource(
+			userResource,
+			userEmail,
+			userName,
+		) + GenerateRoutingQueueMemberResource(
+			memberResource,
+			"genesyscloud_routing_queue."+queueResource+".id",
+			"genesyscloud_user."+userResource+".id",
+			ringNum,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config(defaultQueueRingNum),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue_member."+memberResource, "queue_id", "genesyscloud_routing_queue."+queueResource, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue_member."+memberResource, "user_id", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_member."+memberResource, "ring_num", defaultQueueRingNum),
+				),
+			},
+			{
+				// Update ring number
+				Config: config(queueRingNum),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_member."+memberResource, "ring_num", queueRingNum),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_queue_member." + memberResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestAccResourceRoutingQueueMembersModes(t *testing.T) {
+	var (
+		queueResource     = "test-queue-members-queue"
+		queueName         = "Terraform Test Queue Members-" + uuid.NewString()
+		membersResource   = "test-queue-members"
+		userResource1     = "test-queue-members-user1"
+		userResource2     = "test-queue-members-user2"
+		userEmail1        = "terraform1-" + uuid.NewString() + "@example.com"
+		userEmail2        = "terraform2-" + uuid.NewString() + "@example.com"
+		userName1         = "Henry Terraform"
+		userName2         = "Amanda Terraform"
+		queueRingNum      = "2"
+		unmanagedUserId   string
+		queueIdFromState  string
+		membersResourceId = "genesyscloud_routing_queue_members." + membersResource
+	)
+
+	users := gcloud.GenerateBasicUserResource(
+		userResource1,
+		userEmail1,
+		userName1,
+	) + gcloud.GenerateBasicUserResource(
+		userResource2,
+		userEmail2,
+		userName2,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create in additive mode
+				Config: GenerateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+				) + users + GenerateRoutingQueueMembersResource(
+					membersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					queueMembersModeAdditive,
+					GenerateMemberBlock("genesyscloud_user."+userResource1+".id", queueRingNum),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateMember(membersResourceId, "genesyscloud_user."+userResource1, queueRingNum),
+					func(state *terraform.State) error {
+						// Add a member outside of Terraform. Additive mode must leave it in the queue.
+						queueIdFromState = state.RootModule().Resources[membersResourceId].Primary.ID
+						unmanagedUserId = state.RootModule().Resources["genesyscloud_user."+userResource2].Primary.ID
+						return addQueueMemberOutsideTerraform(queueIdFromState, unmanagedUserId)
+					},
+				),
+			},
+			{
+				// Unmanaged member is not detected as drift in additive mode
+				Config: GenerateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+				) + users + GenerateRoutingQueueMembersResource(
+					membersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					queueMembersModeAdditive,
+					GenerateMemberBlock("genesyscloud_user."+userResource1+".id", queueRingNum),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(membersResourceId, "members.#", "1"),
+					func(state *terraform.State) error {
+						return verifyQueueHasMember(queueIdFromState, unmanagedUserId, true)
+					},
+				),
+			},
+			{
+				// Switching to authoritative mode removes the unmanaged member
+				Config: GenerateRoutingQueueResourceBasic(
+					queueResource,
+					queueName,
+				) + users + GenerateRoutingQueueMembersResource(
+					membersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					queueMembersModeAuthoritative,
+					GenerateMemberBlock("genesyscloud_user."+userResource1+".id", queueRingNum),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(membersResourceId, "members.#", "1"),
+					validateMember(membersResourceId, "genesyscloud_user."+userResource1, queueRingNum),
+					func(state *terraform.State) error {
+						return verifyQueueHasMember(queueIdFromState, unmanagedUserId, false)
+					},
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      membersResourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func addQueueMemberOutsideTerraform(queueId string, userId string) error {
+	sdkConfig, err := gcloud.AuthorizeSdk()
+	if err != nil {
+		return err
+	}
+	proxy := getRoutingQueueProxy(sdkConfig)
+	if _, err := proxy.addOrRemoveRoutingQueueMembers(context.Background(), queueId, []platformclientv2.Writableentity{{Id: &userId}}, false); err != nil {
+		return fmt.Errorf("failed to add user %s to queue %s: %v", userId, queueId, err)
+	}
+	// Give the queue time to report the new member
+	time.Sleep(10 * time.Second)
+	return nil
+}
+
+func verifyQueueHasMember(queueId string, userId string, expected bool) error {
+	sdkConfig, err := gcloud.AuthorizeSdk()
+	if err != nil {
+		return err
+	}
+	members, diagErr := getRoutingQueueMembers(context.Background(), getRoutingQueueProxy(sdkConfig), queueId, "user")
+	if diagErr != nil {
+		return fmt.Errorf("%v", diagErr)
+	}
+	found := false
+	for _, member := range members {
+		if *member.Id == userId {
+			found = true
+			break
+		}
+	}
+	if found != expected {
+		return fmt.Errorf("expected user %s membership of queue %s to be %v", userId, queueId, expected)
+	}
+	return nil
+}
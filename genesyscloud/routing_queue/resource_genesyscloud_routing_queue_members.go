@@ -0,0 +1,115 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// createRoutingQueueMembers adds the configured users to a queue
+func createRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueId := d.Get("queue_id").(string)
+	d.SetId(queueId)
+	log.Printf("Creating members for queue %s", queueId)
+	return updateRoutingQueueMembers(ctx, d, meta)
+}
+
+// readRoutingQueueMembers reads the user members of a queue. In additive mode only the configured users are read.
+func readRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Reading members of queue %s", d.Id())
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		_, resp, getErr := proxy.getRoutingQueueById(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
+		}
+
+		members, diagErr := flattenQueueMembers(ctx, d.Id(), "user", proxy)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueueMembers())
+
+		mode := d.Get("mode").(string)
+		if mode == "" {
+			// Imported resources default to owning every member of the queue
+			mode = queueMembersModeAuthoritative
+		}
+		if mode == queueMembersModeAdditive {
+			members = filterQueueMembersByUser(members, getQueueMembersUserIds(d.Get("members").(*schema.Set)))
+		}
+
+		_ = d.Set("queue_id", d.Id())
+		_ = d.Set("mode", mode)
+		_ = d.Set("members", members)
+
+		log.Printf("Read members of queue %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+// updateRoutingQueueMembers applies the configured members to the queue according to the membership mode
+func updateRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+	authoritative := d.Get("mode").(string) == queueMembersModeAuthoritative
+
+	log.Printf("Updating members of queue %s", d.Id())
+	oldMembers, newMembers := d.GetChange("members")
+	usersToRemove := lists.SliceDifference(getQueueMembersUserIds(oldMembers.(*schema.Set)), getQueueMembersUserIds(newMembers.(*schema.Set)))
+	if err := updateQueueUserMembers(ctx, proxy, d.Id(), buildQueueMemberRingNums(newMembers.(*schema.Set)), usersToRemove, authoritative); err != nil {
+		return err
+	}
+
+	log.Printf("Updated members of queue %s", d.Id())
+	return readRoutingQueueMembers(ctx, d, meta)
+}
+
+// deleteRoutingQueueMembers removes the members in state from the queue
+func deleteRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Removing members of queue %s", d.Id())
+	usersToRemove := getQueueMembersUserIds(d.Get("members").(*schema.Set))
+	if err := updateQueueUserMembers(ctx, proxy, d.Id(), nil, usersToRemove, false); err != nil {
+		return err
+	}
+	log.Printf("Removed members of queue %s", d.Id())
+	return nil
+}
+
+func getQueueMembersUserIds(membersSet *schema.Set) []string {
+	var userIds []string
+	if membersSet == nil {
+		return userIds
+	}
+	for _, member := range membersSet.List() {
+		userIds = append(userIds, member.(map[string]interface{})["user_id"].(string))
+	}
+	return userIds
+}
+
+// filterQueueMembersByUser returns the members of the set that belong to one of the given users
+func filterQueueMembersByUser(membersSet *schema.Set, userIds []string) *schema.Set {
+	filtered := schema.NewSet(schema.HashResource(queueMemberResource), []interface{}{})
+	for _, member := range membersSet.List() {
+		if lists.ItemInSlice(member.(map[string]interface{})["user_id"].(string), userIds) {
+			filtered.Add(member)
+		}
+	}
+	return filtered
+}
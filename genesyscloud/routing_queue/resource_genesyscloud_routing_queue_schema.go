@@ -0,0 +1,721 @@
+package routing_queue
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_routing_queue_schema.go holds four functions within it:
+
+ 1. The registration code that registers the Datasource, Resource and Exporter for the package.
+ 2. The resource schema definitions for the genesyscloud_routing_queue, genesyscloud_routing_queue_member,
+    genesyscloud_routing_queue_members and genesyscloud_routing_queue_wrapupcode resources.
+ 3. The datasource schema definitions for the routing_queue and routing_queue_wrapupcodes datasources.
+ 4. The resource exporter configuration for the routing_queue exporters.
+*/
+const (
+	resourceName        = "genesyscloud_routing_queue"
+	memberResourceName  = "genesyscloud_routing_queue_member"
+	membersResourceName = "genesyscloud_routing_queue_members"
+
+	wrapupCodeResourceName    = "genesyscloud_routing_queue_wrapupcode"
+	wrapupCodesDataSourceName = "genesyscloud_routing_queue_wrapupcodes"
+
+	queueMembersModeAuthoritative = "authoritative"
+	queueMembersModeAdditive      = "additive"
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceRoutingQueue())
+	regInstance.RegisterResource(memberResourceName, ResourceRoutingQueueMember())
+	regInstance.RegisterResource(membersResourceName, ResourceRoutingQueueMembers())
+	regInstance.RegisterResource(wrapupCodeResourceName, ResourceRoutingQueueWrapupCode())
+	regInstance.RegisterDataSource(resourceName, DataSourceRoutingQueue())
+	regInstance.RegisterDataSource(wrapupCodesDataSourceName, DataSourceRoutingQueueWrapupCodes())
+	regInstance.RegisterExporter(resourceName, RoutingQueueExporter())
+	regInstance.RegisterExporter(memberResourceName, RoutingQueueMemberExporter())
+	regInstance.RegisterExporter(membersResourceName, RoutingQueueMembersExporter())
+	regInstance.RegisterExporter(wrapupCodeResourceName, RoutingQueueWrapupCodeExporter())
+}
+
+var (
+	bullseyeExpansionTypeTimeout = "TIMEOUT_SECONDS"
+
+	memberGroupResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"member_group_id": {
+				Description: "ID (GUID) for Group, SkillGroup, Team",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"member_group_type": {
+				Description:  "The type of the member group. Accepted values: TEAM, GROUP, SKILLGROUP",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TEAM", "GROUP", "SKILLGROUP"}, false),
+			},
+		},
+	}
+
+	queueMediaSettingsResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"alerting_timeout_sec": {
+				Description:  "Alerting timeout in seconds. Must be >= 7",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(7),
+			},
+			"enable_auto_answer": {
+				Description: "Auto-Answer for digital channels(Email, Message)",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"service_level_percentage": {
+				Description:  "The desired Service Level. A float value between 0 and 1.",
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"service_level_duration_ms": {
+				Description:  "Service Level target in milliseconds. Must be >= 1000",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1000),
+			},
+		},
+	}
+
+	queueMemberResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "User ID",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ring_num": {
+				Description:  "Ring number between 1 and 6 for this user in the queue.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+		},
+	}
+
+	directRoutingResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"backup_queue_id": {
+				Description: "Direct Routing default backup queue id (if none supplied this queue will be used as backup).",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"agent_wait_seconds": {
+				Description: "The queue default time a Direct Routing interaction will wait for an agent before it goes to configured backup.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     60,
+			},
+			"wait_for_agent": {
+				Description: "Boolean indicating if Direct Routing interactions should wait for the targeted agent by default.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"call_use_agent_address_outbound": {
+				Description: "Boolean indicating if user Direct Routing addresses should be used outbound on behalf of queue in place of Queue address for calls.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"email_use_agent_address_outbound": {
+				Description: "Boolean indicating if user Direct Routing addresses should be used outbound on behalf of queue in place of Queue address for emails.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"message_use_agent_address_outbound": {
+				Description: "Boolean indicating if user Direct Routing addresses should be used outbound on behalf of queue in place of Queue address for messages.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+)
+
+// RoutingQueueExporter returns the resourceExporter object used to hold the genesyscloud_routing_queue exporter's config
+func RoutingQueueExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingQueues),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"division_id":                              {RefType: "genesyscloud_auth_division"},
+			"queue_flow_id":                            {RefType: "genesyscloud_flow"},
+			"email_in_queue_flow_id":                   {RefType: "genesyscloud_flow"},
+			"message_in_queue_flow_id":                 {RefType: "genesyscloud_flow"},
+			"whisper_prompt_id":                        {RefType: "genesyscloud_architect_user_prompt"},
+			"outbound_messaging_sms_address_id":        {},                               // Ref type not yet defined
+			"default_script_ids.*":                     {RefType: "genesyscloud_script"}, // Ref type not yet defined
+			"outbound_email_address.route_id":          {RefType: "genesyscloud_routing_email_route"},
+			"outbound_email_address.domain_id":         {RefType: "genesyscloud_routing_email_domain"},
+			"bullseye_rings.skills_to_remove":          {RefType: "genesyscloud_routing_skill"},
+			"members.user_id":                          {RefType: "genesyscloud_user"},
+			"wrapup_codes":                             {RefType: "genesyscloud_routing_wrapupcode"},
+			"skill_groups":                             {RefType: "genesyscloud_routing_skill_group"},
+			"teams":                                    {RefType: "genesyscloud_team"},
+			"groups":                                   {RefType: "genesyscloud_group"},
+			"conditional_group_routing_rules.queue_id": {RefType: resourceName},
+		},
+		RemoveIfMissing: map[string][]string{
+			"outbound_email_address": {"route_id"},
+			"members":                {"user_id"},
+		},
+		AllowZeroValues: []string{"bullseye_rings.expansion_timeout_seconds"},
+		CustomAttributeResolver: map[string]*resourceExporter.RefAttrCustomResolver{
+			"bullseye_rings.member_groups.member_group_id":           {ResolverFunc: resourceExporter.MemberGroupsResolver},
+			"conditional_group_routing_rules.groups.member_group_id": {ResolverFunc: resourceExporter.MemberGroupsResolver},
+		},
+	}
+}
+
+// ResourceRoutingQueue registers the genesyscloud_routing_queue resource with Terraform
+func ResourceRoutingQueue() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Routing Queue",
+
+		CreateContext: gcloud.CreateWithPooledClient(createQueue),
+		ReadContext:   gcloud.ReadWithPooledClient(readQueue),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateQueue),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteQueue),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Queue name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"division_id": {
+				Description: "The division to which this queue will belong. If not set, the home division will be used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Queue description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"media_settings_call": {
+				Description: "Call media settings.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem:        queueMediaSettingsResource,
+			},
+			"media_settings_callback": {
+				Description: "Callback media settings.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem:        queueMediaSettingsResource,
+			},
+			"media_settings_chat": {
+				Description: "Chat media settings.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem:        queueMediaSettingsResource,
+			},
+			"media_settings_email": {
+				Description: "Email media settings.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem:        queueMediaSettingsResource,
+			},
+			"media_settings_message": {
+				Description: "Message media settings.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem:        queueMediaSettingsResource,
+			},
+			"routing_rules": {
+				Description: "The routing rules for the queue, used for routing to known or preferred agents.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    6,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator": {
+							Description:  "Matching operator (MEETS_THRESHOLD | ANY). MEETS_THRESHOLD matches any agent with a score at or above the rule's threshold. ANY matches all specified agents, regardless of score.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "MEETS_THRESHOLD",
+							ValidateFunc: validation.StringInSlice([]string{"MEETS_THRESHOLD", "ANY"}, false),
+						},
+						"threshold": {
+							Description: "Threshold required for routing attempt (generally an agent score). Ignored for operator ANY.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"wait_seconds": {
+							Description:  "Seconds to wait in this rule before moving to the next.",
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.FloatBetween(2, 259200),
+						},
+					},
+				},
+			},
+			"bullseye_rings": {
+				Description: "The bullseye ring settings for the queue.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expansion_timeout_seconds": {
+							Description:  "Seconds to wait in this ring before moving to the next.",
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 259200),
+						},
+						"skills_to_remove": {
+							Description: "Skill IDs to remove on ring exit.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"member_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     memberGroupResource,
+						},
+					},
+				},
+			},
+			"conditional_group_routing_rules": {
+				Description: "The Conditional Group Routing settings for the queue.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"queue_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The ID of the queue being evaluated for this rule. For rule 1, this is always be the current queue, so no queue id should be specified for the first rule.`,
+						},
+						"operator": {
+							Description:  "The operator that compares the actual value against the condition value. Valid values: GreaterThan, GreaterThanOrEqualTo, LessThan, LessThanOrEqualTo.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"GreaterThan", "LessThan", "GreaterThanOrEqualTo", "LessThanOrEqualTo"}, false),
+						},
+						"metric": {
+							Description: "The queue metric being evaluated. Valid values: EstimatedWaitTime, ServiceLevel",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "EstimatedWaitTime",
+						},
+						"condition_value": {
+							Description:  "The limit value, beyond which a rule evaluates as true.",
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 259200),
+						},
+						"wait_seconds": {
+							Description:  "The number of seconds to wait in this rule, if it evaluates as true, before evaluating the next rule. For the final rule, this is ignored, so need not be specified.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(0, 259200),
+						},
+						"groups": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The group(s) to activate if the rule evaluates as true.",
+							Elem:        memberGroupResource,
+						},
+					},
+				},
+			},
+			"acw_wrapup_prompt": {
+				Description:  "This field controls how the UI prompts the agent for a wrapup (MANDATORY | OPTIONAL | MANDATORY_TIMEOUT | MANDATORY_FORCED_TIMEOUT | AGENT_REQUESTED).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MANDATORY_TIMEOUT",
+				ValidateFunc: validation.StringInSlice([]string{"MANDATORY", "OPTIONAL", "MANDATORY_TIMEOUT", "MANDATORY_FORCED_TIMEOUT", "AGENT_REQUESTED"}, false),
+			},
+			"acw_timeout_ms": {
+				Description:  "The amount of time the agent can stay in ACW. Only set when ACW is MANDATORY_TIMEOUT, MANDATORY_FORCED_TIMEOUT or AGENT_REQUESTED.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true, // Default may be set by server
+				ValidateFunc: validation.IntBetween(0, 86400000),
+			},
+			"skill_evaluation_method": {
+				Description:  "The skill evaluation method to use when routing conversations (NONE | BEST | ALL).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ALL",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "BEST", "ALL"}, false),
+			},
+			"queue_flow_id": {
+				Description: "The in-queue flow ID to use for call conversations waiting in queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"email_in_queue_flow_id": {
+				Description: "The in-queue flow ID to use for email conversations waiting in queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"message_in_queue_flow_id": {
+				Description: "The in-queue flow ID to use for message conversations waiting in queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"whisper_prompt_id": {
+				Description: "The prompt ID used for whisper on the queue, if configured.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"auto_answer_only": {
+				Description: "Specifies whether the configured whisper should play for all ACD calls, or only for those which are auto-answered.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"enable_transcription": {
+				Description: "Indicates whether voice transcription is enabled for this queue.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"suppress_in_queue_call_recording": {
+				Description: "Indicates whether recording in-queue calls is suppressed for this queue.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"enable_manual_assignment": {
+				Description: "Indicates whether manual assignment is enabled for this queue.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"calling_party_name": {
+				Description: "The name to use for caller identification for outbound calls from this queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"calling_party_number": {
+				Description: "The phone number to use for caller identification for outbound calls from this queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"default_script_ids": {
+				Description:      "The default script IDs for each communication type. Communication types: (CALL | CALLBACK | CHAT | COBROWSE | EMAIL | MESSAGE | SOCIAL_EXPRESSION | VIDEO | SCREENSHARE)",
+				Type:             schema.TypeMap,
+				ValidateDiagFunc: validateMapCommTypes,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+			},
+			"outbound_messaging_sms_address_id": {
+				Description: "The unique ID of the outbound messaging SMS address for the queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"outbound_email_address": {
+				Description: "The outbound email address settings for this queue.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_id": {
+							Description: "Unique ID of the email domain. e.g. \"test.example.com\"",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"route_id": {
+							Description: "Unique ID of the email route.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"members": {
+				Description: "Users in the queue. If not set, this resource will not manage members. Set to an empty list to remove all user members. If a user is already assigned to this queue via a group, attempting to assign them using this field will cause an error to be thrown. Do not use together with `genesyscloud_routing_queue_member` or `genesyscloud_routing_queue_members` for the same queue.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        queueMemberResource,
+			},
+			"wrapup_codes": {
+				Description: "IDs of wrapup codes assigned to this queue. If not set, this resource will not manage wrapup codes. Do not use together with `genesyscloud_routing_queue_wrapupcode` for the same queue.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"direct_routing": {
+				Description: "Used by the System to set Direct Routing settings for a system Direct Routing queue.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem:        directRoutingResource,
+			},
+			"skill_groups": {
+				Description: "List of skill group ids assigned to the queue.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Description: "List of group ids assigned to the queue",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"teams": {
+				Description: "List of ids assigned to the queue",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// RoutingQueueMemberExporter returns the resourceExporter object used to hold the genesyscloud_routing_queue_member exporter's config
+func RoutingQueueMemberExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingQueueMembers),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"queue_id": {RefType: resourceName},
+			"user_id":  {RefType: "genesyscloud_user"},
+		},
+		ExportOnlyWhenIncluded: true,
+	}
+}
+
+// ResourceRoutingQueueMember registers the genesyscloud_routing_queue_member resource with Terraform
+func ResourceRoutingQueueMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Member manages the membership of a single user in a queue.
+
+Members that are not managed by this resource, such as agents added through the UI, are left untouched. Do not use this resource for a queue whose members are set with the ` + "`members`" + ` attribute of ` + "`genesyscloud_routing_queue`" + `.
+
+This resource is only exported when it is named in ` + "`include_filter_resources`" + `. Add ` + "`genesyscloud_routing_queue.members`" + ` to ` + "`exclude_attributes`" + ` to export queue members with this resource only.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingQueueMember),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingQueueMember),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingQueueMember),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingQueueMember),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue. Changing the queue_id attribute will cause the member to be removed from the old queue and added to the new one.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "ID of the user. Changing the user_id attribute will cause the old user to be removed from the queue and the new one to be added.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ring_num": {
+				Description:  "Ring number between 1 and 6 for this user in the queue.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+		},
+	}
+}
+
+// RoutingQueueMembersExporter returns the resourceExporter object used to hold the genesyscloud_routing_queue_members exporter's config
+func RoutingQueueMembersExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingQueues),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"queue_id":        {RefType: resourceName},
+			"members.user_id": {RefType: "genesyscloud_user"},
+		},
+		RemoveIfMissing: map[string][]string{
+			"members": {"user_id"},
+		},
+		ExportOnlyWhenIncluded: true,
+	}
+}
+
+// ResourceRoutingQueueMembers registers the genesyscloud_routing_queue_members resource with Terraform
+func ResourceRoutingQueueMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Members manages the user members of a queue.
+
+In ` + "`authoritative`" + ` mode this resource owns all user members of the queue and removes any member that is not configured. In ` + "`additive`" + ` mode only the configured members are managed and members added outside of Terraform are left untouched. Do not use this resource for a queue whose members are set with the ` + "`members`" + ` attribute of ` + "`genesyscloud_routing_queue`" + `.
+
+This resource is only exported when it is named in ` + "`include_filter_resources`" + `. Add ` + "`genesyscloud_routing_queue.members`" + ` to ` + "`exclude_attributes`" + ` to export queue members with this resource only.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingQueueMembers),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingQueueMembers),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingQueueMembers),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingQueueMembers),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue. Changing the queue_id attribute will cause the members to be removed from the old queue and added to the new one.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"mode": {
+				Description:  "Membership mode. `authoritative` removes every user member of the queue that is not configured. `additive` only adds and removes the configured members. Defaults to `authoritative`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      queueMembersModeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{queueMembersModeAuthoritative, queueMembersModeAdditive}, false),
+			},
+			"members": {
+				Description: "Users in the queue. If a user is already assigned to this queue via a group, attempting to assign them using this field will cause an error to be thrown.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        queueMemberResource,
+			},
+		},
+	}
+}
+
+// RoutingQueueWrapupCodeExporter returns the resourceExporter object used to hold the genesyscloud_routing_queue_wrapupcode exporter's config
+func RoutingQueueWrapupCodeExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllRoutingQueueWrapupCodes),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"queue_id":     {RefType: resourceName},
+			"wrapup_codes": {RefType: "genesyscloud_routing_wrapupcode"},
+		},
+		ExportOnlyWhenIncluded: true,
+	}
+}
+
+// ResourceRoutingQueueWrapupCode registers the genesyscloud_routing_queue_wrapupcode resource with Terraform
+func ResourceRoutingQueueWrapupCode() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Wrapup Code assigns wrapup codes to a queue.
+
+Only the configured wrapup codes are managed. Wrapup codes assigned to the queue in any other way are left untouched, so the same queue can be given wrapup codes from several configurations. Do not use this resource for a queue whose wrapup codes are set with the ` + "`wrapup_codes`" + ` attribute of ` + "`genesyscloud_routing_queue`" + `.
+
+This resource is only exported when it is named in ` + "`include_filter_resources`" + `. Add ` + "`genesyscloud_routing_queue.wrapup_codes`" + ` to ` + "`exclude_attributes`" + ` to export queue wrapup codes with this resource only.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createRoutingQueueWrapupCode),
+		ReadContext:   gcloud.ReadWithPooledClient(readRoutingQueueWrapupCode),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateRoutingQueueWrapupCode),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteRoutingQueueWrapupCode),
+		Importer: &schema.ResourceImporter{
+			StateContext: importRoutingQueueWrapupCode,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue. Changing the queue_id attribute will cause the wrapup codes to be removed from the old queue and added to the new one.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"wrapup_codes": {
+				Description: "IDs of the wrapup codes to assign to the queue.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// DataSourceRoutingQueue registers the genesyscloud_routing_queue data source
+func DataSourceRoutingQueue() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Genesys Cloud Routing Queues. Select a queue by name.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceRoutingQueueRead),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Queue name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// DataSourceRoutingQueueWrapupCodes registers the genesyscloud_routing_queue_wrapupcodes data source
+func DataSourceRoutingQueueWrapupCodes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the wrapup codes assigned to a Genesys Cloud Routing Queue.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceRoutingQueueWrapupCodesRead),
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"wrapup_code_ids": {
+				Description: "IDs of the wrapup codes assigned to the queue.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"wrapup_codes": {
+				Description: "Wrapup codes assigned to the queue.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the wrapup code.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the wrapup code.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
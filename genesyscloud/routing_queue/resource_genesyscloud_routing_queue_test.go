@@ -1,13 +1,12 @@
-package genesyscloud
+package routing_queue
 
 import (
 	"fmt"
 	"strconv"
 	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	"testing"
 
-	"github.com/stretchr/testify/assert"
-
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -46,40 +45,40 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create
-				Config: GenerateUserWithCustomAttrs(testUserResource, testUserEmail, testUserName) + GenerateRoutingSkillResource(queueSkillResource, queueSkillName) +
+				Config: gcloud.GenerateUserWithCustomAttrs(testUserResource, testUserEmail, testUserName) + gcloud.GenerateRoutingSkillResource(queueSkillResource, queueSkillName) +
 					generateGroupResource(
 						bullseyeMemberGroupName,
 						"MySeries6Group",
 						strconv.Quote("TestGroupForSeries6"),
-						NullValue, // Default type
-						NullValue, // Default visibility
-						NullValue, // Default rules_visible
-						GenerateGroupOwners("genesyscloud_user."+testUserResource+".id"),
+						gcloud.NullValue, // Default type
+						gcloud.NullValue, // Default visibility
+						gcloud.NullValue, // Default rules_visible
+						gcloud.GenerateGroupOwners("genesyscloud_user."+testUserResource+".id"),
 					) + GenerateRoutingQueueResource(
 					queueResource1,
 					queueName1,
 					queueDesc1,
-					NullValue,  // MANDATORY_TIMEOUT
-					"200000",   // acw_timeout
-					NullValue,  // ALL
-					NullValue,  // auto_answer_only true
-					NullValue,  // No calling party name
-					NullValue,  // No calling party number
-					NullValue,  // enable_manual_assignment false
-					FalseValue, // suppress_in_queue_call_recording false
-					NullValue,  // enable_transcription false
-					GenerateMediaSettings("media_settings_call", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_callback", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_email", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_message", alertTimeout1, FalseValue, slPercent1, slDuration1),
+					gcloud.NullValue,  // MANDATORY_TIMEOUT
+					"200000",          // acw_timeout
+					gcloud.NullValue,  // ALL
+					gcloud.NullValue,  // auto_answer_only true
+					gcloud.NullValue,  // No calling party name
+					gcloud.NullValue,  // No calling party number
+					gcloud.NullValue,  // enable_manual_assignment false
+					gcloud.FalseValue, // suppress_in_queue_call_recording false
+					gcloud.NullValue,  // enable_transcription false
+					GenerateMediaSettings("media_settings_call", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_callback", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_email", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_message", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
 					GenerateBullseyeSettingsWithMemberGroup(alertTimeout1, "genesyscloud_group."+bullseyeMemberGroupName+".id", bullseyeMemberGroupType, "genesyscloud_routing_skill."+queueSkillResource+".id"),
-					GenerateRoutingRules(routingRuleOpAny, "50", NullValue),
+					GenerateRoutingRules(routingRuleOpAny, "50", gcloud.NullValue),
 				),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "name", queueName1),
@@ -87,16 +86,16 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_wrapup_prompt", wrapupPromptMandTimeout),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_timeout_ms", "200000"),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "skill_evaluation_method", skillEvalAll),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", TrueValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", FalseValue),
-					TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
-					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, FalseValue, slPercent1, slDuration1),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", gcloud.TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", gcloud.FalseValue),
+					gcloud.TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
+					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
 					validateBullseyeSettings(queueResource1, 1, alertTimeout1, "genesyscloud_routing_skill."+queueSkillResource),
 					validateRoutingRules(queueResource1, 0, routingRuleOpAny, "50", "5"),
 				),
@@ -108,19 +107,19 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 					queueName2,
 					queueDesc2,
 					strconv.Quote(wrapupPromptOptional),
-					NullValue, // acw_timeout
+					gcloud.NullValue, // acw_timeout
 					strconv.Quote(skillEvalBest),
-					FalseValue, // auto_answer_only false
+					gcloud.FalseValue, // auto_answer_only false
 					strconv.Quote(callingPartyName),
 					strconv.Quote(callingPartyNumber),
-					TrueValue, // suppress_in_queue_call_recording true
-					TrueValue, // enable_manual_assignment true
-					TrueValue, // enable_transcription true
-					GenerateMediaSettings("media_settings_call", alertTimeout2, FalseValue, slPercent2, slDuration2),
-					GenerateMediaSettings("media_settings_callback", alertTimeout2, TrueValue, slPercent2, slDuration2),
-					GenerateMediaSettings("media_settings_chat", alertTimeout2, FalseValue, slPercent2, slDuration2),
-					GenerateMediaSettings("media_settings_email", alertTimeout2, FalseValue, slPercent2, slDuration2),
-					GenerateMediaSettings("media_settings_message", alertTimeout2, FalseValue, slPercent2, slDuration2),
+					gcloud.TrueValue, // suppress_in_queue_call_recording true
+					gcloud.TrueValue, // enable_manual_assignment true
+					gcloud.TrueValue, // enable_transcription true
+					GenerateMediaSettings("media_settings_call", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
+					GenerateMediaSettings("media_settings_callback", alertTimeout2, gcloud.TrueValue, slPercent2, slDuration2),
+					GenerateMediaSettings("media_settings_chat", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
+					GenerateMediaSettings("media_settings_email", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
+					GenerateMediaSettings("media_settings_message", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
 					GenerateBullseyeSettings(alertTimeout2),
 					GenerateBullseyeSettings(alertTimeout2),
 					GenerateBullseyeSettings(alertTimeout2),
@@ -132,18 +131,18 @@ func TestAccResourceRoutingQueueBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "description", queueDesc2),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_wrapup_prompt", wrapupPromptOptional),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "skill_evaluation_method", skillEvalBest),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", gcloud.FalseValue),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "calling_party_name", callingPartyName),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "calling_party_number", callingPartyNumber),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", TrueValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", TrueValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", TrueValue),
-					TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
-					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout2, FalseValue, slPercent2, slDuration2),
-					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout2, TrueValue, slPercent2, slDuration2),
-					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout2, FalseValue, slPercent2, slDuration2),
-					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout2, FalseValue, slPercent2, slDuration2),
-					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout2, FalseValue, slPercent2, slDuration2),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", gcloud.TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", gcloud.TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", gcloud.TrueValue),
+					gcloud.TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
+					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
+					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout2, gcloud.TrueValue, slPercent2, slDuration2),
+					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
+					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
+					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout2, gcloud.FalseValue, slPercent2, slDuration2),
 					validateBullseyeSettings(queueResource1, 3, alertTimeout2, ""),
 					validateRoutingRules(queueResource1, 0, routingRuleOpMeetsThresh, "90", "30"),
 					validateRoutingRules(queueResource1, 1, routingRuleOpAny, "45", "15"),
@@ -196,8 +195,8 @@ func TestAccResourceRoutingQueueConditionalRouting(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create
@@ -209,22 +208,22 @@ func TestAccResourceRoutingQueueConditionalRouting(t *testing.T) {
 					queueResource1,
 					queueName1,
 					queueDesc1,
-					NullValue,  // MANDATORY_TIMEOUT
-					"200000",   // acw_timeout
-					NullValue,  // ALL
-					NullValue,  // auto_answer_only true
-					NullValue,  // No calling party name
-					NullValue,  // No calling party number
-					NullValue,  // enable_transcription false
-					FalseValue, // suppress_in_queue_call_recording false
-					NullValue,  // enable_manual_assignment false
-					GenerateMediaSettings("media_settings_call", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_callback", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_email", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_message", alertTimeout1, TrueValue, slPercent1, slDuration1),
+					gcloud.NullValue,  // MANDATORY_TIMEOUT
+					"200000",          // acw_timeout
+					gcloud.NullValue,  // ALL
+					gcloud.NullValue,  // auto_answer_only true
+					gcloud.NullValue,  // No calling party name
+					gcloud.NullValue,  // No calling party number
+					gcloud.NullValue,  // enable_transcription false
+					gcloud.FalseValue, // suppress_in_queue_call_recording false
+					gcloud.NullValue,  // enable_manual_assignment false
+					GenerateMediaSettings("media_settings_call", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_callback", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_email", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_message", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
 					GenerateConditionalGroupRoutingRules(
-						NullValue,                              // queue_id (queue_id in the first rule should be omitted)
+						gcloud.NullValue,                       // queue_id (queue_id in the first rule should be omitted)
 						conditionalGroupRouting1Operator,       // operator
 						conditionalGroupRouting1Metric,         // metric
 						conditionalGroupRouting1ConditionValue, // condition_value
@@ -242,10 +241,10 @@ func TestAccResourceRoutingQueueConditionalRouting(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_wrapup_prompt", wrapupPromptMandTimeout),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_timeout_ms", "200000"),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "skill_evaluation_method", skillEvalAll),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", TrueValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", gcloud.TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", gcloud.FalseValue),
 
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.operator", conditionalGroupRouting1Operator),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.metric", conditionalGroupRouting1Metric),
@@ -254,20 +253,20 @@ func TestAccResourceRoutingQueueConditionalRouting(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.groups.0.member_group_type", conditionalGroupRouting1GroupType),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.groups.0.member_group_id", "genesyscloud_routing_skill_group."+skillGroupResourceId, "id"),
 
-					TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
-					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, TrueValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, TrueValue, slPercent1, slDuration1),
+					gcloud.TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
+					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, gcloud.TrueValue, slPercent1, slDuration1),
 				),
 			},
 			{
 				// Update
-				Config: GenerateUserWithCustomAttrs(testUserResource, testUserEmail, testUserName) + GenerateBasicGroupResource(
+				Config: gcloud.GenerateUserWithCustomAttrs(testUserResource, testUserEmail, testUserName) + gcloud.GenerateBasicGroupResource(
 					groupResourceId,
 					groupName,
-					GenerateGroupOwners("genesyscloud_user."+testUserResource+".id"),
+					gcloud.GenerateGroupOwners("genesyscloud_user."+testUserResource+".id"),
 				) +
 					generateRoutingQueueResourceBasic(
 						queueResource2,
@@ -281,22 +280,22 @@ func TestAccResourceRoutingQueueConditionalRouting(t *testing.T) {
 					queueResource1,
 					queueName1,
 					queueDesc1,
-					NullValue,  // MANDATORY_TIMEOUT
-					"200000",   // acw_timeout
-					NullValue,  // ALL
-					NullValue,  // auto_answer_only true
-					NullValue,  // No calling party name
-					NullValue,  // No calling party number
-					NullValue,  // enable_transcription false
-					FalseValue, // suppress_in_queue_call_recording false
-					NullValue,  // enable_manual_assignment false
-					GenerateMediaSettings("media_settings_call", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_callback", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_email", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_message", alertTimeout1, FalseValue, slPercent1, slDuration1),
+					gcloud.NullValue,  // MANDATORY_TIMEOUT
+					"200000",          // acw_timeout
+					gcloud.NullValue,  // ALL
+					gcloud.NullValue,  // auto_answer_only true
+					gcloud.NullValue,  // No calling party name
+					gcloud.NullValue,  // No calling party number
+					gcloud.NullValue,  // enable_transcription false
+					gcloud.FalseValue, // suppress_in_queue_call_recording false
+					gcloud.NullValue,  // enable_manual_assignment false
+					GenerateMediaSettings("media_settings_call", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_callback", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_email", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_message", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
 					GenerateConditionalGroupRoutingRules(
-						NullValue,                              // queue_id (queue_id in the first rule should be omitted)
+						gcloud.NullValue,                       // queue_id (queue_id in the first rule should be omitted)
 						conditionalGroupRouting1Operator,       // operator
 						conditionalGroupRouting1Metric,         // metric
 						conditionalGroupRouting1ConditionValue, // condition_value
@@ -326,10 +325,10 @@ func TestAccResourceRoutingQueueConditionalRouting(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_wrapup_prompt", wrapupPromptMandTimeout),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_timeout_ms", "200000"),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "skill_evaluation_method", skillEvalAll),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", TrueValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", gcloud.TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", gcloud.FalseValue),
 
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.operator", conditionalGroupRouting1Operator),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.metric", conditionalGroupRouting1Metric),
@@ -345,12 +344,12 @@ func TestAccResourceRoutingQueueConditionalRouting(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.1.groups.0.member_group_type", conditionalGroupRouting2GroupType),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.1.groups.0.member_group_id", "genesyscloud_group."+groupResourceId, "id"),
 
-					TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
-					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, FalseValue, slPercent1, slDuration1),
+					gcloud.TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
+					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
 				),
 			},
 			{
@@ -388,8 +387,8 @@ func TestAccResourceRoutingQueueParToCGR(t *testing.T) {
 
 	// Create CGR queue with routing rules
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				Config: generateRoutingSkillGroupResourceBasic(
@@ -400,23 +399,23 @@ func TestAccResourceRoutingQueueParToCGR(t *testing.T) {
 					queueResource1,
 					queueName1,
 					queueDesc1,
-					NullValue,  // MANDATORY_TIMEOUT
-					"200000",   // acw_timeout
-					NullValue,  // ALL
-					NullValue,  // auto_answer_only true
-					NullValue,  // No calling party name
-					NullValue,  // No calling party number
-					NullValue,  // enable_transcription false
-					FalseValue, // suppress_in_queue_call_recording false
-					NullValue,  // enable_manual_assignment false
-					GenerateMediaSettings("media_settings_call", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_callback", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_email", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					GenerateMediaSettings("media_settings_message", alertTimeout1, FalseValue, slPercent1, slDuration1),
+					gcloud.NullValue,  // MANDATORY_TIMEOUT
+					"200000",          // acw_timeout
+					gcloud.NullValue,  // ALL
+					gcloud.NullValue,  // auto_answer_only true
+					gcloud.NullValue,  // No calling party name
+					gcloud.NullValue,  // No calling party number
+					gcloud.NullValue,  // enable_transcription false
+					gcloud.FalseValue, // suppress_in_queue_call_recording false
+					gcloud.NullValue,  // enable_manual_assignment false
+					GenerateMediaSettings("media_settings_call", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_callback", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_email", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					GenerateMediaSettings("media_settings_message", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
 					GenerateRoutingRules(routingRuleOpAny, "50", "6"),
 					GenerateConditionalGroupRoutingRules(
-						NullValue,                              // queue_id (queue_id in the first rule should be omitted)
+						gcloud.NullValue,                       // queue_id (queue_id in the first rule should be omitted)
 						conditionalGroupRouting1Operator,       // operator
 						conditionalGroupRouting1Metric,         // metric
 						conditionalGroupRouting1ConditionValue, // condition_value
@@ -434,10 +433,10 @@ func TestAccResourceRoutingQueueParToCGR(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_wrapup_prompt", wrapupPromptMandTimeout),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_timeout_ms", "200000"),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "skill_evaluation_method", skillEvalAll),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", TrueValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", FalseValue),
-					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "auto_answer_only", gcloud.TrueValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_manual_assignment", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "suppress_in_queue_call_recording", gcloud.FalseValue),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "enable_transcription", gcloud.FalseValue),
 
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.operator", conditionalGroupRouting1Operator),
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.metric", conditionalGroupRouting1Metric),
@@ -446,12 +445,12 @@ func TestAccResourceRoutingQueueParToCGR(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.groups.0.member_group_type", conditionalGroupRouting1GroupType),
 					resource.TestCheckResourceAttrPair("genesyscloud_routing_queue."+queueResource1, "conditional_group_routing_rules.0.groups.0.member_group_id", "genesyscloud_routing_skill_group."+skillGroupResourceId, "id"),
 
-					TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
-					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, FalseValue, slPercent1, slDuration1),
-					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, FalseValue, slPercent1, slDuration1),
+					gcloud.TestDefaultHomeDivision("genesyscloud_routing_queue."+queueResource1),
+					validateMediaSettings(queueResource1, "media_settings_call", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_callback", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_chat", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_email", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
+					validateMediaSettings(queueResource1, "media_settings_message", alertTimeout1, gcloud.FalseValue, slPercent1, slDuration1),
 					validateRoutingRules(queueResource1, 0, routingRuleOpAny, "50", "6"),
 				),
 			},
@@ -490,13 +489,13 @@ func TestAccResourceRoutingQueueFlows(t *testing.T) {
 
 	var homeDivisionName string
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				Config: "data \"genesyscloud_auth_division_home\" \"home\" {}",
 				Check: resource.ComposeTestCheckFunc(
-					GetHomeDivisionName("data.genesyscloud_auth_division_home.home", &homeDivisionName),
+					gcloud.GetHomeDivisionName("data.genesyscloud_auth_division_home.home", &homeDivisionName),
 				),
 			},
 		},
@@ -529,22 +528,22 @@ func TestAccResourceRoutingQueueFlows(t *testing.T) {
 `, queueFlowName2, homeDivisionName)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create
-				Config: GenerateFlowResource(
+				Config: gcloud.GenerateFlowResource(
 					queueFlowResource1,
 					queueFlowFilePath1,
 					queueFlowInboundcallConfig1,
 					false,
-				) + GenerateFlowResource(
+				) + gcloud.GenerateFlowResource(
 					emailInQueueFlowResource1,
 					queueFlowFilePath2,
 					emailInQueueFlowInboundcallConfig2,
 					false,
-				) + GenerateFlowResource(
+				) + gcloud.GenerateFlowResource(
 					messageInQueueFlowResource1,
 					queueFlowFilePath3,
 					messageInQueueFlowInboundcallConfig3,
@@ -564,17 +563,17 @@ func TestAccResourceRoutingQueueFlows(t *testing.T) {
 			},
 			{
 				// Update the flows
-				Config: GenerateFlowResource(
+				Config: gcloud.GenerateFlowResource(
 					queueFlowResource2,
 					queueFlowFilePath1,
 					queueFlowInboundcallConfig1,
 					false,
-				) + GenerateFlowResource(
+				) + gcloud.GenerateFlowResource(
 					emailInQueueFlowResource2,
 					queueFlowFilePath2,
 					emailInQueueFlowInboundcallConfig2,
 					false,
-				) + GenerateFlowResource(
+				) + gcloud.GenerateFlowResource(
 					messageInQueueFlowResource2,
 					queueFlowFilePath3,
 					messageInQueueFlowInboundcallConfig3,
@@ -617,20 +616,20 @@ func TestAccResourceRoutingQueueMembers(t *testing.T) {
 		queueRingNum         = "3"
 	)
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create
 				Config: GenerateRoutingQueueResourceBasic(
 					queueResource,
 					queueName,
-					GenerateMemberBlock("genesyscloud_user."+queueMemberResource1+".id", NullValue),
-				) + GenerateBasicUserResource(
+					GenerateMemberBlock("genesyscloud_user."+queueMemberResource1+".id", gcloud.NullValue),
+				) + gcloud.GenerateBasicUserResource(
 					queueMemberResource1,
 					queueMemberEmail1,
 					queueMemberName1,
-				) + GenerateBasicUserResource(
+				) + gcloud.GenerateBasicUserResource(
 					queueMemberResource2,
 					queueMemberEmail2,
 					queueMemberName2,
@@ -649,11 +648,11 @@ func TestAccResourceRoutingQueueMembers(t *testing.T) {
 					GenerateBullseyeSettings("10"),
 					GenerateBullseyeSettings("10"),
 					GenerateBullseyeSettings("10"),
-				) + GenerateBasicUserResource(
+				) + gcloud.GenerateBasicUserResource(
 					queueMemberResource1,
 					queueMemberEmail1,
 					queueMemberName1,
-				) + GenerateBasicUserResource(
+				) + gcloud.GenerateBasicUserResource(
 					queueMemberResource2,
 					queueMemberEmail2,
 					queueMemberName2,
@@ -672,11 +671,11 @@ func TestAccResourceRoutingQueueMembers(t *testing.T) {
 					GenerateBullseyeSettings("10"),
 					GenerateBullseyeSettings("10"),
 					GenerateBullseyeSettings("10"),
-				) + GenerateBasicUserResource(
+				) + gcloud.GenerateBasicUserResource(
 					queueMemberResource1,
 					queueMemberEmail1,
 					queueMemberName1,
-				) + GenerateBasicUserResource(
+				) + gcloud.GenerateBasicUserResource(
 					queueMemberResource2,
 					queueMemberEmail2,
 					queueMemberName2,
@@ -774,22 +773,22 @@ func TestAccResourceRoutingQueueSkillgroupMembers(t *testing.T) {
 		Confirm that the length of `skill_groups` and `members` both equal 1
 	*/
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
-				Config: GenerateRoutingSkillResource(
+				Config: gcloud.GenerateRoutingSkillResource(
 					skillResourceId,
 					skillName,
 				) + skillGroupConfig + user2Config +
-					GenerateBasicUserResource(
+					gcloud.GenerateBasicUserResource(
 						user1ResourceId,
 						user1Email,
 						user1Name,
 					) + GenerateRoutingQueueResourceBasic(
 					queueResourceId,
 					queueName,
-					GenerateMemberBlock("genesyscloud_user."+user1ResourceId+".id", NullValue),
+					GenerateMemberBlock("genesyscloud_user."+user1ResourceId+".id", gcloud.NullValue),
 					fmt.Sprintf("skill_groups = [genesyscloud_routing_skill_group.%s.id]", skillGroupResourceId),
 				),
 				Check: resource.ComposeTestCheckFunc(
@@ -820,8 +819,8 @@ func TestAccResourceRoutingQueueWrapupCodes(t *testing.T) {
 		wrapupCodeName3     = "Terraform Test Code3-" + uuid.NewString()
 	)
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create with two wrapup codes
@@ -830,10 +829,10 @@ func TestAccResourceRoutingQueueWrapupCodes(t *testing.T) {
 					queueName,
 					GenerateQueueWrapupCodes("genesyscloud_routing_wrapupcode."+wrapupCodeResource1+".id",
 						"genesyscloud_routing_wrapupcode."+wrapupCodeResource2+".id"),
-				) + GenerateRoutingWrapupcodeResource(
+				) + gcloud.GenerateRoutingWrapupcodeResource(
 					wrapupCodeResource1,
 					wrapupCodeName1,
-				) + GenerateRoutingWrapupcodeResource(
+				) + gcloud.GenerateRoutingWrapupcodeResource(
 					wrapupCodeResource2,
 					wrapupCodeName2,
 				),
@@ -851,13 +850,13 @@ func TestAccResourceRoutingQueueWrapupCodes(t *testing.T) {
 						"genesyscloud_routing_wrapupcode."+wrapupCodeResource1+".id",
 						"genesyscloud_routing_wrapupcode."+wrapupCodeResource2+".id",
 						"genesyscloud_routing_wrapupcode."+wrapupCodeResource3+".id"),
-				) + GenerateRoutingWrapupcodeResource(
+				) + gcloud.GenerateRoutingWrapupcodeResource(
 					wrapupCodeResource1,
 					wrapupCodeName1,
-				) + GenerateRoutingWrapupcodeResource(
+				) + gcloud.GenerateRoutingWrapupcodeResource(
 					wrapupCodeResource2,
 					wrapupCodeName2,
-				) + GenerateRoutingWrapupcodeResource(
+				) + gcloud.GenerateRoutingWrapupcodeResource(
 					wrapupCodeResource3,
 					wrapupCodeName3,
 				),
@@ -872,7 +871,7 @@ func TestAccResourceRoutingQueueWrapupCodes(t *testing.T) {
 					queueResource,
 					queueName,
 					GenerateQueueWrapupCodes("genesyscloud_routing_wrapupcode."+wrapupCodeResource2+".id"),
-				) + GenerateRoutingWrapupcodeResource(
+				) + gcloud.GenerateRoutingWrapupcodeResource(
 					wrapupCodeResource2,
 					wrapupCodeName2,
 				),
@@ -916,8 +915,8 @@ func TestAccResourceRoutingQueueDirectRouting(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create
@@ -981,92 +980,6 @@ the position of the queue, but instead excluded all queue ids that matched the q
 not the right approach because the second conditional routing rule could legitimately reference the same queue
 for the second rule. The function was changed and the unit test below tests these condition.
 */
-func TestFlattenConditionalGroupRoutingRulesForFirstConditionalRoutingRule(t *testing.T) {
-	//Setting up the queue with 2 sets of conditional routing rules and a member group
-	queueName := "Simple Queue Conditional queue"
-	cgr1Metric := "EstimatedWaitTime"
-	cgr1Operator := "GreaterThan"
-	cgr1QueueId := uuid.NewString()
-	cgr1MemberGroupId := uuid.NewString()
-	cgr1MemberGroupType := "GROUP"
-	cgr1ConditionValue := 60.0
-	cgr1WaitSeconds := 2
-
-	cgr2Metric := "EstimatedWaitTime"
-	cgr2Operator := "GreaterThan"
-	cgr2QueueId := uuid.NewString()
-
-	cgr2ConditionValue := 60.0
-	cgr2WaitSeconds := 2
-
-	//Creating the member group and assigning a member group object to it.
-	cgr1MemberGroup := &platformclientv2.Membergroup{
-		VarType: &cgr1MemberGroupType,
-		Id:      &cgr1MemberGroupId,
-	}
-
-	cgr1MemberGroups := make([]platformclientv2.Membergroup, 1)
-	cgr1MemberGroups[0] = *cgr1MemberGroup
-
-	//Create two conditional routing rules
-	cgr1 := &platformclientv2.Conditionalgrouproutingrule{
-		Queue:          &platformclientv2.Domainentityref{Id: &cgr1QueueId},
-		Metric:         &cgr1Metric,
-		Operator:       &cgr1Operator,
-		ConditionValue: &cgr1ConditionValue,
-		WaitSeconds:    &cgr1WaitSeconds,
-		Groups:         &cgr1MemberGroups,
-	}
-
-	cgr2 := &platformclientv2.Conditionalgrouproutingrule{
-		Queue:          &platformclientv2.Domainentityref{Id: &cgr2QueueId},
-		Metric:         &cgr2Metric,
-		Operator:       &cgr2Operator,
-		ConditionValue: &cgr2ConditionValue,
-		WaitSeconds:    &cgr2WaitSeconds,
-		Groups:         &cgr1MemberGroups, //This is not a mistake.  I made both rules share the same member group
-	}
-
-	//Assigning the two routing rules to the queue
-	cgr := make([]platformclientv2.Conditionalgrouproutingrule, 2)
-	cgr[0] = *cgr1
-	cgr[1] = *cgr2
-
-	c := &platformclientv2.Conditionalgrouprouting{}
-	c.Rules = &cgr
-
-	//Creating the queues
-	queue := &platformclientv2.Queue{
-		Name:                    &queueName,
-		ConditionalGroupRouting: c,
-	}
-
-	//Call the function
-	results := flattenConditionalGroupRoutingRules(queue)
-
-	//Retrieve the first and second conditional rules
-	firstConditionalRule := results[0].(map[string]interface{})
-	secondConditionalRule := results[1].(map[string]interface{})
-
-	//If we find a value (e.g. via the presence of "ok") for the first conditional rule then we have a problem
-	queueVal, ok := firstConditionalRule["queue_id"]
-	if ok {
-		t.Errorf("The first conditional rule should never have its queue id returned by the flattenConditionalGroupRoutingRules() function. A queue record was retured %#v", queueVal)
-	}
-
-	//If we do not find a value (e.g. via the non-presence of ok) then we have a problem,
-	queueVal, ok = secondConditionalRule["queue_id"]
-	if !ok {
-		t.Errorf("The second conditional routing rule always needs to have a queue id assigned to it.  In this case, the value you read from the flattenConditionalGroupRoutingRules() function does not contain a queue id")
-	}
-
-	//On the second conditional rule if the queue id does not match the target id we have a probelm
-	targetQueueId := queueVal
-	if targetQueueId != cgr2QueueId {
-		assert.Equal(t, targetQueueId, cgr2QueueId)
-	}
-}
-
 func testVerifyQueuesDestroyed(state *terraform.State) error {
 	routingAPI := platformclientv2.NewRoutingApi()
 	for _, rs := range state.RootModule().Resources {
@@ -1076,7 +989,7 @@ func testVerifyQueuesDestroyed(state *terraform.State) error {
 		queue, resp, err := routingAPI.GetRoutingQueue(rs.Primary.ID)
 		if queue != nil {
 			return fmt.Errorf("Queue (%s) still exists", rs.Primary.ID)
-		} else if IsStatus404(resp) {
+		} else if gcloud.IsStatus404(resp) {
 			// Queue not found as expected
 			continue
 		} else {
@@ -1155,6 +1068,36 @@ func generateRoutingQueueResource(
 		strings.Join(nestedBlocks, "\n"))
 }
 
+func generateGroupResource(
+	resourceID string,
+	name string,
+	desc string,
+	groupType string,
+	visibility string,
+	rulesVisible string,
+	nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_group" "%s" {
+		name = "%s"
+		description = %s
+		type = %s
+		visibility = %s
+		rules_visible = %s
+		%s
+	}
+	`, resourceID, name, desc, groupType, visibility, rulesVisible, strings.Join(nestedBlocks, "\n"))
+}
+
+func generateRoutingSkillGroupResourceBasic(
+	resourceID string,
+	name string,
+	description string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_skill_group" "%s" {
+		name = "%s"
+		description="%s"
+	}
+	`, resourceID, name, description)
+}
+
 func generateMediaSettings(attrName string, alertingTimeout string, slPercent string, slDurationMs string) string {
 	return fmt.Sprintf(`%s {
 		alerting_timeout_sec = %s
@@ -1414,14 +1357,14 @@ func TestAccResourceRoutingQueueSkillGroups(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { TestAccPreCheck(t) },
-		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create
-				Config: GenerateUserWithCustomAttrs(testUserResource, testUserEmail, testUserName) + generateRoutingSkillGroupResourceBasic(skillGroupResource, skillGroupName, skillGroupDescription) +
-					GenerateBasicGroupResource(groupResource, groupName,
-						GenerateGroupOwners("genesyscloud_user."+testUserResource+".id"),
+				Config: gcloud.GenerateUserWithCustomAttrs(testUserResource, testUserEmail, testUserName) + generateRoutingSkillGroupResourceBasic(skillGroupResource, skillGroupName, skillGroupDescription) +
+					gcloud.GenerateBasicGroupResource(groupResource, groupName,
+						gcloud.GenerateGroupOwners("genesyscloud_user."+testUserResource+".id"),
 					) +
 					GenerateRoutingQueueResourceBasicWithDepends(
 						queueResource,
@@ -0,0 +1,703 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceRoutingQueueCreate(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "My Unit Test Queue"
+	tSkillId := uuid.NewString()
+	tGroupId := uuid.NewString()
+	tOtherQueueId := uuid.NewString()
+	tBackupQueueId := uuid.NewString()
+
+	queueProxy := &routingQueueProxy{}
+
+	var createdQueue *platformclientv2.Queue
+	queueProxy.createRoutingQueueAttr = func(ctx context.Context, p *routingQueueProxy, createReq *platformclientv2.Createqueuerequest) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tName, *createReq.Name)
+
+		// The default ring is appended to the configured rings
+		rings := *createReq.Bullseye.Rings
+		assert.Equal(t, 3, len(rings))
+		assert.Equal(t, float64(2), *(*rings[2].ExpansionCriteria)[0].Threshold)
+		assert.Equal(t, tSkillId, *(*rings[0].Actions.SkillsToRemove)[0].Id)
+
+		rules := *createReq.ConditionalGroupRouting.Rules
+		assert.Equal(t, 2, len(rules))
+		assert.Nil(t, rules[0].Queue)
+		assert.Equal(t, tOtherQueueId, *rules[1].Queue.Id)
+		assert.Equal(t, tGroupId, *(*rules[1].Groups)[0].Id)
+
+		assert.Equal(t, tBackupQueueId, *createReq.DirectRouting.BackupQueueId)
+		assert.Equal(t, 30, *createReq.DirectRouting.AgentWaitSeconds)
+
+		createdQueue = &platformclientv2.Queue{
+			Id:                           &tId,
+			Name:                         createReq.Name,
+			Description:                  createReq.Description,
+			MediaSettings:                createReq.MediaSettings,
+			RoutingRules:                 createReq.RoutingRules,
+			Bullseye:                     createReq.Bullseye,
+			ConditionalGroupRouting:      createReq.ConditionalGroupRouting,
+			AcwSettings:                  createReq.AcwSettings,
+			SkillEvaluationMethod:        createReq.SkillEvaluationMethod,
+			MemberGroups:                 createReq.MemberGroups,
+			AutoAnswerOnly:               createReq.AutoAnswerOnly,
+			EnableTranscription:          createReq.EnableTranscription,
+			SuppressInQueueCallRecording: createReq.SuppressInQueueCallRecording,
+			EnableManualAssignment:       createReq.EnableManualAssignment,
+			CallingPartyName:             createReq.CallingPartyName,
+			CallingPartyNumber:           createReq.CallingPartyNumber,
+			DirectRouting:                createReq.DirectRouting,
+			MemberCount:                  platformclientv2.Int(0),
+		}
+		return createdQueue, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueByIdAttr = func(ctx context.Context, p *routingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, queueId)
+		return createdQueue, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueProxy, queueId string, memberBy string, name string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Queuemember{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueWrapupCodesAttr = func(ctx context.Context, p *routingQueueProxy, queueId string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Wrapupcode{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = queueProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	resourceDataMap := map[string]interface{}{
+		"name": tName,
+		"bullseye_rings": []interface{}{
+			map[string]interface{}{
+				"expansion_timeout_seconds": 10.0,
+				"skills_to_remove":          []interface{}{tSkillId},
+			},
+			map[string]interface{}{
+				"expansion_timeout_seconds": 20.0,
+			},
+		},
+		"conditional_group_routing_rules": []interface{}{
+			map[string]interface{}{
+				"operator":        "GreaterThan",
+				"condition_value": 60.0,
+				"wait_seconds":    5,
+			},
+			map[string]interface{}{
+				"queue_id":        tOtherQueueId,
+				"operator":        "LessThan",
+				"condition_value": 10.0,
+				"groups": []interface{}{
+					map[string]interface{}{
+						"member_group_id":   tGroupId,
+						"member_group_type": "GROUP",
+					},
+				},
+			},
+		},
+		"direct_routing": []interface{}{
+			map[string]interface{}{
+				"backup_queue_id":    tBackupQueueId,
+				"agent_wait_seconds": 30,
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, resourceDataMap)
+
+	diag := createQueue(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tId, d.Id())
+	assert.Equal(t, 2, d.Get("bullseye_rings.#").(int))
+	assert.Equal(t, 2, d.Get("conditional_group_routing_rules.#").(int))
+	assert.Equal(t, tOtherQueueId, d.Get("conditional_group_routing_rules.1.queue_id").(string))
+	assert.Equal(t, tBackupQueueId, d.Get("direct_routing.0.backup_queue_id").(string))
+}
+
+func TestUnitResourceRoutingQueueRead(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "My Unit Test Queue"
+	tDescription := "My Unit Test Queue description"
+	tSkillGroupId := uuid.NewString()
+	tTeamId := uuid.NewString()
+	tUserId := uuid.NewString()
+	tWrapupCodeId := uuid.NewString()
+	tBackupQueueId := uuid.NewString()
+
+	resourceDataMap := map[string]interface{}{
+		"name":        tName,
+		"description": tDescription,
+		"bullseye_rings": []interface{}{
+			map[string]interface{}{
+				"expansion_timeout_seconds": 15.0,
+			},
+		},
+		"skill_groups": []interface{}{tSkillGroupId},
+		"teams":        []interface{}{tTeamId},
+		"wrapup_codes": []interface{}{tWrapupCodeId},
+		"members": []interface{}{
+			map[string]interface{}{
+				"user_id":  tUserId,
+				"ring_num": 3,
+			},
+		},
+		"direct_routing": []interface{}{
+			map[string]interface{}{
+				"backup_queue_id":                 tBackupQueueId,
+				"agent_wait_seconds":              45,
+				"wait_for_agent":                  true,
+				"call_use_agent_address_outbound": false,
+			},
+		},
+	}
+
+	queueProxy := &routingQueueProxy{}
+	queueProxy.getRoutingQueueByIdAttr = func(ctx context.Context, p *routingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, queueId)
+
+		// The API returns the queue as it was configured
+		queue := buildQueueFromResourceData(t, tId, resourceDataMap)
+		queue.MemberCount = platformclientv2.Int(1)
+		return queue, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueProxy, queueId string, memberBy string, name string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "user", memberBy)
+		members := []platformclientv2.Queuemember{{Id: &tUserId, RingNumber: platformclientv2.Int(3)}}
+		return &members, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueWrapupCodesAttr = func(ctx context.Context, p *routingQueueProxy, queueId string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+		codes := []platformclientv2.Wrapupcode{{Id: &tWrapupCodeId}}
+		return &codes, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = queueProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, resourceDataMap)
+	d.SetId(tId)
+
+	diag := readQueue(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, tName, d.Get("name").(string))
+	assert.Equal(t, tDescription, d.Get("description").(string))
+
+	// The default ring returned by the API is not part of the configuration
+	assert.Equal(t, 1, d.Get("bullseye_rings.#").(int))
+	assert.Equal(t, float64(15), d.Get("bullseye_rings.0.expansion_timeout_seconds").(float64))
+
+	assert.Equal(t, []interface{}{tSkillGroupId}, d.Get("skill_groups").(*schema.Set).List())
+	assert.Equal(t, []interface{}{tTeamId}, d.Get("teams").(*schema.Set).List())
+	assert.Equal(t, 0, d.Get("groups").(*schema.Set).Len())
+	assert.Equal(t, []interface{}{tWrapupCodeId}, d.Get("wrapup_codes").(*schema.Set).List())
+
+	members := d.Get("members").(*schema.Set).List()
+	assert.Equal(t, 1, len(members))
+	assert.Equal(t, tUserId, members[0].(map[string]interface{})["user_id"])
+	assert.Equal(t, 3, members[0].(map[string]interface{})["ring_num"])
+
+	assert.Equal(t, tBackupQueueId, d.Get("direct_routing.0.backup_queue_id").(string))
+	assert.Equal(t, 45, d.Get("direct_routing.0.agent_wait_seconds").(int))
+	assert.Equal(t, true, d.Get("direct_routing.0.wait_for_agent").(bool))
+	assert.Equal(t, false, d.Get("direct_routing.0.call_use_agent_address_outbound").(bool))
+}
+
+func TestUnitResourceRoutingQueueDelete(t *testing.T) {
+	tId := uuid.NewString()
+	tName := "My Unit Test Queue"
+
+	queueProxy := &routingQueueProxy{}
+	queueProxy.deleteRoutingQueueAttr = func(ctx context.Context, p *routingQueueProxy, queueId string, forceDelete bool) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, queueId)
+		assert.Equal(t, true, forceDelete)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueByIdAttr = func(ctx context.Context, p *routingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, queueId)
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("Unable to find targeted queue: %s", queueId)
+	}
+	internalProxy = queueProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{"name": tName})
+	d.SetId(tId)
+
+	diag := deleteQueue(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+}
+
+func TestUnitResourceRoutingQueueMemberReadRemoved(t *testing.T) {
+	tQueueId := uuid.NewString()
+	tUserId := uuid.NewString()
+	tOtherUserId := uuid.NewString()
+
+	queueProxy := &routingQueueProxy{}
+	queueProxy.getRoutingQueueByIdAttr = func(ctx context.Context, p *routingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tQueueId, queueId)
+		return &platformclientv2.Queue{Id: &tQueueId, MemberCount: platformclientv2.Int(1)}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueProxy, queueId string, memberBy string, name string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+		// The user was removed from the queue outside of Terraform
+		members := []platformclientv2.Queuemember{{Id: &tOtherUserId, RingNumber: platformclientv2.Int(1)}}
+		return &members, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = queueProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueueMember().Schema, map[string]interface{}{
+		"queue_id": tQueueId,
+		"user_id":  tUserId,
+	})
+	d.SetId(buildQueueMemberId(tQueueId, tUserId))
+
+	diag := readRoutingQueueMember(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, "", d.Id())
+}
+
+func TestUnitBuildSdkBullseyeSettings(t *testing.T) {
+	tMemberGroupId := uuid.NewString()
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{
+		"name": "queue",
+		"bullseye_rings": []interface{}{
+			map[string]interface{}{
+				"expansion_timeout_seconds": 12.5,
+				"member_groups": []interface{}{
+					map[string]interface{}{
+						"member_group_id":   tMemberGroupId,
+						"member_group_type": "SKILLGROUP",
+					},
+				},
+			},
+		},
+	})
+
+	bullseye := buildSdkBullseyeSettings(d)
+	rings := *bullseye.Rings
+	assert.Equal(t, 2, len(rings))
+	assert.Equal(t, 12.5, *(*rings[0].ExpansionCriteria)[0].Threshold)
+	assert.Equal(t, tMemberGroupId, *(*rings[0].MemberGroups)[0].Id)
+	assert.Nil(t, rings[1].MemberGroups)
+	assert.Equal(t, float64(2), *(*rings[1].ExpansionCriteria)[0].Threshold)
+
+	// Flattening the rings drops the default ring again
+	flattened := flattenBullseyeRings(&rings)
+	assert.Equal(t, 1, len(flattened))
+	ring := flattened[0].(map[string]interface{})
+	assert.Equal(t, 12.5, ring["expansion_timeout_seconds"])
+	assert.Equal(t, 1, ring["member_groups"].(*schema.Set).Len())
+
+	// No rings are sent when none are configured
+	d = schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{"name": "queue"})
+	assert.Nil(t, buildSdkBullseyeSettings(d))
+}
+
+func TestUnitBuildSdkConditionalGroupRoutingFirstRuleQueueId(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{
+		"name": "queue",
+		"conditional_group_routing_rules": []interface{}{
+			map[string]interface{}{
+				"queue_id":        uuid.NewString(),
+				"operator":        "GreaterThan",
+				"condition_value": 60.0,
+			},
+		},
+	})
+
+	cgr, diag := buildSdkConditionalGroupRouting(d)
+	assert.Nil(t, cgr)
+	assert.Equal(t, true, diag.HasError())
+}
+
+func TestUnitFlattenConditionalGroupRoutingRulesForFirstConditionalRoutingRule(t *testing.T) {
+	//Setting up the queue with 2 sets of conditional routing rules and a member group
+	queueName := "Simple Queue Conditional queue"
+	cgr1Metric := "EstimatedWaitTime"
+	cgr1Operator := "GreaterThan"
+	cgr1QueueId := uuid.NewString()
+	cgr1MemberGroupId := uuid.NewString()
+	cgr1MemberGroupType := "GROUP"
+	cgr1ConditionValue := 60.0
+	cgr1WaitSeconds := 2
+
+	cgr2Metric := "EstimatedWaitTime"
+	cgr2Operator := "GreaterThan"
+	cgr2QueueId := uuid.NewString()
+
+	cgr2ConditionValue := 60.0
+	cgr2WaitSeconds := 2
+
+	//Creating the member group and assigning a member group object to it.
+	cgr1MemberGroup := &platformclientv2.Membergroup{
+		VarType: &cgr1MemberGroupType,
+		Id:      &cgr1MemberGroupId,
+	}
+
+	cgr1MemberGroups := make([]platformclientv2.Membergroup, 1)
+	cgr1MemberGroups[0] = *cgr1MemberGroup
+
+	//Create two conditional routing rules
+	cgr1 := &platformclientv2.Conditionalgrouproutingrule{
+		Queue:          &platformclientv2.Domainentityref{Id: &cgr1QueueId},
+		Metric:         &cgr1Metric,
+		Operator:       &cgr1Operator,
+		ConditionValue: &cgr1ConditionValue,
+		WaitSeconds:    &cgr1WaitSeconds,
+		Groups:         &cgr1MemberGroups,
+	}
+
+	cgr2 := &platformclientv2.Conditionalgrouproutingrule{
+		Queue:          &platformclientv2.Domainentityref{Id: &cgr2QueueId},
+		Metric:         &cgr2Metric,
+		Operator:       &cgr2Operator,
+		ConditionValue: &cgr2ConditionValue,
+		WaitSeconds:    &cgr2WaitSeconds,
+		Groups:         &cgr1MemberGroups, //This is not a mistake.  I made both rules share the same member group
+	}
+
+	//Assigning the two routing rules to the queue
+	cgr := make([]platformclientv2.Conditionalgrouproutingrule, 2)
+	cgr[0] = *cgr1
+	cgr[1] = *cgr2
+
+	c := &platformclientv2.Conditionalgrouprouting{}
+	c.Rules = &cgr
+
+	//Creating the queues
+	queue := &platformclientv2.Queue{
+		Name:                    &queueName,
+		ConditionalGroupRouting: c,
+	}
+
+	//Call the function
+	results := flattenConditionalGroupRoutingRules(queue)
+
+	//Retrieve the first and second conditional rules
+	firstConditionalRule := results[0].(map[string]interface{})
+	secondConditionalRule := results[1].(map[string]interface{})
+
+	//If we find a value (e.g. via the presence of "ok") for the first conditional rule then we have a problem
+	queueVal, ok := firstConditionalRule["queue_id"]
+	if ok {
+		t.Errorf("The first conditional rule should never have its queue id returned by the flattenConditionalGroupRoutingRules() function. A queue record was retured %#v", queueVal)
+	}
+
+	//If we do not find a value (e.g. via the non-presence of ok) then we have a problem,
+	queueVal, ok = secondConditionalRule["queue_id"]
+	if !ok {
+		t.Errorf("The second conditional routing rule always needs to have a queue id assigned to it.  In this case, the value you read from the flattenConditionalGroupRoutingRules() function does not contain a queue id")
+	}
+
+	//On the second conditional rule if the queue id does not match the target id we have a probelm
+	targetQueueId := queueVal
+	if targetQueueId != cgr2QueueId {
+		assert.Equal(t, targetQueueId, cgr2QueueId)
+	}
+}
+
+func TestUnitBuildSdkDirectRouting(t *testing.T) {
+	tBackupQueueId := uuid.NewString()
+
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{
+		"name": "queue",
+		"direct_routing": []interface{}{
+			map[string]interface{}{
+				"backup_queue_id":                 tBackupQueueId,
+				"wait_for_agent":                  true,
+				"call_use_agent_address_outbound": false,
+			},
+		},
+	})
+
+	directRouting := buildSdkDirectRouting(d)
+	assert.Equal(t, tBackupQueueId, *directRouting.BackupQueueId)
+	assert.Equal(t, 60, *directRouting.AgentWaitSeconds)
+	assert.Equal(t, true, *directRouting.WaitForAgent)
+	assert.Equal(t, false, *directRouting.CallMediaSettings.UseAgentAddressOutbound)
+	assert.Equal(t, true, *directRouting.EmailMediaSettings.UseAgentAddressOutbound)
+	assert.Equal(t, true, *directRouting.MessageMediaSettings.UseAgentAddressOutbound)
+
+	flattened := flattenDirectRouting(directRouting)[0].(map[string]interface{})
+	assert.Equal(t, tBackupQueueId, flattened["backup_queue_id"])
+	assert.Equal(t, 60, flattened["agent_wait_seconds"])
+	assert.Equal(t, true, flattened["wait_for_agent"])
+	assert.Equal(t, false, flattened["call_use_agent_address_outbound"])
+	assert.Equal(t, true, flattened["email_use_agent_address_outbound"])
+	assert.Equal(t, true, flattened["message_use_agent_address_outbound"])
+
+	d = schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{"name": "queue"})
+	assert.Nil(t, buildSdkDirectRouting(d))
+}
+
+func TestUnitUpdateQueueUserMembersAuthoritative(t *testing.T) {
+	tQueueId := uuid.NewString()
+	queueProxy, recorder := buildQueueMembersMockProxy(t, tQueueId, map[string]int{"user-a": 1, "user-b": 1}, nil)
+
+	diag := updateQueueUserMembers(context.Background(), queueProxy, tQueueId, map[string]int{"user-a": 3, "user-c": 1}, nil, true)
+	assert.Equal(t, false, diag.HasError())
+
+	// Members that are not configured are removed in authoritative mode
+	assert.Equal(t, []string{"user-b"}, recorder.removed)
+	assert.Equal(t, []string{"user-c"}, recorder.added)
+	assert.Equal(t, map[string]int{"user-a": 3}, recorder.ringNums)
+}
+
+func TestUnitUpdateQueueUserMembersAdditive(t *testing.T) {
+	tQueueId := uuid.NewString()
+	queueProxy, recorder := buildQueueMembersMockProxy(t, tQueueId, map[string]int{"user-a": 1, "user-b": 1, "user-d": 2}, nil)
+
+	diag := updateQueueUserMembers(context.Background(), queueProxy, tQueueId, map[string]int{"user-a": 1, "user-c": 2}, []string{"user-b", "user-x"}, false)
+	assert.Equal(t, false, diag.HasError())
+
+	// Only the requested members that are still in the queue are removed. user-d is not managed.
+	assert.Equal(t, []string{"user-b"}, recorder.removed)
+	assert.Equal(t, []string{"user-c"}, recorder.added)
+	// New members only get their ring number updated when it is not the default
+	assert.Equal(t, map[string]int{"user-c": 2}, recorder.ringNums)
+}
+
+func TestUnitUpdateQueueUserMembersRemoveAll(t *testing.T) {
+	tQueueId := uuid.NewString()
+	queueProxy, recorder := buildQueueMembersMockProxy(t, tQueueId, map[string]int{"user-a": 1, "user-b": 4}, nil)
+
+	diag := updateQueueUserMembers(context.Background(), queueProxy, tQueueId, nil, nil, true)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, []string{"user-a", "user-b"}, recorder.removed)
+	assert.Equal(t, 0, len(recorder.added))
+	assert.Equal(t, 0, len(recorder.ringNums))
+}
+
+func TestUnitUpdateQueueUserMembersGroupMember(t *testing.T) {
+	tQueueId := uuid.NewString()
+	queueProxy, recorder := buildQueueMembersMockProxy(t, tQueueId, map[string]int{}, []string{"user-a"})
+
+	diag := updateQueueUserMembers(context.Background(), queueProxy, tQueueId, map[string]int{"user-a": 1}, nil, true)
+	assert.Equal(t, true, diag.HasError())
+	assert.Contains(t, diag[0].Summary, "via a group")
+	assert.Equal(t, 0, len(recorder.added))
+}
+
+// queueMembersRecorder captures the member changes made through a mocked routing queue proxy
+type queueMembersRecorder struct {
+	added    []string
+	removed  []string
+	ringNums map[string]int
+}
+
+// buildQueueMembersMockProxy builds a proxy for a queue with the given user members and group members
+func buildQueueMembersMockProxy(t *testing.T, queueId string, userRingNums map[string]int, groupMemberIds []string) (*routingQueueProxy, *queueMembersRecorder) {
+	recorder := &queueMembersRecorder{ringNums: make(map[string]int)}
+	queueProxy := &routingQueueProxy{}
+
+	queueProxy.getRoutingQueueByIdAttr = func(ctx context.Context, p *routingQueueProxy, id string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		return &platformclientv2.Queue{Id: &queueId, MemberCount: platformclientv2.Int(len(userRingNums))}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getUserAttr = func(ctx context.Context, p *routingQueueProxy, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.User{Id: &userId, Name: platformclientv2.String("name " + userId)}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.getRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueProxy, id string, memberBy string, name string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		var members []platformclientv2.Queuemember
+		if memberBy == "group" {
+			for _, userId := range groupMemberIds {
+				members = append(members, platformclientv2.Queuemember{Id: platformclientv2.String(userId), RingNumber: platformclientv2.Int(1)})
+			}
+		} else {
+			var userIds []string
+			for userId := range userRingNums {
+				userIds = append(userIds, userId)
+			}
+			sort.Strings(userIds)
+			for _, userId := range userIds {
+				members = append(members, platformclientv2.Queuemember{Id: platformclientv2.String(userId), RingNumber: platformclientv2.Int(userRingNums[userId])})
+			}
+		}
+		return &members, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.addOrRemoveRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueProxy, id string, members []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		for _, member := range members {
+			if remove {
+				recorder.removed = append(recorder.removed, *member.Id)
+			} else {
+				recorder.added = append(recorder.added, *member.Id)
+			}
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.updateRoutingQueueMemberAttr = func(ctx context.Context, p *routingQueueProxy, id string, userId string, member platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		recorder.ringNums[userId] = *member.RingNumber
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	return queueProxy, recorder
+}
+
+// buildQueueFromResourceData builds the queue the API would return for the given resource configuration
+func buildQueueFromResourceData(t *testing.T, id string, resourceDataMap map[string]interface{}) *platformclientv2.Queue {
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, resourceDataMap)
+	memberGroups := buildMemberGroups(d)
+	conditionalGroupRouting, _ := buildSdkConditionalGroupRouting(d)
+
+	return &platformclientv2.Queue{
+		Id:                           &id,
+		Name:                         platformclientv2.String(d.Get("name").(string)),
+		Description:                  platformclientv2.String(d.Get("description").(string)),
+		MediaSettings:                buildSdkMediaSettings(d),
+		RoutingRules:                 buildSdkRoutingRules(d),
+		Bullseye:                     buildSdkBullseyeSettings(d),
+		ConditionalGroupRouting:      conditionalGroupRouting,
+		AcwSettings:                  buildSdkAcwSettings(d),
+		SkillEvaluationMethod:        platformclientv2.String(d.Get("skill_evaluation_method").(string)),
+		MemberGroups:                 &memberGroups,
+		AutoAnswerOnly:               platformclientv2.Bool(d.Get("auto_answer_only").(bool)),
+		EnableTranscription:          platformclientv2.Bool(d.Get("enable_transcription").(bool)),
+		SuppressInQueueCallRecording: platformclientv2.Bool(d.Get("suppress_in_queue_call_recording").(bool)),
+		EnableManualAssignment:       platformclientv2.Bool(d.Get("enable_manual_assignment").(bool)),
+		CallingPartyName:             platformclientv2.String(d.Get("calling_party_name").(string)),
+		CallingPartyNumber:           platformclientv2.String(d.Get("calling_party_number").(string)),
+		DirectRouting:                buildSdkDirectRouting(d),
+	}
+}
+
+func TestUnitUpdateQueueWrapupCodeAssignments(t *testing.T) {
+	tQueueId := uuid.NewString()
+	queueProxy, recorder := buildQueueWrapupCodesMockProxy(t, tQueueId, []string{"code-a", "code-b", "code-d"})
+
+	diag := updateQueueWrapupCodeAssignments(context.Background(), queueProxy, tQueueId, []string{"code-a", "code-c"}, []string{"code-b", "code-x"})
+	assert.Equal(t, false, diag.HasError())
+
+	// Only unassigned codes that are still on the queue are removed. code-d is not managed.
+	assert.Equal(t, []string{"code-b"}, recorder.removed)
+	assert.Equal(t, []string{"code-c"}, recorder.added)
+}
+
+func TestUnitUpdateQueueWrapupCodeAssignmentsChunks(t *testing.T) {
+	tQueueId := uuid.NewString()
+	queueProxy, recorder := buildQueueWrapupCodesMockProxy(t, tQueueId, nil)
+
+	var codeIds []string
+	for i := 0; i < 250; i++ {
+		codeIds = append(codeIds, fmt.Sprintf("code-%d", i))
+	}
+
+	diag := updateQueueWrapupCodeAssignments(context.Background(), queueProxy, tQueueId, codeIds, nil)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, codeIds, recorder.added)
+	// The API accepts at most 100 wrapup codes per call
+	assert.Equal(t, []int{100, 100, 50}, recorder.addCallSizes)
+}
+
+func TestUnitUpdateQueueWrapupCodeAssignmentsQueueDeleted(t *testing.T) {
+	tQueueId := uuid.NewString()
+	queueProxy, recorder := buildQueueWrapupCodesMockProxy(t, tQueueId, nil)
+	queueProxy.getRoutingQueueWrapupCodesAttr = func(ctx context.Context, p *routingQueueProxy, queueId string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("queue not found")
+	}
+
+	diag := updateQueueWrapupCodeAssignments(context.Background(), queueProxy, tQueueId, nil, []string{"code-a"})
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, 0, len(recorder.removed))
+}
+
+func TestUnitReadRoutingQueueWrapupCodeOnlyStateCodes(t *testing.T) {
+	ctx := context.Background()
+	gcloud := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	defer func() { internalProxy = nil }()
+
+	// code-b is assigned by another configuration and is not adopted
+	tQueueId := uuid.NewString()
+	internalProxy, _ = buildQueueWrapupCodesMockProxy(t, tQueueId, []string{"code-a", "code-b"})
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueueWrapupCode().Schema, map[string]interface{}{
+		"queue_id":     tQueueId,
+		"wrapup_codes": []interface{}{"code-a"},
+	})
+	d.SetId(tQueueId)
+
+	diag := readRoutingQueueWrapupCode(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, []interface{}{"code-a"}, d.Get("wrapup_codes").(*schema.Set).List())
+
+	// Once every code in state is gone, no code of the queue is adopted
+	tQueueId = uuid.NewString()
+	internalProxy, _ = buildQueueWrapupCodesMockProxy(t, tQueueId, []string{"code-a", "code-b"})
+	d = schema.TestResourceDataRaw(t, ResourceRoutingQueueWrapupCode().Schema, map[string]interface{}{"queue_id": tQueueId})
+	d.SetId(tQueueId)
+
+	diag = readRoutingQueueWrapupCode(ctx, d, gcloud)
+	assert.Equal(t, false, diag.HasError())
+	assert.Equal(t, 0, d.Get("wrapup_codes").(*schema.Set).Len())
+
+	// Imports read every code of the queue
+	tQueueId = uuid.NewString()
+	internalProxy, _ = buildQueueWrapupCodesMockProxy(t, tQueueId, []string{"code-a", "code-b"})
+	d = schema.TestResourceDataRaw(t, ResourceRoutingQueueWrapupCode().Schema, map[string]interface{}{})
+	d.SetId(tQueueId)
+
+	imported, err := importRoutingQueueWrapupCode(ctx, d, gcloud)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(imported))
+	assert.ElementsMatch(t, []interface{}{"code-a", "code-b"}, imported[0].Get("wrapup_codes").(*schema.Set).List())
+}
+
+// queueWrapupCodesRecorder captures the wrapup code changes made through a mocked routing queue proxy
+type queueWrapupCodesRecorder struct {
+	added        []string
+	removed      []string
+	addCallSizes []int
+}
+
+// buildQueueWrapupCodesMockProxy builds a proxy for a queue with the given wrapup codes
+func buildQueueWrapupCodesMockProxy(t *testing.T, queueId string, codeIds []string) (*routingQueueProxy, *queueWrapupCodesRecorder) {
+	recorder := &queueWrapupCodesRecorder{}
+	queueProxy := &routingQueueProxy{}
+
+	queueProxy.getRoutingQueueWrapupCodesAttr = func(ctx context.Context, p *routingQueueProxy, id string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		codes := make([]platformclientv2.Wrapupcode, 0)
+		for _, codeId := range codeIds {
+			codes = append(codes, platformclientv2.Wrapupcode{Id: platformclientv2.String(codeId)})
+		}
+		return &codes, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.addRoutingQueueWrapupCodesAttr = func(ctx context.Context, p *routingQueueProxy, id string, codes []platformclientv2.Wrapupcodereference) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		recorder.addCallSizes = append(recorder.addCallSizes, len(codes))
+		for _, code := range codes {
+			recorder.added = append(recorder.added, *code.Id)
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	queueProxy.deleteRoutingQueueWrapupCodeAttr = func(ctx context.Context, p *routingQueueProxy, id string, codeId string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		recorder.removed = append(recorder.removed, codeId)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	return queueProxy, recorder
+}
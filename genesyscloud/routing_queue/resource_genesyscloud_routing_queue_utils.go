@@ -0,0 +1,1097 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// buildMemberGroups collects the skill groups, groups and teams of the queue into a single member group list
+func buildMemberGroups(d *schema.ResourceData) []platformclientv2.Membergroup {
+	skillGroups := buildMemberGroupList(d, "skill_groups", "SKILLGROUP")
+	groups := buildMemberGroupList(d, "groups", "GROUP")
+	teams := buildMemberGroupList(d, "teams", "TEAM")
+	memberGroups := append(*skillGroups, *groups...)
+	return append(memberGroups, *teams...)
+}
+
+func buildSdkMediaSettings(d *schema.ResourceData) *platformclientv2.Queuemediasettings {
+	queueMediaSettings := &platformclientv2.Queuemediasettings{}
+
+	mediaSettingsCall := d.Get("media_settings_call").([]interface{})
+	if mediaSettingsCall != nil && len(mediaSettingsCall) > 0 {
+		queueMediaSettings.Call = buildSdkMediaSetting(mediaSettingsCall)
+	}
+
+	mediaSettingsCallback := d.Get("media_settings_callback").([]interface{})
+	if mediaSettingsCallback != nil && len(mediaSettingsCallback) > 0 {
+		queueMediaSettings.Callback = buildSdkMediaSettingCallback(mediaSettingsCallback)
+	}
+
+	mediaSettingsChat := d.Get("media_settings_chat").([]interface{})
+	if mediaSettingsChat != nil && len(mediaSettingsChat) > 0 {
+		queueMediaSettings.Chat = buildSdkMediaSetting(mediaSettingsChat)
+	}
+
+	mediaSettingsEmail := d.Get("media_settings_email").([]interface{})
+	log.Printf("The media settings email #%v", mediaSettingsEmail)
+	if mediaSettingsEmail != nil && len(mediaSettingsEmail) > 0 {
+		queueMediaSettings.Email = buildSdkMediaSetting(mediaSettingsEmail)
+	}
+
+	mediaSettingsMessage := d.Get("media_settings_message").([]interface{})
+	if mediaSettingsMessage != nil && len(mediaSettingsMessage) > 0 {
+		queueMediaSettings.Message = buildSdkMediaSetting(mediaSettingsMessage)
+	}
+
+	return queueMediaSettings
+}
+
+func buildSdkMediaSetting(settings []interface{}) *platformclientv2.Mediasettings {
+	settingsMap := settings[0].(map[string]interface{})
+
+	return &platformclientv2.Mediasettings{
+		AlertingTimeoutSeconds: platformclientv2.Int(settingsMap["alerting_timeout_sec"].(int)),
+		EnableAutoAnswer:       platformclientv2.Bool(settingsMap["enable_auto_answer"].(bool)),
+		ServiceLevel: &platformclientv2.Servicelevel{
+			Percentage: platformclientv2.Float64(settingsMap["service_level_percentage"].(float64)),
+			DurationMs: platformclientv2.Int(settingsMap["service_level_duration_ms"].(int)),
+		},
+	}
+}
+
+func buildSdkMediaSettingCallback(settings []interface{}) *platformclientv2.Callbackmediasettings {
+	settingsMap := settings[0].(map[string]interface{})
+
+	return &platformclientv2.Callbackmediasettings{
+		AlertingTimeoutSeconds: platformclientv2.Int(settingsMap["alerting_timeout_sec"].(int)),
+		ServiceLevel: &platformclientv2.Servicelevel{
+			Percentage: platformclientv2.Float64(settingsMap["service_level_percentage"].(float64)),
+			DurationMs: platformclientv2.Int(settingsMap["service_level_duration_ms"].(int)),
+		},
+		EnableAutoAnswer: platformclientv2.Bool(settingsMap["enable_auto_answer"].(bool)),
+	}
+}
+
+func flattenMediaSetting(settings *platformclientv2.Mediasettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
+	resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_answer", settings.EnableAutoAnswer)
+	settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
+	settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+
+	return []interface{}{settingsMap}
+}
+
+func flattenMediaSettingCallback(settings *platformclientv2.Callbackmediasettings) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	settingsMap["alerting_timeout_sec"] = *settings.AlertingTimeoutSeconds
+	settingsMap["service_level_percentage"] = *settings.ServiceLevel.Percentage
+	settingsMap["service_level_duration_ms"] = *settings.ServiceLevel.DurationMs
+	resourcedata.SetMapValueIfNotNil(settingsMap, "enable_auto_answer", settings.EnableAutoAnswer)
+
+	return []interface{}{settingsMap}
+}
+
+func buildMemberGroupList(d *schema.ResourceData, groupKey string, groupType string) *[]platformclientv2.Membergroup {
+	var memberGroups []platformclientv2.Membergroup
+	if mg, ok := d.GetOk(groupKey); ok {
+
+		for _, mgId := range mg.(*schema.Set).List() {
+			id := mgId.(string)
+
+			memberGroup := &platformclientv2.Membergroup{Id: &id, VarType: &groupType}
+			memberGroups = append(memberGroups, *memberGroup)
+		}
+	}
+
+	return &memberGroups
+}
+
+func buildSdkRoutingRules(d *schema.ResourceData) *[]platformclientv2.Routingrule {
+	var routingRules []platformclientv2.Routingrule
+	if configRoutingRules, ok := d.GetOk("routing_rules"); ok {
+		for _, configRule := range configRoutingRules.([]interface{}) {
+			ruleSettings, ok := configRule.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			var sdkRule platformclientv2.Routingrule
+
+			resourcedata.BuildSDKStringValueIfNotNil(&sdkRule.Operator, ruleSettings, "operator")
+			if threshold, ok := ruleSettings["threshold"]; ok {
+				v := threshold.(int)
+				sdkRule.Threshold = &v
+			}
+			if waitSeconds, ok := ruleSettings["wait_seconds"].(float64); ok {
+				sdkRule.WaitSeconds = &waitSeconds
+			}
+
+			routingRules = append(routingRules, sdkRule)
+		}
+	}
+	return &routingRules
+}
+
+func flattenRoutingRules(sdkRoutingRules *[]platformclientv2.Routingrule) []interface{} {
+	rules := make([]interface{}, len(*sdkRoutingRules))
+	for i, sdkRule := range *sdkRoutingRules {
+		ruleSettings := make(map[string]interface{})
+
+		resourcedata.SetMapValueIfNotNil(ruleSettings, "operator", sdkRule.Operator)
+		resourcedata.SetMapValueIfNotNil(ruleSettings, "threshold", sdkRule.Threshold)
+		resourcedata.SetMapValueIfNotNil(ruleSettings, "wait_seconds", sdkRule.WaitSeconds)
+
+		rules[i] = ruleSettings
+	}
+	return rules
+}
+
+func buildSdkBullseyeSettings(d *schema.ResourceData) *platformclientv2.Bullseye {
+	if configRings, ok := d.GetOk("bullseye_rings"); ok {
+		var sdkRings []platformclientv2.Ring
+		for _, configRing := range configRings.([]interface{}) {
+			ringSettings, ok := configRing.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			var sdkRing platformclientv2.Ring
+
+			if waitSeconds, ok := ringSettings["expansion_timeout_seconds"].(float64); ok {
+				sdkRing.ExpansionCriteria = &[]platformclientv2.Expansioncriterium{
+					{
+						VarType:   &bullseyeExpansionTypeTimeout,
+						Threshold: &waitSeconds,
+					},
+				}
+			}
+
+			if skillsToRemove, ok := ringSettings["skills_to_remove"]; ok {
+				skillIds := skillsToRemove.(*schema.Set).List()
+				if len(skillIds) > 0 {
+					sdkSkillsToRemove := make([]platformclientv2.Skillstoremove, len(skillIds))
+					for i, id := range skillIds {
+						skillID := id.(string)
+						sdkSkillsToRemove[i] = platformclientv2.Skillstoremove{
+							Id: &skillID,
+						}
+					}
+					sdkRing.Actions = &platformclientv2.Actions{
+						SkillsToRemove: &sdkSkillsToRemove,
+					}
+				}
+			}
+
+			if memberGroups, ok := ringSettings["member_groups"]; ok {
+				memberGroupList := memberGroups.(*schema.Set).List()
+				if len(memberGroupList) > 0 {
+
+					sdkMemberGroups := make([]platformclientv2.Membergroup, len(memberGroupList))
+					for i, memberGroup := range memberGroupList {
+						settingsMap := memberGroup.(map[string]interface{})
+						memberGroupID := settingsMap["member_group_id"].(string)
+						memberGroupType := settingsMap["member_group_type"].(string)
+
+						sdkMemberGroups[i] = platformclientv2.Membergroup{
+							Id:      &memberGroupID,
+							VarType: &memberGroupType,
+						}
+					}
+					sdkRing.MemberGroups = &sdkMemberGroups
+				}
+			}
+			sdkRings = append(sdkRings, sdkRing)
+		}
+
+		/*
+			The routing queues API is a little unusual.  You can have up to six bullseye routing rings but the last one is always
+			a treated as the default ring.  This means you can actually ony define a maximum of 5.  So, I have changed the behavior of this
+			resource to only allow you to add 5 items and then the code always adds a 6 item (see the code below) with a default timeout of 2.
+		*/
+		var defaultSdkRing platformclientv2.Ring
+		defaultTimeoutInt := 2
+		defaultTimeoutFloat := float64(defaultTimeoutInt)
+		defaultSdkRing.ExpansionCriteria = &[]platformclientv2.Expansioncriterium{
+			{
+				VarType:   &bullseyeExpansionTypeTimeout,
+				Threshold: &defaultTimeoutFloat,
+			},
+		}
+
+		sdkRings = append(sdkRings, defaultSdkRing)
+
+		return &platformclientv2.Bullseye{Rings: &sdkRings}
+	}
+	return nil
+}
+
+/*
+The flattenBullseyeRings function maps the data retrieved from our SDK call over to the bullseye_ring attribute within the provider.
+You might notice in the code that we are always mapping all but the last item in the list of rings retrieved by the API.  The reason for this
+is that when you submit a list of bullseye_rings to the API, the API will always take the last item in the list and use it to drive default behavior
+This is a change from earlier parts of the API where you could define 6 bullseye rings and there would always be six.  Now when you define bullseye rings,
+the public API will take the list item in the list and make it the default and it will not show up on the screen.  To get around this you needed
+to always add a dumb bullseye ring block.  Now, we automatically add one for you.  We only except a maximum of 5 bullseyes_ring blocks, but we will always
+remove the last block returned by the API.
+*/
+func flattenBullseyeRings(sdkRings *[]platformclientv2.Ring) []interface{} {
+	rings := make([]interface{}, len(*sdkRings)-1) //Sizing the target array of Rings to account for us removing the default block
+	for i, sdkRing := range *sdkRings {
+		if i < len(*sdkRings)-1 { //Checking to make sure we are do nothing with the last item in the list by skipping processing if it is defined
+			ringSettings := make(map[string]interface{})
+			if sdkRing.ExpansionCriteria != nil {
+				for _, criteria := range *sdkRing.ExpansionCriteria {
+					if *criteria.VarType == bullseyeExpansionTypeTimeout {
+						ringSettings["expansion_timeout_seconds"] = *criteria.Threshold
+						break
+					}
+				}
+			}
+
+			if sdkRing.Actions != nil && sdkRing.Actions.SkillsToRemove != nil {
+				skillIds := make([]interface{}, len(*sdkRing.Actions.SkillsToRemove))
+				for s, skill := range *sdkRing.Actions.SkillsToRemove {
+					skillIds[s] = *skill.Id
+				}
+				ringSettings["skills_to_remove"] = schema.NewSet(schema.HashString, skillIds)
+			}
+
+			if sdkRing.MemberGroups != nil {
+				memberGroups := schema.NewSet(schema.HashResource(memberGroupResource), []interface{}{})
+
+				for _, memberGroup := range *sdkRing.MemberGroups {
+					memberGroupMap := make(map[string]interface{})
+					memberGroupMap["member_group_id"] = *memberGroup.Id
+					memberGroupMap["member_group_type"] = *memberGroup.VarType
+
+					memberGroups.Add(memberGroupMap)
+				}
+
+				ringSettings["member_groups"] = memberGroups
+			}
+			rings[i] = ringSettings
+		}
+	}
+	return rings
+}
+
+func buildSdkConditionalGroupRouting(d *schema.ResourceData) (*platformclientv2.Conditionalgrouprouting, diag.Diagnostics) {
+	if configRules, ok := d.GetOk("conditional_group_routing_rules"); ok {
+		var sdkCGRRules []platformclientv2.Conditionalgrouproutingrule
+		for i, configRules := range configRules.([]interface{}) {
+			ruleSettings, ok := configRules.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			var sdkCGRRule platformclientv2.Conditionalgrouproutingrule
+
+			if waitSeconds, ok := ruleSettings["wait_seconds"].(int); ok {
+				sdkCGRRule.WaitSeconds = &waitSeconds
+			}
+			resourcedata.BuildSDKStringValueIfNotNil(&sdkCGRRule.Operator, ruleSettings, "operator")
+			if conditionValue, ok := ruleSettings["condition_value"].(float64); ok {
+				sdkCGRRule.ConditionValue = &conditionValue
+			}
+			resourcedata.BuildSDKStringValueIfNotNil(&sdkCGRRule.Metric, ruleSettings, "metric")
+
+			if queueId, ok := ruleSettings["queue_id"].(string); ok && queueId != "" {
+				if i == 0 {
+					return nil, diag.Errorf("For rule 1, queue_id is always assumed to be the current queue, so queue id should not be specified.")
+				}
+				sdkCGRRule.Queue = &platformclientv2.Domainentityref{Id: &queueId}
+			}
+
+			if memberGroupList, ok := ruleSettings["groups"].([]interface{}); ok {
+				if len(memberGroupList) > 0 {
+					sdkMemberGroups := make([]platformclientv2.Membergroup, len(memberGroupList))
+					for i, memberGroup := range memberGroupList {
+						settingsMap, ok := memberGroup.(map[string]interface{})
+						if !ok {
+							continue
+						}
+
+						sdkMemberGroups[i] = platformclientv2.Membergroup{
+							Id:      platformclientv2.String(settingsMap["member_group_id"].(string)),
+							VarType: platformclientv2.String(settingsMap["member_group_type"].(string)),
+						}
+					}
+					sdkCGRRule.Groups = &sdkMemberGroups
+				}
+			}
+			sdkCGRRules = append(sdkCGRRules, sdkCGRRule)
+		}
+		rules := &sdkCGRRules
+		return &platformclientv2.Conditionalgrouprouting{Rules: rules}, nil
+	}
+	return nil, nil
+}
+
+func flattenConditionalGroupRoutingRules(queue *platformclientv2.Queue) []interface{} {
+	if queue.ConditionalGroupRouting == nil || len(*queue.ConditionalGroupRouting.Rules) == 0 {
+		return nil
+	}
+
+	rules := make([]interface{}, len(*queue.ConditionalGroupRouting.Rules))
+	for i, rule := range *queue.ConditionalGroupRouting.Rules {
+		ruleSettings := make(map[string]interface{})
+
+		resourcedata.SetMapValueIfNotNil(ruleSettings, "wait_seconds", rule.WaitSeconds)
+		resourcedata.SetMapValueIfNotNil(ruleSettings, "operator", rule.Operator)
+		resourcedata.SetMapValueIfNotNil(ruleSettings, "condition_value", rule.ConditionValue)
+		resourcedata.SetMapValueIfNotNil(ruleSettings, "metric", rule.Metric)
+
+		// The first rule is assumed to apply to this queue, so queue_id should be omitted if the conditional grouping routing rule
+		//is the first one being looked at.
+		if rule.Queue != nil && i > 0 {
+			ruleSettings["queue_id"] = *rule.Queue.Id
+		}
+
+		if rule.Groups != nil {
+			memberGroups := make([]interface{}, 0)
+			for _, group := range *rule.Groups {
+				memberGroupMap := make(map[string]interface{})
+
+				resourcedata.SetMapValueIfNotNil(memberGroupMap, "member_group_id", group.Id)
+				resourcedata.SetMapValueIfNotNil(memberGroupMap, "member_group_type", group.VarType)
+
+				memberGroups = append(memberGroups, memberGroupMap)
+			}
+			ruleSettings["groups"] = memberGroups
+		}
+
+		rules[i] = ruleSettings
+	}
+
+	return rules
+}
+
+func buildSdkAcwSettings(d *schema.ResourceData) *platformclientv2.Acwsettings {
+	acwWrapupPrompt := d.Get("acw_wrapup_prompt").(string)
+
+	acwSettings := platformclientv2.Acwsettings{
+		WrapupPrompt: &acwWrapupPrompt, // Set or default
+	}
+
+	// Only set timeout for certain wrapup prompt types
+	if acwWrapupPrompt == "MANDATORY_TIMEOUT" || acwWrapupPrompt == "MANDATORY_FORCED_TIMEOUT" || acwWrapupPrompt == "AGENT_REQUESTED" {
+		acwTimeoutMs, hasTimeout := d.GetOk("acw_timeout_ms")
+		if hasTimeout {
+			timeout := acwTimeoutMs.(int)
+			acwSettings.TimeoutMs = &timeout
+		}
+	}
+	return &acwSettings
+}
+
+func buildSdkDefaultScriptsMap(d *schema.ResourceData) *map[string]platformclientv2.Script {
+	if scriptIds, ok := d.GetOk("default_script_ids"); ok {
+		scriptMap := scriptIds.(map[string]interface{})
+
+		results := make(map[string]platformclientv2.Script)
+		for k, v := range scriptMap {
+			scriptID := v.(string)
+			results[k] = platformclientv2.Script{Id: &scriptID}
+		}
+		return &results
+	}
+	return nil
+}
+
+func flattenDefaultScripts(sdkScripts map[string]platformclientv2.Script) map[string]interface{} {
+	if len(sdkScripts) == 0 {
+		return nil
+	}
+
+	results := make(map[string]interface{})
+	for k, v := range sdkScripts {
+		results[k] = *v.Id
+	}
+	return results
+}
+
+func validateMapCommTypes(val interface{}, _ cty.Path) diag.Diagnostics {
+	if val == nil {
+		return nil
+	}
+
+	commTypes := []string{"CALL", "CALLBACK", "CHAT", "COBROWSE", "EMAIL", "MESSAGE", "SOCIAL_EXPRESSION", "VIDEO", "SCREENSHARE"}
+	m := val.(map[string]interface{})
+	for k := range m {
+		if !lists.ItemInSlice(k, commTypes) {
+			return diag.Errorf("%s is an invalid communication type key.", k)
+		}
+	}
+	return nil
+}
+
+func buildSdkQueueMessagingAddresses(d *schema.ResourceData) *platformclientv2.Queuemessagingaddresses {
+	if _, ok := d.GetOk("outbound_messaging_sms_address_id"); ok {
+		return &platformclientv2.Queuemessagingaddresses{
+			SmsAddress: gcloud.BuildSdkDomainEntityRef(d, "outbound_messaging_sms_address_id"),
+		}
+	}
+	return nil
+}
+
+func buildSdkQueueEmailAddress(d *schema.ResourceData) *platformclientv2.Queueemailaddress {
+	outboundEmailAddress := d.Get("outbound_email_address").([]interface{})
+	if outboundEmailAddress != nil && len(outboundEmailAddress) > 0 {
+		settingsMap := outboundEmailAddress[0].(map[string]interface{})
+
+		inboundRoute := &platformclientv2.Inboundroute{
+			Id: platformclientv2.String(settingsMap["route_id"].(string)),
+		}
+		return &platformclientv2.Queueemailaddress{
+			Domain: &platformclientv2.Domainentityref{Id: platformclientv2.String(settingsMap["domain_id"].(string))},
+			Route:  &inboundRoute,
+		}
+	}
+	return nil
+}
+
+func flattenQueueEmailAddress(settings platformclientv2.Queueemailaddress) map[string]interface{} {
+	settingsMap := make(map[string]interface{})
+	resourcedata.SetMapReferenceValueIfNotNil(settingsMap, "domain_id", settings.Domain)
+
+	if settings.Route != nil {
+		route := *settings.Route
+		settingsMap["route_id"] = *route.Id
+	}
+
+	return settingsMap
+}
+
+func buildSdkDirectRouting(d *schema.ResourceData) *platformclientv2.Directrouting {
+	directRouting := d.Get("direct_routing").([]interface{})
+	if directRouting != nil && len(directRouting) > 0 {
+		settingsMap := directRouting[0].(map[string]interface{})
+
+		backupQueueID := settingsMap["backup_queue_id"].(string)
+		agentWaitSeconds := settingsMap["agent_wait_seconds"].(int)
+		waitForAgent := settingsMap["wait_for_agent"].(bool)
+
+		callUseAgentAddressOutbound := settingsMap["call_use_agent_address_outbound"].(bool)
+		callSettings := &platformclientv2.Directroutingmediasettings{
+			UseAgentAddressOutbound: &callUseAgentAddressOutbound,
+		}
+
+		emailUseAgentAddressOutbound := settingsMap["email_use_agent_address_outbound"].(bool)
+		emailSettings := &platformclientv2.Directroutingmediasettings{
+			UseAgentAddressOutbound: &emailUseAgentAddressOutbound,
+		}
+
+		messageUseAgentAddressOutbound := settingsMap["message_use_agent_address_outbound"].(bool)
+		messageSettings := &platformclientv2.Directroutingmediasettings{
+			UseAgentAddressOutbound: &messageUseAgentAddressOutbound,
+		}
+
+		return &platformclientv2.Directrouting{
+			CallMediaSettings:    callSettings,
+			EmailMediaSettings:   emailSettings,
+			MessageMediaSettings: messageSettings,
+			WaitForAgent:         &waitForAgent,
+			AgentWaitSeconds:     &agentWaitSeconds,
+			BackupQueueId:        &backupQueueID,
+		}
+	}
+	return nil
+}
+
+func flattenDirectRouting(settings *platformclientv2.Directrouting) []interface{} {
+	settingsMap := make(map[string]interface{})
+
+	if settings.BackupQueueId != nil {
+		settingsMap["backup_queue_id"] = *settings.BackupQueueId
+	}
+	if settings.AgentWaitSeconds != nil {
+		settingsMap["agent_wait_seconds"] = *settings.AgentWaitSeconds
+	}
+	if settings.WaitForAgent != nil {
+		settingsMap["wait_for_agent"] = *settings.WaitForAgent
+	}
+
+	if settings.CallMediaSettings != nil {
+		callSettings := *settings.CallMediaSettings
+		settingsMap["call_use_agent_address_outbound"] = *callSettings.UseAgentAddressOutbound
+	}
+	if settings.EmailMediaSettings != nil {
+		emailSettings := *settings.EmailMediaSettings
+		settingsMap["email_use_agent_address_outbound"] = *emailSettings.UseAgentAddressOutbound
+	}
+	if settings.MessageMediaSettings != nil {
+		messageSettings := *settings.MessageMediaSettings
+		settingsMap["message_use_agent_address_outbound"] = *messageSettings.UseAgentAddressOutbound
+	}
+
+	return []interface{}{settingsMap}
+}
+
+func updateQueueDivision(ctx context.Context, d *schema.ResourceData, proxy *routingQueueProxy) diag.Diagnostics {
+	if !d.HasChange("division_id") {
+		return nil
+	}
+	divisionID := d.Get("division_id").(string)
+	if divisionID == "" {
+		// Default to home division
+		homeDivision, diagErr := gcloud.GetHomeDivisionID()
+		if diagErr != nil {
+			return diagErr
+		}
+		divisionID = homeDivision
+	}
+	log.Printf("Updating division for queue %s to %s", d.Id(), divisionID)
+	if _, err := proxy.updateRoutingQueueDivision(ctx, d.Id(), divisionID); err != nil {
+		return diag.Errorf("Failed to update division for queue %s: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func updateQueueWrapupCodes(ctx context.Context, d *schema.ResourceData, proxy *routingQueueProxy) diag.Diagnostics {
+	if d.HasChange("wrapup_codes") {
+		if codesConfig := d.Get("wrapup_codes"); codesConfig != nil {
+			// Get existing codes
+			codes, _, err := proxy.getRoutingQueueWrapupCodes(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("Failed to query wrapup codes for queue %s: %s", d.Id(), err)
+			}
+
+			var existingCodes []string
+			for _, code := range *codes {
+				existingCodes = append(existingCodes, *code.Id)
+			}
+			configCodes := *lists.SetToStringList(codesConfig.(*schema.Set))
+
+			codesToRemove := lists.SliceDifference(existingCodes, configCodes)
+			if err := removeWrapupCodes(ctx, d.Id(), codesToRemove, proxy); err != nil {
+				return err
+			}
+
+			codesToAdd := lists.SliceDifference(configCodes, existingCodes)
+			if len(codesToAdd) > 0 {
+				err := addWrapupCodesInChunks(ctx, d.Id(), codesToAdd, proxy)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func addWrapupCodesInChunks(ctx context.Context, queueID string, codesToAdd []string, proxy *routingQueueProxy) diag.Diagnostics {
+	// API restricts wrapup code adds to 100 per call
+	if len(codesToAdd) > 0 {
+		chunks := chunksProcess.ChunkItems(codesToAdd, wrapupCodeReferenceFunc, 100)
+		chunkProcessor := func(chunk []platformclientv2.Wrapupcodereference) diag.Diagnostics {
+			_, err := proxy.addRoutingQueueWrapupCodes(ctx, queueID, chunk)
+			if err != nil {
+				return diag.Errorf("Failed to update wrapup codes in queue %s: %s", queueID, err)
+			}
+			return nil
+		}
+		return chunksProcess.ProcessChunks(chunks, chunkProcessor)
+	}
+	return nil
+}
+
+func removeWrapupCodes(ctx context.Context, queueID string, codesToRemove []string, proxy *routingQueueProxy) diag.Diagnostics {
+	// API only removes a single wrapup code per call
+	chunkProcessor := func(codeId string) diag.Diagnostics {
+		resp, err := proxy.deleteRoutingQueueWrapupCode(ctx, queueID, codeId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				// Ignore missing queue or wrapup code
+				return nil
+			}
+			return diag.Errorf("Failed to remove wrapup code from queue %s: %s", queueID, err)
+		}
+		return nil
+	}
+	return chunksProcess.ProcessChunks(codesToRemove, chunkProcessor)
+}
+
+// getQueueWrapupCodeIds returns the IDs of the wrapup codes assigned to a queue
+func getQueueWrapupCodeIds(ctx context.Context, queueID string, proxy *routingQueueProxy) ([]string, *platformclientv2.APIResponse, diag.Diagnostics) {
+	codes, resp, err := proxy.getRoutingQueueWrapupCodes(ctx, queueID)
+	if err != nil {
+		return nil, resp, diag.Errorf("Failed to query wrapup codes for queue %s: %s", queueID, err)
+	}
+	codeIds := make([]string, 0)
+	for _, code := range *codes {
+		if code.Id != nil {
+			codeIds = append(codeIds, *code.Id)
+		}
+	}
+	return codeIds, resp, nil
+}
+
+func wrapupCodeReferenceFunc(val string) platformclientv2.Wrapupcodereference {
+	return platformclientv2.Wrapupcodereference{Id: &val}
+}
+
+func updateQueueMembers(ctx context.Context, d *schema.ResourceData, proxy *routingQueueProxy) diag.Diagnostics {
+	if !d.HasChange("members") {
+		return nil
+	}
+	membersSet, ok := d.Get("members").(*schema.Set)
+	if !ok || membersSet.Len() == 0 {
+		if err := removeAllExistingUserMembersFromQueue(ctx, proxy, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	log.Printf("Updating members for Queue %s", d.Get("name"))
+	if err := updateQueueUserMembers(ctx, proxy, d.Id(), buildQueueMemberRingNums(membersSet), nil, true); err != nil {
+		return err
+	}
+	log.Printf("Members updated for Queue %s", d.Get("name"))
+
+	return nil
+}
+
+// buildQueueMemberRingNums maps the user IDs of a set of queue members to their ring numbers
+func buildQueueMemberRingNums(membersSet *schema.Set) map[string]int {
+	userRingNums := make(map[string]int)
+	for _, member := range membersSet.List() {
+		memberMap := member.(map[string]interface{})
+		userRingNums[memberMap["user_id"].(string)] = memberMap["ring_num"].(int)
+	}
+	return userRingNums
+}
+
+// updateQueueUserMembers adds users to a queue and sets their ring numbers. When authoritative is true every other
+// user member is removed from the queue, otherwise only the users in usersToRemove are removed.
+func updateQueueUserMembers(ctx context.Context, proxy *routingQueueProxy, queueID string, newUserRingNums map[string]int, usersToRemove []string, authoritative bool) diag.Diagnostics {
+	newUserIds := make([]string, 0, len(newUserRingNums))
+	for userId := range newUserRingNums {
+		newUserIds = append(newUserIds, userId)
+	}
+	sort.Strings(newUserIds)
+
+	if len(newUserIds) > 0 {
+		if proxy.memberUpdateDelay > 0 {
+			log.Printf("Sleeping for %v", proxy.memberUpdateDelay)
+			time.Sleep(proxy.memberUpdateDelay)
+		}
+		for _, userId := range newUserIds {
+			if err := verifyUserIsNotGroupMemberOfQueue(ctx, proxy, queueID, userId); err != nil {
+				return diag.Errorf("%v", err)
+			}
+		}
+	}
+
+	oldSdkUsers, err := getRoutingQueueMembers(ctx, proxy, queueID, "user")
+	if err != nil {
+		return err
+	}
+
+	oldUserIds := make([]string, len(oldSdkUsers))
+	oldUserRingNums := make(map[string]int)
+	for i, user := range oldSdkUsers {
+		oldUserIds[i] = *user.Id
+		oldUserRingNums[oldUserIds[i]] = *user.RingNumber
+	}
+
+	if len(oldUserIds) > 0 {
+		if authoritative {
+			usersToRemove = lists.SliceDifference(oldUserIds, newUserIds)
+		} else {
+			// Only remove users that are still members and are not being added back
+			var currentUsersToRemove []string
+			for _, userId := range usersToRemove {
+				if lists.ItemInSlice(userId, oldUserIds) && !lists.ItemInSlice(userId, newUserIds) {
+					currentUsersToRemove = append(currentUsersToRemove, userId)
+				}
+			}
+			usersToRemove = currentUsersToRemove
+		}
+		err := updateMembersInChunks(ctx, proxy, queueID, usersToRemove, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(newUserIds) > 0 {
+		usersToAdd := lists.SliceDifference(newUserIds, oldUserIds)
+		err := updateMembersInChunks(ctx, proxy, queueID, usersToAdd, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check for ring numbers to update
+	for _, userID := range newUserIds {
+		newNum := newUserRingNums[userID]
+		if oldNum, found := oldUserRingNums[userID]; found {
+			if newNum != oldNum {
+				log.Printf("updating ring_num for user %s because it has updated. New: %v, Old: %v", userID, newNum, oldNum)
+				// Number changed. Update ring number
+				err := updateQueueUserRingNum(ctx, proxy, queueID, userID, newNum)
+				if err != nil {
+					return err
+				}
+			}
+		} else if newNum != 1 {
+			// New queue member. Update ring num if not set to the default of 1
+			log.Printf("updating user %s ring_num because it is not the default 1", userID)
+			err := updateQueueUserRingNum(ctx, proxy, queueID, userID, newNum)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeAllExistingUserMembersFromQueue get all existing user members of a given queue and remove them from the queue
+func removeAllExistingUserMembersFromQueue(ctx context.Context, proxy *routingQueueProxy, queueId string) error {
+	log.Printf("Reading user members of queue %s", queueId)
+	oldSdkUsers, err := getRoutingQueueMembers(ctx, proxy, queueId, "user")
+	if err != nil {
+		return fmt.Errorf("%v", err)
+	}
+	log.Printf("Read user members of queue %s", queueId)
+
+	var oldUserIds []string
+	for _, user := range oldSdkUsers {
+		oldUserIds = append(oldUserIds, *user.Id)
+	}
+
+	if len(oldUserIds) > 0 {
+		log.Printf("Removing queue %s user members", queueId)
+		if err := updateMembersInChunks(ctx, proxy, queueId, oldUserIds, true); err != nil {
+			return fmt.Errorf("%v", err)
+		}
+		log.Printf("Removed queue %s user members", queueId)
+	}
+	return nil
+}
+
+// verifyUserIsNotGroupMemberOfQueue Search through queue group members to verify that a given user is not a group member
+func verifyUserIsNotGroupMemberOfQueue(ctx context.Context, proxy *routingQueueProxy, queueId, userId string) error {
+	var userName string
+
+	log.Printf("verifying that member '%s' is not assinged to the queue '%s' via a group", userId, queueId)
+
+	// Read name of user to filter results when listing members of queue
+	log.Printf("reading user %s to fetch name", userId)
+	user, _, err := proxy.getUser(ctx, userId)
+	if err != nil {
+		log.Printf("Failed to read name of user '%s' inside verifyUserIsNotGroupMemberOfQueue: %s. Queue ID: %s", userId, err, queueId)
+	} else {
+		userName = *user.Name
+		log.Printf("read user %s %s", userId, userName)
+	}
+
+	groupMembers, _, err := proxy.getRoutingQueueMembers(ctx, queueId, "group", userName)
+	if err != nil {
+		log.Printf("Error requesting group members of queue '%s': %v. Cannot validate that user '%s' is not already assigned via a group", queueId, err, userId)
+		return nil
+	}
+	for _, member := range *groupMembers {
+		if userId == *member.Id {
+			return fmt.Errorf("member %s '%s' is already assigned to queue '%s' via a group, and cannot be assigned using the members set", userName, userId, queueId)
+		}
+	}
+
+	log.Printf("User %s not found as group member in queue %s", userId, queueId)
+	return nil
+}
+
+func updateMembersInChunks(ctx context.Context, proxy *routingQueueProxy, queueID string, membersToUpdate []string, remove bool) diag.Diagnostics {
+	// API restricts member adds/removes to 100 per call
+	// Generic call to prepare chunks for the Update. Takes in three args
+	// 1. MemberstoUpdate 2. The Entity prepare func for the update 3. Chunk Size
+	if len(membersToUpdate) > 0 {
+		chunks := chunksProcess.ChunkItems(membersToUpdate, platformWritableEntityFunc, 100)
+		// Closure to process the chunks
+		chunkProcessor := func(chunk []platformclientv2.Writableentity) diag.Diagnostics {
+			_, err := proxy.addOrRemoveRoutingQueueMembers(ctx, queueID, chunk, remove)
+			if err != nil {
+				return diag.Errorf("Failed to update members in queue %s: %s", queueID, err)
+			}
+			return nil
+		}
+		// Generic Function call which takes in the chunks and the processing function
+		return chunksProcess.ProcessChunks(chunks, chunkProcessor)
+	}
+	return nil
+}
+
+func platformWritableEntityFunc(val string) platformclientv2.Writableentity {
+	return platformclientv2.Writableentity{Id: &val}
+}
+
+func updateQueueUserRingNum(ctx context.Context, proxy *routingQueueProxy, queueID string, userID string, ringNum int) diag.Diagnostics {
+	_, err := proxy.updateRoutingQueueMember(ctx, queueID, userID, platformclientv2.Queuemember{
+		Id:         &userID,
+		RingNumber: &ringNum,
+	})
+	if err != nil {
+		return diag.Errorf("Failed to update ring number for queue %s user %s: %s", queueID, userID, err)
+	}
+	return nil
+}
+
+func getRoutingQueueMembers(ctx context.Context, proxy *routingQueueProxy, queueID string, memberBy string) ([]platformclientv2.Queuemember, diag.Diagnostics) {
+	// Need to call this method to find the member count for a queue. GetRoutingQueueMembers does not return a `total` property for us to use.
+	queue, _, err := proxy.getRoutingQueueById(ctx, queueID)
+	if err != nil {
+		return nil, diag.Errorf("Can't find queue %s", queueID)
+	}
+
+	members, resp, err := proxy.getRoutingQueueMembers(ctx, queueID, memberBy, "")
+	if err != nil {
+		return nil, diag.Errorf("Failed to query users for queue %s: %s", queueID, err)
+	}
+
+	if queue.MemberCount != nil {
+		log.Printf("%d members belong to queue %s", *queue.MemberCount, queueID)
+		log.Printf("%d queue members found for queue %s", len(*members), queueID)
+		if len(*members) != *queue.MemberCount && resp != nil {
+			log.Printf("Member count is not equal to queue member found for queue %s, Correlation Id: %s", queueID, resp.CorrelationID)
+		}
+	}
+	return *members, nil
+}
+
+func flattenQueueMembers(ctx context.Context, queueID string, memberBy string, proxy *routingQueueProxy) (*schema.Set, diag.Diagnostics) {
+	members, err := getRoutingQueueMembers(ctx, proxy, queueID, memberBy)
+	if err != nil {
+		return nil, err
+	}
+
+	memberSet := schema.NewSet(schema.HashResource(queueMemberResource), []interface{}{})
+	for _, member := range members {
+		memberMap := make(map[string]interface{})
+		memberMap["user_id"] = *member.Id
+		memberMap["ring_num"] = *member.RingNumber
+		memberSet.Add(memberMap)
+	}
+
+	return memberSet, nil
+}
+
+func flattenQueueWrapupCodes(ctx context.Context, queueID string, proxy *routingQueueProxy) (*schema.Set, diag.Diagnostics) {
+	codes, _, err := proxy.getRoutingQueueWrapupCodes(ctx, queueID)
+	if err != nil {
+		return nil, diag.Errorf("Failed to query wrapup codes for queue %s: %s", queueID, err)
+	}
+
+	var codeIds []string
+	for _, code := range *codes {
+		codeIds = append(codeIds, *code.Id)
+	}
+
+	if codeIds != nil {
+		return lists.StringListToSet(codeIds), nil
+	}
+	return nil, nil
+}
+
+func flattenQueueMemberGroupsList(queue *platformclientv2.Queue, groupType *string) *schema.Set {
+	var groupIds []string
+
+	if queue == nil || queue.MemberGroups == nil {
+		return nil
+	}
+
+	for _, memberGroup := range *queue.MemberGroups {
+		if strings.Compare(*memberGroup.VarType, *groupType) == 0 {
+			groupIds = append(groupIds, *memberGroup.Id)
+		}
+	}
+
+	if groupIds != nil {
+		return lists.StringListToSet(groupIds)
+	}
+
+	return nil
+}
+
+func GenerateRoutingQueueResource(
+	resourceID string,
+	name string,
+	desc string,
+	acwWrapupPrompt string,
+	acwTimeout string,
+	skillEvalMethod string,
+	autoAnswerOnly string,
+	callingPartyName string,
+	callingPartyNumber string,
+	enableTranscription string,
+	suppressInQueueCallRecording string,
+	enableManualAssignment string,
+	nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue" "%s" {
+		name = "%s"
+		description = "%s"
+		acw_wrapup_prompt = %s
+		acw_timeout_ms = %s
+		skill_evaluation_method = %s
+		auto_answer_only = %s
+		calling_party_name = %s
+		calling_party_number = %s
+		enable_transcription = %s
+        suppress_in_queue_call_recording = %s
+  		enable_manual_assignment = %s
+		%s
+	}
+	`, resourceID,
+		name,
+		desc,
+		acwWrapupPrompt,
+		acwTimeout,
+		skillEvalMethod,
+		autoAnswerOnly,
+		callingPartyName,
+		callingPartyNumber,
+		enableTranscription,
+		suppressInQueueCallRecording,
+		enableManualAssignment,
+		strings.Join(nestedBlocks, "\n"))
+}
+
+func GenerateRoutingQueueResourceBasic(resourceID string, name string, nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue" "%s" {
+		name = "%s"
+		%s
+	}
+	`, resourceID, name, strings.Join(nestedBlocks, "\n"))
+}
+
+// GenerateRoutingQueueResourceBasicWithDepends Used when testing skills group dependencies.
+func GenerateRoutingQueueResourceBasicWithDepends(resourceID string, dependsOn string, name string, nestedBlocks ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue" "%s" {
+		depends_on = [%s]
+		name = "%s"
+		%s
+	}
+	`, resourceID, dependsOn, name, strings.Join(nestedBlocks, "\n"))
+}
+
+func GenerateMediaSettings(attrName string, alertingTimeout string, enableAutoAnswer string, slPercent string, slDurationMs string) string {
+	return fmt.Sprintf(`%s {
+		alerting_timeout_sec = %s
+		enable_auto_answer = %s
+		service_level_percentage = %s
+		service_level_duration_ms = %s
+	}
+	`, attrName, alertingTimeout, enableAutoAnswer, slPercent, slDurationMs)
+}
+
+func GenerateRoutingRules(operator string, threshold string, waitSeconds string) string {
+	return fmt.Sprintf(`routing_rules {
+		operator = "%s"
+		threshold = %s
+		wait_seconds = %s
+	}
+	`, operator, threshold, waitSeconds)
+}
+
+func GenerateDefaultScriptIDs(chat, email string) string {
+	return fmt.Sprintf(`default_script_ids = {
+		CHAT  = "%s"
+		EMAIL = "%s"
+	}`, chat, email)
+}
+
+func GenerateBullseyeSettings(expTimeout string, skillsToRemove ...string) string {
+	return fmt.Sprintf(`bullseye_rings {
+		expansion_timeout_seconds = %s
+		skills_to_remove = [%s]
+	}
+	`, expTimeout, strings.Join(skillsToRemove, ", "))
+}
+
+func GenerateConditionalGroupRoutingRules(queueId, operator, metric, conditionValue, waitSeconds string, nestedBlocks ...string) string {
+	return fmt.Sprintf(`conditional_group_routing_rules {
+		queue_id        = %s
+		operator        = "%s"
+		metric          = "%s"
+		condition_value = %s
+		wait_seconds    = %s
+		%s
+	}
+	`, queueId, operator, metric, conditionValue, waitSeconds, strings.Join(nestedBlocks, "\n"))
+}
+
+func GenerateConditionalGroupRoutingRuleGroup(groupId, groupType string) string {
+	return fmt.Sprintf(`groups {
+		member_group_id   = %s
+		member_group_type = "%s"
+	}
+	`, groupId, groupType)
+}
+
+func GenerateBullseyeSettingsWithMemberGroup(expTimeout, memberGroupId, memberGroupType string, skillsToRemove ...string) string {
+	return fmt.Sprintf(`bullseye_rings {
+		expansion_timeout_seconds = %s
+		skills_to_remove = [%s]
+		member_groups {
+			member_group_id = %s
+			member_group_type = "%s"
+		}
+	}
+	`, expTimeout, strings.Join(skillsToRemove, ", "), memberGroupId, memberGroupType)
+}
+
+func GenerateMemberBlock(userID, ringNum string) string {
+	return fmt.Sprintf(`members {
+		user_id = %s
+		ring_num = %s
+	}
+	`, userID, ringNum)
+}
+
+func GenerateQueueWrapupCodes(wrapupCodes ...string) string {
+	return fmt.Sprintf(`
+		wrapup_codes = [%s]
+	`, strings.Join(wrapupCodes, ", "))
+}
+
+func GenerateRoutingQueueMemberResource(resourceID string, queueId string, userId string, ringNum string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_member" "%s" {
+		queue_id = %s
+		user_id  = %s
+		ring_num = %s
+	}
+	`, resourceID, queueId, userId, ringNum)
+}
+
+func GenerateRoutingQueueMembersResource(resourceID string, queueId string, mode string, members ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_members" "%s" {
+		queue_id = %s
+		mode     = "%s"
+		%s
+	}
+	`, resourceID, queueId, mode, strings.Join(members, "\n"))
+}
+
+func GenerateRoutingQueueWrapupCodeResource(resourceID string, queueId string, wrapupCodeIds ...string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		queue_id = %s
+		%s
+	}
+	`, wrapupCodeResourceName, resourceID, queueId, GenerateQueueWrapupCodes(wrapupCodeIds...))
+}
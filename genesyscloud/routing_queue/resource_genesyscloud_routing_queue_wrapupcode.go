@@ -0,0 +1,150 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// getAllRoutingQueueWrapupCodes retrieves every queue that has wrapup codes and is used for the exporter
+func getAllRoutingQueueWrapupCodes(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	proxy := getRoutingQueueProxy(clientConfig)
+	resources := make(resourceExporter.ResourceIDMetaMap)
+
+	queues, err := getAllRoutingQueues(ctx, clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for queueId, queueMeta := range queues {
+		codeIds, _, err := getQueueWrapupCodeIds(ctx, queueId, proxy)
+		if err != nil {
+			return nil, err
+		}
+		if len(codeIds) > 0 {
+			resources[queueId] = &resourceExporter.ResourceMeta{Name: queueMeta.Name}
+		}
+	}
+
+	return resources, nil
+}
+
+// createRoutingQueueWrapupCode assigns the configured wrapup codes to a queue
+func createRoutingQueueWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueId := d.Get("queue_id").(string)
+	d.SetId(queueId)
+	log.Printf("Assigning wrapup codes to queue %s", queueId)
+	return updateRoutingQueueWrapupCode(ctx, d, meta)
+}
+
+// importRoutingQueueWrapupCode sets the wrapup codes of an imported resource to every wrapup code of the queue, as reads
+// only keep the wrapup codes that are already in state
+func importRoutingQueueWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Importing wrapup codes of queue %s", d.Id())
+	codeIds, _, diagErr := getQueueWrapupCodeIds(ctx, d.Id(), proxy)
+	if diagErr != nil {
+		return nil, fmt.Errorf("failed to import wrapup codes of queue %s: %v", d.Id(), diagErr)
+	}
+	_ = d.Set("wrapup_codes", lists.StringListToSet(codeIds))
+	return []*schema.ResourceData{d}, nil
+}
+
+// readRoutingQueueWrapupCode reads the wrapup codes in state that are still assigned to the queue. Wrapup codes assigned
+// to the queue in any other way are never read, so they are never unassigned by an update.
+func readRoutingQueueWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Reading wrapup codes of queue %s", d.Id())
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		codeIds, resp, diagErr := getQueueWrapupCodeIds(ctx, d.Id(), proxy)
+		if diagErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read wrapup codes of queue %s: %v", d.Id(), diagErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read wrapup codes of queue %s: %v", d.Id(), diagErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueueWrapupCode())
+
+		codeIds = filterWrapupCodeIds(codeIds, *lists.SetToStringList(d.Get("wrapup_codes").(*schema.Set)))
+
+		_ = d.Set("queue_id", d.Id())
+		_ = d.Set("wrapup_codes", lists.StringListToSet(codeIds))
+
+		log.Printf("Read wrapup codes of queue %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+// updateRoutingQueueWrapupCode assigns the new wrapup codes and unassigns the wrapup codes removed from the configuration
+func updateRoutingQueueWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Updating wrapup codes of queue %s", d.Id())
+	oldCodes, newCodes := d.GetChange("wrapup_codes")
+	newCodeIds := *lists.SetToStringList(newCodes.(*schema.Set))
+	codesToUnassign := lists.SliceDifference(*lists.SetToStringList(oldCodes.(*schema.Set)), newCodeIds)
+	if err := updateQueueWrapupCodeAssignments(ctx, proxy, d.Id(), newCodeIds, codesToUnassign); err != nil {
+		return err
+	}
+
+	log.Printf("Updated wrapup codes of queue %s", d.Id())
+	return readRoutingQueueWrapupCode(ctx, d, meta)
+}
+
+// deleteRoutingQueueWrapupCode unassigns the wrapup codes in state from the queue
+func deleteRoutingQueueWrapupCode(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Unassigning wrapup codes from queue %s", d.Id())
+	codesToUnassign := *lists.SetToStringList(d.Get("wrapup_codes").(*schema.Set))
+	if err := updateQueueWrapupCodeAssignments(ctx, proxy, d.Id(), nil, codesToUnassign); err != nil {
+		return err
+	}
+	log.Printf("Unassigned wrapup codes from queue %s", d.Id())
+	return nil
+}
+
+// updateQueueWrapupCodeAssignments assigns the wrapup codes that are not yet assigned to the queue and unassigns the
+// given wrapup codes that are still assigned. Wrapup codes that are in neither list are left untouched.
+func updateQueueWrapupCodeAssignments(ctx context.Context, proxy *routingQueueProxy, queueId string, codesToAssign []string, codesToUnassign []string) diag.Diagnostics {
+	existingCodes, resp, diagErr := getQueueWrapupCodeIds(ctx, queueId, proxy)
+	if diagErr != nil {
+		if len(codesToAssign) == 0 && gcloud.IsStatus404(resp) {
+			// The queue and its wrapup codes are already gone
+			return nil
+		}
+		return diagErr
+	}
+
+	if err := removeWrapupCodes(ctx, queueId, filterWrapupCodeIds(existingCodes, codesToUnassign), proxy); err != nil {
+		return err
+	}
+	return addWrapupCodesInChunks(ctx, queueId, lists.SliceDifference(codesToAssign, existingCodes), proxy)
+}
+
+// filterWrapupCodeIds returns the wrapup code IDs that are in the given code IDs
+func filterWrapupCodeIds(codeIds []string, filterIds []string) []string {
+	filtered := make([]string, 0)
+	for _, codeId := range codeIds {
+		if lists.ItemInSlice(codeId, filterIds) {
+			filtered = append(filtered, codeId)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,119 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceRoutingQueueWrapupCode(t *testing.T) {
+	var (
+		queueResource       = "test-queue-wrapupcode-queue"
+		queueName           = "Terraform Test Queue Wrapup Code-" + uuid.NewString()
+		assignmentResource  = "test-queue-wrapupcode"
+		dataSourceResource  = "test-queue-wrapupcodes"
+		wrapupCodeResource1 = "test-wrapup-1"
+		wrapupCodeResource2 = "test-wrapup-2"
+		wrapupCodeResource3 = "test-wrapup-3"
+		wrapupCodeName1     = "Terraform Test Code1-" + uuid.NewString()
+		wrapupCodeName2     = "Terraform Test Code2-" + uuid.NewString()
+		wrapupCodeName3     = "Terraform Test Code3-" + uuid.NewString()
+		assignmentId        = wrapupCodeResourceName + "." + assignmentResource
+	)
+
+	codesConfig := gcloud.GenerateRoutingWrapupcodeResource(
+		wrapupCodeResource1,
+		wrapupCodeName1,
+	) + gcloud.GenerateRoutingWrapupcodeResource(
+		wrapupCodeResource2,
+		wrapupCodeName2,
+	) + gcloud.GenerateRoutingWrapupcodeResource(
+		wrapupCodeResource3,
+		wrapupCodeName3,
+	)
+
+	config := func(wrapupCodeIds ...string) string {
+		return GenerateRoutingQueueResourceBasic(
+			queueResource,
+			queueName,
+		) + codesConfig + GenerateRoutingQueueWrapupCodeResource(
+			assignmentResource,
+			"genesyscloud_routing_queue."+queueResource+".id",
+			wrapupCodeIds...,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Assign two wrapup codes
+				Config: config(
+					"genesyscloud_routing_wrapupcode."+wrapupCodeResource1+".id",
+					"genesyscloud_routing_wrapupcode."+wrapupCodeResource2+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(assignmentId, "wrapup_codes.#", "2"),
+					validateQueueWrapupCode("genesyscloud_routing_queue."+queueResource, "genesyscloud_routing_wrapupcode."+wrapupCodeResource1),
+					validateQueueWrapupCode("genesyscloud_routing_queue."+queueResource, "genesyscloud_routing_wrapupcode."+wrapupCodeResource2),
+					func(state *terraform.State) error {
+						// Assign a wrapup code outside of this resource. It must be left untouched.
+						unmanagedCodeId := state.RootModule().Resources["genesyscloud_routing_wrapupcode."+wrapupCodeResource3].Primary.ID
+						return addQueueWrapupCodeOutsideTerraform(state.RootModule().Resources[assignmentId].Primary.ID, unmanagedCodeId)
+					},
+				),
+			},
+			{
+				// Unassign a wrapup code and read the queue wrapup codes with the data source
+				Config: config(
+					"genesyscloud_routing_wrapupcode."+wrapupCodeResource2+".id",
+				) + generateRoutingQueueWrapupCodesDataSource(
+					dataSourceResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					assignmentId,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(assignmentId, "wrapup_codes.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(assignmentId, "wrapup_codes.*", "genesyscloud_routing_wrapupcode."+wrapupCodeResource2, "id"),
+					resource.TestCheckResourceAttr("data."+wrapupCodesDataSourceName+"."+dataSourceResource, "wrapup_code_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data."+wrapupCodesDataSourceName+"."+dataSourceResource, "wrapup_code_ids.*", "genesyscloud_routing_wrapupcode."+wrapupCodeResource2, "id"),
+					resource.TestCheckTypeSetElemAttrPair("data."+wrapupCodesDataSourceName+"."+dataSourceResource, "wrapup_code_ids.*", "genesyscloud_routing_wrapupcode."+wrapupCodeResource3, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data."+wrapupCodesDataSourceName+"."+dataSourceResource, "wrapup_codes.*", map[string]string{"name": wrapupCodeName3}),
+				),
+			},
+			{
+				// Import/Read. Imports read every wrapup code of the queue.
+				ResourceName:            assignmentId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wrapup_codes"},
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func addQueueWrapupCodeOutsideTerraform(queueId string, codeId string) error {
+	sdkConfig, err := gcloud.AuthorizeSdk()
+	if err != nil {
+		return err
+	}
+	if diagErr := addWrapupCodesInChunks(context.Background(), queueId, []string{codeId}, getRoutingQueueProxy(sdkConfig)); diagErr != nil {
+		return fmt.Errorf("failed to assign wrapup code %s to queue %s: %v", codeId, queueId, diagErr)
+	}
+	return nil
+}
+
+func generateRoutingQueueWrapupCodesDataSource(resourceID string, queueId string, dependsOn string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		queue_id   = %s
+		depends_on = [%s]
+	}
+	`, wrapupCodesDataSourceName, resourceID, queueId, dependsOn)
+}
@@ -0,0 +1,70 @@
+package routing_skill_group_members
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func dataSourceRoutingSkillGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getSkillGroupMembersProxy(sdkConfig)
+
+	skillGroupId := d.Get("skill_group_id").(string)
+
+	log.Printf("Reading members of skill group %s", skillGroupId)
+	members, _, err := proxy.getSkillGroupMembers(ctx, skillGroupId)
+	if err != nil {
+		return diag.Errorf("Failed to read members of skill group %s: %s", skillGroupId, err)
+	}
+
+	userIds, flattenedMembers := flattenSkillGroupMembers(*members)
+	d.SetId(skillGroupId)
+	_ = d.Set("user_ids", userIds)
+	_ = d.Set("members", flattenedMembers)
+
+	log.Printf("Read %d members of skill group %s", len(userIds), skillGroupId)
+	return nil
+}
+
+// flattenSkillGroupMembers returns the user IDs ordered by ID and the members ordered by name, then ID
+func flattenSkillGroupMembers(members []platformclientv2.Userreferencewithname) ([]string, []interface{}) {
+	sorted := make([]platformclientv2.Userreferencewithname, 0, len(members))
+	for _, member := range members {
+		if member.Id != nil {
+			sorted = append(sorted, member)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		nameI, nameJ := getMemberName(sorted[i]), getMemberName(sorted[j])
+		if nameI != nameJ {
+			return nameI < nameJ
+		}
+		return *sorted[i].Id < *sorted[j].Id
+	})
+
+	userIds := make([]string, 0, len(sorted))
+	flattenedMembers := make([]interface{}, 0, len(sorted))
+	for _, member := range sorted {
+		userIds = append(userIds, *member.Id)
+		flattenedMembers = append(flattenedMembers, map[string]interface{}{
+			"user_id": *member.Id,
+			"name":    getMemberName(member),
+		})
+	}
+	sort.Strings(userIds)
+	return userIds, flattenedMembers
+}
+
+func getMemberName(member platformclientv2.Userreferencewithname) string {
+	if member.Name == nil {
+		return ""
+	}
+	return *member.Name
+}
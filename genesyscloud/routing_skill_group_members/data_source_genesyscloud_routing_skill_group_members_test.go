@@ -0,0 +1,81 @@
+package routing_skill_group_members
+
+import (
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRoutingSkillGroupMembers(t *testing.T) {
+	var (
+		skillResource       = "test-skill"
+		skillName           = "Terraform Skill " + uuid.NewString()
+		userResource        = "test-user"
+		userEmail           = "terraform-" + uuid.NewString() + "@example.com"
+		userName            = "Terraform Skill Group Member"
+		skillGroupResource  = "test-skill-group"
+		skillGroupName      = "Terraform Skill Group " + uuid.NewString()
+		membersDataResource = "test-members"
+	)
+
+	config := gcloud.GenerateRoutingSkillResource(skillResource, skillName) +
+		fmt.Sprintf(`resource "genesyscloud_user" "%s" {
+		name  = "%s"
+		email = "%s"
+		routing_skills {
+			skill_id    = genesyscloud_routing_skill.%s.id
+			proficiency = 3
+		}
+	}
+	`, userResource, userName, userEmail, skillResource) +
+		fmt.Sprintf(`resource "genesyscloud_routing_skill_group" "%s" {
+		name = "%s"
+		skill_conditions = jsonencode([{
+			"routingSkillConditions" : [{
+				"routingSkill" : genesyscloud_routing_skill.%s.name,
+				"comparator" : "GreaterThanOrEqualTo",
+				"proficiency" : 3,
+				"childConditions" : []
+			}],
+			"languageSkillConditions" : [],
+			"operation" : "And"
+		}])
+		depends_on = [genesyscloud_user.%s]
+	}
+	`, skillGroupResource, skillGroupName, skillResource, userResource) +
+		generateRoutingSkillGroupMembersDataSource(membersDataResource, skillGroupResource)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data."+resourceName+"."+membersDataResource, "id", "genesyscloud_routing_skill_group."+skillGroupResource, "id"),
+				),
+			},
+			{
+				// Membership is calculated asynchronously after the skill group is created
+				PreConfig: func() { time.Sleep(30 * time.Second) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data."+resourceName+"."+membersDataResource, "user_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data."+resourceName+"."+membersDataResource, "user_ids.0", "genesyscloud_user."+userResource, "id"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+membersDataResource, "members.0.name", userName),
+				),
+			},
+		},
+	})
+}
+
+func generateRoutingSkillGroupMembersDataSource(resourceID string, skillGroupResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_routing_skill_group_members" "%s" {
+		skill_group_id = genesyscloud_routing_skill_group.%s.id
+	}
+	`, resourceID, skillGroupResource)
+}
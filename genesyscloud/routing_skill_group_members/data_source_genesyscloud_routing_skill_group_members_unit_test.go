@@ -0,0 +1,66 @@
+package routing_skill_group_members
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDataSourceRoutingSkillGroupMembersRead(t *testing.T) {
+	skillGroupId := "d2f3f8c1-3f6b-4a6b-9d0e-5c8f5a1b2c3d"
+	members := []platformclientv2.Userreferencewithname{
+		{Id: platformclientv2.String("user-c"), Name: platformclientv2.String("Bob")},
+		{Id: platformclientv2.String("user-a"), Name: platformclientv2.String("Carol")},
+		{Id: platformclientv2.String("user-b"), Name: platformclientv2.String("Alice")},
+		{Name: platformclientv2.String("Missing ID")},
+	}
+
+	proxy := &skillGroupMembersProxy{}
+	proxy.getSkillGroupMembersAttr = func(ctx context.Context, p *skillGroupMembersProxy, id string) (*[]platformclientv2.Userreferencewithname, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, skillGroupId, id)
+		return &members, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() {
+		internalProxy = nil
+	}()
+
+	d := schema.TestResourceDataRaw(t, DataSourceRoutingSkillGroupMembers().Schema, map[string]interface{}{
+		"skill_group_id": skillGroupId,
+	})
+
+	diags := dataSourceRoutingSkillGroupMembersRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, skillGroupId, d.Id())
+	assert.Equal(t, []interface{}{"user-a", "user-b", "user-c"}, d.Get("user_ids").([]interface{}))
+	assert.Equal(t, 3, d.Get("members.#").(int))
+	assert.Equal(t, "Alice", d.Get("members.0.name").(string))
+	assert.Equal(t, "user-b", d.Get("members.0.user_id").(string))
+	assert.Equal(t, "Carol", d.Get("members.2.name").(string))
+}
+
+func TestUnitDataSourceRoutingSkillGroupMembersReadError(t *testing.T) {
+	proxy := &skillGroupMembersProxy{}
+	proxy.getSkillGroupMembersAttr = func(ctx context.Context, p *skillGroupMembersProxy, id string) (*[]platformclientv2.Userreferencewithname, *platformclientv2.APIResponse, error) {
+		return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("skill group %s not found", id)
+	}
+	internalProxy = proxy
+	defer func() {
+		internalProxy = nil
+	}()
+
+	d := schema.TestResourceDataRaw(t, DataSourceRoutingSkillGroupMembers().Schema, map[string]interface{}{
+		"skill_group_id": "missing",
+	})
+
+	diags := dataSourceRoutingSkillGroupMembersRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "", d.Id())
+}
@@ -0,0 +1,89 @@
+package routing_skill_group_members
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_skill_group_members_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *skillGroupMembersProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getSkillGroupMembersFunc func(ctx context.Context, p *skillGroupMembersProxy, skillGroupId string) (*[]platformclientv2.Userreferencewithname, *platformclientv2.APIResponse, error)
+
+// skillGroupMembersProxy contains all of the methods that call genesys cloud APIs.
+type skillGroupMembersProxy struct {
+	clientConfig             *platformclientv2.Configuration
+	routingApi               *platformclientv2.RoutingApi
+	getSkillGroupMembersAttr getSkillGroupMembersFunc
+}
+
+// newSkillGroupMembersProxy initializes the proxy with all of the data needed to communicate with Genesys Cloud
+func newSkillGroupMembersProxy(clientConfig *platformclientv2.Configuration) *skillGroupMembersProxy {
+	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+	return &skillGroupMembersProxy{
+		clientConfig:             clientConfig,
+		routingApi:               api,
+		getSkillGroupMembersAttr: getSkillGroupMembersFn,
+	}
+}
+
+// getSkillGroupMembersProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getSkillGroupMembersProxy(clientConfig *platformclientv2.Configuration) *skillGroupMembersProxy {
+	if internalProxy == nil {
+		internalProxy = newSkillGroupMembersProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getSkillGroupMembers returns all of the members of a Genesys Cloud skill group
+func (p *skillGroupMembersProxy) getSkillGroupMembers(ctx context.Context, skillGroupId string) (*[]platformclientv2.Userreferencewithname, *platformclientv2.APIResponse, error) {
+	return p.getSkillGroupMembersAttr(ctx, p, skillGroupId)
+}
+
+// getSkillGroupMembersFn is an implementation of the function to get all of the members of a Genesys Cloud skill group
+func getSkillGroupMembersFn(_ context.Context, p *skillGroupMembersProxy, skillGroupId string) (*[]platformclientv2.Userreferencewithname, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var (
+		after      string
+		resp       *platformclientv2.APIResponse
+		allMembers = make([]platformclientv2.Userreferencewithname, 0)
+	)
+
+	for {
+		members, pageResp, err := p.routingApi.GetRoutingSkillgroupMembers(skillGroupId, pageSize, after, "", "")
+		if err != nil {
+			return nil, pageResp, fmt.Errorf("failed to get members of skill group %s: %s", skillGroupId, err)
+		}
+		resp = pageResp
+		if members.Entities == nil || len(*members.Entities) == 0 {
+			break
+		}
+		allMembers = append(allMembers, *members.Entities...)
+
+		if members.NextUri == nil || *members.NextUri == "" {
+			break
+		}
+		u, err := url.Parse(*members.NextUri)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to parse next page of members of skill group %s: %s", skillGroupId, err)
+		}
+		nextAfter := u.Query().Get("after")
+		if nextAfter == "" || nextAfter == after {
+			break
+		}
+		after = nextAfter
+	}
+
+	return &allMembers, resp, nil
+}
@@ -0,0 +1,64 @@
+package routing_skill_group_members
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+genesyscloud_routing_skill_group_members_schema.go holds two functions within it:
+
+1.  The registration code that registers the Datasource for the package.
+2.  The datasource schema definitions for the routing_skill_group_members datasource.
+*/
+const resourceName = "genesyscloud_routing_skill_group_members"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(resourceName, DataSourceRoutingSkillGroupMembers())
+}
+
+var skillGroupMemberResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"user_id": {
+			Description: "ID of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceRoutingSkillGroupMembers registers the genesyscloud_routing_skill_group_members data source
+func DataSourceRoutingSkillGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the members of a Genesys Cloud Routing Skill Group. Returns the users currently matched by the skill conditions of the skill group. " +
+			"Membership is calculated asynchronously, so changes to the skill conditions or to the skills of users can take some time to show up.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceRoutingSkillGroupMembersRead),
+		Schema: map[string]*schema.Schema{
+			"skill_group_id": {
+				Description: "ID of the skill group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"user_ids": {
+				Description: "IDs of the users that are members of the skill group, ordered by ID.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"members": {
+				Description: "Users that are members of the skill group, ordered by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        skillGroupMemberResource,
+			},
+		},
+	}
+}
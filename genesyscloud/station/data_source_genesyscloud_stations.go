@@ -0,0 +1,168 @@
+package station
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// stationsFilter holds the filters of a stations data source. Empty filters match every station.
+type stationsFilter struct {
+	siteId           string
+	stationType      string
+	associationState string
+}
+
+// stationPhone is the phone backing a station
+type stationPhone struct {
+	phoneId string
+	siteId  string
+}
+
+// dataSourceStationsRead returns the stations matching the filters with the phone and site backing each station
+func dataSourceStationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	sp := getStationProxy(sdkConfig)
+
+	filter := stationsFilter{
+		siteId:           d.Get("site_id").(string),
+		stationType:      d.Get("type").(string),
+		associationState: d.Get("association_state").(string),
+	}
+
+	stations, _, err := sp.getAllStations(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to get stations: %s", err)
+	}
+	phones, _, err := sp.getStationPhones(ctx, filter.siteId)
+	if err != nil {
+		return diag.Errorf("Failed to get phones: %s", err)
+	}
+
+	phonesByLine := mapPhonesByLine(*phones)
+	ids := make([]string, 0)
+	flattenedStations := make([]interface{}, 0)
+	for _, station := range filterStations(*stations, phonesByLine, filter) {
+		ids = append(ids, *station.Id)
+		flattenedStations = append(flattenedStations, flattenStation(station, findStationPhone(station, phonesByLine)))
+	}
+
+	d.SetId(filter.id())
+	_ = d.Set("ids", ids)
+	_ = d.Set("stations", flattenedStations)
+	return nil
+}
+
+// filterStations returns the stations matching all of the filters, ordered by name
+func filterStations(stations []platformclientv2.Station, phonesByLine map[string]stationPhone, filter stationsFilter) []platformclientv2.Station {
+	var matches []platformclientv2.Station
+	for _, station := range stations {
+		if station.Id != nil && filter.matches(station, findStationPhone(station, phonesByLine)) {
+			matches = append(matches, station)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return getStationName(matches[i]) < getStationName(matches[j])
+	})
+	return matches
+}
+
+// matches reports whether the station matches all of the filters
+func (f stationsFilter) matches(station platformclientv2.Station, phone *stationPhone) bool {
+	if f.siteId != "" && (phone == nil || phone.siteId != f.siteId) {
+		return false
+	}
+	if f.stationType != "" && (station.VarType == nil || *station.VarType != f.stationType) {
+		return false
+	}
+	if f.associationState != "" && (station.Status == nil || *station.Status != f.associationState) {
+		return false
+	}
+	return true
+}
+
+// id returns a data source ID that is stable for the same set of filters
+func (f stationsFilter) id() string {
+	key := strings.Join([]string{f.siteId, f.stationType, f.associationState}, "|")
+	return fmt.Sprintf("stations-%x", sha256.Sum256([]byte(key)))
+}
+
+// mapPhonesByLine maps the ID of every line of the phones to the phone
+func mapPhonesByLine(phones []platformclientv2.Phone) map[string]stationPhone {
+	phonesByLine := make(map[string]stationPhone)
+	for _, phone := range phones {
+		if phone.Id == nil || phone.Lines == nil {
+			continue
+		}
+		backingPhone := stationPhone{phoneId: *phone.Id}
+		if phone.Site != nil && phone.Site.Id != nil {
+			backingPhone.siteId = *phone.Site.Id
+		}
+		for _, line := range *phone.Lines {
+			if line.Id != nil {
+				phonesByLine[*line.Id] = backingPhone
+			}
+		}
+	}
+	return phonesByLine
+}
+
+// findStationPhone returns the phone whose line appears on the station, or nil if no phone backs the station
+func findStationPhone(station platformclientv2.Station, phonesByLine map[string]stationPhone) *stationPhone {
+	if station.LineAppearanceId != nil {
+		if phone, ok := phonesByLine[*station.LineAppearanceId]; ok {
+			return &phone
+		}
+	}
+	if station.Id != nil {
+		if phone, ok := phonesByLine[*station.Id]; ok {
+			return &phone
+		}
+	}
+	return nil
+}
+
+func flattenStation(station platformclientv2.Station, phone *stationPhone) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"id":              *station.Id,
+		"name":            getStationName(station),
+		"type":            "",
+		"status":          "",
+		"user_id":         "",
+		"web_rtc_user_id": "",
+		"phone_id":        "",
+		"site_id":         "",
+	}
+	if station.VarType != nil {
+		flattened["type"] = *station.VarType
+	}
+	if station.Status != nil {
+		flattened["status"] = *station.Status
+	}
+	if station.UserId != nil {
+		flattened["user_id"] = *station.UserId
+	}
+	if station.WebRtcUserId != nil {
+		flattened["web_rtc_user_id"] = *station.WebRtcUserId
+	}
+	if phone != nil {
+		flattened["phone_id"] = phone.phoneId
+		flattened["site_id"] = phone.siteId
+	}
+	return flattened
+}
+
+func getStationName(station platformclientv2.Station) string {
+	if station.Name == nil {
+		return ""
+	}
+	return *station.Name
+}
@@ -0,0 +1,123 @@
+package station
+
+import (
+	"context"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestStation(id, name, stationType, status, lineAppearanceId string) platformclientv2.Station {
+	return platformclientv2.Station{
+		Id:               platformclientv2.String(id),
+		Name:             platformclientv2.String(name),
+		VarType:          platformclientv2.String(stationType),
+		Status:           platformclientv2.String(status),
+		LineAppearanceId: platformclientv2.String(lineAppearanceId),
+	}
+}
+
+func buildTestPhone(id, siteId string, lineIds ...string) platformclientv2.Phone {
+	lines := make([]platformclientv2.Line, 0, len(lineIds))
+	for _, lineId := range lineIds {
+		lines = append(lines, platformclientv2.Line{Id: platformclientv2.String(lineId)})
+	}
+	return platformclientv2.Phone{
+		Id:    platformclientv2.String(id),
+		Site:  &platformclientv2.Domainentityref{Id: platformclientv2.String(siteId)},
+		Lines: &lines,
+	}
+}
+
+func TestUnitDataSourceStationsFilters(t *testing.T) {
+	orgStations := []platformclientv2.Station{
+		buildTestStation("station-c", "Desk C", "inin_remote", "AVAILABLE", "line-c"),
+		buildTestStation("station-a", "Desk A", "inin_webrtc_softphone", "ASSOCIATED", "line-a"),
+		buildTestStation("station-b", "Desk B", "inin_webrtc_softphone", "AVAILABLE", "line-b"),
+		buildTestStation("station-d", "Desk D", "inin_remote", "ASSOCIATED", ""),
+	}
+	orgPhones := []platformclientv2.Phone{
+		buildTestPhone("phone-a", "site-1", "line-a"),
+		buildTestPhone("phone-b", "site-2", "line-b"),
+		buildTestPhone("phone-c", "site-1", "line-c"),
+	}
+
+	sp := &stationProxy{}
+	sp.getAllStationsAttr = func(ctx context.Context, p *stationProxy) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error) {
+		return &orgStations, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	sp.getStationPhonesAttr = func(ctx context.Context, p *stationProxy, siteId string) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+		phones := make([]platformclientv2.Phone, 0)
+		for _, phone := range orgPhones {
+			if siteId == "" || *phone.Site.Id == siteId {
+				phones = append(phones, phone)
+			}
+		}
+		return &phones, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = sp
+	defer func() { internalProxy = nil }()
+
+	testCases := []struct {
+		name        string
+		filters     map[string]interface{}
+		expectedIds []string
+	}{
+		{
+			name:        "no filters",
+			filters:     map[string]interface{}{},
+			expectedIds: []string{"station-a", "station-b", "station-c", "station-d"},
+		},
+		{
+			name:        "site",
+			filters:     map[string]interface{}{"site_id": "site-1"},
+			expectedIds: []string{"station-a", "station-c"},
+		},
+		{
+			name:        "type",
+			filters:     map[string]interface{}{"type": "inin_remote"},
+			expectedIds: []string{"station-c", "station-d"},
+		},
+		{
+			name:        "association state",
+			filters:     map[string]interface{}{"association_state": "AVAILABLE"},
+			expectedIds: []string{"station-b", "station-c"},
+		},
+		{
+			name:        "combined filters",
+			filters:     map[string]interface{}{"site_id": "site-1", "association_state": "ASSOCIATED"},
+			expectedIds: []string{"station-a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, DataSourceStations().Schema, tc.filters)
+			diags := dataSourceStationsRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			ids := make([]string, 0)
+			for _, id := range d.Get("ids").([]interface{}) {
+				ids = append(ids, id.(string))
+			}
+			assert.Equal(t, tc.expectedIds, ids)
+			assert.Equal(t, len(tc.expectedIds), len(d.Get("stations").([]interface{})))
+			assert.NotEmpty(t, d.Id())
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceStations().Schema, map[string]interface{}{})
+	diags := dataSourceStationsRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError())
+	stations := d.Get("stations").([]interface{})
+	assert.Equal(t, "phone-a", stations[0].(map[string]interface{})["phone_id"])
+	assert.Equal(t, "site-1", stations[0].(map[string]interface{})["site_id"])
+	assert.Equal(t, "Desk A", stations[0].(map[string]interface{})["name"])
+	assert.Equal(t, "", stations[3].(map[string]interface{})["phone_id"])
+}
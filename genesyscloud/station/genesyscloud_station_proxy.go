@@ -11,22 +11,49 @@ import (
 var internalProxy *stationProxy
 
 type getStationIdByNameFunc func(ctx context.Context, p *stationProxy, stationName string) (stationId string, retryable bool, err error)
+type getAllStationsFunc func(ctx context.Context, p *stationProxy) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error)
+type getStationPhonesFunc func(ctx context.Context, p *stationProxy, siteId string) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error)
+type getUserStationsFunc func(ctx context.Context, p *stationProxy, userId string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error)
+type setUserDefaultStationFunc func(ctx context.Context, p *stationProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
+type removeUserDefaultStationFunc func(ctx context.Context, p *stationProxy, userId string) (*platformclientv2.APIResponse, error)
+type setUserAssociatedStationFunc func(ctx context.Context, p *stationProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
+type removeUserAssociatedStationFunc func(ctx context.Context, p *stationProxy, userId string) (*platformclientv2.APIResponse, error)
 
 // stationProxy contains all of the methods that call genesys cloud APIs.
 type stationProxy struct {
-	clientConfig           *platformclientv2.Configuration
-	stationsApi            *platformclientv2.StationsApi
-	getStationIdByNameAttr getStationIdByNameFunc
+	clientConfig                    *platformclientv2.Configuration
+	stationsApi                     *platformclientv2.StationsApi
+	usersApi                        *platformclientv2.UsersApi
+	edgesApi                        *platformclientv2.TelephonyProvidersEdgeApi
+	getStationIdByNameAttr          getStationIdByNameFunc
+	getAllStationsAttr              getAllStationsFunc
+	getStationPhonesAttr            getStationPhonesFunc
+	getUserStationsAttr             getUserStationsFunc
+	setUserDefaultStationAttr       setUserDefaultStationFunc
+	removeUserDefaultStationAttr    removeUserDefaultStationFunc
+	setUserAssociatedStationAttr    setUserAssociatedStationFunc
+	removeUserAssociatedStationAttr removeUserAssociatedStationFunc
 }
 
 // newStationProxy initializes the Station proxy with all of the data needed to communicate with Genesys Cloud
 func newStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy {
 	stationsApi := platformclientv2.NewStationsApiWithConfig(clientConfig)
+	usersApi := platformclientv2.NewUsersApiWithConfig(clientConfig)
+	edgesApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
 
 	return &stationProxy{
-		clientConfig:           clientConfig,
-		stationsApi:            stationsApi,
-		getStationIdByNameAttr: getStationIdByNameFn,
+		clientConfig:                    clientConfig,
+		stationsApi:                     stationsApi,
+		usersApi:                        usersApi,
+		edgesApi:                        edgesApi,
+		getStationIdByNameAttr:          getStationIdByNameFn,
+		getAllStationsAttr:              getAllStationsFn,
+		getStationPhonesAttr:            getStationPhonesFn,
+		getUserStationsAttr:             getUserStationsFn,
+		setUserDefaultStationAttr:       setUserDefaultStationFn,
+		removeUserDefaultStationAttr:    removeUserDefaultStationFn,
+		setUserAssociatedStationAttr:    setUserAssociatedStationFn,
+		removeUserAssociatedStationAttr: removeUserAssociatedStationFn,
 	}
 }
 
@@ -40,11 +67,52 @@ func getStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy
 	return internalProxy
 }
 
+// GetAllStations retrieves all Genesys Cloud stations. It is used by resources in other packages that need to look up
+// stations so the stations are only listed here.
+func GetAllStations(ctx context.Context, clientConfig *platformclientv2.Configuration) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error) {
+	return getStationProxy(clientConfig).getAllStations(ctx)
+}
+
 // getStationIdByName retrieves a Genesys Cloud Station ID by Name
 func (p *stationProxy) getStationIdByName(ctx context.Context, stationName string) (stationId string, retryable bool, err error) {
 	return p.getStationIdByNameAttr(ctx, p, stationName)
 }
 
+// getAllStations retrieves all Genesys Cloud stations
+func (p *stationProxy) getAllStations(ctx context.Context) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error) {
+	return p.getAllStationsAttr(ctx, p)
+}
+
+// getStationPhones retrieves the phones of a site, or of every site if siteId is empty
+func (p *stationProxy) getStationPhones(ctx context.Context, siteId string) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	return p.getStationPhonesAttr(ctx, p, siteId)
+}
+
+// getUserStations retrieves the default, associated and effective stations of a user
+func (p *stationProxy) getUserStations(ctx context.Context, userId string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+	return p.getUserStationsAttr(ctx, p, userId)
+}
+
+// setUserDefaultStation sets the default station of a user
+func (p *stationProxy) setUserDefaultStation(ctx context.Context, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.setUserDefaultStationAttr(ctx, p, userId, stationId)
+}
+
+// removeUserDefaultStation clears the default station of a user
+func (p *stationProxy) removeUserDefaultStation(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.removeUserDefaultStationAttr(ctx, p, userId)
+}
+
+// setUserAssociatedStation associates a station with a user
+func (p *stationProxy) setUserAssociatedStation(ctx context.Context, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.setUserAssociatedStationAttr(ctx, p, userId, stationId)
+}
+
+// removeUserAssociatedStation disassociates the associated station of a user
+func (p *stationProxy) removeUserAssociatedStation(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.removeUserAssociatedStationAttr(ctx, p, userId)
+}
+
 // getStationIdByNameFn is an implementation function for retrieving a Station Id by Name
 func getStationIdByNameFn(ctx context.Context, p *stationProxy, stationName string) (stationId string, retryable bool, err error) {
 	const pageSize = 100
@@ -80,3 +148,79 @@ func getStationIdByNameFn(ctx context.Context, p *stationProxy, stationName stri
 
 	return "", true, fmt.Errorf("failed to find ID of station '%s'", stationName)
 }
+
+// getAllStationsFn is an implementation function for retrieving all stations
+func getAllStationsFn(_ context.Context, p *stationProxy) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error) {
+	var allStations []platformclientv2.Station
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		stations, apiResp, err := p.stationsApi.GetStations(pageSize, pageNum, "", "", "", "", "", "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get stations: %v", err)
+		}
+		if stations.Entities == nil || len(*stations.Entities) == 0 {
+			break
+		}
+		allStations = append(allStations, *stations.Entities...)
+		if stations.PageCount == nil || pageNum >= *stations.PageCount {
+			break
+		}
+	}
+	return &allStations, resp, nil
+}
+
+// getStationPhonesFn is an implementation function for retrieving the phones backing stations
+func getStationPhonesFn(_ context.Context, p *stationProxy, siteId string) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	var allPhones []platformclientv2.Phone
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		phones, apiResp, err := p.edgesApi.GetTelephonyProvidersEdgesPhones(pageNum, pageSize, "", "", siteId, "", "", "", "", "", "", "", "", "", "", nil, nil)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get phones: %v", err)
+		}
+		if phones.Entities == nil || len(*phones.Entities) == 0 {
+			break
+		}
+		for _, phone := range *phones.Entities {
+			if phone.State != nil && *phone.State == "deleted" {
+				continue
+			}
+			allPhones = append(allPhones, phone)
+		}
+		if phones.PageCount == nil || pageNum >= *phones.PageCount {
+			break
+		}
+	}
+	return &allPhones, resp, nil
+}
+
+// getUserStationsFn is an implementation function for retrieving the stations of a user
+func getUserStationsFn(_ context.Context, p *stationProxy, userId string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+	return p.usersApi.GetUserStation(userId)
+}
+
+// setUserDefaultStationFn is an implementation function for setting the default station of a user
+func setUserDefaultStationFn(_ context.Context, p *stationProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.usersApi.PutUserStationDefaultstationStationId(userId, stationId)
+}
+
+// removeUserDefaultStationFn is an implementation function for clearing the default station of a user
+func removeUserDefaultStationFn(_ context.Context, p *stationProxy, userId string) (*platformclientv2.APIResponse, error) {
+	return p.usersApi.DeleteUserStationDefaultstation(userId)
+}
+
+// setUserAssociatedStationFn is an implementation function for associating a station with a user
+func setUserAssociatedStationFn(_ context.Context, p *stationProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.usersApi.PutUserStationAssociatedstationStationId(userId, stationId)
+}
+
+// removeUserAssociatedStationFn is an implementation function for disassociating the associated station of a user
+func removeUserAssociatedStationFn(_ context.Context, p *stationProxy, userId string) (*platformclientv2.APIResponse, error) {
+	return p.usersApi.DeleteUserStationAssociatedstation(userId)
+}
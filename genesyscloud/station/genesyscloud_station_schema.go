@@ -2,16 +2,21 @@ package station
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 )
 
 const resourceName = "genesyscloud_station"
+const stationsDataSourceName = "genesyscloud_stations"
+const userStationResourceName = "genesyscloud_user_station"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceStation())
+	l.RegisterDataSource(stationsDataSourceName, DataSourceStations())
+	l.RegisterResource(userStationResourceName, ResourceUserStation())
 }
 
 // DataSourceStation registers the genesyscloud_station data source
@@ -28,3 +33,124 @@ func DataSourceStation() *schema.Resource {
 		},
 	}
 }
+
+var stationResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "ID of the station.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the station.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: "Type of the station, e.g. inin_webrtc_softphone or inin_remote.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"status": {
+			Description: "Association status of the station. ASSOCIATED or AVAILABLE.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"user_id": {
+			Description: "ID of the user associated with the station. Empty if the station is not associated.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"web_rtc_user_id": {
+			Description: "ID of the user the WebRTC station belongs to. Empty for other types of station.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"phone_id": {
+			Description: "ID of the phone backing the station. Empty if no phone backs the station.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"site_id": {
+			Description: "ID of the site of the phone backing the station. Empty if no phone backs the station.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceStations registers the genesyscloud_stations data source
+func DataSourceStations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Genesys Cloud Stations. Select all stations matching a set of filters.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceStationsRead),
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description: "Only return stations backed by a phone in this site.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"type": {
+				Description: "Only return stations of this type, e.g. inin_webrtc_softphone or inin_remote.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"association_state": {
+				Description:  "Only return stations in this association state. Valid values: ASSOCIATED, AVAILABLE. If not set, stations in both states are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ASSOCIATED", "AVAILABLE"}, false),
+			},
+			"ids": {
+				Description: "IDs of the matching stations, ordered by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"stations": {
+				Description: "The matching stations, in the same order as `ids`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        stationResource,
+			},
+		},
+	}
+}
+
+// ResourceUserStation registers the genesyscloud_user_station resource
+func ResourceUserStation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud User Station maintains the default station and the associated station of a user.
+
+The associated station of a user changes when the user logs in to another station, e.g. on a hot desk. Only manage ` + "`associated_station_id`" + ` for users whose station should not change outside of Terraform.`,
+
+		CreateContext: gcloud.CreateWithPooledClient(createUserStation),
+		ReadContext:   gcloud.ReadWithPooledClient(readUserStation),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateUserStation),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteUserStation),
+		Importer: &schema.ResourceImporter{
+			StateContext: importUserStation,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "User ID that will be managed by this resource. Changing the user_id attribute will cause the user station object to be dropped and recreated with a new ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default_station_id": {
+				Description:  "ID of the station the user is associated with when they log in.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"default_station_id", "associated_station_id"},
+			},
+			"associated_station_id": {
+				Description:  "ID of the station currently associated with the user.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"default_station_id", "associated_station_id"},
+			},
+		},
+	}
+}
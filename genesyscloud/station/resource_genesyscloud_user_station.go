@@ -0,0 +1,155 @@
+package station
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// The ID of a user station resource is the ID of the user
+
+func createUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userId := d.Get("user_id").(string)
+	d.SetId(userId)
+
+	log.Printf("Setting stations of user %s", userId)
+	if diagErr := updateUserStations(ctx, d, meta, true); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Set stations of user %s", userId)
+	return readUserStation(ctx, d, meta)
+}
+
+func readUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	sp := getStationProxy(sdkConfig)
+
+	log.Printf("Reading stations of user %s", d.Id())
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		userStations, resp, err := sp.getUserStations(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read stations of user %s: %s", d.Id(), err))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read stations of user %s: %s", d.Id(), err))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceUserStation())
+
+		// Only the stations managed by this resource are read. Stations that are not in state are left untouched.
+		d.Set("user_id", d.Id())
+		if d.Get("default_station_id").(string) != "" {
+			d.Set("default_station_id", getUserStationId(userStations.DefaultStation))
+		}
+		if d.Get("associated_station_id").(string) != "" {
+			d.Set("associated_station_id", getUserStationId(userStations.AssociatedStation))
+		}
+
+		log.Printf("Read stations of user %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+// importUserStation sets both stations of the user, as reads only refresh the stations that are already in state
+func importUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	sp := getStationProxy(sdkConfig)
+
+	log.Printf("Importing stations of user %s", d.Id())
+	userStations, _, err := sp.getUserStations(ctx, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("failed to import stations of user %s: %s", d.Id(), err)
+	}
+	d.Set("user_id", d.Id())
+	d.Set("default_station_id", getUserStationId(userStations.DefaultStation))
+	d.Set("associated_station_id", getUserStationId(userStations.AssociatedStation))
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating stations of user %s", d.Id())
+	if diagErr := updateUserStations(ctx, d, meta, false); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Updated stations of user %s", d.Id())
+	return readUserStation(ctx, d, meta)
+}
+
+func deleteUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	sp := getStationProxy(sdkConfig)
+
+	if d.Get("default_station_id").(string) != "" {
+		log.Printf("Removing default station of user %s", d.Id())
+		resp, err := sp.removeUserDefaultStation(ctx, d.Id())
+		if err != nil && !gcloud.IsStatus404(resp) {
+			return diag.Errorf("Failed to remove default station of user %s: %s", d.Id(), err)
+		}
+	}
+	if d.Get("associated_station_id").(string) != "" {
+		log.Printf("Removing associated station of user %s", d.Id())
+		resp, err := sp.removeUserAssociatedStation(ctx, d.Id())
+		if err != nil && !gcloud.IsStatus404(resp) {
+			return diag.Errorf("Failed to remove associated station of user %s: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("Removed stations of user %s", d.Id())
+	return nil
+}
+
+// updateUserStations sets or removes the default and associated stations of the user that are new or have changed
+func updateUserStations(ctx context.Context, d *schema.ResourceData, meta interface{}, create bool) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	sp := getStationProxy(sdkConfig)
+	userId := d.Id()
+
+	if stationId := d.Get("default_station_id").(string); (create && stationId != "") || (!create && d.HasChange("default_station_id")) {
+		err := applyUserStation(stationId,
+			func() (*platformclientv2.APIResponse, error) { return sp.setUserDefaultStation(ctx, userId, stationId) },
+			func() (*platformclientv2.APIResponse, error) { return sp.removeUserDefaultStation(ctx, userId) })
+		if err != nil {
+			return diag.Errorf("Failed to update default station of user %s: %s", userId, err)
+		}
+	}
+
+	if stationId := d.Get("associated_station_id").(string); (create && stationId != "") || (!create && d.HasChange("associated_station_id")) {
+		err := applyUserStation(stationId,
+			func() (*platformclientv2.APIResponse, error) {
+				return sp.setUserAssociatedStation(ctx, userId, stationId)
+			},
+			func() (*platformclientv2.APIResponse, error) { return sp.removeUserAssociatedStation(ctx, userId) })
+		if err != nil {
+			return diag.Errorf("Failed to update associated station of user %s: %s", userId, err)
+		}
+	}
+	return nil
+}
+
+// applyUserStation sets the station if one is configured and removes it otherwise. Removing a station that is already gone is not an error.
+func applyUserStation(stationId string, set func() (*platformclientv2.APIResponse, error), remove func() (*platformclientv2.APIResponse, error)) error {
+	if stationId != "" {
+		_, err := set()
+		return err
+	}
+	resp, err := remove()
+	if err != nil && !gcloud.IsStatus404(resp) {
+		return err
+	}
+	return nil
+}
+
+func getUserStationId(userStation *platformclientv2.Userstation) string {
+	if userStation == nil || userStation.Id == nil {
+		return ""
+	}
+	return *userStation.Id
+}
@@ -0,0 +1,124 @@
+package station
+
+import (
+	"fmt"
+	"strconv"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	edgePhone "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phone"
+	phoneBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phonebasesettings"
+	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUserStation(t *testing.T) {
+	var (
+		phoneRes              = "phone1234"
+		phoneName             = "test-phone_" + uuid.NewString()
+		phoneBaseSettingsRes  = "phoneBaseSettings1234"
+		phoneBaseSettingsName = "phoneBaseSettings " + uuid.NewString()
+
+		userRes   = "user1"
+		userName  = "test_webrtc_user_" + uuid.NewString()
+		userEmail = userName + "@test.com"
+
+		stationDataRes  = "station1234"
+		stationsDataRes = "stations1234"
+		userStationRes  = "userStation1234"
+	)
+
+	defaultSiteId, err := edgeSite.GetOrganizationDefaultSiteId(sdkConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := gcloud.GenerateUserResource(
+		userRes,
+		userEmail,
+		userName,
+		gcloud.NullValue, // Defaults to active
+		strconv.Quote("Senior Director"),
+		strconv.Quote("Development"),
+		gcloud.NullValue, // No manager
+		gcloud.NullValue, // Default acdAutoAnswer
+		"",               // No profile skills
+		"",               // No certs
+	) + phoneBaseSettings.GeneratePhoneBaseSettingsResourceWithCustomAttrs(
+		phoneBaseSettingsRes,
+		phoneBaseSettingsName,
+		"phoneBaseSettings description",
+		"inin_webrtc_softphone.json",
+	) + edgePhone.GeneratePhoneResourceWithCustomAttrs(&edgePhone.PhoneConfig{
+		PhoneRes:            phoneRes,
+		Name:                phoneName,
+		State:               "active",
+		SiteId:              fmt.Sprintf("\"%s\"", defaultSiteId),
+		PhoneBaseSettingsId: "genesyscloud_telephony_providers_edges_phonebasesettings." + phoneBaseSettingsRes + ".id",
+		LineAddresses:       nil, // no line addresses
+		WebRtcUserId:        "genesyscloud_user." + userRes + ".id",
+		Depends_on:          "", // no depends on
+	},
+	) + generateStationDataSource(
+		stationDataRes,
+		"genesyscloud_telephony_providers_edges_phone."+phoneRes+".name",
+		"genesyscloud_telephony_providers_edges_phone."+phoneRes,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Set the default station of the user
+				Config: config + generateUserStationResource(
+					userStationRes,
+					"genesyscloud_user."+userRes+".id",
+					"data.genesyscloud_station."+stationDataRes+".id",
+					gcloud.NullValue,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(userStationResourceName+"."+userStationRes, "user_id", "genesyscloud_user."+userRes, "id"),
+					resource.TestCheckResourceAttrPair(userStationResourceName+"."+userStationRes, "default_station_id", "data.genesyscloud_station."+stationDataRes, "id"),
+					resource.TestCheckResourceAttr(userStationResourceName+"."+userStationRes, "associated_station_id", ""),
+				),
+			},
+			{
+				// Also associate the station and list the stations of the site
+				Config: config + generateUserStationResource(
+					userStationRes,
+					"genesyscloud_user."+userRes+".id",
+					"data.genesyscloud_station."+stationDataRes+".id",
+					"data.genesyscloud_station."+stationDataRes+".id",
+				) + fmt.Sprintf(`
+data "%s" "%s" {
+	site_id           = "%s"
+	association_state = "ASSOCIATED"
+	depends_on        = [%s.%s]
+}
+`, stationsDataSourceName, stationsDataRes, defaultSiteId, userStationResourceName, userStationRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(userStationResourceName+"."+userStationRes, "associated_station_id", "data.genesyscloud_station."+stationDataRes, "id"),
+					resource.TestCheckTypeSetElemAttrPair("data."+stationsDataSourceName+"."+stationsDataRes, "ids.*", "data.genesyscloud_station."+stationDataRes, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      userStationResourceName + "." + userStationRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: edgePhone.TestVerifyWebRtcPhoneDestroyed,
+	})
+}
+
+func generateUserStationResource(resourceID string, userId string, defaultStationId string, associatedStationId string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		user_id               = %s
+		default_station_id    = %s
+		associated_station_id = %s
+	}
+	`, userStationResourceName, resourceID, userId, defaultStationId, associatedStationId)
+}
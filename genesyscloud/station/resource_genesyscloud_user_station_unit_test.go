@@ -0,0 +1,171 @@
+package station
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestUserStations(defaultStationId string, associatedStationId string) *platformclientv2.Userstations {
+	userStations := &platformclientv2.Userstations{}
+	if defaultStationId != "" {
+		userStations.DefaultStation = &platformclientv2.Userstation{Id: platformclientv2.String(defaultStationId)}
+	}
+	if associatedStationId != "" {
+		userStations.AssociatedStation = &platformclientv2.Userstation{Id: platformclientv2.String(associatedStationId)}
+	}
+	return userStations
+}
+
+func TestUnitResourceUserStationCreate(t *testing.T) {
+	userId := uuid.NewString()
+	defaultStationId := uuid.NewString()
+	associatedStationId := uuid.NewString()
+
+	calls := make([]string, 0)
+	sp := &stationProxy{}
+	sp.setUserDefaultStationAttr = func(ctx context.Context, p *stationProxy, id string, stationId string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		assert.Equal(t, defaultStationId, stationId)
+		calls = append(calls, "setDefault")
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	sp.removeUserAssociatedStationAttr = func(ctx context.Context, p *stationProxy, id string) (*platformclientv2.APIResponse, error) {
+		calls = append(calls, "removeAssociated")
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	sp.getUserStationsAttr = func(ctx context.Context, p *stationProxy, id string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+		return buildTestUserStations(defaultStationId, associatedStationId), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = sp
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUserStation().Schema, map[string]interface{}{
+		"user_id":            userId,
+		"default_station_id": defaultStationId,
+	})
+
+	diags := createUserStation(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, userId, d.Id())
+	assert.Equal(t, defaultStationId, d.Get("default_station_id").(string))
+
+	// The associated station is not managed, so it is neither removed nor read
+	assert.Equal(t, []string{"setDefault"}, calls)
+	assert.Equal(t, "", d.Get("associated_station_id").(string))
+}
+
+func TestUnitResourceUserStationImport(t *testing.T) {
+	userId := uuid.NewString()
+	defaultStationId := uuid.NewString()
+	associatedStationId := uuid.NewString()
+
+	sp := &stationProxy{}
+	sp.getUserStationsAttr = func(ctx context.Context, p *stationProxy, id string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		return buildTestUserStations(defaultStationId, associatedStationId), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = sp
+	defer func() { internalProxy = nil }()
+
+	meta := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceUserStation().Schema, map[string]interface{}{})
+	d.SetId(userId)
+
+	imported, err := importUserStation(context.Background(), d, meta)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(imported))
+
+	diags := readUserStation(context.Background(), imported[0], meta)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, userId, imported[0].Get("user_id").(string))
+	assert.Equal(t, defaultStationId, imported[0].Get("default_station_id").(string))
+	assert.Equal(t, associatedStationId, imported[0].Get("associated_station_id").(string))
+}
+
+func TestUnitResourceUserStationReadUnmanaged(t *testing.T) {
+	userId := uuid.NewString()
+	defaultStationId := uuid.NewString()
+	associatedStationId := uuid.NewString()
+
+	sp := &stationProxy{}
+	sp.getUserStationsAttr = func(ctx context.Context, p *stationProxy, id string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+		return buildTestUserStations(defaultStationId, associatedStationId), &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = sp
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUserStation().Schema, map[string]interface{}{
+		"user_id":            userId,
+		"default_station_id": defaultStationId,
+	})
+	d.SetId(userId)
+
+	// The associated station is not in state, so it is never read
+	diags := readUserStation(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, defaultStationId, d.Get("default_station_id").(string))
+	assert.Equal(t, "", d.Get("associated_station_id").(string))
+
+	// With no station in state, neither is read
+	d = schema.TestResourceDataRaw(t, ResourceUserStation().Schema, map[string]interface{}{"user_id": userId})
+	d.SetId(uuid.NewString())
+
+	diags = readUserStation(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", d.Get("default_station_id").(string))
+	assert.Equal(t, "", d.Get("associated_station_id").(string))
+}
+
+func TestUnitResourceUserStationDelete(t *testing.T) {
+	userId := uuid.NewString()
+
+	calls := make([]string, 0)
+	sp := &stationProxy{}
+	sp.removeUserDefaultStationAttr = func(ctx context.Context, p *stationProxy, id string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		calls = append(calls, "removeDefault")
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+	}
+	sp.removeUserAssociatedStationAttr = func(ctx context.Context, p *stationProxy, id string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		calls = append(calls, "removeAssociated")
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	internalProxy = sp
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUserStation().Schema, map[string]interface{}{
+		"user_id":               userId,
+		"default_station_id":    uuid.NewString(),
+		"associated_station_id": uuid.NewString(),
+	})
+	d.SetId(userId)
+
+	diags := deleteUserStation(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.Nil(t, diags)
+	assert.Equal(t, []string{"removeDefault", "removeAssociated"}, calls)
+}
+
+func TestUnitApplyUserStation(t *testing.T) {
+	set := func() (*platformclientv2.APIResponse, error) {
+		return &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("bad request")
+	}
+	removeMissing := func() (*platformclientv2.APIResponse, error) {
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+	}
+	removeFailed := func() (*platformclientv2.APIResponse, error) {
+		return &platformclientv2.APIResponse{StatusCode: http.StatusInternalServerError}, fmt.Errorf("server error")
+	}
+
+	assert.EqualError(t, applyUserStation("station", set, removeMissing), "bad request")
+	assert.Nil(t, applyUserStation("", set, removeMissing))
+	assert.EqualError(t, applyUserStation("", set, removeFailed), "server error")
+}
@@ -11,6 +11,7 @@ import (
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	externalContact "terraform-provider-genesyscloud/genesyscloud/external_contacts"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 	workbin "terraform-provider-genesyscloud/genesyscloud/task_management_workbin"
 	workitemSchema "terraform-provider-genesyscloud/genesyscloud/task_management_workitem_schema"
 	worktype "terraform-provider-genesyscloud/genesyscloud/task_management_worktype"
@@ -189,7 +190,7 @@ func TestAccResourceTaskManagementWorkitem(t *testing.T) {
 				Config: taskMgmtConfig +
 					gcloud.GenerateAuthDivisionHomeDataSource(homeDivRes) +
 					gcloud.GenerateRoutingLanguageResource(resLang, lang) +
-					gcloud.GenerateRoutingQueueResourceBasic(resQueue, queueName) +
+					routingQueue.GenerateRoutingQueueResourceBasic(resQueue, queueName) +
 					gcloud.GenerateRoutingSkillResource(skillResId1, skillResName1) +
 					gcloud.GenerateBasicUserResource(userResId1, userEmail1, userName1) +
 					externalContact.GenerateBasicExternalContactResource(externalContactResId1, externalContactTitle1) +
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 	workbin "terraform-provider-genesyscloud/genesyscloud/task_management_workbin"
 	workitemSchema "terraform-provider-genesyscloud/genesyscloud/task_management_workitem_schema"
 
@@ -105,7 +106,7 @@ func TestAccResourceTaskManagementWorktype(t *testing.T) {
 			{
 				Config: workbin.GenerateWorkbinResource(wbResourceId, wbName, wbDescription, gcloud.NullValue) +
 					workitemSchema.GenerateWorkitemSchemaResourceBasic(wsResourceId, wsName, wsDescription) +
-					gcloud.GenerateRoutingQueueResourceBasic(queueResId, queueName) +
+					routingQueue.GenerateRoutingQueueResourceBasic(queueResId, queueName) +
 					gcloud.GenerateRoutingLanguageResource(langResId, langName) +
 					gcloud.GenerateRoutingSkillResource(skillResId1, skillResName1) +
 					gcloud.GenerateRoutingSkillResource(skillResId2, skillResName2) +
@@ -59,7 +59,7 @@ func ResourceTrunkBaseSettings() *schema.Resource {
 			},
 
 			"properties": {
-				Description:      "trunk base settings properties",
+				Description:      "trunk base settings properties. Properties are validated during plan against the schema of the trunk meta-base.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
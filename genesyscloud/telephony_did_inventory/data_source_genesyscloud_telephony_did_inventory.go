@@ -0,0 +1,277 @@
+package telephony_did_inventory
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/nyaruka/phonenumbers"
+)
+
+/*
+   The data_source_genesyscloud_telephony_did_inventory.go contains the data source implementation
+   for the DID inventory data source.
+*/
+
+// didInventoryNumber is a number in one of the selected DID pools
+type didInventoryNumber struct {
+	number    string
+	didPoolId string
+	assigned  bool
+	ownerType string
+	ownerId   string
+	ownerName string
+}
+
+// didInventoryDuplicate is a number contained in more than one DID pool
+type didInventoryDuplicate struct {
+	number     string
+	didPoolIds []string
+}
+
+// didInventory is the reconciliation of the numbers of the selected DID pools against every DID pool in the org
+type didInventory struct {
+	numbers         []didInventoryNumber
+	assignedCount   int
+	ownerTypeCounts map[string]int
+	duplicates      []didInventoryDuplicate
+	unpooledNumbers []string
+}
+
+// dataSourceDidInventoryRead lists the numbers of the selected DID pools and reconciles them against every DID pool in the org
+func dataSourceDidInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getDidInventoryProxy(sdkConfig)
+
+	didPoolIds := *lists.SetToStringList(d.Get("did_pool_ids").(*schema.Set))
+	sort.Strings(didPoolIds)
+
+	referencedNumbers, err := normalizeNumbers(*lists.SetToStringList(d.Get("referenced_numbers").(*schema.Set)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		allDidPools, _, err := proxy.getAllDidPools(ctx)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		// A DID pool created in the same run may not be listed yet
+		for _, didPoolId := range didPoolIds {
+			if findDidPool(*allDidPools, didPoolId) == nil {
+				return retry.RetryableError(fmt.Errorf("failed to find DID pool %s", didPoolId))
+			}
+		}
+
+		numbersByPool := make(map[string][]platformclientv2.Didnumber, len(didPoolIds))
+		for _, didPoolId := range didPoolIds {
+			numbers, _, err := proxy.getDidPoolNumbers(ctx, didPoolId)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			numbersByPool[didPoolId] = *numbers
+		}
+
+		inventory := buildDidInventory(numbersByPool, *allDidPools, referencedNumbers)
+
+		d.SetId(didInventoryId(didPoolIds, referencedNumbers))
+		_ = d.Set("numbers", flattenDidInventoryNumbers(inventory.numbers))
+		_ = d.Set("total_count", len(inventory.numbers))
+		_ = d.Set("assigned_count", inventory.assignedCount)
+		_ = d.Set("unassigned_count", len(inventory.numbers)-inventory.assignedCount)
+		_ = d.Set("owner_type_counts", inventory.ownerTypeCounts)
+		_ = d.Set("duplicate_numbers", flattenDidInventoryDuplicates(inventory.duplicates))
+		_ = d.Set("unpooled_numbers", inventory.unpooledNumbers)
+		return nil
+	})
+}
+
+// buildDidInventory reconciles the numbers of the selected DID pools against the ranges of every DID pool in the org
+func buildDidInventory(numbersByPool map[string][]platformclientv2.Didnumber, allDidPools []platformclientv2.Didpool, referencedNumbers []string) didInventory {
+	inventory := didInventory{
+		numbers:         make([]didInventoryNumber, 0),
+		ownerTypeCounts: make(map[string]int),
+		duplicates:      make([]didInventoryDuplicate, 0),
+		unpooledNumbers: make([]string, 0),
+	}
+
+	// Pools containing each number, from the listings and from the ranges of every pool in the org
+	poolsByNumber := make(map[string]map[string]bool)
+	addPool := func(number, didPoolId string) {
+		if poolsByNumber[number] == nil {
+			poolsByNumber[number] = make(map[string]bool)
+		}
+		poolsByNumber[number][didPoolId] = true
+	}
+
+	for didPoolId, numbers := range numbersByPool {
+		for _, didNumber := range numbers {
+			if didNumber.Number == nil {
+				continue
+			}
+			entry := didInventoryNumber{
+				number:    *didNumber.Number,
+				didPoolId: didPoolId,
+			}
+			if didNumber.Assigned != nil {
+				entry.assigned = *didNumber.Assigned
+			}
+			if didNumber.OwnerType != nil {
+				entry.ownerType = *didNumber.OwnerType
+			}
+			if didNumber.Owner != nil {
+				if didNumber.Owner.Id != nil {
+					entry.ownerId = *didNumber.Owner.Id
+				}
+				if didNumber.Owner.Name != nil {
+					entry.ownerName = *didNumber.Owner.Name
+				}
+			}
+
+			inventory.numbers = append(inventory.numbers, entry)
+			if entry.assigned {
+				inventory.assignedCount++
+				if entry.ownerType != "" {
+					inventory.ownerTypeCounts[entry.ownerType]++
+				}
+			}
+
+			addPool(entry.number, didPoolId)
+			for _, didPool := range allDidPools {
+				if didPool.Id != nil && didPoolContains(didPool, entry.number) {
+					addPool(entry.number, *didPool.Id)
+				}
+			}
+		}
+	}
+	sort.SliceStable(inventory.numbers, func(i, j int) bool {
+		if inventory.numbers[i].number != inventory.numbers[j].number {
+			return inventory.numbers[i].number < inventory.numbers[j].number
+		}
+		return inventory.numbers[i].didPoolId < inventory.numbers[j].didPoolId
+	})
+
+	for number, didPoolIds := range poolsByNumber {
+		if len(didPoolIds) < 2 {
+			continue
+		}
+		duplicate := didInventoryDuplicate{number: number}
+		for didPoolId := range didPoolIds {
+			duplicate.didPoolIds = append(duplicate.didPoolIds, didPoolId)
+		}
+		sort.Strings(duplicate.didPoolIds)
+		inventory.duplicates = append(inventory.duplicates, duplicate)
+	}
+	sort.Slice(inventory.duplicates, func(i, j int) bool {
+		return inventory.duplicates[i].number < inventory.duplicates[j].number
+	})
+
+	for _, number := range referencedNumbers {
+		if _, listed := poolsByNumber[number]; listed {
+			continue
+		}
+		pooled := false
+		for _, didPool := range allDidPools {
+			if didPoolContains(didPool, number) {
+				pooled = true
+				break
+			}
+		}
+		if !pooled {
+			inventory.unpooledNumbers = append(inventory.unpooledNumbers, number)
+		}
+	}
+	return inventory
+}
+
+// didPoolContains reports whether an E.164 number is within the range of a DID pool
+func didPoolContains(didPool platformclientv2.Didpool, number string) bool {
+	if didPool.StartPhoneNumber == nil || didPool.EndPhoneNumber == nil {
+		return false
+	}
+	return compareNumbers(*didPool.StartPhoneNumber, number) <= 0 && compareNumbers(number, *didPool.EndPhoneNumber) <= 0
+}
+
+// compareNumbers numerically compares two E.164 numbers
+func compareNumbers(a, b string) int {
+	a = strings.TrimPrefix(a, "+")
+	b = strings.TrimPrefix(b, "+")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// normalizeNumbers formats the numbers as E.164, removing duplicates and ordering them by number
+func normalizeNumbers(numbers []string) ([]string, error) {
+	normalized := make(map[string]bool, len(numbers))
+	for _, number := range numbers {
+		phoneNumber, err := phonenumbers.Parse(number, "US")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse referenced number %s: %s", number, err)
+		}
+		normalized[phonenumbers.Format(phoneNumber, phonenumbers.E164)] = true
+	}
+
+	result := make([]string, 0, len(normalized))
+	for number := range normalized {
+		result = append(result, number)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func findDidPool(didPools []platformclientv2.Didpool, didPoolId string) *platformclientv2.Didpool {
+	for i, didPool := range didPools {
+		if didPool.Id != nil && *didPool.Id == didPoolId {
+			return &didPools[i]
+		}
+	}
+	return nil
+}
+
+func flattenDidInventoryNumbers(numbers []didInventoryNumber) []interface{} {
+	flattened := make([]interface{}, 0, len(numbers))
+	for _, number := range numbers {
+		flattened = append(flattened, map[string]interface{}{
+			"number":      number.number,
+			"did_pool_id": number.didPoolId,
+			"assigned":    number.assigned,
+			"owner_type":  number.ownerType,
+			"owner_id":    number.ownerId,
+			"owner_name":  number.ownerName,
+		})
+	}
+	return flattened
+}
+
+func flattenDidInventoryDuplicates(duplicates []didInventoryDuplicate) []interface{} {
+	flattened := make([]interface{}, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		flattened = append(flattened, map[string]interface{}{
+			"number":       duplicate.number,
+			"did_pool_ids": duplicate.didPoolIds,
+		})
+	}
+	return flattened
+}
+
+// didInventoryId returns a data source ID that is stable for the same DID pools and referenced numbers
+func didInventoryId(didPoolIds []string, referencedNumbers []string) string {
+	key := strings.Join(didPoolIds, ",") + "|" + strings.Join(referencedNumbers, ",")
+	return fmt.Sprintf("did-inventory-%x", sha256.Sum256([]byte(key)))
+}
@@ -0,0 +1,71 @@
+package telephony_did_inventory
+
+import (
+	"context"
+	"fmt"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDidInventory(t *testing.T) {
+	var (
+		didPoolStartPhoneNumber = "+45465550021"
+		didPoolEndPhoneNumber   = "+45465550023"
+		unpooledNumber          = "+45465559999"
+		didPoolRes              = "didPool"
+		inventoryDataRes        = "inventory"
+	)
+
+	// did pool cleanup
+	defer func() {
+		if _, err := gcloud.AuthorizeSdk(); err != nil {
+			return
+		}
+		ctx := context.TODO()
+		_, _ = didPool.DeleteDidPoolWithStartAndEndNumber(ctx, didPoolStartPhoneNumber, didPoolEndPhoneNumber)
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: didPool.GenerateDidPoolResource(&didPool.DidPoolStruct{
+					ResourceID:       didPoolRes,
+					StartPhoneNumber: didPoolStartPhoneNumber,
+					EndPhoneNumber:   didPoolEndPhoneNumber,
+					Description:      gcloud.NullValue, // No description
+					Comments:         gcloud.NullValue, // No comments
+					PoolProvider:     gcloud.NullValue, // No provider
+				}) + generateDidInventoryDataSource(
+					inventoryDataRes,
+					"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+					[]string{didPoolStartPhoneNumber, unpooledNumber},
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "total_count", "3"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "assigned_count", "0"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "unassigned_count", "3"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "numbers.#", "3"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "numbers.0.number", didPoolStartPhoneNumber),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "numbers.0.assigned", "false"),
+					resource.TestCheckResourceAttrPair("data."+resourceName+"."+inventoryDataRes, "numbers.0.did_pool_id", "genesyscloud_telephony_providers_edges_did_pool."+didPoolRes, "id"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "duplicate_numbers.#", "0"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "unpooled_numbers.#", "1"),
+					resource.TestCheckResourceAttr("data."+resourceName+"."+inventoryDataRes, "unpooled_numbers.0", unpooledNumber),
+				),
+			},
+		},
+	})
+}
+
+func generateDidInventoryDataSource(dataSourceId string, didPoolId string, referencedNumbers []string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		did_pool_ids       = [%s]
+		referenced_numbers = %s
+	}
+	`, resourceName, dataSourceId, didPoolId, gcloud.GenerateStringArrayEnquote(referencedNumbers...))
+}
@@ -0,0 +1,124 @@
+package telephony_did_inventory
+
+import (
+	"context"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestDidPool(id, start, end string) platformclientv2.Didpool {
+	return platformclientv2.Didpool{
+		Id:               platformclientv2.String(id),
+		StartPhoneNumber: platformclientv2.String(start),
+		EndPhoneNumber:   platformclientv2.String(end),
+	}
+}
+
+func buildTestDidNumber(number string, ownerType string, ownerId string) platformclientv2.Didnumber {
+	didNumber := platformclientv2.Didnumber{
+		Number:   platformclientv2.String(number),
+		Assigned: platformclientv2.Bool(ownerId != ""),
+	}
+	if ownerId != "" {
+		didNumber.OwnerType = platformclientv2.String(ownerType)
+		didNumber.Owner = &platformclientv2.Domainentityref{Id: platformclientv2.String(ownerId), Name: platformclientv2.String(ownerId + " name")}
+	}
+	return didNumber
+}
+
+func TestUnitDataSourceDidInventoryRead(t *testing.T) {
+	orgDidPools := []platformclientv2.Didpool{
+		buildTestDidPool("pool-a", "+13175550100", "+13175550102"),
+		buildTestDidPool("pool-b", "+13175550200", "+13175550201"),
+		// Overlaps the last number of pool-a
+		buildTestDidPool("pool-c", "+13175550102", "+13175550102"),
+		buildTestDidPool("pool-d", "+13175550300", "+13175550399"),
+	}
+	poolNumbers := map[string][]platformclientv2.Didnumber{
+		"pool-a": {
+			buildTestDidNumber("+13175550101", "IVR_CONFIG", "ivr-1"),
+			buildTestDidNumber("+13175550100", "USER", "user-1"),
+			buildTestDidNumber("+13175550102", "", ""),
+		},
+		"pool-b": {
+			buildTestDidNumber("+13175550200", "USER", "user-2"),
+			buildTestDidNumber("+13175550201", "QUEUE", "queue-1"),
+		},
+	}
+
+	requestedPools := make([]string, 0)
+	proxy := &didInventoryProxy{}
+	proxy.getAllDidPoolsAttr = func(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+		return &orgDidPools, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getDidPoolNumbersAttr = func(ctx context.Context, p *didInventoryProxy, didPoolId string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+		requestedPools = append(requestedPools, didPoolId)
+		numbers := poolNumbers[didPoolId]
+		return &numbers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() {
+		internalProxy = nil
+	}()
+
+	d := schema.TestResourceDataRaw(t, DataSourceDidInventory().Schema, map[string]interface{}{
+		"did_pool_ids":       []interface{}{"pool-b", "pool-a"},
+		"referenced_numbers": []interface{}{"(317) 555-0101", "+1 317 555 0350", "+13175559999", "317-555-9999"},
+	})
+	diags := dataSourceDidInventoryRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	assert.Equal(t, []string{"pool-a", "pool-b"}, requestedPools)
+	assert.Equal(t, 5, d.Get("total_count"))
+	assert.Equal(t, 4, d.Get("assigned_count"))
+	assert.Equal(t, 1, d.Get("unassigned_count"))
+	assert.Equal(t, map[string]interface{}{"USER": 2, "IVR_CONFIG": 1, "QUEUE": 1}, d.Get("owner_type_counts"))
+
+	numbers := d.Get("numbers").([]interface{})
+	assert.Equal(t, 5, len(numbers))
+	assert.Equal(t, map[string]interface{}{
+		"number":      "+13175550100",
+		"did_pool_id": "pool-a",
+		"assigned":    true,
+		"owner_type":  "USER",
+		"owner_id":    "user-1",
+		"owner_name":  "user-1 name",
+	}, numbers[0])
+	assert.Equal(t, "+13175550201", numbers[4].(map[string]interface{})["number"])
+
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"number":       "+13175550102",
+		"did_pool_ids": []interface{}{"pool-a", "pool-c"},
+	}}, d.Get("duplicate_numbers"))
+
+	// Numbers within the range of pool-d are pooled even though pool-d was not selected
+	assert.Equal(t, []interface{}{"+13175559999"}, d.Get("unpooled_numbers"))
+	assert.NotEmpty(t, d.Id())
+}
+
+func TestUnitDataSourceDidInventoryInvalidReferencedNumber(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceDidInventory().Schema, map[string]interface{}{
+		"did_pool_ids":       []interface{}{"pool-a"},
+		"referenced_numbers": []interface{}{"not a number"},
+	})
+	diags := dataSourceDidInventoryRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.True(t, diags.HasError())
+}
+
+func TestUnitDidPoolContains(t *testing.T) {
+	didPool := buildTestDidPool("pool", "+13175550100", "+13175550199")
+	assert.True(t, didPoolContains(didPool, "+13175550100"))
+	assert.True(t, didPoolContains(didPool, "+13175550150"))
+	assert.True(t, didPoolContains(didPool, "+13175550199"))
+	assert.False(t, didPoolContains(didPool, "+13175550200"))
+	assert.False(t, didPoolContains(didPool, "+1317555015"))
+	assert.False(t, didPoolContains(didPool, "+131755501500"))
+	assert.False(t, didPoolContains(platformclientv2.Didpool{}, "+13175550150"))
+}
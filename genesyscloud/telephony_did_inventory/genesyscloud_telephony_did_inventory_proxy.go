@@ -0,0 +1,125 @@
+package telephony_did_inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_did_inventory_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+Each proxy implementation:
+
+1.  Should provide a private package level variable that holds a instance of a proxy class.
+2.  A New... constructor function to initialize the proxy object. This constructor should only be used within
+    the proxy.
+3.  A get private constructor function that the classes in the package can be used to retrieve
+    the proxy. This proxy should check to see if the package level proxy instance is nil and
+    should initialize it, otherwise it should return the instance
+4.  Type definitions for each function that will be used in the proxy.  We use composition here
+    so that we can easily provide mocks for testing.
+5.  A struct for the proxy that holds an attribute for each function type.
+6.  Wrapper methods on each of the elements on the struct.
+7.  Function implementations for each function type definition.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *didInventoryProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllDidPoolsFunc func(ctx context.Context, p *didInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error)
+type getDidPoolNumbersFunc func(ctx context.Context, p *didInventoryProxy, didPoolId string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error)
+
+// didInventoryProxy contains all of the methods that call genesys cloud APIs.
+type didInventoryProxy struct {
+	clientConfig *platformclientv2.Configuration
+	edgesApi     *platformclientv2.TelephonyProvidersEdgeApi
+
+	getAllDidPoolsAttr    getAllDidPoolsFunc
+	getDidPoolNumbersAttr getDidPoolNumbersFunc
+}
+
+// newDidInventoryProxy initializes the DID inventory proxy with all of the data needed to communicate with Genesys Cloud
+func newDidInventoryProxy(clientConfig *platformclientv2.Configuration) *didInventoryProxy {
+	return &didInventoryProxy{
+		clientConfig: clientConfig,
+		edgesApi:     platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
+
+		getAllDidPoolsAttr:    getAllDidPoolsFn,
+		getDidPoolNumbersAttr: getDidPoolNumbersFn,
+	}
+}
+
+// getDidInventoryProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getDidInventoryProxy(clientConfig *platformclientv2.Configuration) *didInventoryProxy {
+	if internalProxy == nil {
+		internalProxy = newDidInventoryProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllDidPools retrieves every DID pool in the org
+func (p *didInventoryProxy) getAllDidPools(ctx context.Context) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	return p.getAllDidPoolsAttr(ctx, p)
+}
+
+// getDidPoolNumbers retrieves the assigned and unassigned numbers of a DID pool
+func (p *didInventoryProxy) getDidPoolNumbers(ctx context.Context, didPoolId string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	return p.getDidPoolNumbersAttr(ctx, p, didPoolId)
+}
+
+// getAllDidPoolsFn is the implementation for retrieving all DID pools in Genesys Cloud
+func getAllDidPoolsFn(_ context.Context, p *didInventoryProxy) (*[]platformclientv2.Didpool, *platformclientv2.APIResponse, error) {
+	var allDidPools []platformclientv2.Didpool
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		didPools, apiResp, err := p.edgesApi.GetTelephonyProvidersEdgesDidpools(pageSize, pageNum, "", nil)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get DID pools: %v", err)
+		}
+		if didPools.Entities == nil || len(*didPools.Entities) == 0 {
+			break
+		}
+		for _, didPool := range *didPools.Entities {
+			if didPool.State != nil && *didPool.State == "deleted" {
+				continue
+			}
+			allDidPools = append(allDidPools, didPool)
+		}
+		if didPools.PageCount == nil || pageNum >= *didPools.PageCount {
+			break
+		}
+	}
+	return &allDidPools, resp, nil
+}
+
+// getDidPoolNumbersFn is the implementation for retrieving the numbers of a DID pool in Genesys Cloud
+func getDidPoolNumbersFn(_ context.Context, p *didInventoryProxy, didPoolId string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	var allNumbers []platformclientv2.Didnumber
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		numbers, apiResp, err := p.edgesApi.GetTelephonyProvidersEdgesDidpoolsDids("ASSIGNED_AND_UNASSIGNED", []string{didPoolId}, "", pageSize, pageNum, "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get numbers of DID pool %s: %v", didPoolId, err)
+		}
+		if numbers.Entities == nil || len(*numbers.Entities) == 0 {
+			break
+		}
+		allNumbers = append(allNumbers, *numbers.Entities...)
+		if numbers.PageCount == nil || pageNum >= *numbers.PageCount {
+			break
+		}
+	}
+	return &allNumbers, resp, nil
+}
@@ -0,0 +1,139 @@
+package telephony_did_inventory
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+genesyscloud_telephony_did_inventory_schema.go holds two functions within it:
+
+1.  The registration code that registers the Datasource for the package.
+2.  The datasource schema definitions for the DID inventory datasource.
+*/
+const resourceName = "genesyscloud_telephony_did_inventory"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(resourceName, DataSourceDidInventory())
+}
+
+var (
+	didInventoryNumberResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"number": {
+				Description: "The DID number formatted as E.164.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"did_pool_id": {
+				Description: "ID of the DID pool the number belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"assigned": {
+				Description: "True if the number is assigned to an entity.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"owner_type": {
+				Description: "Type of the entity that owns the number, e.g. USER, IVR_CONFIG, GROUP or QUEUE. Empty if the number is unassigned.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"owner_id": {
+				Description: "ID of the entity that owns the number. Empty if the number is unassigned.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"owner_name": {
+				Description: "Name of the entity that owns the number. Empty if the number is unassigned.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	didInventoryDuplicateResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"number": {
+				Description: "The DID number formatted as E.164.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"did_pool_ids": {
+				Description: "IDs of every DID pool in the org that contains the number, ordered by ID.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+)
+
+// DataSourceDidInventory registers the genesyscloud_telephony_did_inventory data source
+func DataSourceDidInventory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the DID inventory of a set of Genesys Cloud DID pools. " +
+			"Returns every number in the pools with the type and ID of its owner, summary counts, " +
+			"numbers that are contained in more than one DID pool and referenced numbers that are not contained in any DID pool.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceDidInventoryRead),
+		Schema: map[string]*schema.Schema{
+			"did_pool_ids": {
+				Description: "IDs of the DID pools to list the numbers of.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"referenced_numbers": {
+				Description: "Phone numbers referenced in configuration, e.g. caller IDs or outbound ANIs. " +
+					"Numbers are normalized to E.164 and any number not contained in a DID pool of the org is returned in `unpooled_numbers`.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"numbers": {
+				Description: "Every number in the DID pools, ordered by number.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        didInventoryNumberResource,
+			},
+			"total_count": {
+				Description: "Number of numbers in the DID pools.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"assigned_count": {
+				Description: "Number of numbers in the DID pools that are assigned to an entity.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"unassigned_count": {
+				Description: "Number of numbers in the DID pools that are not assigned to any entity.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"owner_type_counts": {
+				Description: "Number of assigned numbers keyed by owner type.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"duplicate_numbers": {
+				Description: "Numbers in the DID pools that are also contained in another DID pool of the org, ordered by number.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        didInventoryDuplicateResource,
+			},
+			"unpooled_numbers": {
+				Description: "Referenced numbers, formatted as E.164, that are not contained in any DID pool of the org, ordered by number.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
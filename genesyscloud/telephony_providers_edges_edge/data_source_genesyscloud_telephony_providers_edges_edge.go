@@ -0,0 +1,35 @@
+package telephony_providers_edges_edge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEdgeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	name := d.Get("name").(string)
+	siteId := d.Get("site_id").(string)
+	managed := d.Get("managed").(bool)
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		edgeId, retryable, _, err := ep.getEdgeIdByName(ctx, name, siteId, managed)
+		if err != nil {
+			if retryable {
+				return retry.RetryableError(fmt.Errorf("failed to get edge %s", name))
+			}
+			return retry.NonRetryableError(fmt.Errorf("error requesting edge %s: %s", name, err))
+		}
+
+		d.SetId(edgeId)
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package telephony_providers_edges_edge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEdgeStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	edgeId := d.Get("edge_id").(string)
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		edge, resp, err := ep.getEdge(ctx, edgeId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("failed to get edge %s: %s", edgeId, err))
+			}
+			return retry.NonRetryableError(fmt.Errorf("error requesting edge %s: %s", edgeId, err))
+		}
+
+		versions, _, err := ep.getEdgeSoftwareVersions(ctx, edgeId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get software versions of edge %s: %s", edgeId, err))
+		}
+
+		softwareUpdateStatus := ""
+		softwareUpdate, resp, err := ep.getEdgeSoftwareUpdate(ctx, edgeId)
+		if err != nil {
+			if !gcloud.IsStatus404(resp) {
+				return retry.NonRetryableError(fmt.Errorf("failed to get software update of edge %s: %s", edgeId, err))
+			}
+		} else if softwareUpdate.Version != nil {
+			softwareUpdateStatus = stringValue(softwareUpdate.Status)
+		}
+
+		d.SetId(edgeId)
+		resourcedata.SetNillableValue(d, "name", edge.Name)
+		resourcedata.SetNillableValue(d, "state", edge.State)
+		resourcedata.SetNillableValue(d, "status_code", edge.StatusCode)
+		resourcedata.SetNillableValue(d, "online_status", edge.OnlineStatus)
+		resourcedata.SetNillableValue(d, "call_draining_state", edge.CallDrainingState)
+		d.Set("conversation_count", intValue(edge.ConversationCount))
+		resourcedata.SetNillableValue(d, "make", edge.Make)
+		resourcedata.SetNillableValue(d, "model", edge.Model)
+		resourcedata.SetNillableValue(d, "serial_number", edge.SerialNumber)
+		resourcedata.SetNillableValue(d, "software_version", edge.SoftwareVersion)
+		resourcedata.SetNillableValue(d, "full_software_version", edge.FullSoftwareVersion)
+		resourcedata.SetNillableValue(d, "staged_version", edge.StagedVersion)
+		d.Set("software_update_status", softwareUpdateStatus)
+		d.Set("available_software_versions", flattenSoftwareVersions(*versions))
+		return nil
+	})
+}
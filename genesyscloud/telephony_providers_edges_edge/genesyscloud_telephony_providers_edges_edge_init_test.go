@@ -0,0 +1,81 @@
+package telephony_providers_edges_edge
+
+import (
+	"log"
+	"sync"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+   The genesyscloud_telephony_providers_edges_edge_init_test.go file is used to initialize the data sources and resources
+   used in testing the edges.
+
+   Please make sure you register ALL resources and data sources your test cases will use.
+*/
+
+var (
+	// providerDataSources holds a map of all registered datasources
+	providerDataSources map[string]*schema.Resource
+
+	// providerResources holds a map of all registered resources
+	providerResources map[string]*schema.Resource
+
+	sdkConfig *platformclientv2.Configuration
+	authErr   error
+)
+
+type registerTestInstance struct {
+	resourceMapMutex   sync.RWMutex
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestResources registers all resources used in the tests
+func (r *registerTestInstance) registerTestResources() {
+	r.resourceMapMutex.Lock()
+	defer r.resourceMapMutex.Unlock()
+
+	providerResources[resourceName] = ResourceEdge()
+	providerResources[logicalInterfaceResourceName] = ResourceEdgeLogicalInterface()
+	providerResources[softwareUpdateResourceName] = ResourceEdgeSoftwareUpdate()
+	providerResources["genesyscloud_location"] = gcloud.ResourceLocation()
+	providerResources["genesyscloud_telephony_providers_edges_site"] = edgeSite.ResourceSite()
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+
+	providerDataSources[resourceName] = DataSourceEdge()
+	providerDataSources[statusDataSourceName] = DataSourceEdgeStatus()
+}
+
+// initTestResources initializes all test resources and data sources.
+func initTestResources() {
+	sdkConfig, authErr = gcloud.AuthorizeSdk()
+	if authErr != nil {
+		log.Fatalf("failed to authorize sdk for the package telephony_providers_edges_edge: %v", authErr)
+	}
+	providerDataSources = make(map[string]*schema.Resource)
+	providerResources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestDataSources()
+	regInstance.registerTestResources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for telephony_providers_edges_edge package
+	initTestResources()
+
+	// Run the test suite for the telephony_providers_edges_edge package
+	m.Run()
+}
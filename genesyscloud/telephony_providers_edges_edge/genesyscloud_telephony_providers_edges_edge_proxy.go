@@ -0,0 +1,353 @@
+package telephony_providers_edges_edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_providers_edges_edge_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *edgeProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllEdgesFunc func(ctx context.Context, p *edgeProxy) (*[]platformclientv2.Edge, *platformclientv2.APIResponse, error)
+type getEdgeIdByNameFunc func(ctx context.Context, p *edgeProxy, name string, siteId string, managed bool) (edgeId string, retryable bool, resp *platformclientv2.APIResponse, err error)
+type getEdgeFunc func(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error)
+type createEdgeFunc func(ctx context.Context, p *edgeProxy, edge *platformclientv2.Edge) (*platformclientv2.Edge, *platformclientv2.APIResponse, error)
+type updateEdgeFunc func(ctx context.Context, p *edgeProxy, edgeId string, edge *platformclientv2.Edge) (*platformclientv2.Edge, *platformclientv2.APIResponse, error)
+type deleteEdgeFunc func(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.APIResponse, error)
+type getEdgePhysicalInterfacesFunc func(ctx context.Context, p *edgeProxy, edgeId string) (*[]platformclientv2.Domainphysicalinterface, *platformclientv2.APIResponse, error)
+
+type getEdgeLogicalInterfacesFunc func(ctx context.Context, p *edgeProxy, edgeId string) (*[]platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error)
+type getEdgeLogicalInterfaceFunc func(ctx context.Context, p *edgeProxy, edgeId string, interfaceId string) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error)
+type createEdgeLogicalInterfaceFunc func(ctx context.Context, p *edgeProxy, edgeId string, logicalInterface *platformclientv2.Domainlogicalinterface) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error)
+type updateEdgeLogicalInterfaceFunc func(ctx context.Context, p *edgeProxy, edgeId string, interfaceId string, logicalInterface *platformclientv2.Domainlogicalinterface) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error)
+type deleteEdgeLogicalInterfaceFunc func(ctx context.Context, p *edgeProxy, edgeId string, interfaceId string) (*platformclientv2.APIResponse, error)
+
+type getEdgeSoftwareUpdateFunc func(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.Domainedgesoftwareupdatedto, *platformclientv2.APIResponse, error)
+type createEdgeSoftwareUpdateFunc func(ctx context.Context, p *edgeProxy, edgeId string, softwareUpdate *platformclientv2.Domainedgesoftwareupdatedto) (*platformclientv2.Domainedgesoftwareupdatedto, *platformclientv2.APIResponse, error)
+type cancelEdgeSoftwareUpdateFunc func(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.APIResponse, error)
+type getEdgeSoftwareVersionsFunc func(ctx context.Context, p *edgeProxy, edgeId string) (*[]platformclientv2.Domainedgesoftwareversiondto, *platformclientv2.APIResponse, error)
+
+// edgeProxy contains all of the methods that call genesys cloud APIs.
+type edgeProxy struct {
+	clientConfig *platformclientv2.Configuration
+	edgesApi     *platformclientv2.TelephonyProvidersEdgeApi
+
+	getAllEdgesAttr               getAllEdgesFunc
+	getEdgeIdByNameAttr           getEdgeIdByNameFunc
+	getEdgeAttr                   getEdgeFunc
+	createEdgeAttr                createEdgeFunc
+	updateEdgeAttr                updateEdgeFunc
+	deleteEdgeAttr                deleteEdgeFunc
+	getEdgePhysicalInterfacesAttr getEdgePhysicalInterfacesFunc
+
+	getEdgeLogicalInterfacesAttr   getEdgeLogicalInterfacesFunc
+	getEdgeLogicalInterfaceAttr    getEdgeLogicalInterfaceFunc
+	createEdgeLogicalInterfaceAttr createEdgeLogicalInterfaceFunc
+	updateEdgeLogicalInterfaceAttr updateEdgeLogicalInterfaceFunc
+	deleteEdgeLogicalInterfaceAttr deleteEdgeLogicalInterfaceFunc
+
+	getEdgeSoftwareUpdateAttr    getEdgeSoftwareUpdateFunc
+	createEdgeSoftwareUpdateAttr createEdgeSoftwareUpdateFunc
+	cancelEdgeSoftwareUpdateAttr cancelEdgeSoftwareUpdateFunc
+	getEdgeSoftwareVersionsAttr  getEdgeSoftwareVersionsFunc
+}
+
+// newEdgeProxy initializes the Edge proxy with all the data needed to communicate with Genesys Cloud
+func newEdgeProxy(clientConfig *platformclientv2.Configuration) *edgeProxy {
+	edgesApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
+
+	return &edgeProxy{
+		clientConfig: clientConfig,
+		edgesApi:     edgesApi,
+
+		getAllEdgesAttr:               getAllEdgesFn,
+		getEdgeIdByNameAttr:           getEdgeIdByNameFn,
+		getEdgeAttr:                   getEdgeFn,
+		createEdgeAttr:                createEdgeFn,
+		updateEdgeAttr:                updateEdgeFn,
+		deleteEdgeAttr:                deleteEdgeFn,
+		getEdgePhysicalInterfacesAttr: getEdgePhysicalInterfacesFn,
+
+		getEdgeLogicalInterfacesAttr:   getEdgeLogicalInterfacesFn,
+		getEdgeLogicalInterfaceAttr:    getEdgeLogicalInterfaceFn,
+		createEdgeLogicalInterfaceAttr: createEdgeLogicalInterfaceFn,
+		updateEdgeLogicalInterfaceAttr: updateEdgeLogicalInterfaceFn,
+		deleteEdgeLogicalInterfaceAttr: deleteEdgeLogicalInterfaceFn,
+
+		getEdgeSoftwareUpdateAttr:    getEdgeSoftwareUpdateFn,
+		createEdgeSoftwareUpdateAttr: createEdgeSoftwareUpdateFn,
+		cancelEdgeSoftwareUpdateAttr: cancelEdgeSoftwareUpdateFn,
+		getEdgeSoftwareVersionsAttr:  getEdgeSoftwareVersionsFn,
+	}
+}
+
+// getEdgeProxy acts as a singleton for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getEdgeProxy(clientConfig *platformclientv2.Configuration) *edgeProxy {
+	if internalProxy == nil {
+		internalProxy = newEdgeProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllEdges retrieves all managed and unmanaged Genesys Cloud Edges
+func (p *edgeProxy) getAllEdges(ctx context.Context) (*[]platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	return p.getAllEdgesAttr(ctx, p)
+}
+
+// getEdgeIdByName returns the ID of a Genesys Cloud Edge by name
+func (p *edgeProxy) getEdgeIdByName(ctx context.Context, name string, siteId string, managed bool) (string, bool, *platformclientv2.APIResponse, error) {
+	return p.getEdgeIdByNameAttr(ctx, p, name, siteId, managed)
+}
+
+// getEdge returns a single Genesys Cloud Edge by ID
+func (p *edgeProxy) getEdge(ctx context.Context, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	return p.getEdgeAttr(ctx, p, edgeId)
+}
+
+// createEdge creates a Genesys Cloud Edge
+func (p *edgeProxy) createEdge(ctx context.Context, edge *platformclientv2.Edge) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	return p.createEdgeAttr(ctx, p, edge)
+}
+
+// updateEdge updates a Genesys Cloud Edge
+func (p *edgeProxy) updateEdge(ctx context.Context, edgeId string, edge *platformclientv2.Edge) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	return p.updateEdgeAttr(ctx, p, edgeId, edge)
+}
+
+// deleteEdge deletes a Genesys Cloud Edge by ID
+func (p *edgeProxy) deleteEdge(ctx context.Context, edgeId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteEdgeAttr(ctx, p, edgeId)
+}
+
+// getEdgePhysicalInterfaces retrieves the physical interfaces of a Genesys Cloud Edge
+func (p *edgeProxy) getEdgePhysicalInterfaces(ctx context.Context, edgeId string) (*[]platformclientv2.Domainphysicalinterface, *platformclientv2.APIResponse, error) {
+	return p.getEdgePhysicalInterfacesAttr(ctx, p, edgeId)
+}
+
+// getEdgeLogicalInterfaces retrieves the logical interfaces of a Genesys Cloud Edge
+func (p *edgeProxy) getEdgeLogicalInterfaces(ctx context.Context, edgeId string) (*[]platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	return p.getEdgeLogicalInterfacesAttr(ctx, p, edgeId)
+}
+
+// getEdgeLogicalInterface returns a single logical interface of a Genesys Cloud Edge by ID
+func (p *edgeProxy) getEdgeLogicalInterface(ctx context.Context, edgeId string, interfaceId string) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	return p.getEdgeLogicalInterfaceAttr(ctx, p, edgeId, interfaceId)
+}
+
+// createEdgeLogicalInterface creates a logical interface on a Genesys Cloud Edge
+func (p *edgeProxy) createEdgeLogicalInterface(ctx context.Context, edgeId string, logicalInterface *platformclientv2.Domainlogicalinterface) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	return p.createEdgeLogicalInterfaceAttr(ctx, p, edgeId, logicalInterface)
+}
+
+// updateEdgeLogicalInterface updates a logical interface of a Genesys Cloud Edge
+func (p *edgeProxy) updateEdgeLogicalInterface(ctx context.Context, edgeId string, interfaceId string, logicalInterface *platformclientv2.Domainlogicalinterface) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	return p.updateEdgeLogicalInterfaceAttr(ctx, p, edgeId, interfaceId, logicalInterface)
+}
+
+// deleteEdgeLogicalInterface deletes a logical interface of a Genesys Cloud Edge
+func (p *edgeProxy) deleteEdgeLogicalInterface(ctx context.Context, edgeId string, interfaceId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteEdgeLogicalInterfaceAttr(ctx, p, edgeId, interfaceId)
+}
+
+// getEdgeSoftwareUpdate returns the scheduled software update of a Genesys Cloud Edge
+func (p *edgeProxy) getEdgeSoftwareUpdate(ctx context.Context, edgeId string) (*platformclientv2.Domainedgesoftwareupdatedto, *platformclientv2.APIResponse, error) {
+	return p.getEdgeSoftwareUpdateAttr(ctx, p, edgeId)
+}
+
+// createEdgeSoftwareUpdate schedules a software update for a Genesys Cloud Edge
+func (p *edgeProxy) createEdgeSoftwareUpdate(ctx context.Context, edgeId string, softwareUpdate *platformclientv2.Domainedgesoftwareupdatedto) (*platformclientv2.Domainedgesoftwareupdatedto, *platformclientv2.APIResponse, error) {
+	return p.createEdgeSoftwareUpdateAttr(ctx, p, edgeId, softwareUpdate)
+}
+
+// cancelEdgeSoftwareUpdate cancels the scheduled software update of a Genesys Cloud Edge
+func (p *edgeProxy) cancelEdgeSoftwareUpdate(ctx context.Context, edgeId string) (*platformclientv2.APIResponse, error) {
+	return p.cancelEdgeSoftwareUpdateAttr(ctx, p, edgeId)
+}
+
+// getEdgeSoftwareVersions retrieves the software versions a Genesys Cloud Edge can be updated to
+func (p *edgeProxy) getEdgeSoftwareVersions(ctx context.Context, edgeId string) (*[]platformclientv2.Domainedgesoftwareversiondto, *platformclientv2.APIResponse, error) {
+	return p.getEdgeSoftwareVersionsAttr(ctx, p, edgeId)
+}
+
+// getAllEdgesFn is an implementation function for retrieving all Genesys Cloud Edges
+func getAllEdgesFn(ctx context.Context, p *edgeProxy) (*[]platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	var allEdges []platformclientv2.Edge
+	const pageSize = 100
+
+	for _, managed := range []bool{false, true} {
+		for pageNum := 1; ; pageNum++ {
+			edges, resp, err := p.edgesApi.GetTelephonyProvidersEdges(pageSize, pageNum, "", "", "", "", managed, false)
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get page of edges: %v", err)
+			}
+			if edges.Entities == nil || len(*edges.Entities) == 0 {
+				break
+			}
+
+			// Get only edges that are not 'deleted'
+			for _, edge := range *edges.Entities {
+				if edge.State != nil && *edge.State != "deleted" {
+					allEdges = append(allEdges, edge)
+				}
+			}
+
+			if edges.PageCount == nil || pageNum >= *edges.PageCount {
+				break
+			}
+		}
+	}
+
+	return &allEdges, nil, nil
+}
+
+// getEdgeIdByNameFn is an implementation function for retrieving a Genesys Cloud Edge by name
+func getEdgeIdByNameFn(ctx context.Context, p *edgeProxy, name string, siteId string, managed bool) (string, bool, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	edges, resp, err := p.edgesApi.GetTelephonyProvidersEdges(pageSize, 1, name, siteId, "", "", managed, false)
+	if err != nil {
+		return "", false, resp, err
+	}
+	if edges.Entities == nil {
+		return "", true, resp, fmt.Errorf("no edges found with name %s", name)
+	}
+
+	for _, edge := range *edges.Entities {
+		if edge.Name != nil && *edge.Name == name && edge.State != nil && *edge.State != "deleted" {
+			return *edge.Id, false, resp, nil
+		}
+	}
+	return "", true, resp, fmt.Errorf("no edges found with name %s", name)
+}
+
+// getEdgeFn is an implementation function for retrieving a Genesys Cloud Edge by ID
+func getEdgeFn(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	edge, resp, err := p.edgesApi.GetTelephonyProvidersEdge(edgeId, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	return edge, resp, nil
+}
+
+// createEdgeFn is an implementation function for creating a Genesys Cloud Edge
+func createEdgeFn(ctx context.Context, p *edgeProxy, edge *platformclientv2.Edge) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	createdEdge, resp, err := p.edgesApi.PostTelephonyProvidersEdges(*edge)
+	if err != nil {
+		return nil, resp, err
+	}
+	return createdEdge, resp, nil
+}
+
+// updateEdgeFn is an implementation function for updating a Genesys Cloud Edge
+func updateEdgeFn(ctx context.Context, p *edgeProxy, edgeId string, edge *platformclientv2.Edge) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
+	updatedEdge, resp, err := p.edgesApi.PutTelephonyProvidersEdge(edgeId, *edge)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updatedEdge, resp, nil
+}
+
+// deleteEdgeFn is an implementation function for deleting a Genesys Cloud Edge
+func deleteEdgeFn(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.APIResponse, error) {
+	return p.edgesApi.DeleteTelephonyProvidersEdge(edgeId)
+}
+
+// getEdgePhysicalInterfacesFn is an implementation function for retrieving the physical interfaces of a Genesys Cloud Edge
+func getEdgePhysicalInterfacesFn(ctx context.Context, p *edgeProxy, edgeId string) (*[]platformclientv2.Domainphysicalinterface, *platformclientv2.APIResponse, error) {
+	physicalInterfaces, resp, err := p.edgesApi.GetTelephonyProvidersEdgePhysicalinterfaces(edgeId)
+	if err != nil {
+		return nil, resp, err
+	}
+	if physicalInterfaces.Entities == nil {
+		return &[]platformclientv2.Domainphysicalinterface{}, resp, nil
+	}
+	return physicalInterfaces.Entities, resp, nil
+}
+
+// getEdgeLogicalInterfacesFn is an implementation function for retrieving the logical interfaces of a Genesys Cloud Edge
+func getEdgeLogicalInterfacesFn(ctx context.Context, p *edgeProxy, edgeId string) (*[]platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	logicalInterfaces, resp, err := p.edgesApi.GetTelephonyProvidersEdgeLogicalinterfaces(edgeId, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	if logicalInterfaces.Entities == nil {
+		return &[]platformclientv2.Domainlogicalinterface{}, resp, nil
+	}
+	return logicalInterfaces.Entities, resp, nil
+}
+
+// getEdgeLogicalInterfaceFn is an implementation function for retrieving a logical interface of a Genesys Cloud Edge
+func getEdgeLogicalInterfaceFn(ctx context.Context, p *edgeProxy, edgeId string, interfaceId string) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	logicalInterface, resp, err := p.edgesApi.GetTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	return logicalInterface, resp, nil
+}
+
+// createEdgeLogicalInterfaceFn is an implementation function for creating a logical interface on a Genesys Cloud Edge
+func createEdgeLogicalInterfaceFn(ctx context.Context, p *edgeProxy, edgeId string, logicalInterface *platformclientv2.Domainlogicalinterface) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	createdInterface, resp, err := p.edgesApi.PostTelephonyProvidersEdgeLogicalinterfaces(edgeId, *logicalInterface)
+	if err != nil {
+		return nil, resp, err
+	}
+	return createdInterface, resp, nil
+}
+
+// updateEdgeLogicalInterfaceFn is an implementation function for updating a logical interface of a Genesys Cloud Edge
+func updateEdgeLogicalInterfaceFn(ctx context.Context, p *edgeProxy, edgeId string, interfaceId string, logicalInterface *platformclientv2.Domainlogicalinterface) (*platformclientv2.Domainlogicalinterface, *platformclientv2.APIResponse, error) {
+	updatedInterface, resp, err := p.edgesApi.PutTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId, *logicalInterface)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updatedInterface, resp, nil
+}
+
+// deleteEdgeLogicalInterfaceFn is an implementation function for deleting a logical interface of a Genesys Cloud Edge
+func deleteEdgeLogicalInterfaceFn(ctx context.Context, p *edgeProxy, edgeId string, interfaceId string) (*platformclientv2.APIResponse, error) {
+	return p.edgesApi.DeleteTelephonyProvidersEdgeLogicalinterface(edgeId, interfaceId)
+}
+
+// getEdgeSoftwareUpdateFn is an implementation function for retrieving the scheduled software update of a Genesys Cloud Edge
+func getEdgeSoftwareUpdateFn(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.Domainedgesoftwareupdatedto, *platformclientv2.APIResponse, error) {
+	softwareUpdate, resp, err := p.edgesApi.GetTelephonyProvidersEdgeSoftwareupdate(edgeId)
+	if err != nil {
+		return nil, resp, err
+	}
+	return softwareUpdate, resp, nil
+}
+
+// createEdgeSoftwareUpdateFn is an implementation function for scheduling a software update for a Genesys Cloud Edge
+func createEdgeSoftwareUpdateFn(ctx context.Context, p *edgeProxy, edgeId string, softwareUpdate *platformclientv2.Domainedgesoftwareupdatedto) (*platformclientv2.Domainedgesoftwareupdatedto, *platformclientv2.APIResponse, error) {
+	createdUpdate, resp, err := p.edgesApi.PostTelephonyProvidersEdgeSoftwareupdate(edgeId, *softwareUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+	return createdUpdate, resp, nil
+}
+
+// cancelEdgeSoftwareUpdateFn is an implementation function for cancelling the scheduled software update of a Genesys Cloud Edge
+func cancelEdgeSoftwareUpdateFn(ctx context.Context, p *edgeProxy, edgeId string) (*platformclientv2.APIResponse, error) {
+	return p.edgesApi.DeleteTelephonyProvidersEdgeSoftwareupdate(edgeId)
+}
+
+// getEdgeSoftwareVersionsFn is an implementation function for retrieving the software versions available to a Genesys Cloud Edge
+func getEdgeSoftwareVersionsFn(ctx context.Context, p *edgeProxy, edgeId string) (*[]platformclientv2.Domainedgesoftwareversiondto, *platformclientv2.APIResponse, error) {
+	versions, resp, err := p.edgesApi.GetTelephonyProvidersEdgeSoftwareversions(edgeId)
+	if err != nil {
+		return nil, resp, err
+	}
+	if versions.Entities == nil {
+		return &[]platformclientv2.Domainedgesoftwareversiondto{}, resp, nil
+	}
+	return versions.Entities, resp, nil
+}
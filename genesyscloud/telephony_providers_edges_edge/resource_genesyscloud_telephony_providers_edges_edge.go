@@ -0,0 +1,162 @@
+package telephony_providers_edges_edge
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func getAllEdges(ctx context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	ep := getEdgeProxy(sdkConfig)
+
+	edges, _, err := ep.getAllEdges(ctx)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, edge := range *edges {
+		resources[*edge.Id] = &resourceExporter.ResourceMeta{Name: *edge.Name}
+	}
+
+	return resources, nil
+}
+
+func createEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	edge := buildSdkEdge(d)
+
+	log.Printf("Creating edge %s", *edge.Name)
+	createdEdge, _, err := ep.createEdge(ctx, edge)
+	if err != nil {
+		return diag.Errorf("Failed to create edge %s: %s", *edge.Name, err)
+	}
+
+	d.SetId(*createdEdge.Id)
+	log.Printf("Created edge %s", *createdEdge.Id)
+	return readEdge(ctx, d, meta)
+}
+
+func readEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	log.Printf("Reading edge %s", d.Id())
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		edge, resp, err := ep.getEdge(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read edge %s: %s", d.Id(), err))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read edge %s: %s", d.Id(), err))
+		}
+		if edge.State != nil && *edge.State == "deleted" {
+			d.SetId("")
+			return nil
+		}
+
+		physicalInterfaces, _, err := ep.getEdgePhysicalInterfaces(ctx, d.Id())
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read physical interfaces of edge %s: %s", d.Id(), err))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceEdge())
+		d.Set("name", *edge.Name)
+		resourcedata.SetNillableValue(d, "description", edge.Description)
+		d.Set("site_id", nil)
+		if edge.Site != nil {
+			d.Set("site_id", *edge.Site.Id)
+		}
+		d.Set("edge_group_id", nil)
+		if edge.EdgeGroup != nil {
+			d.Set("edge_group_id", *edge.EdgeGroup.Id)
+		}
+		if edge.Managed != nil {
+			d.Set("managed", *edge.Managed)
+		}
+		resourcedata.SetNillableValue(d, "physical_edge", edge.PhysicalEdge)
+		resourcedata.SetNillableValue(d, "edge_deployment_type", edge.EdgeDeploymentType)
+		resourcedata.SetNillableValue(d, "state", edge.State)
+		resourcedata.SetNillableValue(d, "serial_number", edge.SerialNumber)
+		d.Set("physical_interfaces", flattenPhysicalInterfaces(*physicalInterfaces))
+
+		log.Printf("Read edge %s %s", d.Id(), *edge.Name)
+		return cc.CheckState()
+	})
+}
+
+func updateEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	edge := buildSdkEdge(d)
+
+	diagErr := gcloud.RetryWhen(gcloud.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		// Get current edge version
+		currentEdge, resp, err := ep.getEdge(ctx, d.Id())
+		if err != nil {
+			return resp, diag.Errorf("Failed to read edge %s: %s", d.Id(), err)
+		}
+		edge.Version = currentEdge.Version
+		edge.PhysicalEdge = currentEdge.PhysicalEdge
+		edge.EdgeDeploymentType = currentEdge.EdgeDeploymentType
+
+		log.Printf("Updating edge %s", *edge.Name)
+		_, resp, err = ep.updateEdge(ctx, d.Id(), edge)
+		if err != nil {
+			return resp, diag.Errorf("Failed to update edge %s: %s", *edge.Name, err)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated edge %s", d.Id())
+	return readEdge(ctx, d, meta)
+}
+
+func deleteEdge(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	log.Printf("Deleting edge %s", d.Id())
+	resp, err := ep.deleteEdge(ctx, d.Id())
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			log.Printf("Edge %s already deleted", d.Id())
+			return nil
+		}
+		return diag.Errorf("Failed to delete edge %s: %s", d.Id(), err)
+	}
+
+	return gcloud.WithRetries(ctx, 60*time.Second, func() *retry.RetryError {
+		edge, resp, err := ep.getEdge(ctx, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted edge %s", d.Id())
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting edge %s: %s", d.Id(), err))
+		}
+
+		if edge.State != nil && *edge.State == "deleted" {
+			log.Printf("Deleted edge %s", d.Id())
+			return nil
+		}
+
+		return retry.RetryableError(fmt.Errorf("Edge %s still exists", d.Id()))
+	})
+}
@@ -0,0 +1,192 @@
+package telephony_providers_edges_edge
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func getAllEdgeLogicalInterfaces(ctx context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	ep := getEdgeProxy(sdkConfig)
+
+	edges, _, err := ep.getAllEdges(ctx)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, edge := range *edges {
+		logicalInterfaces, resp, err := ep.getEdgeLogicalInterfaces(ctx, *edge.Id)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				// Edge deleted since it was listed
+				continue
+			}
+			return nil, diag.Errorf("Failed to get logical interfaces of edge %s: %v", *edge.Id, err)
+		}
+		for _, logicalInterface := range *logicalInterfaces {
+			if logicalInterface.State != nil && *logicalInterface.State == "deleted" {
+				continue
+			}
+			resources[*logicalInterface.Id] = &resourceExporter.ResourceMeta{
+				Name:     *edge.Name + "_" + *logicalInterface.Name,
+				IdPrefix: *edge.Id + "/",
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+func importEdgeLogicalInterface(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	// Import must specify edge ID and interface ID
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Invalid edge logical interface import ID %s. Expected <edge_id>/<interface_id>", d.Id())
+	}
+	d.Set("edge_id", idParts[0])
+	d.SetId(idParts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func createEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+	edgeId := d.Get("edge_id").(string)
+
+	logicalInterface := buildSdkLogicalInterface(d)
+
+	log.Printf("Creating logical interface %s on edge %s", *logicalInterface.Name, edgeId)
+	createdInterface, _, err := ep.createEdgeLogicalInterface(ctx, edgeId, logicalInterface)
+	if err != nil {
+		return diag.Errorf("Failed to create logical interface %s on edge %s: %s", *logicalInterface.Name, edgeId, err)
+	}
+
+	d.SetId(*createdInterface.Id)
+	log.Printf("Created logical interface %s on edge %s", *createdInterface.Id, edgeId)
+	return readEdgeLogicalInterface(ctx, d, meta)
+}
+
+func readEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+	edgeId := d.Get("edge_id").(string)
+
+	log.Printf("Reading logical interface %s of edge %s", d.Id(), edgeId)
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		logicalInterface, resp, err := ep.getEdgeLogicalInterface(ctx, edgeId, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read logical interface %s of edge %s: %s", d.Id(), edgeId, err))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read logical interface %s of edge %s: %s", d.Id(), edgeId, err))
+		}
+		if logicalInterface.State != nil && *logicalInterface.State == "deleted" {
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceEdgeLogicalInterface())
+		d.Set("name", *logicalInterface.Name)
+		resourcedata.SetNillableValue(d, "description", logicalInterface.Description)
+		resourcedata.SetNillableValue(d, "physical_adapter_id", logicalInterface.PhysicalAdapterId)
+		d.Set("vlan_tag_id", 0)
+		if logicalInterface.VlanTagId != nil {
+			d.Set("vlan_tag_id", *logicalInterface.VlanTagId)
+		}
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "ipv4_capabilities", logicalInterface.Ipv4Capabilities, flattenIpCapabilities)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "ipv6_capabilities", logicalInterface.Ipv6Capabilities, flattenIpCapabilities)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "addresses", logicalInterface.Addresses, flattenNetworkAddresses)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "routes", logicalInterface.Routes, flattenNetworkRoutes)
+		resourcedata.SetNillableValue(d, "public_nat_address_ipv4", logicalInterface.PublicNatAddressIpV4)
+		resourcedata.SetNillableValue(d, "public_nat_address_ipv6", logicalInterface.PublicNatAddressIpV6)
+		d.Set("use_for_internal_edge_communication", boolValue(logicalInterface.UseForInternalEdgeCommunication))
+		d.Set("use_for_indirect_edge_communication", boolValue(logicalInterface.UseForIndirectEdgeCommunication))
+		d.Set("use_for_cloud_proxy_edge_communication", boolValue(logicalInterface.UseForCloudProxyEdgeCommunication))
+		d.Set("use_for_wan_interface", boolValue(logicalInterface.UseForWanInterface))
+		d.Set("inherit_phone_trunk_bases_ipv4", boolValue(logicalInterface.InheritPhoneTrunkBasesIPv4))
+		d.Set("inherit_phone_trunk_bases_ipv6", boolValue(logicalInterface.InheritPhoneTrunkBasesIPv6))
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "external_trunk_base_assignments", logicalInterface.ExternalTrunkBaseAssignments, flattenTrunkBaseAssignments)
+		resourcedata.SetNillableValueWithInterfaceArrayWithFunc(d, "phone_trunk_base_assignments", logicalInterface.PhoneTrunkBaseAssignments, flattenTrunkBaseAssignments)
+		resourcedata.SetNillableValue(d, "current_state", logicalInterface.CurrentState)
+
+		log.Printf("Read logical interface %s %s of edge %s", d.Id(), *logicalInterface.Name, edgeId)
+		return cc.CheckState()
+	})
+}
+
+func updateEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+	edgeId := d.Get("edge_id").(string)
+
+	logicalInterface := buildSdkLogicalInterface(d)
+
+	diagErr := gcloud.RetryWhen(gcloud.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		// Get current logical interface version
+		currentInterface, resp, err := ep.getEdgeLogicalInterface(ctx, edgeId, d.Id())
+		if err != nil {
+			return resp, diag.Errorf("Failed to read logical interface %s of edge %s: %s", d.Id(), edgeId, err)
+		}
+		logicalInterface.Id = currentInterface.Id
+		logicalInterface.Version = currentInterface.Version
+
+		log.Printf("Updating logical interface %s of edge %s", *logicalInterface.Name, edgeId)
+		_, resp, err = ep.updateEdgeLogicalInterface(ctx, edgeId, d.Id(), logicalInterface)
+		if err != nil {
+			return resp, diag.Errorf("Failed to update logical interface %s of edge %s: %s", *logicalInterface.Name, edgeId, err)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated logical interface %s of edge %s", d.Id(), edgeId)
+	return readEdgeLogicalInterface(ctx, d, meta)
+}
+
+func deleteEdgeLogicalInterface(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+	edgeId := d.Get("edge_id").(string)
+
+	log.Printf("Deleting logical interface %s of edge %s", d.Id(), edgeId)
+	resp, err := ep.deleteEdgeLogicalInterface(ctx, edgeId, d.Id())
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			log.Printf("Logical interface %s of edge %s already deleted", d.Id(), edgeId)
+			return nil
+		}
+		return diag.Errorf("Failed to delete logical interface %s of edge %s: %s", d.Id(), edgeId, err)
+	}
+
+	return gcloud.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
+		logicalInterface, resp, err := ep.getEdgeLogicalInterface(ctx, edgeId, d.Id())
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				log.Printf("Deleted logical interface %s of edge %s", d.Id(), edgeId)
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting logical interface %s of edge %s: %s", d.Id(), edgeId, err))
+		}
+
+		if logicalInterface.State != nil && *logicalInterface.State == "deleted" {
+			log.Printf("Deleted logical interface %s of edge %s", d.Id(), edgeId)
+			return nil
+		}
+
+		return retry.RetryableError(fmt.Errorf("Logical interface %s of edge %s still exists", d.Id(), edgeId))
+	})
+}
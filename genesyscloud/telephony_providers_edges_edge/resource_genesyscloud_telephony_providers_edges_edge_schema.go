@@ -0,0 +1,631 @@
+package telephony_providers_edges_edge
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_telephony_providers_edges_edge_schema.go holds four types of functions within it:
+
+1.  The registration code that registers the Datasources, Resources and Exporters for the package.
+2.  The resource schema definitions for the edge, edge_logical_interface and edge_software_update resources.
+3.  The datasource schema definitions for the edge and edge_status datasources.
+4.  The resource exporter configuration for the edge and edge_logical_interface exporters.
+*/
+const (
+	resourceName                 = "genesyscloud_telephony_providers_edges_edge"
+	logicalInterfaceResourceName = "genesyscloud_telephony_providers_edges_edge_logical_interface"
+	softwareUpdateResourceName   = "genesyscloud_telephony_providers_edges_edge_software_update"
+	statusDataSourceName         = "genesyscloud_telephony_providers_edges_edge_status"
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterDataSource(resourceName, DataSourceEdge())
+	l.RegisterDataSource(statusDataSourceName, DataSourceEdgeStatus())
+	l.RegisterResource(resourceName, ResourceEdge())
+	l.RegisterResource(logicalInterfaceResourceName, ResourceEdgeLogicalInterface())
+	l.RegisterResource(softwareUpdateResourceName, ResourceEdgeSoftwareUpdate())
+	l.RegisterExporter(resourceName, EdgeExporter())
+	l.RegisterExporter(logicalInterfaceResourceName, EdgeLogicalInterfaceExporter())
+}
+
+var (
+	physicalInterfaceResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the physical interface. Used as `physical_adapter_id` of logical interfaces.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"friendly_name": {
+				Description: "The friendly name of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"hardware_address": {
+				Description: "The MAC address of the physical interface.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"port_label": {
+				Description: "The label of the port on the Edge appliance.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	ipCapabilitiesResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "Whether the address family is enabled on the interface.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"dhcp": {
+				Description: "Whether the addresses of the interface are assigned by DHCP.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"metric": {
+				Description: "The routing metric of the interface. Ignored when `auto_metric` is true.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"auto_metric": {
+				Description: "Whether the routing metric of the interface is assigned automatically.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"ping_enabled": {
+				Description: "Whether the interface responds to ping.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+
+	trunkBaseAssignmentResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"trunk_base_id": {
+				Description: "The ID of the trunk base settings.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"family": {
+				Description:  "The address family to use with the trunk base settings. 2 for IPv4, 23 for IPv6.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      ipv4Family,
+				ValidateFunc: validation.IntInSlice([]int{ipv4Family, ipv6Family}),
+			},
+		},
+	}
+)
+
+// ResourceEdge registers the genesyscloud_telephony_providers_edges_edge resource with Terraform
+func ResourceEdge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Edge. Manages an Edge appliance or virtual Edge of a premises or BYOC premises site. " +
+			"The Edge must be paired before it can carry calls. Use genesyscloud_telephony_providers_edges_edge_logical_interface to configure its network.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createEdge),
+		ReadContext:   gcloud.ReadWithPooledClient(readEdge),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateEdge),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteEdge),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the Edge.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The Edge's description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"site_id": {
+				Description: "The ID of the site the Edge belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"edge_group_id": {
+				Description: "The ID of the edge group the Edge belongs to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"managed": {
+				Description: "Whether the Edge is managed remotely by Genesys Cloud.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"physical_edge": {
+				Description: "Whether the Edge is a physical appliance rather than a virtual Edge. Changing the physical_edge attribute will cause the Edge to be dropped and recreated.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"edge_deployment_type": {
+				Description:  "The deployment type of the Edge. Valid values: HARDWARE, LDM, MDM, DDM. Changing the edge_deployment_type attribute will cause the Edge to be dropped and recreated.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"HARDWARE", "LDM", "MDM", "DDM"}, false),
+			},
+			"state": {
+				Description: "Indicates if the Edge is active, inactive, or deleted.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"serial_number": {
+				Description: "The serial number of the Edge appliance.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"physical_interfaces": {
+				Description: "The physical interfaces of the Edge, reported once the Edge is paired.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        physicalInterfaceResource,
+			},
+		},
+	}
+}
+
+// ResourceEdgeLogicalInterface registers the genesyscloud_telephony_providers_edges_edge_logical_interface resource with Terraform
+func ResourceEdgeLogicalInterface() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Edge Logical Interface. Manages the IP settings and trunk assignments of a network interface of an Edge. " +
+			"Import with an ID of the form `<edge_id>/<interface_id>`.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createEdgeLogicalInterface),
+		ReadContext:   gcloud.ReadWithPooledClient(readEdgeLogicalInterface),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateEdgeLogicalInterface),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteEdgeLogicalInterface),
+		Importer: &schema.ResourceImporter{
+			StateContext: importEdgeLogicalInterface,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"edge_id": {
+				Description: "The ID of the Edge. Changing the edge_id attribute will cause the logical interface to be dropped and recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the logical interface.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The logical interface's description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"physical_adapter_id": {
+				Description: "The ID of the physical interface the logical interface is bound to. Changing the physical_adapter_id attribute will cause the logical interface to be dropped and recreated.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"vlan_tag_id": {
+				Description:  "The VLAN tag of the logical interface. 0 for an untagged interface.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			"ipv4_capabilities": {
+				Description: "The IPv4 settings of the logical interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        ipCapabilitiesResource,
+			},
+			"ipv6_capabilities": {
+				Description: "The IPv6 settings of the logical interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        ipCapabilitiesResource,
+			},
+			"addresses": {
+				Description: "The static addresses of the logical interface. Not used when addresses are assigned by DHCP.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Description: "The address with its prefix length, e.g. `10.0.0.10/24`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"family": {
+							Description:  "The address family. 2 for IPv4, 23 for IPv6.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ipv4Family,
+							ValidateFunc: validation.IntInSlice([]int{ipv4Family, ipv6Family}),
+						},
+						"persistent": {
+							Description: "Whether the address is kept when the Edge restarts.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"routes": {
+				Description: "The static routes of the logical interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Description: "The destination of the route, e.g. `0.0.0.0/0` for a default route.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"nexthop": {
+							Description: "The gateway address of the route.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"metric": {
+							Description: "The metric of the route.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+						},
+						"family": {
+							Description:  "The address family. 2 for IPv4, 23 for IPv6.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ipv4Family,
+							ValidateFunc: validation.IntInSlice([]int{ipv4Family, ipv6Family}),
+						},
+						"persistent": {
+							Description: "Whether the route is kept when the Edge restarts.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"public_nat_address_ipv4": {
+				Description: "The public IPv4 address of the interface when it is behind NAT.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"public_nat_address_ipv6": {
+				Description: "The public IPv6 address of the interface when it is behind NAT.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"use_for_internal_edge_communication": {
+				Description: "Whether the interface is used for communication between Edges.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"use_for_indirect_edge_communication": {
+				Description: "Whether the interface is used for indirect communication between Edges.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"use_for_cloud_proxy_edge_communication": {
+				Description: "Whether the interface is used for communication with the cloud through a proxy.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"use_for_wan_interface": {
+				Description: "Whether the interface is the WAN interface of the Edge.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"inherit_phone_trunk_bases_ipv4": {
+				Description: "Whether the IPv4 phone trunks of the edge group are inherited by the interface.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"inherit_phone_trunk_bases_ipv6": {
+				Description: "Whether the IPv6 phone trunks of the edge group are inherited by the interface.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"external_trunk_base_assignments": {
+				Description: "Trunk base settings of trunkType \"EXTERNAL\" assigned to the interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        trunkBaseAssignmentResource,
+			},
+			"phone_trunk_base_assignments": {
+				Description: "Trunk base settings of trunkType \"PHONE\" assigned to the interface.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        trunkBaseAssignmentResource,
+			},
+			"current_state": {
+				Description: "The current state of the interface on the Edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ResourceEdgeSoftwareUpdate registers the genesyscloud_telephony_providers_edges_edge_software_update resource with Terraform
+func ResourceEdgeSoftwareUpdate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Edge Software Update. Schedules an update of the software of an Edge to a specific version. " +
+			"Destroying the resource cancels the update if it has not run yet. " +
+			"Once the update is installed the resource is kept as long as the Edge runs the requested version.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createEdgeSoftwareUpdate),
+		ReadContext:   gcloud.ReadWithPooledClient(readEdgeSoftwareUpdate),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateEdgeSoftwareUpdate),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteEdgeSoftwareUpdate),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"edge_id": {
+				Description: "The ID of the Edge to update. Changing the edge_id attribute will cause the update to be cancelled and scheduled again.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"version": {
+				Description: "The name or ID of the software version to install. Must be one of the versions available to the Edge.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"download_start_time": {
+				Description:      "The time at which the download of the software starts, in RFC 3339 format e.g. `2024-05-01T02:00:00Z`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"execute_start_time": {
+				Description:      "The start of the window in which the software is installed, in RFC 3339 format.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"execute_stop_time": {
+				Description:      "The end of the window in which the software is installed, in RFC 3339 format.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"execute_on_idle": {
+				Description: "Whether the software is installed as soon as the Edge has no active calls within the window.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"max_download_rate": {
+				Description: "The maximum download rate of the software in kilobits per second. 0 for no limit.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"call_draining_wait_time_seconds": {
+				Description: "The number of seconds to wait for active calls to end before the software is installed.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"status": {
+				Description: "The status of the scheduled update. Empty once the update has been installed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// EdgeExporter returns the resourceExporter object used to hold the genesyscloud_telephony_providers_edges_edge exporter's config
+func EdgeExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllEdges),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"site_id":       {RefType: "genesyscloud_telephony_providers_edges_site"},
+			"edge_group_id": {RefType: "genesyscloud_telephony_providers_edges_edge_group"},
+		},
+	}
+}
+
+// EdgeLogicalInterfaceExporter returns the resourceExporter object used to hold the genesyscloud_telephony_providers_edges_edge_logical_interface exporter's config
+func EdgeLogicalInterfaceExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllEdgeLogicalInterfaces),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"edge_id": {RefType: resourceName},
+			"external_trunk_base_assignments.trunk_base_id": {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+			"phone_trunk_base_assignments.trunk_base_id":    {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+		},
+	}
+}
+
+// DataSourceEdge registers the genesyscloud_telephony_providers_edges_edge data source
+func DataSourceEdge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Genesys Cloud Edges. Select an Edge by name",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceEdgeRead),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Edge name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"site_id": {
+				Description: "Only return Edges of this site.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"managed": {
+				Description: "Return entities that are managed by Genesys Cloud.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// DataSourceEdgeStatus registers the genesyscloud_telephony_providers_edges_edge_status data source
+func DataSourceEdgeStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the status of a Genesys Cloud Edge. Exposes the online status, software versions and any scheduled software update of an Edge.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceEdgeStatusRead),
+		Schema: map[string]*schema.Schema{
+			"edge_id": {
+				Description: "The ID of the Edge.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the Edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"state": {
+				Description: "Indicates if the Edge is active, inactive, or deleted.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_code": {
+				Description: "The current status of the Edge, e.g. `INSERVICE` or `OUTOFSERVICE`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"online_status": {
+				Description: "Whether the Edge is connected to Genesys Cloud, e.g. `ONLINE` or `OFFLINE`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"call_draining_state": {
+				Description: "The state of the call draining of the Edge before it can be safely rebooted or updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"conversation_count": {
+				Description: "The number of active conversations on the Edge.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"make": {
+				Description: "The make of the Edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"model": {
+				Description: "The model of the Edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"serial_number": {
+				Description: "The serial number of the Edge appliance.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"software_version": {
+				Description: "The software version running on the Edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"full_software_version": {
+				Description: "The full software version running on the Edge.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"staged_version": {
+				Description: "The software version downloaded to the Edge and waiting to be installed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"software_update_status": {
+				Description: "The status of the scheduled software update of the Edge. Empty if no update is scheduled.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"available_software_versions": {
+				Description: "The software versions the Edge can be updated to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the software version.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the software version.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"edge_version": {
+							Description: "The Edge version of the software version.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"publish_date": {
+							Description: "The date the software version was published, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"latest_release": {
+							Description: "Whether the software version is the latest release.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"current": {
+							Description: "Whether the software version is running on the Edge.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package telephony_providers_edges_edge
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The ID of a software update resource is the ID of the edge, as an edge has at most one scheduled update.
+
+func createEdgeSoftwareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	edgeId := d.Get("edge_id").(string)
+	if diagErr := scheduleEdgeSoftwareUpdate(ctx, d, meta, edgeId); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(edgeId)
+	return readEdgeSoftwareUpdate(ctx, d, meta)
+}
+
+func readEdgeSoftwareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	log.Printf("Reading software update of edge %s", d.Id())
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		softwareUpdate, resp, err := ep.getEdgeSoftwareUpdate(ctx, d.Id())
+		if err != nil && !gcloud.IsStatus404(resp) {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read software update of edge %s: %s", d.Id(), err))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceEdgeSoftwareUpdate())
+		d.Set("edge_id", d.Id())
+
+		if err != nil || softwareUpdate.Version == nil {
+			// No update is scheduled. The update is still in effect if the edge runs the requested version.
+			edge, resp, err := ep.getEdge(ctx, d.Id())
+			if err != nil {
+				if gcloud.IsStatus404(resp) {
+					d.SetId("")
+					return nil
+				}
+				return retry.NonRetryableError(fmt.Errorf("Failed to read edge %s: %s", d.Id(), err))
+			}
+			if !isEdgeRunningVersion(edge, d.Get("version").(string)) {
+				log.Printf("Software update of edge %s is no longer scheduled", d.Id())
+				d.SetId("")
+				return nil
+			}
+			d.Set("status", "")
+			log.Printf("Edge %s runs software version %s", d.Id(), d.Get("version").(string))
+			return cc.CheckState()
+		}
+
+		if !softwareVersionMatches(softwareUpdate.Version, d.Get("version").(string)) {
+			d.Set("version", stringValue(softwareUpdate.Version.Name))
+		}
+		d.Set("download_start_time", formatUpdateTime(softwareUpdate.DownloadStartTime))
+		d.Set("execute_start_time", formatUpdateTime(softwareUpdate.ExecuteStartTime))
+		d.Set("execute_stop_time", formatUpdateTime(softwareUpdate.ExecuteStopTime))
+		d.Set("execute_on_idle", boolValue(softwareUpdate.ExecuteOnIdle))
+		d.Set("max_download_rate", intValue(softwareUpdate.MaxDownloadRate))
+		d.Set("call_draining_wait_time_seconds", intValue(softwareUpdate.CallDrainingWaitTimeSeconds))
+		d.Set("status", stringValue(softwareUpdate.Status))
+
+		log.Printf("Read software update of edge %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateEdgeSoftwareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	// A scheduled update cannot be changed, so it is cancelled and scheduled again
+	log.Printf("Cancelling software update of edge %s", d.Id())
+	resp, err := ep.cancelEdgeSoftwareUpdate(ctx, d.Id())
+	if err != nil && !gcloud.IsStatus404(resp) {
+		return diag.Errorf("Failed to cancel software update of edge %s: %s", d.Id(), err)
+	}
+
+	if diagErr := scheduleEdgeSoftwareUpdate(ctx, d, meta, d.Id()); diagErr != nil {
+		return diagErr
+	}
+	return readEdgeSoftwareUpdate(ctx, d, meta)
+}
+
+func deleteEdgeSoftwareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+
+	log.Printf("Cancelling software update of edge %s", d.Id())
+	resp, err := ep.cancelEdgeSoftwareUpdate(ctx, d.Id())
+	if err != nil && !gcloud.IsStatus404(resp) {
+		return diag.Errorf("Failed to cancel software update of edge %s: %s", d.Id(), err)
+	}
+
+	log.Printf("Cancelled software update of edge %s", d.Id())
+	return nil
+}
+
+// scheduleEdgeSoftwareUpdate resolves the requested version against the versions available to the edge and schedules the update
+func scheduleEdgeSoftwareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, edgeId string) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	ep := getEdgeProxy(sdkConfig)
+	version := d.Get("version").(string)
+
+	versions, _, err := ep.getEdgeSoftwareVersions(ctx, edgeId)
+	if err != nil {
+		return diag.Errorf("Failed to get software versions of edge %s: %s", edgeId, err)
+	}
+	softwareVersion := findSoftwareVersion(*versions, version)
+	if softwareVersion == nil {
+		return diag.Errorf("Software version %s is not available to edge %s. Available versions: %s", version, edgeId, formatSoftwareVersionNames(*versions))
+	}
+
+	softwareUpdate, err := buildSdkSoftwareUpdate(d, softwareVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("Scheduling software update of edge %s to %s", edgeId, version)
+	if _, _, err := ep.createEdgeSoftwareUpdate(ctx, edgeId, softwareUpdate); err != nil {
+		return diag.Errorf("Failed to schedule software update of edge %s to %s: %s", edgeId, version, err)
+	}
+	log.Printf("Scheduled software update of edge %s to %s", edgeId, version)
+	return nil
+}
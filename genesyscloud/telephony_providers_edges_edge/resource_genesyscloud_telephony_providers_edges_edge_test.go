@@ -0,0 +1,149 @@
+package telephony_providers_edges_edge
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func TestAccResourceEdge(t *testing.T) {
+	var (
+		edgeRes          = "edge"
+		name1            = "test edge " + uuid.NewString()
+		name2            = "test edge " + uuid.NewString()
+		description1     = "TestAccResourceEdge description 1"
+		description2     = "TestAccResourceEdge description 2"
+		siteRes          = "site"
+		siteName         = "site " + uuid.NewString()
+		locationRes      = "test-location1"
+		emergencyNumber  = "+13173114131"
+		dataSourceRes    = "edge-data"
+		statusDataSource = "edge-status"
+	)
+
+	err := edgeSite.DeleteLocationWithNumber(emergencyNumber, sdkConfig)
+	if err != nil {
+		t.Skipf("failed to delete location with number %s, %v", emergencyNumber, err)
+	}
+
+	location := gcloud.GenerateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		gcloud.GenerateLocationEmergencyNum(
+			emergencyNumber,
+			gcloud.NullValue,
+		),
+		gcloud.GenerateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		),
+	)
+
+	site := edgeSite.GenerateSiteResourceWithCustomAttrs(
+		siteRes,
+		siteName,
+		"TestAccResourceEdge site",
+		"genesyscloud_location."+locationRes+".id",
+		"Premises",
+		false,
+		gcloud.NullValue,
+		gcloud.NullValue,
+		gcloud.NullValue,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create a virtual edge
+				Config: location + site + GenerateEdgeResource(
+					edgeRes,
+					name1,
+					description1,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					"physical_edge = false",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"."+edgeRes, "name", name1),
+					resource.TestCheckResourceAttr(resourceName+"."+edgeRes, "description", description1),
+					resource.TestCheckResourceAttr(resourceName+"."+edgeRes, "physical_edge", "false"),
+					resource.TestCheckResourceAttr(resourceName+"."+edgeRes, "managed", "false"),
+					resource.TestCheckResourceAttrPair(resourceName+"."+edgeRes, "site_id", "genesyscloud_telephony_providers_edges_site."+siteRes, "id"),
+				),
+			},
+			{
+				// Update the edge and read it with the data sources
+				Config: location + site + GenerateEdgeResource(
+					edgeRes,
+					name2,
+					description2,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					"physical_edge = false",
+				) + fmt.Sprintf(`
+data "%s" "%s" {
+  name       = %s
+  site_id    = genesyscloud_telephony_providers_edges_site.%s.id
+  depends_on = [%s.%s]
+}
+
+data "%s" "%s" {
+  edge_id = %s.%s.id
+}
+`, resourceName, dataSourceRes, strconv.Quote(name2), siteRes, resourceName, edgeRes, statusDataSourceName, statusDataSource, resourceName, edgeRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"."+edgeRes, "name", name2),
+					resource.TestCheckResourceAttr(resourceName+"."+edgeRes, "description", description2),
+					resource.TestCheckResourceAttrPair("data."+resourceName+"."+dataSourceRes, "id", resourceName+"."+edgeRes, "id"),
+					resource.TestCheckResourceAttr("data."+statusDataSourceName+"."+statusDataSource, "name", name2),
+					resource.TestCheckResourceAttrSet("data."+statusDataSourceName+"."+statusDataSource, "state"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      resourceName + "." + edgeRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyEdgesDestroyed,
+	})
+}
+
+func testVerifyEdgesDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		edge, resp, err := edgesAPI.GetTelephonyProvidersEdge(rs.Primary.ID, nil)
+		if edge != nil {
+			if edge.State != nil && *edge.State == "deleted" {
+				continue
+			}
+			return fmt.Errorf("Edge (%s) still exists", rs.Primary.ID)
+		} else if gcloud.IsStatus404(resp) {
+			// Edge not found as expected
+			continue
+		} else {
+			// Unexpected error
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+	}
+	// Success. All edges destroyed
+	return nil
+}
@@ -0,0 +1,114 @@
+package telephony_providers_edges_edge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBuildSdkLogicalInterface(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceEdgeLogicalInterface().Schema, map[string]interface{}{
+		"edge_id":             "edge-1",
+		"name":                "WAN",
+		"physical_adapter_id": "eth0",
+		"vlan_tag_id":         10,
+		"ipv4_capabilities": []interface{}{map[string]interface{}{
+			"enabled":      true,
+			"dhcp":         false,
+			"auto_metric":  false,
+			"metric":       5,
+			"ping_enabled": true,
+		}},
+		"addresses": []interface{}{map[string]interface{}{
+			"address":    "10.0.0.10/24",
+			"family":     ipv4Family,
+			"persistent": true,
+		}},
+		"routes": []interface{}{map[string]interface{}{
+			"prefix":     "0.0.0.0/0",
+			"nexthop":    "10.0.0.1",
+			"metric":     1,
+			"family":     ipv4Family,
+			"persistent": true,
+		}},
+		"use_for_wan_interface": true,
+		"external_trunk_base_assignments": []interface{}{map[string]interface{}{
+			"trunk_base_id": "trunk-1",
+			"family":        ipv6Family,
+		}},
+	})
+
+	logicalInterface := buildSdkLogicalInterface(d)
+	assert.Equal(t, "WAN", *logicalInterface.Name)
+	assert.Equal(t, "eth0", *logicalInterface.PhysicalAdapterId)
+	assert.Equal(t, 10, *logicalInterface.VlanTagId)
+	assert.Equal(t, 5, *logicalInterface.Ipv4Capabilities.Metric)
+	assert.False(t, *logicalInterface.Ipv4Capabilities.Dhcp)
+	assert.Nil(t, logicalInterface.Ipv6Capabilities)
+	assert.True(t, *logicalInterface.UseForWanInterface)
+	assert.Nil(t, logicalInterface.Description)
+
+	// Flattening the built interface returns the configuration
+	assert.Equal(t, d.Get("addresses"), flattenNetworkAddresses(logicalInterface.Addresses))
+	assert.Equal(t, d.Get("routes"), flattenNetworkRoutes(logicalInterface.Routes))
+	assert.Equal(t, d.Get("external_trunk_base_assignments"), flattenTrunkBaseAssignments(logicalInterface.ExternalTrunkBaseAssignments))
+	assert.Equal(t, d.Get("ipv4_capabilities"), flattenIpCapabilities(logicalInterface.Ipv4Capabilities))
+	assert.Equal(t, []interface{}{}, flattenTrunkBaseAssignments(logicalInterface.PhoneTrunkBaseAssignments))
+}
+
+func TestUnitBuildSdkSoftwareUpdate(t *testing.T) {
+	version := &platformclientv2.Domainedgesoftwareversiondto{Id: platformclientv2.String("version-1")}
+
+	d := schema.TestResourceDataRaw(t, ResourceEdgeSoftwareUpdate().Schema, map[string]interface{}{
+		"edge_id":            "edge-1",
+		"version":            "version-1",
+		"execute_start_time": "2024-05-01T02:00:00+02:00",
+		"execute_stop_time":  "2024-05-01T04:00:00Z",
+		"execute_on_idle":    true,
+	})
+	softwareUpdate, err := buildSdkSoftwareUpdate(d, version)
+	assert.Nil(t, err)
+	assert.Equal(t, version, softwareUpdate.Version)
+	assert.Nil(t, softwareUpdate.DownloadStartTime)
+	assert.True(t, softwareUpdate.ExecuteStartTime.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, *softwareUpdate.ExecuteOnIdle)
+	assert.Equal(t, "2024-05-01T00:00:00Z", formatUpdateTime(softwareUpdate.ExecuteStartTime))
+
+	d = schema.TestResourceDataRaw(t, ResourceEdgeSoftwareUpdate().Schema, map[string]interface{}{
+		"edge_id":            "edge-1",
+		"version":            "version-1",
+		"execute_start_time": "2024-05-01T04:00:00Z",
+		"execute_stop_time":  "2024-05-01T02:00:00Z",
+	})
+	_, err = buildSdkSoftwareUpdate(d, version)
+	assert.EqualError(t, err, "execute_stop_time must be after execute_start_time")
+}
+
+func TestUnitSuppressEquivalentTimes(t *testing.T) {
+	assert.True(t, suppressEquivalentTimes("", "2024-05-01T00:00:00Z", "2024-05-01T02:00:00+02:00", nil))
+	assert.False(t, suppressEquivalentTimes("", "2024-05-01T00:00:00Z", "2024-05-01T00:00:00+02:00", nil))
+	assert.False(t, suppressEquivalentTimes("", "", "2024-05-01T00:00:00Z", nil))
+}
+
+func TestUnitFindSoftwareVersion(t *testing.T) {
+	versions := []platformclientv2.Domainedgesoftwareversiondto{
+		{Id: platformclientv2.String("id-1"), Name: platformclientv2.String("Edge 1.0"), EdgeVersion: platformclientv2.String("1.0.0.100")},
+		{Id: platformclientv2.String("id-2"), Name: platformclientv2.String("Edge 2.0"), EdgeVersion: platformclientv2.String("2.0.0.200")},
+	}
+
+	assert.Equal(t, "id-2", *findSoftwareVersion(versions, "Edge 2.0").Id)
+	assert.Equal(t, "id-1", *findSoftwareVersion(versions, "1.0.0.100").Id)
+	assert.Equal(t, "id-2", *findSoftwareVersion(versions, "id-2").Id)
+	assert.Nil(t, findSoftwareVersion(versions, "3.0"))
+	assert.Nil(t, findSoftwareVersion(versions, ""))
+	assert.Equal(t, "Edge 1.0, Edge 2.0", formatSoftwareVersionNames(versions))
+	assert.Equal(t, "none", formatSoftwareVersionNames(nil))
+
+	edge := &platformclientv2.Edge{SoftwareVersion: platformclientv2.String("2.0.0.200"), FullSoftwareVersion: platformclientv2.String("2.0.0.200.1")}
+	assert.True(t, isEdgeRunningVersion(edge, "2.0.0.200"))
+	assert.False(t, isEdgeRunningVersion(edge, "1.0.0.100"))
+	assert.False(t, isEdgeRunningVersion(edge, ""))
+}
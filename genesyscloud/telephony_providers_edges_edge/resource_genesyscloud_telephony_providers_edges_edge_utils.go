@@ -0,0 +1,349 @@
+package telephony_providers_edges_edge
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// Address families used by logical interfaces and trunk base assignments
+const (
+	ipv4Family = 2
+	ipv6Family = 23
+)
+
+func buildSdkEdge(d *schema.ResourceData) *platformclientv2.Edge {
+	edge := &platformclientv2.Edge{
+		Name:    platformclientv2.String(d.Get("name").(string)),
+		Site:    &platformclientv2.Site{Id: platformclientv2.String(d.Get("site_id").(string))},
+		Managed: platformclientv2.Bool(d.Get("managed").(bool)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		edge.Description = platformclientv2.String(description.(string))
+	}
+	if edgeGroupId, ok := d.GetOk("edge_group_id"); ok {
+		edge.EdgeGroup = &platformclientv2.Edgegroup{Id: platformclientv2.String(edgeGroupId.(string))}
+	}
+	// GetOkExists is needed to send physical_edge = false
+	if physicalEdge, ok := d.GetOkExists("physical_edge"); ok {
+		edge.PhysicalEdge = platformclientv2.Bool(physicalEdge.(bool))
+	}
+	if deploymentType, ok := d.GetOk("edge_deployment_type"); ok {
+		edge.EdgeDeploymentType = platformclientv2.String(deploymentType.(string))
+	}
+	return edge
+}
+
+func flattenPhysicalInterfaces(physicalInterfaces []platformclientv2.Domainphysicalinterface) []interface{} {
+	flattened := make([]interface{}, 0, len(physicalInterfaces))
+	for _, physicalInterface := range physicalInterfaces {
+		flattened = append(flattened, map[string]interface{}{
+			"id":               stringValue(physicalInterface.Id),
+			"name":             stringValue(physicalInterface.Name),
+			"friendly_name":    stringValue(physicalInterface.FriendlyName),
+			"hardware_address": stringValue(physicalInterface.HardwareAddress),
+			"port_label":       stringValue(physicalInterface.PortLabel),
+		})
+	}
+	return flattened
+}
+
+func buildSdkLogicalInterface(d *schema.ResourceData) *platformclientv2.Domainlogicalinterface {
+	logicalInterface := &platformclientv2.Domainlogicalinterface{
+		Name:                              platformclientv2.String(d.Get("name").(string)),
+		PhysicalAdapterId:                 platformclientv2.String(d.Get("physical_adapter_id").(string)),
+		VlanTagId:                         platformclientv2.Int(d.Get("vlan_tag_id").(int)),
+		Addresses:                         buildSdkNetworkAddresses(d.Get("addresses").([]interface{})),
+		Routes:                            buildSdkNetworkRoutes(d.Get("routes").([]interface{})),
+		UseForInternalEdgeCommunication:   platformclientv2.Bool(d.Get("use_for_internal_edge_communication").(bool)),
+		UseForIndirectEdgeCommunication:   platformclientv2.Bool(d.Get("use_for_indirect_edge_communication").(bool)),
+		UseForCloudProxyEdgeCommunication: platformclientv2.Bool(d.Get("use_for_cloud_proxy_edge_communication").(bool)),
+		UseForWanInterface:                platformclientv2.Bool(d.Get("use_for_wan_interface").(bool)),
+		InheritPhoneTrunkBasesIPv4:        platformclientv2.Bool(d.Get("inherit_phone_trunk_bases_ipv4").(bool)),
+		InheritPhoneTrunkBasesIPv6:        platformclientv2.Bool(d.Get("inherit_phone_trunk_bases_ipv6").(bool)),
+		ExternalTrunkBaseAssignments:      buildSdkTrunkBaseAssignments(d.Get("external_trunk_base_assignments").([]interface{})),
+		PhoneTrunkBaseAssignments:         buildSdkTrunkBaseAssignments(d.Get("phone_trunk_base_assignments").([]interface{})),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		logicalInterface.Description = platformclientv2.String(description.(string))
+	}
+	if capabilities, ok := d.GetOk("ipv4_capabilities"); ok {
+		logicalInterface.Ipv4Capabilities = buildSdkIpCapabilities(capabilities.([]interface{}))
+	}
+	if capabilities, ok := d.GetOk("ipv6_capabilities"); ok {
+		logicalInterface.Ipv6Capabilities = buildSdkIpCapabilities(capabilities.([]interface{}))
+	}
+	if address, ok := d.GetOk("public_nat_address_ipv4"); ok {
+		logicalInterface.PublicNatAddressIpV4 = platformclientv2.String(address.(string))
+	}
+	if address, ok := d.GetOk("public_nat_address_ipv6"); ok {
+		logicalInterface.PublicNatAddressIpV6 = platformclientv2.String(address.(string))
+	}
+	return logicalInterface
+}
+
+func buildSdkIpCapabilities(capabilities []interface{}) *platformclientv2.Domaincapabilities {
+	if len(capabilities) == 0 || capabilities[0] == nil {
+		return nil
+	}
+	capabilitiesMap := capabilities[0].(map[string]interface{})
+	sdkCapabilities := &platformclientv2.Domaincapabilities{
+		Enabled:     platformclientv2.Bool(capabilitiesMap["enabled"].(bool)),
+		Dhcp:        platformclientv2.Bool(capabilitiesMap["dhcp"].(bool)),
+		AutoMetric:  platformclientv2.Bool(capabilitiesMap["auto_metric"].(bool)),
+		PingEnabled: platformclientv2.Bool(capabilitiesMap["ping_enabled"].(bool)),
+	}
+	if !*sdkCapabilities.AutoMetric {
+		sdkCapabilities.Metric = platformclientv2.Int(capabilitiesMap["metric"].(int))
+	}
+	return sdkCapabilities
+}
+
+func flattenIpCapabilities(capabilities *platformclientv2.Domaincapabilities) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"enabled":      boolValue(capabilities.Enabled),
+		"dhcp":         boolValue(capabilities.Dhcp),
+		"metric":       intValue(capabilities.Metric),
+		"auto_metric":  boolValue(capabilities.AutoMetric),
+		"ping_enabled": boolValue(capabilities.PingEnabled),
+	}}
+}
+
+func buildSdkNetworkAddresses(addresses []interface{}) *[]platformclientv2.Domainnetworkaddress {
+	sdkAddresses := make([]platformclientv2.Domainnetworkaddress, 0, len(addresses))
+	for _, address := range addresses {
+		addressMap := address.(map[string]interface{})
+		sdkAddresses = append(sdkAddresses, platformclientv2.Domainnetworkaddress{
+			Address:    platformclientv2.String(addressMap["address"].(string)),
+			Family:     platformclientv2.Int(addressMap["family"].(int)),
+			Persistent: platformclientv2.Bool(addressMap["persistent"].(bool)),
+		})
+	}
+	return &sdkAddresses
+}
+
+func flattenNetworkAddresses(addresses *[]platformclientv2.Domainnetworkaddress) []interface{} {
+	flattened := make([]interface{}, 0, len(*addresses))
+	for _, address := range *addresses {
+		family := ipv4Family
+		if address.Family != nil {
+			family = *address.Family
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"address":    stringValue(address.Address),
+			"family":     family,
+			"persistent": boolValue(address.Persistent),
+		})
+	}
+	return flattened
+}
+
+func buildSdkNetworkRoutes(routes []interface{}) *[]platformclientv2.Domainnetworkroute {
+	sdkRoutes := make([]platformclientv2.Domainnetworkroute, 0, len(routes))
+	for _, route := range routes {
+		routeMap := route.(map[string]interface{})
+		sdkRoutes = append(sdkRoutes, platformclientv2.Domainnetworkroute{
+			Prefix:     platformclientv2.String(routeMap["prefix"].(string)),
+			Nexthop:    platformclientv2.String(routeMap["nexthop"].(string)),
+			Metric:     platformclientv2.Int(routeMap["metric"].(int)),
+			Family:     platformclientv2.Int(routeMap["family"].(int)),
+			Persistent: platformclientv2.Bool(routeMap["persistent"].(bool)),
+		})
+	}
+	return &sdkRoutes
+}
+
+func flattenNetworkRoutes(routes *[]platformclientv2.Domainnetworkroute) []interface{} {
+	flattened := make([]interface{}, 0, len(*routes))
+	for _, route := range *routes {
+		family := ipv4Family
+		if route.Family != nil {
+			family = *route.Family
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"prefix":     stringValue(route.Prefix),
+			"nexthop":    stringValue(route.Nexthop),
+			"metric":     intValue(route.Metric),
+			"family":     family,
+			"persistent": boolValue(route.Persistent),
+		})
+	}
+	return flattened
+}
+
+func buildSdkTrunkBaseAssignments(assignments []interface{}) *[]platformclientv2.Trunkbaseassignment {
+	sdkAssignments := make([]platformclientv2.Trunkbaseassignment, 0, len(assignments))
+	for _, assignment := range assignments {
+		assignmentMap := assignment.(map[string]interface{})
+		sdkAssignments = append(sdkAssignments, platformclientv2.Trunkbaseassignment{
+			TrunkBase: &platformclientv2.Trunkbase{Id: platformclientv2.String(assignmentMap["trunk_base_id"].(string))},
+			Family:    platformclientv2.Int(assignmentMap["family"].(int)),
+		})
+	}
+	return &sdkAssignments
+}
+
+func flattenTrunkBaseAssignments(assignments *[]platformclientv2.Trunkbaseassignment) []interface{} {
+	flattened := make([]interface{}, 0, len(*assignments))
+	for _, assignment := range *assignments {
+		if assignment.TrunkBase == nil || assignment.TrunkBase.Id == nil {
+			continue
+		}
+		family := ipv4Family
+		if assignment.Family != nil {
+			family = *assignment.Family
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"trunk_base_id": *assignment.TrunkBase.Id,
+			"family":        family,
+		})
+	}
+	return flattened
+}
+
+func buildSdkSoftwareUpdate(d *schema.ResourceData, version *platformclientv2.Domainedgesoftwareversiondto) (*platformclientv2.Domainedgesoftwareupdatedto, error) {
+	softwareUpdate := &platformclientv2.Domainedgesoftwareupdatedto{
+		Version:                     version,
+		ExecuteOnIdle:               platformclientv2.Bool(d.Get("execute_on_idle").(bool)),
+		MaxDownloadRate:             platformclientv2.Int(d.Get("max_download_rate").(int)),
+		CallDrainingWaitTimeSeconds: platformclientv2.Int(d.Get("call_draining_wait_time_seconds").(int)),
+	}
+
+	var err error
+	if softwareUpdate.DownloadStartTime, err = parseUpdateTime(d.Get("download_start_time").(string)); err != nil {
+		return nil, err
+	}
+	if softwareUpdate.ExecuteStartTime, err = parseUpdateTime(d.Get("execute_start_time").(string)); err != nil {
+		return nil, err
+	}
+	if softwareUpdate.ExecuteStopTime, err = parseUpdateTime(d.Get("execute_stop_time").(string)); err != nil {
+		return nil, err
+	}
+	if softwareUpdate.ExecuteStartTime != nil && softwareUpdate.ExecuteStopTime != nil && !softwareUpdate.ExecuteStopTime.After(*softwareUpdate.ExecuteStartTime) {
+		return nil, fmt.Errorf("execute_stop_time must be after execute_start_time")
+	}
+	return softwareUpdate, nil
+}
+
+// parseUpdateTime parses an optional RFC 3339 time of a software update
+func parseUpdateTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %s: %v", value, err)
+	}
+	return &parsed, nil
+}
+
+func formatUpdateTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+// suppressEquivalentTimes ignores differences between RFC 3339 times that refer to the same instant, e.g. in different time zones
+func suppressEquivalentTimes(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// findSoftwareVersion returns the software version with the given name, Edge version or ID
+func findSoftwareVersion(versions []platformclientv2.Domainedgesoftwareversiondto, version string) *platformclientv2.Domainedgesoftwareversiondto {
+	for i := range versions {
+		if softwareVersionMatches(&versions[i], version) {
+			return &versions[i]
+		}
+	}
+	return nil
+}
+
+func softwareVersionMatches(softwareVersion *platformclientv2.Domainedgesoftwareversiondto, version string) bool {
+	return version != "" && (stringValue(softwareVersion.Id) == version ||
+		stringValue(softwareVersion.Name) == version ||
+		stringValue(softwareVersion.EdgeVersion) == version)
+}
+
+func formatSoftwareVersionNames(versions []platformclientv2.Domainedgesoftwareversiondto) string {
+	if len(versions) == 0 {
+		return "none"
+	}
+	names := make([]string, 0, len(versions))
+	for _, version := range versions {
+		names = append(names, stringValue(version.Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+// isEdgeRunningVersion checks whether the software version running on an edge is the requested version
+func isEdgeRunningVersion(edge *platformclientv2.Edge, version string) bool {
+	if version == "" {
+		return false
+	}
+	for _, running := range []*string{edge.SoftwareVersion, edge.FullSoftwareVersion, edge.CurrentVersion} {
+		if running != nil && (*running == version || strings.HasPrefix(*running, version)) {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenSoftwareVersions(versions []platformclientv2.Domainedgesoftwareversiondto) []interface{} {
+	flattened := make([]interface{}, 0, len(versions))
+	for _, version := range versions {
+		flattened = append(flattened, map[string]interface{}{
+			"id":             stringValue(version.Id),
+			"name":           stringValue(version.Name),
+			"edge_version":   stringValue(version.EdgeVersion),
+			"publish_date":   formatUpdateTime(version.PublishDate),
+			"latest_release": boolValue(version.LatestRelease),
+			"current":        boolValue(version.Current),
+		})
+	}
+	return flattened
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func GenerateEdgeResource(
+	edgeRes,
+	name,
+	description,
+	siteId string,
+	otherAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_edge" "%s" {
+		name = "%s"
+		description = "%s"
+		site_id = %s
+		%s
+	}
+	`, edgeRes, name, description, siteId, strings.Join(otherAttrs, "\n"))
+}
@@ -45,3 +45,40 @@ func dataSourceExtensionPoolRead(ctx context.Context, d *schema.ResourceData, m
 	})
 
 }
+
+func dataSourceExtensionPoolFreeExtensionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getExtensionPoolProxy(sdkConfig)
+
+	extensionPoolId := d.Get("extension_pool_id").(string)
+	extensionCount := d.Get("extension_count").(int)
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		extensionPool, resp, err := proxy.getExtensionPool(ctx, extensionPoolId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("failed to find extension pool %s: %s", extensionPoolId, err))
+			}
+			return retry.NonRetryableError(fmt.Errorf("error requesting extension pool %s: %s", extensionPoolId, err))
+		}
+		poolRange, err := parseExtensionRange(*extensionPool.StartNumber, *extensionPool.EndNumber)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("invalid range of extension pool %s: %s", extensionPoolId, err))
+		}
+
+		extensions, _, err := proxy.getCachedExtensions(ctx)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error requesting extensions: %s", err))
+		}
+
+		freeCount := poolRange.size() - countAssignedExtensions(*extensions, poolRange)
+		if freeCount < extensionCount {
+			return retry.NonRetryableError(fmt.Errorf("extension pool %s (%s) has %d free extensions, fewer than the %d requested", extensionPoolId, poolRange, freeCount, extensionCount))
+		}
+
+		d.SetId(extensionPoolId)
+		_ = d.Set("extensions", findFreeExtensions(*extensions, poolRange, extensionCount))
+		_ = d.Set("free_extensions", freeCount)
+		return nil
+	})
+}
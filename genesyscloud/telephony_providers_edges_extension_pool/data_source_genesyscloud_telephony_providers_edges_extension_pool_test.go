@@ -15,6 +15,7 @@ func TestAccDataSourceExtensionPoolBasic(t *testing.T) {
 		extensionPoolEndNumber   = "2599"
 		extensionPoolRes         = "extensionPool"
 		extensionPoolDataRes     = "extensionPoolData"
+		freeExtensionsDataRes    = "freeExtensionsData"
 	)
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
@@ -30,9 +31,14 @@ func TestAccDataSourceExtensionPoolBasic(t *testing.T) {
 				}) + generateExtensionPoolDataSource(extensionPoolDataRes,
 					extensionPoolStartNumber,
 					extensionPoolEndNumber,
-					"genesyscloud_telephony_providers_edges_extension_pool."+extensionPoolRes),
+					"genesyscloud_telephony_providers_edges_extension_pool."+extensionPoolRes) + generateFreeExtensionsDataSource(freeExtensionsDataRes,
+					"genesyscloud_telephony_providers_edges_extension_pool."+extensionPoolRes+".id",
+					3),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.genesyscloud_telephony_providers_edges_extension_pool."+extensionPoolDataRes, "id", "genesyscloud_telephony_providers_edges_extension_pool."+extensionPoolRes, "id"),
+					resource.TestCheckResourceAttr("data."+freeExtensionsDataSourceName+"."+freeExtensionsDataRes, "extensions.#", "3"),
+					resource.TestCheckResourceAttr("data."+freeExtensionsDataSourceName+"."+freeExtensionsDataRes, "extensions.0", extensionPoolStartNumber),
+					resource.TestCheckResourceAttr("data."+freeExtensionsDataSourceName+"."+freeExtensionsDataRes, "free_extensions", "100"),
 				),
 			},
 		},
@@ -53,3 +59,11 @@ func generateExtensionPoolDataSource(
 	}
 	`, resourceID, startNumber, endNumber, dependsOnResource)
 }
+
+func generateFreeExtensionsDataSource(resourceID string, extensionPoolId string, extensionCount int) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		extension_pool_id = %s
+		extension_count   = %d
+	}
+	`, freeExtensionsDataSourceName, resourceID, extensionPoolId, extensionCount)
+}
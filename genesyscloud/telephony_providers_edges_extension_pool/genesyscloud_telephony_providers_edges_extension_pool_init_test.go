@@ -25,6 +25,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	r.datasourceMapMutex.Lock()
 	defer r.datasourceMapMutex.Unlock()
 	providerDataSources["genesyscloud_telephony_providers_edges_extension_pool"] = DataSourceExtensionPool()
+	providerDataSources[freeExtensionsDataSourceName] = DataSourceExtensionPoolFreeExtensions()
 }
 
 func initTestresources() {
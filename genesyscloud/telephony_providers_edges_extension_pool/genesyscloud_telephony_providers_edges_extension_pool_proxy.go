@@ -0,0 +1,180 @@
+package telephony_providers_edges_extension_pool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/plannedranges"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_providers_edges_extension_pool_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *extensionPoolProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllExtensionPoolsFunc func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extensionpool, *platformclientv2.APIResponse, error)
+type getExtensionPoolFunc func(ctx context.Context, p *extensionPoolProxy, id string) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error)
+type getAllExtensionsFunc func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error)
+
+// extensionPoolProxy contains all of the methods that call genesys cloud APIs.
+type extensionPoolProxy struct {
+	clientConfig *platformclientv2.Configuration
+	edgesApi     *platformclientv2.TelephonyProvidersEdgeApi
+
+	getAllExtensionPoolsAttr getAllExtensionPoolsFunc
+	getExtensionPoolAttr     getExtensionPoolFunc
+	getAllExtensionsAttr     getAllExtensionsFunc
+
+	// extensionCache holds every extension assigned in the org. It is loaded by the first
+	// extension pool plan, read or data source and shared by all others until a pool is changed.
+	extensionCache      *[]platformclientv2.Extension
+	extensionCacheMutex sync.Mutex
+
+	// plannedPools holds the ranges of the extension pools planned in each provider run, so pools in the same
+	// configuration are checked against each other but never against pools planned by an earlier run
+	plannedPools      map[*gcloud.ProviderMeta]*plannedranges.Registry
+	plannedPoolsMutex sync.Mutex
+}
+
+// newExtensionPoolProxy initializes the extension pool proxy with all of the data needed to communicate with Genesys Cloud
+func newExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extensionPoolProxy {
+	return &extensionPoolProxy{
+		clientConfig: clientConfig,
+		edgesApi:     platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
+
+		getAllExtensionPoolsAttr: getAllExtensionPoolsFn,
+		getExtensionPoolAttr:     getExtensionPoolFn,
+		getAllExtensionsAttr:     getAllExtensionsFn,
+	}
+}
+
+// getExtensionPoolProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getExtensionPoolProxy(clientConfig *platformclientv2.Configuration) *extensionPoolProxy {
+	if internalProxy == nil {
+		internalProxy = newExtensionPoolProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllExtensionPools retrieves every extension pool in the org that is not deleted
+func (p *extensionPoolProxy) getAllExtensionPools(ctx context.Context) (*[]platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
+	return p.getAllExtensionPoolsAttr(ctx, p)
+}
+
+// getExtensionPool retrieves an extension pool by ID
+func (p *extensionPoolProxy) getExtensionPool(ctx context.Context, id string) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
+	return p.getExtensionPoolAttr(ctx, p, id)
+}
+
+// getAllExtensions retrieves every extension assigned in the org
+func (p *extensionPoolProxy) getAllExtensions(ctx context.Context) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error) {
+	return p.getAllExtensionsAttr(ctx, p)
+}
+
+// getCachedExtensions returns every extension assigned in the org, paging through the extensions only on the first call.
+// Concurrent callers wait for the first load to finish instead of paging through the extensions themselves.
+func (p *extensionPoolProxy) getCachedExtensions(ctx context.Context) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error) {
+	p.extensionCacheMutex.Lock()
+	defer p.extensionCacheMutex.Unlock()
+
+	if p.extensionCache != nil {
+		log.Printf("found %d extensions in cache", len(*p.extensionCache))
+		return p.extensionCache, nil, nil
+	}
+
+	extensions, resp, err := p.getAllExtensions(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	log.Printf("loaded %d extensions into cache", len(*extensions))
+	p.extensionCache = extensions
+	return extensions, resp, nil
+}
+
+// invalidateExtensionCache clears the cached extensions so they are loaded again by the next call to getCachedExtensions
+func (p *extensionPoolProxy) invalidateExtensionCache() {
+	p.extensionCacheMutex.Lock()
+	defer p.extensionCacheMutex.Unlock()
+	p.extensionCache = nil
+}
+
+// getPlannedPools returns the registry of the extension pools planned in the provider run of meta
+func (p *extensionPoolProxy) getPlannedPools(meta *gcloud.ProviderMeta) *plannedranges.Registry {
+	p.plannedPoolsMutex.Lock()
+	defer p.plannedPoolsMutex.Unlock()
+
+	if p.plannedPools == nil {
+		p.plannedPools = make(map[*gcloud.ProviderMeta]*plannedranges.Registry)
+	}
+	if _, exists := p.plannedPools[meta]; !exists {
+		p.plannedPools[meta] = &plannedranges.Registry{}
+	}
+	return p.plannedPools[meta]
+}
+
+// getAllExtensionPoolsFn is the implementation for retrieving all extension pools in Genesys Cloud
+func getAllExtensionPoolsFn(_ context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
+	var allExtensionPools []platformclientv2.Extensionpool
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		extensionPools, apiResp, err := p.edgesApi.GetTelephonyProvidersEdgesExtensionpools(pageSize, pageNum, "", "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get extension pools: %v", err)
+		}
+		if extensionPools.Entities == nil || len(*extensionPools.Entities) == 0 {
+			break
+		}
+		for _, extensionPool := range *extensionPools.Entities {
+			if extensionPool.State != nil && *extensionPool.State == "deleted" {
+				continue
+			}
+			allExtensionPools = append(allExtensionPools, extensionPool)
+		}
+		if extensionPools.PageCount == nil || pageNum >= *extensionPools.PageCount {
+			break
+		}
+	}
+	return &allExtensionPools, resp, nil
+}
+
+// getExtensionPoolFn is the implementation for retrieving an extension pool in Genesys Cloud
+func getExtensionPoolFn(_ context.Context, p *extensionPoolProxy, id string) (*platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
+	return p.edgesApi.GetTelephonyProvidersEdgesExtensionpool(id)
+}
+
+// getAllExtensionsFn is the implementation for retrieving all extensions in Genesys Cloud
+func getAllExtensionsFn(_ context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error) {
+	var allExtensions []platformclientv2.Extension
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		extensions, apiResp, err := p.edgesApi.GetTelephonyProvidersEdgesExtensions(pageSize, pageNum, "", "", "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get extensions: %v", err)
+		}
+		if extensions.Entities == nil || len(*extensions.Entities) == 0 {
+			break
+		}
+		allExtensions = append(allExtensions, *extensions.Entities...)
+		if extensions.PageCount == nil || pageNum >= *extensions.PageCount {
+			break
+		}
+	}
+	return &allExtensions, resp, nil
+}
@@ -6,11 +6,18 @@ import (
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const resourceName = "genesyscloud_telephony_providers_edges_extension_pool"
+const freeExtensionsDataSourceName = "genesyscloud_telephony_providers_edges_extension_pool_free_extensions"
+
 func ResourceTelephonyExtensionPool() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Genesys Cloud Extension Pool",
+		Description: "Genesys Cloud Extension Pool. " +
+			"During plan the range is checked against the other extension pools in the configuration " +
+			"and against extensions already assigned outside of the pool. " +
+			"Extension pools in the org that overlap the range may be destroyed in the same apply, so they are only rejected if they still exist when the pool is created.",
 		CreateContext: gcloud.CreateWithPooledClient(createExtensionPool),
 		ReadContext:   gcloud.ReadWithPooledClient(readExtensionPool),
 		UpdateContext: gcloud.UpdateWithPooledClient(updateExtensionPool),
@@ -19,6 +26,7 @@ func ResourceTelephonyExtensionPool() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeExtensionPoolDiff,
 		Schema: map[string]*schema.Schema{
 			"start_number": {
 				Description:      "Starting phone number of the Extension Pool range. Changing the start_number attribute will cause the extension object to be dropped and recreated with a new ID.",
@@ -39,6 +47,23 @@ func ResourceTelephonyExtensionPool() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"min_free_extensions": {
+				Description:  "Fail the plan if fewer extensions than this are free in the pool. Defaults to 0, which disables the check.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"total_extensions": {
+				Description: "Number of extensions in the Extension Pool range.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"assigned_extensions": {
+				Description: "Number of extensions in the Extension Pool range that are assigned. The extensions of the org are loaded once and loaded again after an Extension Pool is created, updated or deleted.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -64,6 +89,39 @@ func DataSourceExtensionPool() *schema.Resource {
 	}
 }
 
+func DataSourceExtensionPoolFreeExtensions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the free extensions of a Genesys Cloud Extension pool. Returns the lowest extensions of the pool that are not assigned, " +
+			"so the same extensions are returned until they are assigned.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceExtensionPoolFreeExtensionsRead),
+		Schema: map[string]*schema.Schema{
+			"extension_pool_id": {
+				Description: "ID of the Extension Pool.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"extension_count": {
+				Description:  "Number of free extensions to return. The read fails if the pool has fewer free extensions.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"extensions": {
+				Description: "The free extensions, in ascending order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"free_extensions": {
+				Description: "Total number of free extensions in the Extension Pool.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
 func TelephonyExtensionPoolExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: gcloud.GetAllWithPooledClient(getAllExtensionPools),
@@ -72,7 +130,8 @@ func TelephonyExtensionPoolExporter() *resourceExporter.ResourceExporter {
 }
 
 func SetRegistrar(l registrar.Registrar) {
-	l.RegisterDataSource("genesyscloud_telephony_providers_edges_extension_pool", DataSourceExtensionPool())
-	l.RegisterResource("genesyscloud_telephony_providers_edges_extension_pool", ResourceTelephonyExtensionPool())
-	l.RegisterExporter("genesyscloud_telephony_providers_edges_extension_pool", TelephonyExtensionPoolExporter())
+	l.RegisterDataSource(resourceName, DataSourceExtensionPool())
+	l.RegisterDataSource(freeExtensionsDataSourceName, DataSourceExtensionPoolFreeExtensions())
+	l.RegisterResource(resourceName, ResourceTelephonyExtensionPool())
+	l.RegisterExporter(resourceName, TelephonyExtensionPoolExporter())
 }
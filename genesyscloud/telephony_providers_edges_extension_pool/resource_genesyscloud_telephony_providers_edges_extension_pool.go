@@ -51,6 +51,14 @@ func createExtensionPool(ctx context.Context, d *schema.ResourceData, meta inter
 	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
 	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
 
+	poolRange, err := parseExtensionRange(startNumber, endNumber)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diagErr := waitForExtensionPoolRange(ctx, getExtensionPoolProxy(sdkConfig), poolRange); diagErr != nil {
+		return diagErr
+	}
+
 	log.Printf("Creating Extension pool %s", startNumber)
 	extensionPool, _, err := telephonyApi.PostTelephonyProvidersEdgesExtensionpools(platformclientv2.Extensionpool{
 		StartNumber: &startNumber,
@@ -62,6 +70,8 @@ func createExtensionPool(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.SetId(*extensionPool.Id)
+	// The extensions in the range of the pool now belong to it
+	getExtensionPoolProxy(sdkConfig).invalidateExtensionCache()
 
 	log.Printf("Created Extension pool %s %s", startNumber, *extensionPool.Id)
 	return readExtensionPool(ctx, d, meta)
@@ -86,9 +96,18 @@ func readExtensionPool(ctx context.Context, d *schema.ResourceData, meta interfa
 			return nil
 		}
 
+		extensions, _, err := getExtensionPoolProxy(sdkConfig).getCachedExtensions(ctx)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read extensions of Extension pool %s: %s", d.Id(), err))
+		}
+
 		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceTelephonyExtensionPool())
 		d.Set("start_number", *extensionPool.StartNumber)
 		d.Set("end_number", *extensionPool.EndNumber)
+		if poolRange, err := parseExtensionRange(*extensionPool.StartNumber, *extensionPool.EndNumber); err == nil {
+			d.Set("total_extensions", poolRange.size())
+			d.Set("assigned_extensions", countAssignedExtensions(*extensions, poolRange))
+		}
 
 		if extensionPool.Description != nil {
 			d.Set("description", *extensionPool.Description)
@@ -119,6 +138,7 @@ func updateExtensionPool(ctx context.Context, d *schema.ResourceData, meta inter
 	if _, _, err := telephonyApi.PutTelephonyProvidersEdgesExtensionpool(d.Id(), extensionPoolBody); err != nil {
 		return diag.Errorf("Error updating Extension pool %s: %s", startNumber, err)
 	}
+	getExtensionPoolProxy(sdkConfig).invalidateExtensionCache()
 
 	log.Printf("Updated Extension pool %s", d.Id())
 	return readExtensionPool(ctx, d, meta)
@@ -131,6 +151,7 @@ func deleteExtensionPool(ctx context.Context, d *schema.ResourceData, meta inter
 	telephonyApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
 
 	log.Printf("Deleting Extension pool with starting number %s", startNumber)
+	defer getExtensionPoolProxy(sdkConfig).invalidateExtensionCache()
 	if _, err := telephonyApi.DeleteTelephonyProvidersEdgesExtensionpool(d.Id()); err != nil {
 		return diag.Errorf("Failed to delete Extension pool with starting number %s: %s", startNumber, err)
 	}
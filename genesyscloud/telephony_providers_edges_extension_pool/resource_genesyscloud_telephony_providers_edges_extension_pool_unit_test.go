@@ -0,0 +1,215 @@
+package telephony_providers_edges_extension_pool
+
+import (
+	"context"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestExtension(number string, extensionPoolId string) platformclientv2.Extension {
+	extension := platformclientv2.Extension{
+		Number:    platformclientv2.String(number),
+		OwnerType: platformclientv2.String("USER"),
+		Owner:     &platformclientv2.Domainentityref{Id: platformclientv2.String("user-" + number)},
+	}
+	if extensionPoolId != "" {
+		extension.ExtensionPool = &platformclientv2.Domainentityref{Id: platformclientv2.String(extensionPoolId)}
+	}
+	return extension
+}
+
+func buildTestExtensionPoolProxy(extensionPools []platformclientv2.Extensionpool, extensions []platformclientv2.Extension) *extensionPoolProxy {
+	proxy := &extensionPoolProxy{}
+	proxy.getAllExtensionPoolsAttr = func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
+		return &extensionPools, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.getAllExtensionsAttr = func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error) {
+		return &extensions, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	return proxy
+}
+
+func TestUnitParseExtensionRange(t *testing.T) {
+	poolRange, err := parseExtensionRange("0100", "0199")
+	assert.Nil(t, err)
+	assert.Equal(t, 100, poolRange.size())
+	assert.Equal(t, "0100-0199", poolRange.String())
+	assert.True(t, poolRange.contains(150))
+	assert.False(t, poolRange.contains(200))
+
+	_, err = parseExtensionRange("200", "100")
+	assert.EqualError(t, err, "start_number 200 must not be greater than end_number 100")
+
+	other, _ := parseExtensionRange("150", "250")
+	assert.True(t, poolRange.overlaps(other))
+	other, _ = parseExtensionRange("200", "250")
+	assert.False(t, poolRange.overlaps(other))
+}
+
+func TestUnitExtensionPoolDiffPlannedPools(t *testing.T) {
+	orgPools := []platformclientv2.Extensionpool{
+		{Id: platformclientv2.String("pool-1"), StartNumber: platformclientv2.String("1000"), EndNumber: platformclientv2.String("1099")},
+	}
+	internalProxy = buildTestExtensionPoolProxy(orgPools, []platformclientv2.Extension{buildTestExtension("1000", "pool-1")})
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	meta := &gcloud.ProviderMeta{}
+	state := &terraform.InstanceState{
+		ID: "pool-1",
+		Attributes: map[string]string{
+			"start_number":        "1000",
+			"end_number":          "1099",
+			"min_free_extensions": "0",
+		},
+	}
+
+	// A pool that replaces pool-1 with a wider range does not overlap the pool it replaces
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"start_number": "1000", "end_number": "1199"})
+	instanceDiff, err := ResourceTelephonyExtensionPool().Diff(ctx, state, config, meta)
+	assert.Nil(t, err)
+	assert.True(t, instanceDiff.RequiresNew())
+
+	// Another pool in the configuration overlapping the replacement is rejected
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"start_number": "1150", "end_number": "1249"})
+	_, err = ResourceTelephonyExtensionPool().Diff(ctx, nil, config, meta)
+	assert.EqualError(t, err, "extension pool 1150-1249 overlaps extension pool 1000-1199 in the configuration")
+
+	// Pools planned by another run are not remembered
+	meta = &gcloud.ProviderMeta{}
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"start_number": "1150", "end_number": "1249"})
+	_, err = ResourceTelephonyExtensionPool().Diff(ctx, nil, config, meta)
+	assert.Nil(t, err)
+}
+
+func TestUnitExtensionCacheInvalidation(t *testing.T) {
+	extensions := []platformclientv2.Extension{buildTestExtension("1000", "pool-1")}
+	proxy := buildTestExtensionPoolProxy(nil, extensions)
+	ctx := context.Background()
+
+	cached, _, err := proxy.getCachedExtensions(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(*cached))
+
+	// Extensions assigned after the cache is loaded are only seen once it is invalidated
+	extensions = append(extensions, buildTestExtension("1001", "pool-1"))
+	proxy.getAllExtensionsAttr = func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extension, *platformclientv2.APIResponse, error) {
+		return &extensions, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	cached, _, _ = proxy.getCachedExtensions(ctx)
+	assert.Equal(t, 1, len(*cached))
+
+	proxy.invalidateExtensionCache()
+	cached, _, _ = proxy.getCachedExtensions(ctx)
+	assert.Equal(t, 2, len(*cached))
+}
+
+func TestUnitValidateExtensionPoolRange(t *testing.T) {
+	orgPools := []platformclientv2.Extensionpool{
+		{Id: platformclientv2.String("pool-1"), StartNumber: platformclientv2.String("1000"), EndNumber: platformclientv2.String("1009")},
+		{Id: platformclientv2.String("pool-2"), StartNumber: platformclientv2.String("2000"), EndNumber: platformclientv2.String("2099")},
+	}
+	extensions := []platformclientv2.Extension{
+		buildTestExtension("1000", "pool-1"),
+		buildTestExtension("1001", "pool-1"),
+		buildTestExtension("1002", ""),
+		buildTestExtension("3005", ""),
+	}
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		id            string
+		start         string
+		end           string
+		minFree       int
+		expectedError string
+	}{
+		{
+			name:  "new pool in a free range",
+			start: "4000",
+			end:   "4099",
+		},
+		{
+			// The overlapping pool may be destroyed in the same apply
+			name:  "new pool overlapping a pool in the org",
+			start: "2050",
+			end:   "2150",
+		},
+		{
+			name:  "new pool taking over the extensions of an overlapping pool",
+			start: "1000",
+			end:   "1001",
+		},
+		{
+			name:          "new pool containing an assigned extension",
+			start:         "3000",
+			end:           "3099",
+			expectedError: "extension pool 3000-3099 contains extension 3005 already assigned to USER user-3005 outside of the pool",
+		},
+		{
+			name:  "existing pool with its own extensions",
+			id:    "pool-1",
+			start: "1000",
+			end:   "1009",
+		},
+		{
+			name:          "existing pool with too few free extensions",
+			id:            "pool-1",
+			start:         "1000",
+			end:           "1009",
+			minFree:       8,
+			expectedError: "extension pool 1000-1009 has 7 free extensions, fewer than min_free_extensions 8",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			poolRange, err := parseExtensionRange(tc.start, tc.end)
+			assert.Nil(t, err)
+			err = validateExtensionPoolRange(ctx, buildTestExtensionPoolProxy(orgPools, extensions), tc.id, poolRange, tc.minFree)
+			if tc.expectedError == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestUnitFindFreeExtensions(t *testing.T) {
+	extensions := []platformclientv2.Extension{
+		buildTestExtension("0100", "pool-1"),
+		buildTestExtension("0102", "pool-1"),
+		buildTestExtension("0500", "pool-2"),
+	}
+	poolRange, _ := parseExtensionRange("0100", "0104")
+
+	assert.Equal(t, 2, countAssignedExtensions(extensions, poolRange))
+	assert.Equal(t, []string{"0101", "0103"}, findFreeExtensions(extensions, poolRange, 2))
+	assert.Equal(t, []string{"0101", "0103", "0104"}, findFreeExtensions(extensions, poolRange, 5))
+}
+
+func TestUnitWaitForExtensionPoolRange(t *testing.T) {
+	overlappingPool := platformclientv2.Extensionpool{Id: platformclientv2.String("pool-1"), StartNumber: platformclientv2.String("1000"), EndNumber: platformclientv2.String("1099")}
+	proxy := buildTestExtensionPoolProxy(nil, nil)
+	calls := 0
+	proxy.getAllExtensionPoolsAttr = func(ctx context.Context, p *extensionPoolProxy) (*[]platformclientv2.Extensionpool, *platformclientv2.APIResponse, error) {
+		calls++
+		// The overlapping pool is destroyed after the first check
+		if calls == 1 {
+			return &[]platformclientv2.Extensionpool{overlappingPool}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		}
+		return &[]platformclientv2.Extensionpool{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	poolRange, _ := parseExtensionRange("1050", "1149")
+	diagErr := waitForExtensionPoolRange(context.Background(), proxy, poolRange)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,225 @@
+package telephony_providers_edges_extension_pool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/plannedranges"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// extensionPoolOverlapTimeout is the time to wait for overlapping pools to be destroyed before a pool is created
+const extensionPoolOverlapTimeout = 2 * time.Minute
+
+// extensionRange is the inclusive range of extensions of an extension pool
+type extensionRange struct {
+	start int
+	end   int
+	width int
+}
+
+// parseExtensionRange parses the start and end numbers of an extension pool
+func parseExtensionRange(startNumber string, endNumber string) (extensionRange, error) {
+	start, err := strconv.Atoi(startNumber)
+	if err != nil {
+		return extensionRange{}, fmt.Errorf("invalid start_number %s: %s", startNumber, err)
+	}
+	end, err := strconv.Atoi(endNumber)
+	if err != nil {
+		return extensionRange{}, fmt.Errorf("invalid end_number %s: %s", endNumber, err)
+	}
+	if start > end {
+		return extensionRange{}, fmt.Errorf("start_number %s must not be greater than end_number %s", startNumber, endNumber)
+	}
+	return extensionRange{start: start, end: end, width: len(startNumber)}, nil
+}
+
+func (r extensionRange) contains(number int) bool {
+	return number >= r.start && number <= r.end
+}
+
+func (r extensionRange) overlaps(other extensionRange) bool {
+	return r.start <= other.end && other.start <= r.end
+}
+
+func (r extensionRange) size() int {
+	return r.end - r.start + 1
+}
+
+func (r extensionRange) String() string {
+	return fmt.Sprintf("%s-%s", r.format(r.start), r.format(r.end))
+}
+
+// format formats an extension with the same number of digits as the start number of the range
+func (r extensionRange) format(number int) string {
+	return fmt.Sprintf("%0*d", r.width, number)
+}
+
+// customizeExtensionPoolDiff checks the range of a planned extension pool against the other pools in the configuration,
+// the pools and assigned extensions in the org, and the minimum number of free extensions
+func customizeExtensionPoolDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("start_number") || !diff.NewValueKnown("end_number") {
+		return nil
+	}
+	poolRange, err := parseExtensionRange(diff.Get("start_number").(string), diff.Get("end_number").(string))
+	if err != nil {
+		return err
+	}
+	if diff.Id() == "" || diff.HasChanges("start_number", "end_number") {
+		if err := diff.SetNew("total_extensions", poolRange.size()); err != nil {
+			return err
+		}
+	}
+
+	// A pool with a new range replaces the pool in the state. The replacement is planned again without the ID of the
+	// replaced pool, which the registry keeps so the replaced pool is not reported as an overlap.
+	providerMeta := meta.(*gcloud.ProviderMeta)
+	proxy := getExtensionPoolProxy(providerMeta.ClientConfig)
+	planned, conflict := proxy.getPlannedPools(providerMeta).Register(plannedranges.Range{
+		Id:        diff.Id(),
+		Start:     poolRange.start,
+		End:       poolRange.end,
+		Replacing: diff.Id() != "" && diff.HasChanges("start_number", "end_number"),
+	})
+	if conflict != nil {
+		conflictRange := extensionRange{start: conflict.Start, end: conflict.End, width: poolRange.width}
+		return fmt.Errorf("extension pool %s overlaps extension pool %s in the configuration", poolRange, conflictRange)
+	}
+
+	return validateExtensionPoolRange(ctx, proxy, planned.Id, poolRange, diff.Get("min_free_extensions").(int))
+}
+
+// validateExtensionPoolRange checks the range of an extension pool against the pools and assigned extensions in the org.
+// The ID is empty for pools that have not been created yet, or is the ID of the pool being replaced. Pools in the org
+// that overlap the range are not an error, as they may be destroyed in the same apply. They are checked again when
+// the pool is created.
+func validateExtensionPoolRange(ctx context.Context, proxy *extensionPoolProxy, id string, poolRange extensionRange, minFree int) error {
+	extensionPools, _, err := proxy.getAllExtensionPools(ctx)
+	if err != nil {
+		return err
+	}
+	overlappingPools := make(map[string]bool)
+	for _, extensionPool := range findOverlappingExtensionPools(*extensionPools, id, poolRange) {
+		log.Printf("Extension pool %s overlaps extension pool %s. It must be destroyed before the pool is created.", poolRange, *extensionPool.Id)
+		overlappingPools[*extensionPool.Id] = true
+	}
+
+	extensions, _, err := proxy.getCachedExtensions(ctx)
+	if err != nil {
+		return err
+	}
+	assigned := 0
+	for _, extension := range *extensions {
+		number, ok := parseExtensionNumber(extension)
+		if !ok || !poolRange.contains(number) {
+			continue
+		}
+		// Extensions of an overlapping pool stay assigned when their range moves to this pool
+		if extension.ExtensionPool != nil && extension.ExtensionPool.Id != nil && overlappingPools[*extension.ExtensionPool.Id] {
+			assigned++
+			continue
+		}
+		// Extensions in the range of a new pool are assigned outside of any pool
+		if id == "" || (extension.ExtensionPool != nil && extension.ExtensionPool.Id != nil && *extension.ExtensionPool.Id != id) {
+			return fmt.Errorf("extension pool %s contains extension %s already assigned to %s outside of the pool", poolRange, *extension.Number, describeExtensionOwner(extension))
+		}
+		assigned++
+	}
+
+	if free := poolRange.size() - assigned; free < minFree {
+		return fmt.Errorf("extension pool %s has %d free extensions, fewer than min_free_extensions %d", poolRange, free, minFree)
+	}
+	return nil
+}
+
+// findOverlappingExtensionPools returns the pools in the org, other than the pool with the ID, that overlap the range
+func findOverlappingExtensionPools(extensionPools []platformclientv2.Extensionpool, id string, poolRange extensionRange) []platformclientv2.Extensionpool {
+	var overlapping []platformclientv2.Extensionpool
+	for _, extensionPool := range extensionPools {
+		if extensionPool.Id == nil || *extensionPool.Id == id || extensionPool.StartNumber == nil || extensionPool.EndNumber == nil {
+			continue
+		}
+		existingRange, err := parseExtensionRange(*extensionPool.StartNumber, *extensionPool.EndNumber)
+		if err != nil {
+			continue
+		}
+		if existingRange.overlaps(poolRange) {
+			overlapping = append(overlapping, extensionPool)
+		}
+	}
+	return overlapping
+}
+
+// waitForExtensionPoolRange waits for the pools in the org that overlap the range of a new pool to be destroyed.
+// Pools removed from the configuration are destroyed in the same apply, but not necessarily before the new pool is created.
+func waitForExtensionPoolRange(ctx context.Context, proxy *extensionPoolProxy, poolRange extensionRange) diag.Diagnostics {
+	return gcloud.WithRetries(ctx, extensionPoolOverlapTimeout, func() *retry.RetryError {
+		extensionPools, _, err := proxy.getAllExtensionPools(ctx)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if overlapping := findOverlappingExtensionPools(*extensionPools, "", poolRange); len(overlapping) > 0 {
+			return retry.RetryableError(fmt.Errorf("extension pool %s overlaps extension pool %s (%s-%s)", poolRange, *overlapping[0].Id, *overlapping[0].StartNumber, *overlapping[0].EndNumber))
+		}
+		return nil
+	})
+}
+
+// countAssignedExtensions counts the extensions within the range of the pool
+func countAssignedExtensions(extensions []platformclientv2.Extension, poolRange extensionRange) int {
+	assigned := 0
+	for _, extension := range extensions {
+		if number, ok := parseExtensionNumber(extension); ok && poolRange.contains(number) {
+			assigned++
+		}
+	}
+	return assigned
+}
+
+// findFreeExtensions returns the lowest extensions of the range not assigned to anything
+func findFreeExtensions(extensions []platformclientv2.Extension, poolRange extensionRange, count int) []string {
+	assigned := make(map[int]bool)
+	for _, extension := range extensions {
+		if number, ok := parseExtensionNumber(extension); ok && poolRange.contains(number) {
+			assigned[number] = true
+		}
+	}
+
+	free := make([]string, 0, count)
+	for number := poolRange.start; number <= poolRange.end && len(free) < count; number++ {
+		if !assigned[number] {
+			free = append(free, poolRange.format(number))
+		}
+	}
+	return free
+}
+
+func parseExtensionNumber(extension platformclientv2.Extension) (int, bool) {
+	if extension.Number == nil {
+		return 0, false
+	}
+	number, err := strconv.Atoi(*extension.Number)
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
+
+func describeExtensionOwner(extension platformclientv2.Extension) string {
+	ownerType := "an owner"
+	if extension.OwnerType != nil {
+		ownerType = *extension.OwnerType
+	}
+	if extension.Owner != nil && extension.Owner.Id != nil {
+		return fmt.Sprintf("%s %s", ownerType, *extension.Owner.Id)
+	}
+	return ownerType
+}
@@ -94,7 +94,7 @@ func ResourcePhoneBaseSettings() *schema.Resource {
 				Required:    true,
 			},
 			"properties": {
-				Description:      "phone base settings properties",
+				Description:      "phone base settings properties. Properties are validated during plan against the schema of the phone meta-base.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
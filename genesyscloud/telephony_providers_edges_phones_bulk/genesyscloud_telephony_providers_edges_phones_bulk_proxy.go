@@ -0,0 +1,322 @@
+package telephony_providers_edges_phones_bulk
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/station"
+	"terraform-provider-genesyscloud/genesyscloud/util/usercache"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_providers_edges_phones_bulk_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *phonesBulkProxy
+
+// phonesBulkExpands are the phone expands needed to compare phones in the org with the inventory file
+var phonesBulkExpands = []string{"properties", "lines"}
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllPhonesFunc func(ctx context.Context, p *phonesBulkProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error)
+type createPhoneFunc func(ctx context.Context, p *phonesBulkProxy, phone *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error)
+type updatePhoneFunc func(ctx context.Context, p *phonesBulkProxy, phoneId string, phone *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error)
+type deletePhoneFunc func(ctx context.Context, p *phonesBulkProxy, phoneId string) (*platformclientv2.APIResponse, error)
+type getAllSitesFunc func(ctx context.Context, p *phonesBulkProxy) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error)
+type getAllPhoneBaseSettingsFunc func(ctx context.Context, p *phonesBulkProxy) (*[]platformclientv2.Phonebase, *platformclientv2.APIResponse, error)
+type getPhoneBaseSettingFunc func(ctx context.Context, p *phonesBulkProxy, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error)
+type getAllPhoneMetaBasesFunc func(ctx context.Context, p *phonesBulkProxy) (*[]platformclientv2.Metabase, *platformclientv2.APIResponse, error)
+type getAllUsersFunc func(ctx context.Context, p *phonesBulkProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+type getAllStationsFunc func(ctx context.Context, p *phonesBulkProxy) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error)
+type getStationByLineIdFunc func(ctx context.Context, p *phonesBulkProxy, lineId string) (*platformclientv2.Station, *platformclientv2.APIResponse, error)
+type unassignUserFromStationFunc func(ctx context.Context, p *phonesBulkProxy, stationId string) (*platformclientv2.APIResponse, error)
+type assignUserToStationFunc func(ctx context.Context, p *phonesBulkProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
+type setUserDefaultStationFunc func(ctx context.Context, p *phonesBulkProxy, userId string, stationId string) (*platformclientv2.APIResponse, error)
+
+// phonesBulkProxy contains all of the methods that call genesys cloud APIs.
+type phonesBulkProxy struct {
+	clientConfig *platformclientv2.Configuration
+	edgesApi     *platformclientv2.TelephonyProvidersEdgeApi
+	stationsApi  *platformclientv2.StationsApi
+	usersApi     *platformclientv2.UsersApi
+
+	getAllPhonesAttr            getAllPhonesFunc
+	createPhoneAttr             createPhoneFunc
+	updatePhoneAttr             updatePhoneFunc
+	deletePhoneAttr             deletePhoneFunc
+	getAllSitesAttr             getAllSitesFunc
+	getAllPhoneBaseSettingsAttr getAllPhoneBaseSettingsFunc
+	getPhoneBaseSettingAttr     getPhoneBaseSettingFunc
+	getAllPhoneMetaBasesAttr    getAllPhoneMetaBasesFunc
+	getAllUsersAttr             getAllUsersFunc
+	getAllStationsAttr          getAllStationsFunc
+	getStationByLineIdAttr      getStationByLineIdFunc
+	unassignUserFromStationAttr unassignUserFromStationFunc
+	assignUserToStationAttr     assignUserToStationFunc
+	setUserDefaultStationAttr   setUserDefaultStationFunc
+}
+
+// newPhonesBulkProxy initializes the phones bulk proxy with all of the data needed to communicate with Genesys Cloud
+func newPhonesBulkProxy(clientConfig *platformclientv2.Configuration) *phonesBulkProxy {
+	return &phonesBulkProxy{
+		clientConfig: clientConfig,
+		edgesApi:     platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
+		stationsApi:  platformclientv2.NewStationsApiWithConfig(clientConfig),
+		usersApi:     platformclientv2.NewUsersApiWithConfig(clientConfig),
+
+		getAllPhonesAttr:            getAllPhonesFn,
+		createPhoneAttr:             createPhoneFn,
+		updatePhoneAttr:             updatePhoneFn,
+		deletePhoneAttr:             deletePhoneFn,
+		getAllSitesAttr:             getAllSitesFn,
+		getAllPhoneBaseSettingsAttr: getAllPhoneBaseSettingsFn,
+		getPhoneBaseSettingAttr:     getPhoneBaseSettingFn,
+		getAllPhoneMetaBasesAttr:    getAllPhoneMetaBasesFn,
+		getAllUsersAttr:             getAllUsersFn,
+		getAllStationsAttr:          getAllStationsFn,
+		getStationByLineIdAttr:      getStationByLineIdFn,
+		unassignUserFromStationAttr: unassignUserFromStationFn,
+		assignUserToStationAttr:     assignUserToStationFn,
+		setUserDefaultStationAttr:   setUserDefaultStationFn,
+	}
+}
+
+// getPhonesBulkProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getPhonesBulkProxy(clientConfig *platformclientv2.Configuration) *phonesBulkProxy {
+	if internalProxy == nil {
+		internalProxy = newPhonesBulkProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllPhones retrieves all phones in the org that are not deleted, with their properties and lines
+func (p *phonesBulkProxy) getAllPhones(ctx context.Context) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	return p.getAllPhonesAttr(ctx, p)
+}
+
+// createPhone creates a phone
+func (p *phonesBulkProxy) createPhone(ctx context.Context, phone *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	return p.createPhoneAttr(ctx, p, phone)
+}
+
+// updatePhone updates a phone
+func (p *phonesBulkProxy) updatePhone(ctx context.Context, phoneId string, phone *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	return p.updatePhoneAttr(ctx, p, phoneId, phone)
+}
+
+// deletePhone deletes a phone
+func (p *phonesBulkProxy) deletePhone(ctx context.Context, phoneId string) (*platformclientv2.APIResponse, error) {
+	return p.deletePhoneAttr(ctx, p, phoneId)
+}
+
+// getAllSites retrieves all managed and unmanaged sites
+func (p *phonesBulkProxy) getAllSites(ctx context.Context) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error) {
+	return p.getAllSitesAttr(ctx, p)
+}
+
+// getAllPhoneBaseSettings retrieves the ID and name of all phone base settings
+func (p *phonesBulkProxy) getAllPhoneBaseSettings(ctx context.Context) (*[]platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
+	return p.getAllPhoneBaseSettingsAttr(ctx, p)
+}
+
+// getPhoneBaseSetting retrieves phone base settings with their lines and capabilities
+func (p *phonesBulkProxy) getPhoneBaseSetting(ctx context.Context, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
+	return p.getPhoneBaseSettingAttr(ctx, p, phoneBaseSettingsId)
+}
+
+// getAllPhoneMetaBases retrieves the phone meta-bases that phone base settings can be created from
+func (p *phonesBulkProxy) getAllPhoneMetaBases(ctx context.Context) (*[]platformclientv2.Metabase, *platformclientv2.APIResponse, error) {
+	return p.getAllPhoneMetaBasesAttr(ctx, p)
+}
+
+// getAllUsers retrieves all active and inactive users in the org from the provider's user cache
+func (p *phonesBulkProxy) getAllUsers(ctx context.Context, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getAllUsersAttr(ctx, p, userCache)
+}
+
+// getAllStations retrieves all stations in the org
+func (p *phonesBulkProxy) getAllStations(ctx context.Context) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error) {
+	return p.getAllStationsAttr(ctx, p)
+}
+
+// getStationByLineId retrieves the station of a phone line. A nil station is returned if the station does not exist yet.
+func (p *phonesBulkProxy) getStationByLineId(ctx context.Context, lineId string) (*platformclientv2.Station, *platformclientv2.APIResponse, error) {
+	return p.getStationByLineIdAttr(ctx, p, lineId)
+}
+
+// unassignUserFromStation unassigns the associated user from a station
+func (p *phonesBulkProxy) unassignUserFromStation(ctx context.Context, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.unassignUserFromStationAttr(ctx, p, stationId)
+}
+
+// assignUserToStation associates a user with a station
+func (p *phonesBulkProxy) assignUserToStation(ctx context.Context, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.assignUserToStationAttr(ctx, p, userId, stationId)
+}
+
+// setUserDefaultStation makes a station the default station of a user
+func (p *phonesBulkProxy) setUserDefaultStation(ctx context.Context, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.setUserDefaultStationAttr(ctx, p, userId, stationId)
+}
+
+// getAllPhonesFn is the implementation for retrieving all phones in Genesys Cloud
+func getAllPhonesFn(_ context.Context, p *phonesBulkProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	var allPhones []platformclientv2.Phone
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		phones, resp, err := p.edgesApi.GetTelephonyProvidersEdgesPhones(pageNum, pageSize, "id", "", "", "", "", "", "", "", "", "", "", "", "", phonesBulkExpands, nil)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get phones: %v", err)
+		}
+		if phones.Entities == nil || len(*phones.Entities) == 0 {
+			break
+		}
+		for _, phone := range *phones.Entities {
+			if phone.State != nil && *phone.State == "deleted" {
+				continue
+			}
+			allPhones = append(allPhones, phone)
+		}
+		if phones.PageCount == nil || pageNum >= *phones.PageCount {
+			break
+		}
+	}
+	return &allPhones, nil, nil
+}
+
+// createPhoneFn is the implementation for creating a phone in Genesys Cloud
+func createPhoneFn(_ context.Context, p *phonesBulkProxy, phone *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	return p.edgesApi.PostTelephonyProvidersEdgesPhones(*phone)
+}
+
+// updatePhoneFn is the implementation for updating a phone in Genesys Cloud
+func updatePhoneFn(_ context.Context, p *phonesBulkProxy, phoneId string, phone *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	return p.edgesApi.PutTelephonyProvidersEdgesPhone(phoneId, *phone)
+}
+
+// deletePhoneFn is the implementation for deleting a phone in Genesys Cloud
+func deletePhoneFn(_ context.Context, p *phonesBulkProxy, phoneId string) (*platformclientv2.APIResponse, error) {
+	return p.edgesApi.DeleteTelephonyProvidersEdgesPhone(phoneId)
+}
+
+// getAllSitesFn is the implementation for retrieving all sites in Genesys Cloud
+func getAllSitesFn(_ context.Context, p *phonesBulkProxy) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error) {
+	var allSites []platformclientv2.Site
+	const pageSize = 100
+
+	// Managed sites such as the cloud site of WebRTC phones are listed separately
+	for _, managed := range []bool{false, true} {
+		for pageNum := 1; ; pageNum++ {
+			sites, resp, err := p.edgesApi.GetTelephonyProvidersEdgesSites(pageSize, pageNum, "", "", "", "", managed)
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get sites: %v", err)
+			}
+			if sites.Entities == nil || len(*sites.Entities) == 0 {
+				break
+			}
+			allSites = append(allSites, *sites.Entities...)
+			if sites.PageCount == nil || pageNum >= *sites.PageCount {
+				break
+			}
+		}
+	}
+	return &allSites, nil, nil
+}
+
+// getAllPhoneBaseSettingsFn is the implementation for retrieving all phone base settings in Genesys Cloud
+func getAllPhoneBaseSettingsFn(_ context.Context, p *phonesBulkProxy) (*[]platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
+	var allPhoneBases []platformclientv2.Phonebase
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		phoneBases, resp, err := p.edgesApi.GetTelephonyProvidersEdgesPhonebasesettings(pageSize, pageNum, "", "", nil, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get phone base settings: %v", err)
+		}
+		if phoneBases.Entities == nil || len(*phoneBases.Entities) == 0 {
+			break
+		}
+		for _, phoneBase := range *phoneBases.Entities {
+			if phoneBase.State != nil && *phoneBase.State == "deleted" {
+				continue
+			}
+			allPhoneBases = append(allPhoneBases, phoneBase)
+		}
+		if phoneBases.PageCount == nil || pageNum >= *phoneBases.PageCount {
+			break
+		}
+	}
+	return &allPhoneBases, nil, nil
+}
+
+// getPhoneBaseSettingFn is the implementation for retrieving phone base settings in Genesys Cloud
+func getPhoneBaseSettingFn(_ context.Context, p *phonesBulkProxy, phoneBaseSettingsId string) (*platformclientv2.Phonebase, *platformclientv2.APIResponse, error) {
+	return p.edgesApi.GetTelephonyProvidersEdgesPhonebasesetting(phoneBaseSettingsId)
+}
+
+// getAllPhoneMetaBasesFn is the implementation for retrieving all phone meta-bases in Genesys Cloud
+func getAllPhoneMetaBasesFn(_ context.Context, p *phonesBulkProxy) (*[]platformclientv2.Metabase, *platformclientv2.APIResponse, error) {
+	var allMetaBases []platformclientv2.Metabase
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		metaBases, resp, err := p.edgesApi.GetTelephonyProvidersEdgesPhonebasesettingsAvailablemetabases(pageSize, pageNum)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get phone meta-bases: %v", err)
+		}
+		if metaBases.Entities == nil || len(*metaBases.Entities) == 0 {
+			break
+		}
+		allMetaBases = append(allMetaBases, *metaBases.Entities...)
+		if metaBases.PageCount == nil || pageNum >= *metaBases.PageCount {
+			break
+		}
+	}
+	return &allMetaBases, nil, nil
+}
+
+// getAllUsersFn is the implementation for retrieving all users in Genesys Cloud. The users are only
+// read once per provider run unless a user resource changes them.
+func getAllUsersFn(ctx context.Context, p *phonesBulkProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return userCache.GetUsers(ctx, func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return usercache.GetAllUsers(ctx, p.usersApi)
+	})
+}
+
+// getAllStationsFn is the implementation for retrieving all stations in Genesys Cloud
+func getAllStationsFn(ctx context.Context, p *phonesBulkProxy) (*[]platformclientv2.Station, *platformclientv2.APIResponse, error) {
+	return station.GetAllStations(ctx, p.clientConfig)
+}
+
+// getStationByLineIdFn is the implementation for retrieving the station of a phone line in Genesys Cloud
+func getStationByLineIdFn(_ context.Context, p *phonesBulkProxy, lineId string) (*platformclientv2.Station, *platformclientv2.APIResponse, error) {
+	stations, resp, err := p.stationsApi.GetStations(1, 1, "", "", "", "", "", lineId)
+	if err != nil {
+		return nil, resp, err
+	}
+	if stations.Entities == nil || len(*stations.Entities) == 0 {
+		return nil, resp, nil
+	}
+	return &(*stations.Entities)[0], resp, nil
+}
+
+// unassignUserFromStationFn is the implementation for unassigning the user of a station in Genesys Cloud
+func unassignUserFromStationFn(_ context.Context, p *phonesBulkProxy, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.stationsApi.DeleteStationAssociateduser(stationId)
+}
+
+// assignUserToStationFn is the implementation for assigning a user to a station in Genesys Cloud
+func assignUserToStationFn(_ context.Context, p *phonesBulkProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.usersApi.PutUserStationAssociatedstationStationId(userId, stationId)
+}
+
+// setUserDefaultStationFn is the implementation for setting the default station of a user in Genesys Cloud
+func setUserDefaultStationFn(_ context.Context, p *phonesBulkProxy, userId string, stationId string) (*platformclientv2.APIResponse, error) {
+	return p.usersApi.PutUserStationDefaultstationStationId(userId, stationId)
+}
@@ -0,0 +1,407 @@
+package telephony_providers_edges_phones_bulk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/usercache"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func createPhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+
+	log.Printf("Creating phones from %s", d.Get("filepath").(string))
+	diags := syncPhonesBulk(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	log.Printf("Created phones from %s", d.Get("filepath").(string))
+	return append(diags, readPhonesBulk(ctx, d, meta)...)
+}
+
+// readPhonesBulk keeps the managed phones in the state. Listing every phone in the org on each refresh is too slow
+// for large inventories, so phones changed or deleted outside of Terraform are detected during plan instead.
+func readPhonesBulk(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("Read %d phones for %s", len(bulk.GetManaged(d, phonesBulkAttributes)), d.Id())
+	return nil
+}
+
+func updatePhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating phones from %s", d.Get("filepath").(string))
+	diags := syncPhonesBulk(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("Updated phones from %s", d.Get("filepath").(string))
+	return append(diags, readPhonesBulk(ctx, d, meta)...)
+}
+
+func deletePhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getPhonesBulkProxy(sdkConfig)
+	managedPhones := bulk.GetManaged(d, phonesBulkAttributes)
+	createdPhones := bulk.GetCreated(d, phonesBulkAttributes)
+
+	log.Printf("Deleting %d phones for %s", len(managedPhones), d.Id())
+
+	deletes := make([]*bulk.Removal, 0, len(managedPhones))
+	for _, name := range bulk.SortedKeys(managedPhones) {
+		id := managedPhones[name]
+		if !createdPhones[id] {
+			log.Printf("Releasing phone %s. Removing it from the state only", name)
+			continue
+		}
+		deletes = append(deletes, &bulk.Removal{Key: name, Id: id})
+	}
+
+	var mutex sync.Mutex
+	var deleteErrors []string
+	processInBatches(deletes, d.Get("batch_size").(int), func(deleted *bulk.Removal) {
+		if err := deleteBulkPhone(ctx, proxy, deleted.Id); err != nil {
+			mutex.Lock()
+			deleteErrors = append(deleteErrors, fmt.Sprintf("%s: %v", deleted.Key, err))
+			mutex.Unlock()
+		}
+	})
+	if len(deleteErrors) > 0 {
+		sort.Strings(deleteErrors)
+		return diag.Errorf("Failed to delete %d phones:\n%s", len(deleteErrors), strings.Join(deleteErrors, "\n"))
+	}
+
+	log.Printf("Deleted phones for %s", d.Id())
+	return nil
+}
+
+// syncPhonesBulk compares the inventory file with the org and applies the differences. Phones that fail to
+// sync are reported as warnings and in the row_errors attribute.
+func syncPhonesBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*gcloud.ProviderMeta)
+	proxy := getPhonesBulkProxy(providerMeta.ClientConfig)
+	filePath := d.Get("filepath").(string)
+
+	rows, err := readPhonesBulkFile(filePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	refs, err := getPhonesBulkReferences(ctx, proxy, rows, providerMeta.UserCache)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orgPhones, _, err := proxy.getAllPhones(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to read phones: %s", err)
+	}
+
+	previousPhones := bulk.GetManaged(d, phonesBulkAttributes)
+	createdPhones := bulk.GetCreated(d, phonesBulkAttributes)
+	phonesDiff := diffPhonesBulk(rows, refs, *orgPhones, previousPhones, createdPhones)
+	log.Printf("Syncing %s will create %d, update %d, delete %d and release %d phones", filePath, len(phonesDiff.Created), len(phonesDiff.Updated), len(phonesDiff.Deleted), len(phonesDiff.Released))
+
+	setDefaultStation := d.Get("set_default_station").(bool)
+	managedPhones, rowErrors := applyPhonesBulkDiff(ctx, proxy, phonesDiff, d.Get("batch_size").(int), setDefaultStation)
+	// The default stations of the users are read from the user cache
+	for _, change := range append(append([]*bulkPhoneChange{}, phonesDiff.Created...), phonesDiff.Updated...) {
+		if change.phone.stationUserId != "" || (change.phone.userId != "" && setDefaultStation) {
+			providerMeta.UserCache.Invalidate()
+			break
+		}
+	}
+	for _, change := range phonesDiff.Created {
+		if change.existing != nil {
+			createdPhones[*change.existing.Id] = true
+		}
+	}
+
+	return bulk.SaveSync(d, phonesBulkAttributes, filePath, phonesDiff, previousPhones, managedPhones, createdPhones, rowErrors)
+}
+
+// applyPhonesBulkDiff creates, updates and deletes phones in parallel batches. It returns the phones that are now
+// managed and the phones that failed to sync.
+func applyPhonesBulkDiff(ctx context.Context, proxy *phonesBulkProxy, phonesDiff *phonesBulkDiff, batchSize int, setDefaultStation bool) (map[string]string, []bulk.RowError) {
+	managedPhones := make(map[string]string, len(phonesDiff.Unchanged))
+	for name, id := range phonesDiff.Unchanged {
+		managedPhones[name] = id
+	}
+	rowErrors := append([]bulk.RowError{}, phonesDiff.RowErrors...)
+
+	var mutex sync.Mutex
+	manage := func(name string, id string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		managedPhones[name] = id
+	}
+	fail := func(position string, name string, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		rowErrors = append(rowErrors, bulk.RowError{Position: position, Key: name, Message: err.Error()})
+	}
+
+	changes := append(append([]*bulkPhoneChange{}, phonesDiff.Created...), phonesDiff.Updated...)
+	processInBatches(changes, batchSize, func(change *bulkPhoneChange) {
+		row := change.phone.row
+		if change.existing != nil {
+			// Existing phones stay managed even if the update fails
+			manage(row.name, *change.existing.Id)
+		}
+		phoneId, err := syncBulkPhone(ctx, proxy, change, setDefaultStation)
+		if phoneId != "" {
+			manage(row.name, phoneId)
+		}
+		if err != nil {
+			fail(row.position, row.name, err)
+		}
+	})
+
+	for _, released := range phonesDiff.Released {
+		log.Printf("Releasing phone %s. Removing it from the state only", released.Key)
+	}
+	processInBatches(phonesDiff.Deleted, batchSize, func(deleted *bulk.Removal) {
+		if err := deleteBulkPhone(ctx, proxy, deleted.Id); err != nil {
+			// Keep managing the phone so the delete is retried on the next apply
+			manage(deleted.Key, deleted.Id)
+			fail("removed from file", deleted.Key, err)
+		}
+	})
+
+	sort.SliceStable(rowErrors, func(i, j int) bool {
+		return bulk.PositionNumber(rowErrors[i].Position+":") < bulk.PositionNumber(rowErrors[j].Position+":")
+	})
+	return managedPhones, rowErrors
+}
+
+// syncBulkPhone creates or updates a phone and assigns its user or station user. It returns the ID of the phone once it exists.
+func syncBulkPhone(ctx context.Context, proxy *phonesBulkProxy, change *bulkPhoneChange, setDefaultStation bool) (string, error) {
+	phone := change.phone
+	sdkPhone := buildSdkBulkPhone(phone, change.existing)
+
+	if change.existing == nil {
+		log.Printf("Creating phone %s", phone.row.name)
+		var createdPhone *platformclientv2.Phone
+		// Phone base settings created in the same apply may not be available yet
+		diagErr := gcloud.RetryWhen(gcloud.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			created, resp, err := proxy.createPhone(ctx, sdkPhone)
+			if err != nil {
+				return resp, diag.FromErr(err)
+			}
+			createdPhone = created
+			return nil, nil
+		})
+		if diagErr != nil {
+			return "", fmt.Errorf("failed to create phone: %s", diagErr[0].Summary)
+		}
+		change.existing = createdPhone
+		if phone.userId == "" && phone.stationUserId == "" {
+			return *createdPhone.Id, nil
+		}
+		return *createdPhone.Id, assignBulkPhoneUser(ctx, proxy, phone, createdPhone, setDefaultStation)
+	}
+
+	phoneId := *change.existing.Id
+	if len(lists.SliceDifference(change.changes, []string{"user", "station"})) > 0 {
+		log.Printf("Updating %s for phone %s", strings.Join(change.changes, ", "), phone.row.name)
+		updatedPhone, _, err := proxy.updatePhone(ctx, phoneId, sdkPhone)
+		if err != nil {
+			return phoneId, fmt.Errorf("failed to update phone: %v", err)
+		}
+		change.existing = updatedPhone
+	}
+	if lists.ItemInSlice("user", change.changes) || lists.ItemInSlice("station", change.changes) {
+		return phoneId, assignBulkPhoneUser(ctx, proxy, phone, change.existing, setDefaultStation)
+	}
+	return phoneId, nil
+}
+
+// assignBulkPhoneUser assigns the user of a phone to the station of the phone and, if setDefaultStation is true, makes
+// it the default station of the user. The station user of a phone only gets the station as their default station and
+// is not assigned to it. The station of a new phone is created asynchronously so it is polled for.
+func assignBulkPhoneUser(ctx context.Context, proxy *phonesBulkProxy, phone *bulkPhone, sdkPhone *platformclientv2.Phone, setDefaultStation bool) error {
+	lineId := getPhoneLineId(sdkPhone)
+	if lineId == "" {
+		return fmt.Errorf("phone %s has no line to assign a user to", phone.row.name)
+	}
+
+	var station *platformclientv2.Station
+	diagErr := gcloud.WithRetries(ctx, 60*time.Second, func() *retry.RetryError {
+		lineStation, _, err := proxy.getStationByLineId(ctx, lineId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get station of phone: %v", err))
+		}
+		if lineStation == nil {
+			return retry.RetryableError(fmt.Errorf("station of phone %s not found", phone.row.name))
+		}
+		station = lineStation
+		return nil
+	})
+	if diagErr != nil {
+		return fmt.Errorf("%s", diagErr[0].Summary)
+	}
+
+	if phone.stationUserId != "" {
+		diagErr = gcloud.RetryWhen(gcloud.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			if resp, err := proxy.setUserDefaultStation(ctx, phone.stationUserId, *station.Id); err != nil {
+				return resp, diag.Errorf("failed to set station %s as the default station of user %s: %v", *station.Id, *phone.row.station, err)
+			}
+			return nil, nil
+		})
+		if diagErr != nil {
+			return fmt.Errorf("%s", diagErr[0].Summary)
+		}
+		return nil
+	}
+
+	if stringValue(station.UserId) == phone.userId {
+		return nil
+	}
+
+	diagErr = gcloud.RetryWhen(gcloud.IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		if station.Status != nil && *station.Status == "ASSOCIATED" {
+			log.Printf("Disassociating user from station %s of phone %s", *station.Id, phone.row.name)
+			if resp, err := proxy.unassignUserFromStation(ctx, *station.Id); err != nil {
+				return resp, diag.Errorf("failed to unassign user from station %s: %v", *station.Id, err)
+			}
+		}
+		if resp, err := proxy.assignUserToStation(ctx, phone.userId, *station.Id); err != nil {
+			return resp, diag.Errorf("failed to assign user %s to station %s: %v", *phone.row.user, *station.Id, err)
+		}
+		if setDefaultStation {
+			if resp, err := proxy.setUserDefaultStation(ctx, phone.userId, *station.Id); err != nil {
+				return resp, diag.Errorf("failed to set station %s as the default station of user %s: %v", *station.Id, *phone.row.user, err)
+			}
+		}
+		return nil, nil
+	})
+	if diagErr != nil {
+		return fmt.Errorf("%s", diagErr[0].Summary)
+	}
+	return nil
+}
+
+// deleteBulkPhone deletes a phone. Phones that are already deleted are ignored.
+func deleteBulkPhone(ctx context.Context, proxy *phonesBulkProxy, phoneId string) error {
+	resp, err := proxy.deletePhone(ctx, phoneId)
+	if err != nil && !gcloud.IsStatus404(resp) {
+		return err
+	}
+	return nil
+}
+
+// processInBatches calls process for every item, running up to batchSize calls in parallel
+func processInBatches[T any](items []T, batchSize int, process func(T)) {
+	for _, batch := range chunks.ChunkBy(items, batchSize) {
+		var wg sync.WaitGroup
+		for _, item := range batch {
+			wg.Add(1)
+			go func(item T) {
+				defer wg.Done()
+				process(item)
+			}(item)
+		}
+		wg.Wait()
+	}
+}
+
+// getPhonesBulkReferences reads the sites, phone base settings, meta-bases, users and stations that can be referenced in an inventory file.
+// Only the phone base settings used in the file are read in full. The users are read from the provider's user cache.
+func getPhonesBulkReferences(ctx context.Context, proxy *phonesBulkProxy, rows []*bulkPhoneRow, userCache *usercache.UserCache) (*phonesBulkReferences, error) {
+	sites, _, err := proxy.getAllSites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metaBases, _, err := proxy.getAllPhoneMetaBases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allPhoneBases, _, err := proxy.getAllPhoneBaseSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usedPhoneBases := make(map[string]bool)
+	hasUsers := false
+	for _, row := range rows {
+		usedPhoneBases[strings.ToLower(row.phoneBaseSettings)] = true
+		hasUsers = hasUsers || row.user != nil || row.station != nil
+	}
+	phoneBases := make(map[string]*platformclientv2.Phonebase)
+	for _, phoneBase := range *allPhoneBases {
+		if phoneBase.Id == nil || phoneBase.Name == nil || !usedPhoneBases[strings.ToLower(*phoneBase.Name)] {
+			continue
+		}
+		fullPhoneBase, _, err := proxy.getPhoneBaseSetting(ctx, *phoneBase.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get phone base settings %s: %v", *phoneBase.Name, err)
+		}
+		phoneBases[strings.ToLower(*phoneBase.Name)] = fullPhoneBase
+	}
+
+	users := &[]platformclientv2.User{}
+	stations := &[]platformclientv2.Station{}
+	if hasUsers {
+		if users, _, err = proxy.getAllUsers(ctx, userCache); err != nil {
+			return nil, err
+		}
+		if stations, _, err = proxy.getAllStations(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return buildPhonesBulkReferences(*sites, phoneBases, *metaBases, *users, *stations), nil
+}
+
+// customizePhonesBulkDiff validates the inventory file and compares it with the org. Any difference between the
+// file and the org, including changes made outside of Terraform, causes the phones to be synced on apply.
+func customizePhonesBulkDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("filepath") || !diff.NewValueKnown("file_content_hash") {
+		// The file is not known until apply. The phones will be validated then.
+		return bulk.SetDiffComputed(diff, phonesBulkAttributes)
+	}
+
+	filePath := diff.Get("filepath").(string)
+	rows, err := readPhonesBulkFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	providerMeta := meta.(*gcloud.ProviderMeta)
+	proxy := getPhonesBulkProxy(providerMeta.ClientConfig)
+
+	refs, err := getPhonesBulkReferences(ctx, proxy, rows, providerMeta.UserCache)
+	if err != nil {
+		return err
+	}
+
+	orgPhones, _, err := proxy.getAllPhones(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed to read phones: %s", err)
+	}
+
+	managedPhones := bulk.GetManaged(diff, phonesBulkAttributes)
+	createdPhones := bulk.GetCreated(diff, phonesBulkAttributes)
+
+	phonesDiff := diffPhonesBulk(rows, refs, *orgPhones, managedPhones, createdPhones)
+	log.Printf("Syncing %s will create %d, update %d, delete %d and release %d phones", filePath, len(phonesDiff.Created), len(phonesDiff.Updated), len(phonesDiff.Deleted), len(phonesDiff.Released))
+	for _, rowError := range phonesDiff.RowErrors {
+		log.Printf("Phone %s at %s in %s will not be synced: %s", rowError.Key, rowError.Position, filePath, rowError.Message)
+	}
+
+	return bulk.PlanSync(diff, phonesBulkAttributes, phonesDiff, phonesBulkRenamed(phonesDiff))
+}
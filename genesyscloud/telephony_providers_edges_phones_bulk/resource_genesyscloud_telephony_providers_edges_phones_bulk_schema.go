@@ -0,0 +1,128 @@
+package telephony_providers_edges_phones_bulk
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_telephony_providers_edges_phones_bulk_schema.go holds two functions within it:
+
+1.  The registration code that registers the Resource for the package.
+2.  The resource schema definitions for the telephony_providers_edges_phones_bulk resource.
+*/
+const resourceName = "genesyscloud_telephony_providers_edges_phones_bulk"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourcePhonesBulk())
+}
+
+var rowErrorResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"row": {
+			Description: "Position of the phone in the inventory file, e.g. `line 3`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the phone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"message": {
+			Description: "Reason the phone could not be synced.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// ResourcePhonesBulk registers the genesyscloud_telephony_providers_edges_phones_bulk resource with Terraform
+func ResourcePhonesBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Phones Bulk. Manages many phones from a single hardware inventory CSV file. " +
+			"Phones are matched to the phones this resource already manages, then to existing phones in the org by hardware ID. " +
+			"Existing phones that are matched are updated but never deleted. Phones created by this resource are deleted when they are removed from the file or the resource is destroyed. " +
+			"Phones without a hardware ID, such as WebRTC phones, are created unless this resource already manages them. " +
+			"Phones that fail validation or fail to sync are reported in `row_errors` and as warnings without failing the apply, and are retried on the next apply. " +
+			"Do not manage the same phones with genesyscloud_telephony_providers_edges_phone.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createPhonesBulk),
+		ReadContext:   gcloud.ReadWithPooledClient(readPhonesBulk),
+		UpdateContext: gcloud.UpdateWithPooledClient(updatePhonesBulk),
+		DeleteContext: gcloud.DeleteWithPooledClient(deletePhonesBulk),
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"filepath": {
+				Description: "Path to a CSV file with a header row describing the phones. " +
+					"The `name`, `site` and `phone_base_settings` columns are required. " +
+					"The optional columns are `model`, `hardware_id`, `user`, `station` and `line_addresses`. " +
+					"Sites and phone base settings are referenced by name and users by email. " +
+					"`model` is the name of the phone meta-base and is checked against the meta-base of the phone base settings. " +
+					"`hardware_id` is required for phone models that are provisioned by hardware ID, such as a MAC address. " +
+					"`user` is the WebRTC user of WebRTC phones, or the user assigned to the station of hardware phones. See `set_default_station`. " +
+					"`station` is the email of a user whose default station becomes the station of a hardware phone without assigning the user to it. Only one of `user` and `station` may be set. " +
+					"`line_addresses` are E.164 numbers separated by `;` and make the phone a standalone phone.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: gcloud.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the inventory file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"batch_size": {
+				Description:  "Number of phones created, updated or deleted in parallel.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"set_default_station": {
+				Description: "Whether the station of a hardware phone is also made the default station of the user in the `user` column when the user is assigned to it.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"phones": {
+				Description: "Map of name to phone ID for every phone managed by this resource.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_phones": {
+				Description: "IDs of the managed phones that were created by this resource. Only these phones are deleted by this resource.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"phones_created": {
+				Description: "Number of phones created by the most recent sync. Calculated during plan.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"phones_updated": {
+				Description: "Number of existing phones changed by the most recent sync. Calculated during plan.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"phones_deleted": {
+				Description: "Number of phones created by this resource that were deleted by the most recent sync. Existing phones removed from the file are no longer managed but are not deleted. Calculated during plan.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"row_errors": {
+				Description: "Phones in the file that could not be synced by the most recent apply.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        rowErrorResource,
+			},
+		},
+		CustomizeDiff: customizePhonesBulkDiff,
+	}
+}
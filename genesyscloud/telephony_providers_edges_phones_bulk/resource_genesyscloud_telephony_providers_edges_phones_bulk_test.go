@@ -0,0 +1,143 @@
+package telephony_providers_edges_phones_bulk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	phoneBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phonebasesettings"
+	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func TestAccResourcePhonesBulk(t *testing.T) {
+	var (
+		phonesResource1       = "test-phones"
+		phoneBaseSettingsRes  = "test-phone-base-settings"
+		phoneBaseSettingsName = "Terraform Phones Bulk " + uuid.NewString()
+		userResource1         = "test-user-1"
+		userResource2         = "test-user-2"
+		suffix                = strings.Replace(uuid.NewString(), "-", "", -1)
+		email1                = "terraform-phones-bulk-1-" + suffix + "@example.com"
+		email2                = "terraform-phones-bulk-2-" + suffix + "@example.com"
+		phoneName1            = "Terraform Bulk Phone 1 " + suffix
+		phoneName2            = "Terraform Bulk Phone 2 " + suffix
+
+		phonesFile1 = filepath.Join(t.TempDir(), "phones1.csv")
+		phonesFile2 = filepath.Join(t.TempDir(), "phones2.csv")
+	)
+
+	sdkConfig, err := gcloud.AuthorizeSdk()
+	if err != nil {
+		t.Skipf("failed to authorize sdk: %v", err)
+	}
+	siteId, err := edgeSite.GetOrganizationDefaultSiteId(sdkConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	site, _, err := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig).GetTelephonyProvidersEdgesSite(siteId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csvContent := "name,site,phone_base_settings,user\n" +
+		fmt.Sprintf("%s,%s,%s,%s\n", phoneName1, *site.Name, phoneBaseSettingsName, email1) +
+		fmt.Sprintf("%s,%s,%s,%s\n", phoneName2, *site.Name, phoneBaseSettingsName, email2)
+	if err := os.WriteFile(phonesFile1, []byte(csvContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The second phone is removed and the first phone is assigned to the second user
+	csvContent = "name,site,phone_base_settings,user\n" +
+		fmt.Sprintf("%s,%s,%s,%s\n", phoneName1, *site.Name, phoneBaseSettingsName, email2)
+	if err := os.WriteFile(phonesFile2, []byte(csvContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	baseConfig := phoneBaseSettings.GeneratePhoneBaseSettingsResourceWithCustomAttrs(
+		phoneBaseSettingsRes,
+		phoneBaseSettingsName,
+		"Terraform phones bulk",
+		"inin_webrtc_softphone.json",
+	) + gcloud.GenerateBasicUserResource(userResource1, email1, "Terraform Phones Bulk 1") +
+		gcloud.GenerateBasicUserResource(userResource2, email2, "Terraform Phones Bulk 2")
+	dependsOn := strings.Join([]string{
+		"genesyscloud_telephony_providers_edges_phonebasesettings." + phoneBaseSettingsRes,
+		"genesyscloud_user." + userResource1,
+		"genesyscloud_user." + userResource2,
+	}, ", ")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create two WebRTC phones from a CSV file
+				Config: baseConfig + generatePhonesBulkResource(phonesResource1, phonesFile1, dependsOn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "phones.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName+"."+phonesResource1, "phones."+phoneName1),
+					resource.TestCheckResourceAttrSet(resourceName+"."+phonesResource1, "phones."+phoneName2),
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "created_phones.#", "2"),
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "row_errors.#", "0"),
+				),
+			},
+			{
+				// Delete one phone and update the user of the other
+				Config: baseConfig + generatePhonesBulkResource(phonesResource1, phonesFile2, dependsOn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "phones.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName+"."+phonesResource1, "phones."+phoneName1),
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "phones_updated", "1"),
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "phones_deleted", "1"),
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "created_phones.#", "1"),
+					resource.TestCheckResourceAttr(resourceName+"."+phonesResource1, "row_errors.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyPhonesBulkDestroyed,
+	})
+}
+
+func generatePhonesBulkResource(resourceID string, filePath string, dependsOn string) string {
+	return fmt.Sprintf(`resource "%s" "%s" {
+		filepath = %s
+		file_content_hash = filesha256(%s)
+		depends_on = [%s]
+	}
+	`, resourceName, resourceID, strconv.Quote(filePath), strconv.Quote(filePath), dependsOn)
+}
+
+func testVerifyPhonesBulkDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceName {
+			continue
+		}
+
+		for attr, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(attr, "phones.") || attr == "phones.%" {
+				continue
+			}
+			phone, resp, err := edgesAPI.GetTelephonyProvidersEdgesPhone(id)
+			if phone != nil && (phone.State == nil || *phone.State != "deleted") {
+				return fmt.Errorf("Phone (%s) still exists", id)
+			} else if phone != nil || gcloud.IsStatus404(resp) {
+				// Phone not found as expected
+				continue
+			} else {
+				// Unexpected error
+				return fmt.Errorf("Unexpected error: %s", err)
+			}
+		}
+	}
+	// Success. All phones destroyed
+	return nil
+}
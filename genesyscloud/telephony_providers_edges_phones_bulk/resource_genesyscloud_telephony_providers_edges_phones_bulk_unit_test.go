@@ -0,0 +1,337 @@
+package telephony_providers_edges_phones_bulk
+
+import (
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestPhoneBase(id string, name string, metaBaseId string, hardwareIdType string, maxLineKeys int) *platformclientv2.Phonebase {
+	lineBaseId := id + "-line"
+	properties := map[string]interface{}{
+		"phone_maxLineKeys": buildPropertyValue(float64(maxLineKeys)),
+	}
+	phoneBase := &platformclientv2.Phonebase{
+		Id:            &id,
+		Name:          &name,
+		PhoneMetaBase: &platformclientv2.Domainentityref{Id: &metaBaseId},
+		Lines:         &[]platformclientv2.Linebase{{Id: &lineBaseId}},
+		Properties:    &properties,
+		Capabilities:  &platformclientv2.Phonecapabilities{},
+	}
+	if hardwareIdType != "" {
+		phoneBase.Capabilities.HardwareIdType = &hardwareIdType
+	}
+	return phoneBase
+}
+
+func buildTestPhonesBulkReferences() *phonesBulkReferences {
+	stationId := "station-1"
+	lineId := "line-1"
+	userId := "user-alice"
+	return &phonesBulkReferences{
+		sites: map[string]string{"hq": "site-hq"},
+		phoneBases: map[string]*platformclientv2.Phonebase{
+			"desk phones": buildTestPhoneBase("base-desk", "Desk Phones", "polycom_vvx_400.json", "mac", 2),
+			"webrtc":      buildTestPhoneBase("base-webrtc", "WebRTC", "inin_webrtc_softphone.json", "", 1),
+		},
+		metaBaseNames: map[string]string{
+			"polycom_vvx_400.json":       "Polycom VVX 400",
+			"inin_webrtc_softphone.json": "WebRTC",
+		},
+		users: map[string]string{"alice@example.com": "user-alice", "bob@example.com": "user-bob"},
+		stations: map[string]*platformclientv2.Station{
+			lineId: {Id: &stationId, LineAppearanceId: &lineId, UserId: &userId},
+		},
+		defaultStations: map[string]string{"user-bob": stationId},
+	}
+}
+
+func TestUnitPhonesBulkCsvParsing(t *testing.T) {
+	rows, err := parsePhonesBulkCsv(strings.NewReader(
+		"\ufeffname,site,phone_base_settings,model,hardware_id,user,station,line_addresses\n" +
+			"Desk 1,HQ,Desk Phones,Polycom VVX 400,00:04:F2:AA:BB:CC,alice@example.com,,+13175550100;+13175550101\n" +
+			"Soft 1,HQ,WebRTC,,,bob@example.com,,\n" +
+			"Desk 2,HQ,Desk Phones,,00:04:F2:AA:BB:DD,,bob@example.com,\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(rows))
+
+	desk := rows[0]
+	assert.Equal(t, "line 2", desk.position)
+	assert.Equal(t, "Desk 1", desk.name)
+	assert.Equal(t, "HQ", desk.site)
+	assert.Equal(t, "Desk Phones", desk.phoneBaseSettings)
+	assert.Equal(t, "Polycom VVX 400", *desk.model)
+	assert.Equal(t, "00:04:F2:AA:BB:CC", *desk.hardwareId)
+	assert.Equal(t, "alice@example.com", *desk.user)
+	assert.Equal(t, []string{"+13175550100", "+13175550101"}, desk.lineAddresses)
+
+	// Empty cells are not set
+	soft := rows[1]
+	assert.Nil(t, soft.model)
+	assert.Nil(t, soft.hardwareId)
+	assert.Equal(t, []string{}, soft.lineAddresses)
+	assert.Nil(t, soft.station)
+
+	assert.Nil(t, rows[2].user)
+	assert.Equal(t, "bob@example.com", *rows[2].station)
+}
+
+func TestUnitPhonesBulkCsvValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		errText string
+	}{
+		{"unknown column", "name,site,phone_base_settings,color\n", `unknown column "color"`},
+		{"missing column", "name,site\n", `"phone_base_settings" column is required`},
+		{"empty site", "name,site,phone_base_settings\nDesk 1,,Desk Phones\n", "line 2: site must not be empty"},
+		{"duplicate name", "name,site,phone_base_settings\nDesk 1,HQ,Desk Phones\nDesk 1,HQ,Desk Phones\n", `line 3: duplicate name "Desk 1", first defined at line 2`},
+		{"duplicate hardware id", "name,site,phone_base_settings,hardware_id\nDesk 1,HQ,Desk Phones,0004f2aabbcc\nDesk 2,HQ,Desk Phones,00-04-F2-AA-BB-CC\n", `line 3: duplicate hardware_id "00-04-F2-AA-BB-CC"`},
+		{"user and station", "name,site,phone_base_settings,user,station\nDesk 1,HQ,Desk Phones,alice@example.com,bob@example.com\n", "line 2: only one of user and station may be set"},
+		{"invalid line address", "name,site,phone_base_settings,line_addresses\nDesk 1,HQ,Desk Phones,3175550100\n", `line 2: line address "3175550100" is not a valid E.164 number`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parsePhonesBulkCsv(strings.NewReader(tc.content))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.errText)
+			}
+		})
+	}
+}
+
+func TestUnitPhonesBulkResolveRow(t *testing.T) {
+	refs := buildTestPhonesBulkReferences()
+	str := func(s string) *string { return &s }
+
+	phone, err := resolveBulkPhoneRow(&bulkPhoneRow{
+		name:              "Desk 1",
+		site:              "hq",
+		phoneBaseSettings: "Desk Phones",
+		model:             str("polycom vvx 400"),
+		hardwareId:        str("00:04:F2:AA:BB:CC"),
+		user:              str("Alice@example.com"),
+		lineAddresses:     []string{"+13175550100"},
+	}, refs)
+	assert.Nil(t, err)
+	assert.Equal(t, "site-hq", phone.siteId)
+	assert.Equal(t, "0004f2aabbcc", phone.hardwareId)
+	assert.Equal(t, "user-alice", phone.userId)
+	assert.False(t, phone.webRtc)
+
+	phone, err = resolveBulkPhoneRow(&bulkPhoneRow{name: "Soft 1", site: "HQ", phoneBaseSettings: "WebRTC", user: str("bob@example.com")}, refs)
+	assert.Nil(t, err)
+	assert.True(t, phone.webRtc)
+
+	// The station user of a hardware phone is not assigned to the station
+	phone, err = resolveBulkPhoneRow(&bulkPhoneRow{name: "Desk 2", site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("0004f2aabbdd"), station: str("Bob@example.com")}, refs)
+	assert.Nil(t, err)
+	assert.Equal(t, "user-bob", phone.stationUserId)
+	assert.Equal(t, "", phone.userId)
+
+	testCases := []struct {
+		name    string
+		row     *bulkPhoneRow
+		errText string
+	}{
+		{"unknown site", &bulkPhoneRow{site: "Branch", phoneBaseSettings: "WebRTC"}, `site "Branch" not found`},
+		{"unknown phone base", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "Lobby"}, `phone base settings "Lobby" not found`},
+		{"model mismatch", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "Desk Phones", model: str("Yealink T54W"), hardwareId: str("0004f2aabbcc")}, `model "Yealink T54W" does not match`},
+		{"missing hardware id", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "Desk Phones"}, "a hardware_id of type mac is required"},
+		{"invalid mac", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("00:04:F2:AA")}, "is not a valid MAC address"},
+		{"unexpected hardware id", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "WebRTC", hardwareId: str("0004f2aabbcc")}, "do not use a hardware ID"},
+		{"too many lines", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("0004f2aabbcc"), lineAddresses: []string{"+13175550100", "+13175550101", "+13175550102"}}, "exceed the 2 lines"},
+		{"webrtc without user", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "WebRTC"}, "a user is required for WebRTC phones"},
+		{"unknown user", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "WebRTC", user: str("carol@example.com")}, `user "carol@example.com" not found`},
+		{"webrtc station", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "WebRTC", station: str("bob@example.com")}, "station is not supported for WebRTC phones"},
+		{"unknown station user", &bulkPhoneRow{site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("0004f2aabbcc"), station: str("carol@example.com")}, `station user "carol@example.com" not found`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resolveBulkPhoneRow(tc.row, refs)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.errText)
+			}
+		})
+	}
+}
+
+func TestUnitPhonesBulkDiff(t *testing.T) {
+	refs := buildTestPhonesBulkReferences()
+	str := func(s string) *string { return &s }
+	hardwareProperties := map[string]interface{}{"phone_hardwareId": buildPropertyValue("0004f2aabbcc")}
+	lobbyProperties := map[string]interface{}{"phone_hardwareId": buildPropertyValue("0004F2AABBDD")}
+
+	orgPhones := []platformclientv2.Phone{
+		{
+			// Matches the file
+			Id:                str("phone-desk-1"),
+			Name:              str("Desk 1"),
+			Site:              &platformclientv2.Domainentityref{Id: str("site-hq")},
+			PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: str("base-desk")},
+			Properties:        &hardwareProperties,
+			Lines:             &[]platformclientv2.Line{{Id: str("line-1")}},
+		},
+		{
+			// The WebRTC user differs from the file
+			Id:                str("phone-soft-1"),
+			Name:              str("Soft 1"),
+			Site:              &platformclientv2.Domainentityref{Id: str("site-hq")},
+			PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: str("base-webrtc")},
+			WebRtcUser:        &platformclientv2.Domainentityref{Id: str("user-alice")},
+		},
+		{
+			// An existing phone with the hardware ID of Desk 2 under another name
+			Id:                str("phone-lobby"),
+			Name:              str("Lobby Phone"),
+			Site:              &platformclientv2.Domainentityref{Id: str("site-hq")},
+			PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: str("base-desk")},
+			Properties:        &lobbyProperties,
+		},
+		// Phones without a hardware ID are not matched by name
+		{Id: str("phone-other-soft-2"), Name: str("Soft 2")},
+		{Id: str("phone-old"), Name: str("Old Phone")},
+		{Id: str("phone-adopted"), Name: str("Adopted Phone")},
+	}
+	rows := []*bulkPhoneRow{
+		{position: "line 2", name: "Desk 1", site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("00:04:f2:aa:bb:cc"), user: str("alice@example.com"), lineAddresses: []string{}},
+		{position: "line 3", name: "Soft 1", site: "HQ", phoneBaseSettings: "WebRTC", user: str("bob@example.com"), lineAddresses: []string{}},
+		{position: "line 4", name: "Soft 2", site: "HQ", phoneBaseSettings: "WebRTC", user: str("bob@example.com"), lineAddresses: []string{}},
+		{position: "line 5", name: "Broken", site: "Branch", phoneBaseSettings: "WebRTC", lineAddresses: []string{}},
+		{position: "line 6", name: "Desk 2", site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("00:04:f2:aa:bb:dd"), lineAddresses: []string{}},
+	}
+	managedPhones := map[string]string{
+		"Desk 1":        "phone-desk-1",
+		"Soft 1":        "phone-soft-1",
+		"Old Phone":     "phone-old",
+		"Adopted Phone": "phone-adopted",
+		"Gone":          "phone-gone",
+	}
+	createdPhones := map[string]bool{"phone-desk-1": true, "phone-soft-1": true, "phone-old": true, "phone-gone": true}
+
+	diff := diffPhonesBulk(rows, refs, orgPhones, managedPhones, createdPhones)
+
+	assert.Equal(t, map[string]string{"Desk 1": "phone-desk-1"}, diff.Unchanged)
+	if assert.Equal(t, 1, len(diff.Created)) {
+		assert.Equal(t, "Soft 2", diff.Created[0].phone.row.name)
+	}
+	if assert.Equal(t, 2, len(diff.Updated)) {
+		assert.Equal(t, "Soft 1", diff.Updated[0].phone.row.name)
+		assert.Equal(t, []string{"user"}, diff.Updated[0].changes)
+		// Existing phones are matched by hardware ID and renamed
+		assert.Equal(t, "Desk 2", diff.Updated[1].phone.row.name)
+		assert.Equal(t, "phone-lobby", *diff.Updated[1].existing.Id)
+		assert.Equal(t, []string{"name"}, diff.Updated[1].changes)
+	}
+	assert.True(t, phonesBulkRenamed(diff))
+	if assert.Equal(t, 1, len(diff.Deleted)) {
+		assert.Equal(t, "phone-old", diff.Deleted[0].Id)
+	}
+	// Phones the resource did not create are never deleted. Phones deleted outside of Terraform are not deleted
+	// again and are only removed from the state.
+	assert.Equal(t, []*bulk.Removal{{Key: "Adopted Phone", Id: "phone-adopted"}, {Key: "Gone", Id: "phone-gone"}}, diff.Released)
+	assert.Equal(t, []bulk.RowError{{Position: "line 5", Key: "Broken", Message: `site "Branch" not found`}}, diff.RowErrors)
+	assert.Equal(t, map[string]int{"phones_created": 1, "phones_updated": 2, "phones_deleted": 1}, diff.Counts(phonesBulkAttributes))
+}
+
+func TestUnitPhonesBulkDiffRenamedPhone(t *testing.T) {
+	refs := buildTestPhonesBulkReferences()
+	str := func(s string) *string { return &s }
+	hardwareProperties := map[string]interface{}{"phone_hardwareId": buildPropertyValue("0004f2aabbcc")}
+
+	orgPhones := []platformclientv2.Phone{{
+		Id:                str("phone-desk-1"),
+		Name:              str("Desk 1"),
+		Site:              &platformclientv2.Domainentityref{Id: str("site-hq")},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: str("base-desk")},
+		Properties:        &hardwareProperties,
+	}}
+	rows := []*bulkPhoneRow{
+		{position: "line 2", name: "Reception", site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("0004f2aabbcc"), lineAddresses: []string{}},
+	}
+
+	// A phone renamed in the file keeps its ID and is not deleted under its old name
+	diff := diffPhonesBulk(rows, refs, orgPhones, map[string]string{"Desk 1": "phone-desk-1"}, map[string]bool{"phone-desk-1": true})
+	assert.Empty(t, diff.Created)
+	assert.Empty(t, diff.Deleted)
+	assert.Empty(t, diff.Released)
+	if assert.Equal(t, 1, len(diff.Updated)) {
+		assert.Equal(t, "phone-desk-1", *diff.Updated[0].existing.Id)
+		assert.Equal(t, []string{"name"}, diff.Updated[0].changes)
+	}
+}
+
+func TestUnitPhonesBulkDiffStation(t *testing.T) {
+	refs := buildTestPhonesBulkReferences()
+	str := func(s string) *string { return &s }
+	hardwareProperties := map[string]interface{}{"phone_hardwareId": buildPropertyValue("0004f2aabbcc")}
+
+	orgPhones := []platformclientv2.Phone{{
+		Id:                str("phone-desk-1"),
+		Name:              str("Desk 1"),
+		Site:              &platformclientv2.Domainentityref{Id: str("site-hq")},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: str("base-desk")},
+		Properties:        &hardwareProperties,
+		Lines:             &[]platformclientv2.Line{{Id: str("line-1")}},
+	}}
+	managedPhones := map[string]string{"Desk 1": "phone-desk-1"}
+	createdPhones := map[string]bool{"phone-desk-1": true}
+
+	// The station is already the default station of the station user
+	rows := []*bulkPhoneRow{
+		{position: "line 2", name: "Desk 1", site: "HQ", phoneBaseSettings: "Desk Phones", hardwareId: str("0004f2aabbcc"), station: str("bob@example.com"), lineAddresses: []string{}},
+	}
+	diff := diffPhonesBulk(rows, refs, orgPhones, managedPhones, createdPhones)
+	assert.Empty(t, diff.Updated)
+	assert.Equal(t, map[string]string{"Desk 1": "phone-desk-1"}, diff.Unchanged)
+
+	// The station is not the default station of the station user
+	rows[0].station = str("alice@example.com")
+	diff = diffPhonesBulk(rows, refs, orgPhones, managedPhones, createdPhones)
+	if assert.Equal(t, 1, len(diff.Updated)) {
+		assert.Equal(t, []string{"station"}, diff.Updated[0].changes)
+	}
+}
+
+func TestUnitPhonesBulkBuildSdkPhone(t *testing.T) {
+	refs := buildTestPhonesBulkReferences()
+	str := func(s string) *string { return &s }
+
+	phone, err := resolveBulkPhoneRow(&bulkPhoneRow{
+		name:              "Desk 1",
+		site:              "HQ",
+		phoneBaseSettings: "Desk Phones",
+		hardwareId:        str("00:04:F2:AA:BB:CC"),
+		lineAddresses:     []string{"+13175550100", "+13175550101"},
+	}, refs)
+	assert.Nil(t, err)
+
+	existingProperties := map[string]interface{}{"phone_label": buildPropertyValue("Front desk")}
+	existing := &platformclientv2.Phone{
+		Id:         str("phone-desk-1"),
+		Properties: &existingProperties,
+		Lines:      &[]platformclientv2.Line{{Id: str("line-1")}},
+	}
+
+	sdkPhone := buildSdkBulkPhone(phone, existing)
+	assert.Equal(t, "Desk 1", *sdkPhone.Name)
+	assert.Equal(t, "site-hq", *sdkPhone.Site.Id)
+	assert.Equal(t, "base-desk", *sdkPhone.PhoneBaseSettings.Id)
+	assert.Nil(t, sdkPhone.WebRtcUser)
+
+	// Existing properties are kept
+	assert.Equal(t, "Front desk", getPhoneProperty(sdkPhone.Properties, "phone_label"))
+	assert.Equal(t, "0004f2aabbcc", getPhoneProperty(sdkPhone.Properties, "phone_hardwareId"))
+	assert.Contains(t, *sdkPhone.Properties, "phone_standalone")
+
+	// Existing line IDs are kept
+	assert.Equal(t, []string{"+13175550100", "+13175550101"}, flattenPhoneLineAddresses(sdkPhone.Lines))
+	assert.Equal(t, "line-1", *(*sdkPhone.Lines)[0].Id)
+	assert.Nil(t, (*sdkPhone.Lines)[1].Id)
+}
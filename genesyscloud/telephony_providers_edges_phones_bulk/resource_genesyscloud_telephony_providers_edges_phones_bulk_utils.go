@@ -0,0 +1,618 @@
+package telephony_providers_edges_phones_bulk
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// webRtcMetaBasePrefix is the prefix of the ID of the meta-base of WebRTC phones
+const webRtcMetaBasePrefix = "inin_webrtc_softphone"
+
+// phonesBulkCsvColumns are the columns allowed in a phones inventory file
+var phonesBulkCsvColumns = []string{"name", "site", "phone_base_settings", "model", "hardware_id", "user", "station", "line_addresses"}
+
+// bulkPhoneRow is a single phone read from an inventory file. A nil attribute is not set in the file.
+type bulkPhoneRow struct {
+	position          string
+	name              string
+	site              string
+	phoneBaseSettings string
+	model             *string
+	hardwareId        *string
+	user              *string
+	station           *string
+	lineAddresses     []string
+}
+
+// bulkPhone is a phone from an inventory file with its site, phone base settings, user and station user resolved
+type bulkPhone struct {
+	row           *bulkPhoneRow
+	siteId        string
+	phoneBase     *platformclientv2.Phonebase
+	hardwareId    string
+	userId        string
+	stationUserId string
+	webRtc        bool
+}
+
+// bulkPhoneChange is a phone in the file that needs to be created or updated along with the attributes that differ from the org
+type bulkPhoneChange struct {
+	phone    *bulkPhone
+	existing *platformclientv2.Phone
+	changes  []string
+}
+
+// phonesBulkDiff describes the changes a sync will make to the phones in the org. Phones are keyed by name.
+type phonesBulkDiff = bulk.Diff[*bulkPhoneChange]
+
+// phonesBulkAttributes names the phones, created_phones and count attributes of the resource
+var phonesBulkAttributes = bulk.Attributes{Kind: "phones", Key: "name"}
+
+// phonesBulkReferences maps the objects referenced in an inventory file to their IDs
+type phonesBulkReferences struct {
+	// sites and phoneBases are keyed by lower case name and users by lower case email
+	sites         map[string]string
+	phoneBases    map[string]*platformclientv2.Phonebase
+	metaBaseNames map[string]string
+	users         map[string]string
+	// stations are keyed by the ID of the line of the phone and defaultStations map user IDs to the IDs of their default stations
+	stations        map[string]*platformclientv2.Station
+	defaultStations map[string]string
+}
+
+// readPhonesBulkFile reads a phones inventory CSV file
+func readPhonesBulkFile(filePath string) ([]*bulkPhoneRow, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read phones file %s: %v", filePath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	rows, err := parsePhonesBulkCsv(reader)
+	if err != nil {
+		return nil, fmt.Errorf("invalid phones file %s: %v", filePath, err)
+	}
+	return rows, nil
+}
+
+// parsePhonesBulkCsv parses a CSV file with a header row of column names
+func parsePhonesBulkCsv(reader io.Reader) ([]*bulkPhoneRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("phones file is empty, a header row is required")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %v", err)
+	}
+	if len(header) > 0 {
+		// Strip a UTF-8 byte order mark written by spreadsheet tools
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !lists.ItemInSlice(column, phonesBulkCsvColumns) {
+			return nil, fmt.Errorf("unknown column %q. Valid columns are %s", header[i], strings.Join(phonesBulkCsvColumns, ", "))
+		}
+		if _, exists := columns[column]; exists {
+			return nil, fmt.Errorf("duplicate column %q", header[i])
+		}
+		columns[column] = i
+	}
+	for _, required := range []string{"name", "site", "phone_base_settings"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("a %q column is required", required)
+		}
+	}
+
+	var rows []*bulkPhoneRow
+	var rowErrors []string
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read line %d: %v", line, err)
+		}
+
+		// Empty cells are treated like missing columns
+		cell := func(column string) *string {
+			if i, ok := columns[column]; ok {
+				if value := strings.TrimSpace(record[i]); value != "" {
+					return &value
+				}
+			}
+			return nil
+		}
+		value := func(column string) string {
+			if v := cell(column); v != nil {
+				return *v
+			}
+			return ""
+		}
+
+		row := &bulkPhoneRow{
+			position:          fmt.Sprintf("line %d", line),
+			name:              value("name"),
+			site:              value("site"),
+			phoneBaseSettings: value("phone_base_settings"),
+			model:             cell("model"),
+			hardwareId:        cell("hardware_id"),
+			user:              cell("user"),
+			station:           cell("station"),
+			lineAddresses:     make([]string, 0),
+		}
+		if row.site == "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: site must not be empty", row.position))
+		}
+		if row.phoneBaseSettings == "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: phone_base_settings must not be empty", row.position))
+		}
+		if row.user != nil && row.station != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: only one of user and station may be set", row.position))
+		}
+		for _, address := range splitPhonesBulkList(value("line_addresses")) {
+			if diagErr := gcloud.ValidatePhoneNumber(address, nil); diagErr.HasError() {
+				rowErrors = append(rowErrors, fmt.Sprintf("%s: line address %q is not a valid E.164 number", row.position, address))
+				continue
+			}
+			row.lineAddresses = append(row.lineAddresses, address)
+		}
+		rows = append(rows, row)
+	}
+
+	if err := validatePhonesBulkRows(rows, rowErrors); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// splitPhonesBulkList splits a ; separated list from a CSV cell and drops empty items
+func splitPhonesBulkList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// validatePhonesBulkRows checks that every phone has a unique name and hardware ID and combines the errors found while parsing
+func validatePhonesBulkRows(rows []*bulkPhoneRow, rowErrors []string) error {
+	namePositions := make(map[string]string, len(rows))
+	hardwareIdPositions := make(map[string]string, len(rows))
+	for _, row := range rows {
+		if row.name == "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: name must not be empty", row.position))
+			continue
+		}
+		if firstPosition, exists := namePositions[row.name]; exists {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: duplicate name %q, first defined at %s", row.position, row.name, firstPosition))
+			continue
+		}
+		namePositions[row.name] = row.position
+
+		if row.hardwareId == nil {
+			continue
+		}
+		hardwareId := normalizeHardwareId(*row.hardwareId)
+		if firstPosition, exists := hardwareIdPositions[hardwareId]; exists {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: duplicate hardware_id %q, first defined at %s", row.position, *row.hardwareId, firstPosition))
+			continue
+		}
+		hardwareIdPositions[hardwareId] = row.position
+	}
+
+	return bulk.JoinRowErrors(rowErrors)
+}
+
+// normalizeHardwareId removes the separators of a MAC address style hardware ID and lower cases it
+func normalizeHardwareId(hardwareId string) string {
+	return strings.ToLower(strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.TrimSpace(hardwareId)))
+}
+
+// isMacAddress checks whether a normalized hardware ID is a MAC address of 12 hex digits
+func isMacAddress(hardwareId string) bool {
+	if len(hardwareId) != 12 {
+		return false
+	}
+	for _, c := range hardwareId {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// buildPhonesBulkReferences maps the names of the sites and users in the org to their IDs, the stations to the lines of their
+// phones and the users to their default stations
+func buildPhonesBulkReferences(sites []platformclientv2.Site, phoneBases map[string]*platformclientv2.Phonebase, metaBases []platformclientv2.Metabase, users []platformclientv2.User, stations []platformclientv2.Station) *phonesBulkReferences {
+	refs := &phonesBulkReferences{
+		sites:           make(map[string]string, len(sites)),
+		phoneBases:      phoneBases,
+		metaBaseNames:   make(map[string]string, len(metaBases)),
+		users:           make(map[string]string, len(users)),
+		stations:        make(map[string]*platformclientv2.Station, len(stations)),
+		defaultStations: make(map[string]string),
+	}
+	for _, site := range sites {
+		if site.Id != nil && site.Name != nil {
+			refs.sites[strings.ToLower(*site.Name)] = *site.Id
+		}
+	}
+	for _, metaBase := range metaBases {
+		if metaBase.Id != nil && metaBase.Name != nil {
+			refs.metaBaseNames[*metaBase.Id] = *metaBase.Name
+		}
+	}
+	for _, user := range users {
+		if user.Id != nil && user.Email != nil {
+			refs.users[strings.ToLower(*user.Email)] = *user.Id
+		}
+		if user.Id != nil && user.Station != nil && *user.Station != nil && (*user.Station).DefaultStation != nil && (*user.Station).DefaultStation.Id != nil {
+			refs.defaultStations[*user.Id] = *(*user.Station).DefaultStation.Id
+		}
+	}
+	for i, station := range stations {
+		if station.LineAppearanceId != nil {
+			refs.stations[*station.LineAppearanceId] = &stations[i]
+		}
+	}
+	return refs
+}
+
+// resolveBulkPhoneRow resolves the site, phone base settings, user and station user of a phone and validates the phone against
+// the capabilities of the phone meta-base
+func resolveBulkPhoneRow(row *bulkPhoneRow, refs *phonesBulkReferences) (*bulkPhone, error) {
+	phone := &bulkPhone{row: row}
+
+	siteId, ok := refs.sites[strings.ToLower(row.site)]
+	if !ok {
+		return nil, fmt.Errorf("site %q not found", row.site)
+	}
+	phone.siteId = siteId
+
+	phoneBase, ok := refs.phoneBases[strings.ToLower(row.phoneBaseSettings)]
+	if !ok {
+		return nil, fmt.Errorf("phone base settings %q not found", row.phoneBaseSettings)
+	}
+	if phoneBase.Lines == nil || len(*phoneBase.Lines) == 0 {
+		return nil, fmt.Errorf("phone base settings %q have no line base settings", row.phoneBaseSettings)
+	}
+	phone.phoneBase = phoneBase
+
+	metaBaseId := ""
+	if phoneBase.PhoneMetaBase != nil && phoneBase.PhoneMetaBase.Id != nil {
+		metaBaseId = *phoneBase.PhoneMetaBase.Id
+	}
+	if row.model != nil {
+		metaBaseName := refs.metaBaseNames[metaBaseId]
+		if !strings.EqualFold(metaBaseName, *row.model) && !strings.EqualFold(metaBaseId, *row.model) {
+			return nil, fmt.Errorf("model %q does not match the %q model of phone base settings %q", *row.model, metaBaseName, row.phoneBaseSettings)
+		}
+	}
+	phone.webRtc = strings.HasPrefix(metaBaseId, webRtcMetaBasePrefix)
+
+	hardwareIdType := ""
+	if phoneBase.Capabilities != nil && phoneBase.Capabilities.HardwareIdType != nil {
+		hardwareIdType = *phoneBase.Capabilities.HardwareIdType
+	}
+	if hardwareIdType == "" {
+		if row.hardwareId != nil {
+			return nil, fmt.Errorf("phone base settings %q do not use a hardware ID", row.phoneBaseSettings)
+		}
+	} else {
+		if row.hardwareId == nil {
+			return nil, fmt.Errorf("a hardware_id of type %s is required by phone base settings %q", hardwareIdType, row.phoneBaseSettings)
+		}
+		phone.hardwareId = *row.hardwareId
+		if strings.EqualFold(hardwareIdType, "mac") {
+			phone.hardwareId = normalizeHardwareId(*row.hardwareId)
+			if !isMacAddress(phone.hardwareId) {
+				return nil, fmt.Errorf("hardware_id %q is not a valid MAC address", *row.hardwareId)
+			}
+		}
+	}
+
+	if maxLines := getMaxLineKeys(phoneBase); maxLines > 0 && len(row.lineAddresses) > maxLines {
+		return nil, fmt.Errorf("%d line addresses exceed the %d lines supported by phone base settings %q", len(row.lineAddresses), maxLines, row.phoneBaseSettings)
+	}
+
+	if row.station != nil {
+		if phone.webRtc {
+			return nil, fmt.Errorf("station is not supported for WebRTC phones, use user instead")
+		}
+		userId, ok := refs.users[strings.ToLower(*row.station)]
+		if !ok {
+			return nil, fmt.Errorf("station user %q not found", *row.station)
+		}
+		phone.stationUserId = userId
+		return phone, nil
+	}
+	if row.user == nil {
+		if phone.webRtc {
+			return nil, fmt.Errorf("a user is required for WebRTC phones")
+		}
+		return phone, nil
+	}
+	userId, ok := refs.users[strings.ToLower(*row.user)]
+	if !ok {
+		return nil, fmt.Errorf("user %q not found", *row.user)
+	}
+	phone.userId = userId
+	return phone, nil
+}
+
+// getMaxLineKeys returns the number of line keys of phone base settings, or 0 if it is not known
+func getMaxLineKeys(phoneBase *platformclientv2.Phonebase) int {
+	if phoneBase.Properties == nil {
+		return 0
+	}
+	property, _ := (*phoneBase.Properties)["phone_maxLineKeys"].(map[string]interface{})
+	value, _ := property["value"].(map[string]interface{})
+	maxLineKeys, _ := value["instance"].(float64)
+	return int(maxLineKeys)
+}
+
+// diffPhonesBulk compares the phones in a file with the phones in the org. A row is matched to the phone the resource
+// already manages under its name, or else to the phone in the org with the same hardware ID. Phones created by the
+// resource that are no longer in the file are deleted and other phones are released. Phones that cannot be resolved
+// are reported as row errors.
+func diffPhonesBulk(rows []*bulkPhoneRow, refs *phonesBulkReferences, orgPhones []platformclientv2.Phone, managedPhones map[string]string, createdPhones map[string]bool) *phonesBulkDiff {
+	diff := bulk.NewDiff[*bulkPhoneChange]()
+
+	orgPhonesById := make(map[string]*platformclientv2.Phone, len(orgPhones))
+	orgPhonesByHardwareId := make(map[string]*platformclientv2.Phone, len(orgPhones))
+	for i, orgPhone := range orgPhones {
+		if orgPhone.Id == nil {
+			continue
+		}
+		orgPhonesById[*orgPhone.Id] = &orgPhones[i]
+		if hardwareId := getPhoneProperty(orgPhone.Properties, "phone_hardwareId"); hardwareId != "" {
+			orgPhonesByHardwareId[normalizeHardwareId(hardwareId)] = &orgPhones[i]
+		}
+	}
+	fileNames := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		fileNames[row.name] = true
+	}
+
+	matchedIds := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		phone, err := resolveBulkPhoneRow(row, refs)
+		if err != nil {
+			diff.RowErrors = append(diff.RowErrors, bulk.RowError{Position: row.position, Key: row.name, Message: err.Error()})
+			continue
+		}
+
+		existing := orgPhonesById[managedPhones[row.name]]
+		if existing == nil && phone.hardwareId != "" {
+			if orgPhone, ok := orgPhonesByHardwareId[normalizeHardwareId(phone.hardwareId)]; ok && !matchedIds[*orgPhone.Id] {
+				existing = orgPhone
+			}
+		}
+		if existing == nil {
+			diff.Created = append(diff.Created, &bulkPhoneChange{phone: phone})
+			continue
+		}
+		matchedIds[*existing.Id] = true
+
+		changes := compareBulkPhone(phone, existing, refs)
+		if len(changes) > 0 {
+			diff.Updated = append(diff.Updated, &bulkPhoneChange{phone: phone, existing: existing, changes: changes})
+		} else {
+			diff.Unchanged[row.name] = *existing.Id
+		}
+	}
+
+	for name, id := range managedPhones {
+		// Renamed phones are matched by hardware ID and stay managed
+		if fileNames[name] || matchedIds[id] {
+			continue
+		}
+		removed := &bulk.Removal{Key: name, Id: id}
+		// Phones that existed before the resource matched them are never deleted. Phones that were deleted outside
+		// of Terraform do not need to be deleted again and are only removed from the state.
+		if !createdPhones[id] || orgPhonesById[id] == nil {
+			diff.Released = append(diff.Released, removed)
+			continue
+		}
+		diff.Deleted = append(diff.Deleted, removed)
+	}
+	for _, removed := range [][]*bulk.Removal{diff.Deleted, diff.Released} {
+		sort.Slice(removed, func(i, j int) bool {
+			return removed[i].Key < removed[j].Key
+		})
+	}
+
+	return diff
+}
+
+// compareBulkPhone returns the names of the attributes of a phone that differ from the phone in the org
+func compareBulkPhone(phone *bulkPhone, existing *platformclientv2.Phone, refs *phonesBulkReferences) []string {
+	var changes []string
+
+	if stringValue(existing.Name) != phone.row.name {
+		changes = append(changes, "name")
+	}
+	if existing.Site == nil || stringValue(existing.Site.Id) != phone.siteId {
+		changes = append(changes, "site")
+	}
+	if existing.PhoneBaseSettings == nil || stringValue(existing.PhoneBaseSettings.Id) != *phone.phoneBase.Id {
+		changes = append(changes, "phone_base_settings")
+	}
+	if !strings.EqualFold(getPhoneProperty(existing.Properties, "phone_hardwareId"), phone.hardwareId) {
+		changes = append(changes, "hardware_id")
+	}
+	if !sameStringList(flattenPhoneLineAddresses(existing.Lines), phone.row.lineAddresses) {
+		changes = append(changes, "line_addresses")
+	}
+
+	if phone.webRtc {
+		if existing.WebRtcUser == nil || stringValue(existing.WebRtcUser.Id) != phone.userId {
+			changes = append(changes, "user")
+		}
+	} else if phone.userId != "" {
+		station := refs.stations[getPhoneLineId(existing)]
+		if station == nil || stringValue(station.UserId) != phone.userId {
+			changes = append(changes, "user")
+		}
+	} else if phone.stationUserId != "" {
+		station := refs.stations[getPhoneLineId(existing)]
+		if station == nil || refs.defaultStations[phone.stationUserId] != stringValue(station.Id) {
+			changes = append(changes, "station")
+		}
+	}
+	return changes
+}
+
+// buildSdkBulkPhone builds the phone to create or update. The properties and line IDs of an existing phone are kept.
+func buildSdkBulkPhone(phone *bulkPhone, existing *platformclientv2.Phone) *platformclientv2.Phone {
+	row := phone.row
+	lineBaseSettings := &platformclientv2.Domainentityref{Id: (*phone.phoneBase.Lines)[0].Id}
+	state := "active"
+
+	properties := make(map[string]interface{})
+	var existingLines []platformclientv2.Line
+	if existing != nil {
+		if existing.Properties != nil {
+			for key, value := range *existing.Properties {
+				properties[key] = value
+			}
+		}
+		if existing.Lines != nil {
+			existingLines = *existing.Lines
+		}
+	}
+
+	if phone.hardwareId != "" {
+		properties["phone_hardwareId"] = buildPropertyValue(phone.hardwareId)
+	} else {
+		delete(properties, "phone_hardwareId")
+	}
+
+	lines := make([]platformclientv2.Line, 0)
+	if len(row.lineAddresses) == 0 {
+		delete(properties, "phone_standalone")
+		lineName := "line_" + *lineBaseSettings.Id + row.name
+		lines = append(lines, platformclientv2.Line{Name: &lineName, LineBaseSettings: lineBaseSettings})
+	} else {
+		properties["phone_standalone"] = buildPropertyValue(true)
+		for i, address := range row.lineAddresses {
+			lineName := "line_" + *lineBaseSettings.Id + "_" + strconv.Itoa(i+1)
+			lineProperties := map[string]interface{}{
+				"station_identity_address": buildPropertyValue(address),
+			}
+			lines = append(lines, platformclientv2.Line{Name: &lineName, LineBaseSettings: lineBaseSettings, Properties: &lineProperties})
+		}
+	}
+	for i := range lines {
+		if i < len(existingLines) {
+			lines[i].Id = existingLines[i].Id
+		}
+	}
+
+	sdkPhone := &platformclientv2.Phone{
+		Name:              &row.name,
+		State:             &state,
+		Site:              &platformclientv2.Domainentityref{Id: &phone.siteId},
+		PhoneBaseSettings: &platformclientv2.Phonebasesettings{Id: phone.phoneBase.Id},
+		LineBaseSettings:  lineBaseSettings,
+		PhoneMetaBase:     phone.phoneBase.PhoneMetaBase,
+		Lines:             &lines,
+		Properties:        &properties,
+		Capabilities:      phone.phoneBase.Capabilities,
+	}
+	if phone.webRtc {
+		sdkPhone.WebRtcUser = &platformclientv2.Domainentityref{Id: &phone.userId}
+	}
+	return sdkPhone
+}
+
+func buildPropertyValue(instance interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"value": map[string]interface{}{
+			"instance": instance,
+		},
+	}
+}
+
+// getPhoneProperty returns the string value of a phone property, or an empty string if it is not set
+func getPhoneProperty(properties *map[string]interface{}, name string) string {
+	if properties == nil {
+		return ""
+	}
+	property, _ := (*properties)[name].(map[string]interface{})
+	value, _ := property["value"].(map[string]interface{})
+	instance, _ := value["instance"].(string)
+	return instance
+}
+
+// flattenPhoneLineAddresses returns the addresses of the lines of a standalone phone
+func flattenPhoneLineAddresses(lines *[]platformclientv2.Line) []string {
+	addresses := make([]string, 0)
+	if lines == nil {
+		return addresses
+	}
+	for _, line := range *lines {
+		if address := getPhoneProperty(line.Properties, "station_identity_address"); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// getPhoneLineId returns the ID of the first line of a phone, which is the line appearance of its station
+func getPhoneLineId(phone *platformclientv2.Phone) string {
+	if phone.Lines == nil || len(*phone.Lines) == 0 {
+		return ""
+	}
+	return stringValue((*phone.Lines)[0].Id)
+}
+
+func sameStringList(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// phonesBulkRenamed checks whether a sync renames a managed phone, which changes the keys of the phones attribute
+func phonesBulkRenamed(diff *phonesBulkDiff) bool {
+	for _, change := range diff.Updated {
+		if lists.ItemInSlice("name", change.changes) {
+			return true
+		}
+	}
+	return false
+}
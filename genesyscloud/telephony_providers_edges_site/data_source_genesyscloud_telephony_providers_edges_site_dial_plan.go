@@ -0,0 +1,293 @@
+package telephony_providers_edges_site
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/nyaruka/phonenumbers"
+)
+
+/*
+   The data_source_genesyscloud_telephony_providers_edges_site_dial_plan.go contains the data source implementation
+   that evaluates dialed strings against the number plans and outbound routes of a site.
+*/
+
+// captureGroupReference matches the $1 capture group references used in the normalized format of number plans
+var captureGroupReference = regexp.MustCompile(`\$(\d+)`)
+
+// dialPlanResult holds the outcome of evaluating one dialed string against a dial plan
+type dialPlanResult struct {
+	dialedNumber         string
+	numberPlan           *platformclientv2.Numberplan
+	normalizedNumber     string
+	outboundRoute        *platformclientv2.Outboundroutebase
+	externalTrunkBaseIds []string
+}
+
+// dataSourceSiteDialPlanTestRead reads the number plans and outbound routes of a site and evaluates the dialed strings against them
+func dataSourceSiteDialPlanTestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*gcloud.ProviderMeta).ClientConfig
+	sp := getSiteProxy(sdkConfig)
+
+	siteId := d.Get("site_id").(string)
+	dialedNumbers := lists.InterfaceListToStrings(d.Get("dialed_numbers").([]interface{}))
+
+	return gcloud.WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		site, resp, err := sp.getSiteById(ctx, siteId)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("failed to find site %s", siteId))
+			}
+			return retry.NonRetryableError(fmt.Errorf("error requesting site %s: %s", siteId, err))
+		}
+
+		numberPlans, _, err := sp.getSiteNumberPlans(ctx, siteId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get number plans for site %s: %s", siteId, err))
+		}
+
+		outboundRoutes, err := sp.getSiteOutboundRoutes(ctx, siteId)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to get outbound routes for site %s: %s", siteId, err))
+		}
+
+		// Country codes are only needed by the intraCountryCode and interCountryCode match types
+		siteCountry := ""
+		if site.Location != nil && site.Location.Id != nil {
+			location, err := sp.getLocation(ctx, *site.Location.Id)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("failed to get location of site %s: %s", siteId, err))
+			}
+			if location.Address != nil && location.Address.Country != nil {
+				siteCountry = *location.Address.Country
+			}
+		}
+
+		results := make([]interface{}, 0, len(dialedNumbers))
+		for _, dialedNumber := range dialedNumbers {
+			result, err := evaluateDialPlan(dialedNumber, *numberPlans, *outboundRoutes, siteCountry)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("failed to evaluate %s against the dial plan of site %s: %s", dialedNumber, siteId, err))
+			}
+			results = append(results, flattenDialPlanResult(result))
+		}
+
+		d.SetId(siteId)
+		_ = d.Set("results", results)
+		return nil
+	})
+}
+
+// evaluateDialPlan classifies a dialed string with the first number plan that matches it, in priority order, and
+// selects the first enabled outbound route that handles the classification
+func evaluateDialPlan(dialedNumber string, numberPlans []platformclientv2.Numberplan, outboundRoutes []platformclientv2.Outboundroutebase, siteCountry string) (*dialPlanResult, error) {
+	result := &dialPlanResult{dialedNumber: dialedNumber}
+
+	for _, plan := range sortNumberPlansByPriority(numberPlans) {
+		plan := plan
+		normalizedNumber, matched, err := matchNumberPlan(dialedNumber, plan, siteCountry)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+		result.numberPlan = &plan
+		result.normalizedNumber = normalizedNumber
+		break
+	}
+	if result.numberPlan == nil || result.numberPlan.Classification == nil {
+		return result, nil
+	}
+
+	for _, route := range outboundRoutes {
+		route := route
+		if route.Enabled == nil || !*route.Enabled || route.ClassificationTypes == nil {
+			continue
+		}
+		if !lists.ItemInSlice(*result.numberPlan.Classification, *route.ClassificationTypes) {
+			continue
+		}
+		result.outboundRoute = &route
+		if route.ExternalTrunkBases != nil {
+			for _, trunkBase := range *route.ExternalTrunkBases {
+				if trunkBase.Id != nil {
+					result.externalTrunkBaseIds = append(result.externalTrunkBaseIds, *trunkBase.Id)
+				}
+			}
+		}
+		break
+	}
+	return result, nil
+}
+
+// sortNumberPlansByPriority returns the number plans ordered by ascending priority. Plans without a priority are evaluated last.
+func sortNumberPlansByPriority(numberPlans []platformclientv2.Numberplan) []platformclientv2.Numberplan {
+	sorted := make([]platformclientv2.Numberplan, len(numberPlans))
+	copy(sorted, numberPlans)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority == nil {
+			return false
+		}
+		if sorted[j].Priority == nil {
+			return true
+		}
+		return *sorted[i].Priority < *sorted[j].Priority
+	})
+	return sorted
+}
+
+// matchNumberPlan checks whether a dialed string matches a number plan and returns the normalized number
+func matchNumberPlan(dialedNumber string, plan platformclientv2.Numberplan, siteCountry string) (string, bool, error) {
+	if plan.MatchType == nil {
+		return "", false, nil
+	}
+
+	switch *plan.MatchType {
+	case "regex":
+		if plan.Match == nil {
+			return "", false, nil
+		}
+		re, err := regexp.Compile("^(?:" + *plan.Match + ")$")
+		if err != nil {
+			return "", false, fmt.Errorf("number plan %s has an invalid regular expression: %s", getNumberPlanName(plan), err)
+		}
+		submatches := re.FindStringSubmatchIndex(dialedNumber)
+		if submatches == nil {
+			return "", false, nil
+		}
+		if plan.NormalizedFormat == nil || *plan.NormalizedFormat == "" {
+			return dialedNumber, true, nil
+		}
+		return string(re.ExpandString(nil, toGoTemplate(*plan.NormalizedFormat), dialedNumber, submatches)), true, nil
+	case "digitLength":
+		if plan.DigitLength == nil || !isDigits(dialedNumber) {
+			return "", false, nil
+		}
+		return dialedNumber, digitLengthInRange(len(dialedNumber), plan.DigitLength), nil
+	case "numberList":
+		if !isDigits(dialedNumber) {
+			return "", false, nil
+		}
+		return dialedNumber, numberInRanges(dialedNumber, plan.Numbers), nil
+	case "e164NumberList":
+		number := strings.TrimPrefix(dialedNumber, "+")
+		if !strings.HasPrefix(dialedNumber, "+") || !isDigits(number) {
+			return "", false, nil
+		}
+		return dialedNumber, numberInRanges(number, plan.Numbers), nil
+	case "intraCountryCode", "interCountryCode":
+		if siteCountry == "" {
+			return "", false, nil
+		}
+		parsed, err := phonenumbers.Parse(dialedNumber, siteCountry)
+		if err != nil || !phonenumbers.IsPossibleNumber(parsed) {
+			return "", false, nil
+		}
+		intraCountry := int(parsed.GetCountryCode()) == phonenumbers.GetCountryCodeForRegion(siteCountry)
+		if intraCountry != (*plan.MatchType == "intraCountryCode") {
+			return "", false, nil
+		}
+		return phonenumbers.Format(parsed, phonenumbers.E164), true, nil
+	}
+	return "", false, nil
+}
+
+// toGoTemplate converts the $1 capture group references of a normalized format to the ${1} form expected by regexp
+func toGoTemplate(format string) string {
+	return captureGroupReference.ReplaceAllString(format, "$${${1}}")
+}
+
+func digitLengthInRange(length int, digitLength *platformclientv2.Digitlength) bool {
+	if digitLength.Start != nil {
+		if start, err := strconv.Atoi(*digitLength.Start); err == nil && length < start {
+			return false
+		}
+	}
+	if digitLength.End != nil {
+		if end, err := strconv.Atoi(*digitLength.End); err == nil && length > end {
+			return false
+		}
+	}
+	return true
+}
+
+// numberInRanges checks whether a number is in one of the number ranges. Numbers within a range have the same length,
+// so the digits are compared as strings.
+func numberInRanges(number string, numbers *[]platformclientv2.Number) bool {
+	if numbers == nil {
+		return false
+	}
+	for _, numberRange := range *numbers {
+		if numberRange.Start == nil {
+			continue
+		}
+		start := strings.TrimPrefix(*numberRange.Start, "+")
+		end := start
+		if numberRange.End != nil && *numberRange.End != "" {
+			end = strings.TrimPrefix(*numberRange.End, "+")
+		}
+		if len(number) == len(start) && number >= start && number <= end {
+			return true
+		}
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func getNumberPlanName(plan platformclientv2.Numberplan) string {
+	if plan.Name == nil {
+		return ""
+	}
+	return *plan.Name
+}
+
+func flattenDialPlanResult(result *dialPlanResult) map[string]interface{} {
+	dResult := map[string]interface{}{
+		"dialed_number":           result.dialedNumber,
+		"matched":                 result.numberPlan != nil,
+		"external_trunk_base_ids": lists.StringListToInterfaceList(result.externalTrunkBaseIds),
+	}
+	if result.numberPlan != nil {
+		dResult["number_plan_name"] = getNumberPlanName(*result.numberPlan)
+		dResult["normalized_number"] = result.normalizedNumber
+		if result.numberPlan.MatchType != nil {
+			dResult["match_type"] = *result.numberPlan.MatchType
+		}
+		if result.numberPlan.Classification != nil {
+			dResult["classification"] = *result.numberPlan.Classification
+		}
+	}
+	if result.outboundRoute != nil {
+		if result.outboundRoute.Name != nil {
+			dResult["outbound_route_name"] = *result.outboundRoute.Name
+		}
+		if result.outboundRoute.Distribution != nil {
+			dResult["distribution"] = *result.outboundRoute.Distribution
+		}
+	}
+	return dResult
+}
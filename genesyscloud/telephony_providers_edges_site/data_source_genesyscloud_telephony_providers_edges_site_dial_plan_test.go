@@ -0,0 +1,192 @@
+package telephony_providers_edges_site
+
+import (
+	"fmt"
+	"testing"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitEvaluateDialPlan(t *testing.T) {
+	numberPlans := []platformclientv2.Numberplan{
+		{
+			Name:           platformclientv2.String("National"),
+			MatchType:      platformclientv2.String("intraCountryCode"),
+			Classification: platformclientv2.String("National"),
+			Priority:       platformclientv2.Int(5),
+		},
+		{
+			Name:           platformclientv2.String("International"),
+			MatchType:      platformclientv2.String("interCountryCode"),
+			Classification: platformclientv2.String("International"),
+			Priority:       platformclientv2.Int(6),
+		},
+		{
+			Name:           platformclientv2.String("Emergency"),
+			MatchType:      platformclientv2.String("numberList"),
+			Numbers:        &[]platformclientv2.Number{{Start: platformclientv2.String("911")}, {Start: platformclientv2.String("933")}},
+			Classification: platformclientv2.String("Emergency"),
+			Priority:       platformclientv2.Int(1),
+		},
+		{
+			Name:             platformclientv2.String("Outbound prefix"),
+			MatchType:        platformclientv2.String("regex"),
+			Match:            platformclientv2.String(`9(\d{10})`),
+			NormalizedFormat: platformclientv2.String("+1$1"),
+			Classification:   platformclientv2.String("National"),
+			Priority:         platformclientv2.Int(2),
+		},
+		{
+			Name:           platformclientv2.String("Extension"),
+			MatchType:      platformclientv2.String("digitLength"),
+			DigitLength:    &platformclientv2.Digitlength{Start: platformclientv2.String("4"), End: platformclientv2.String("5")},
+			Classification: platformclientv2.String("Extension"),
+			Priority:       platformclientv2.Int(3),
+		},
+		{
+			Name:           platformclientv2.String("Premium"),
+			MatchType:      platformclientv2.String("e164NumberList"),
+			Numbers:        &[]platformclientv2.Number{{Start: platformclientv2.String("+19005550000"), End: platformclientv2.String("+19005559999")}},
+			Classification: platformclientv2.String("Premium"),
+			Priority:       platformclientv2.Int(4),
+		},
+	}
+	outboundRoutes := []platformclientv2.Outboundroutebase{
+		{
+			Name:                platformclientv2.String("Disabled route"),
+			ClassificationTypes: &[]string{"National"},
+			Enabled:             platformclientv2.Bool(false),
+		},
+		{
+			Name:                platformclientv2.String("Default route"),
+			ClassificationTypes: &[]string{"National", "International", "Emergency"},
+			Enabled:             platformclientv2.Bool(true),
+			Distribution:        platformclientv2.String("SEQUENTIAL"),
+			ExternalTrunkBases:  &[]platformclientv2.Domainentityref{{Id: platformclientv2.String("trunk-1")}, {Id: platformclientv2.String("trunk-2")}},
+		},
+	}
+
+	testCases := []struct {
+		dialedNumber     string
+		numberPlanName   string
+		normalizedNumber string
+		routeName        string
+	}{
+		{dialedNumber: "911", numberPlanName: "Emergency", normalizedNumber: "911", routeName: "Default route"},
+		{dialedNumber: "93175551234", numberPlanName: "Outbound prefix", normalizedNumber: "+13175551234", routeName: "Default route"},
+		{dialedNumber: "1234", numberPlanName: "Extension", normalizedNumber: "1234"},
+		{dialedNumber: "+19005551234", numberPlanName: "Premium", normalizedNumber: "+19005551234"},
+		{dialedNumber: "3175551234", numberPlanName: "National", normalizedNumber: "+13175551234", routeName: "Default route"},
+		{dialedNumber: "+442071838750", numberPlanName: "International", normalizedNumber: "+442071838750", routeName: "Default route"},
+		{dialedNumber: "*99"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.dialedNumber, func(t *testing.T) {
+			result, err := evaluateDialPlan(tc.dialedNumber, numberPlans, outboundRoutes, "US")
+			assert.NoError(t, err)
+
+			if tc.numberPlanName == "" {
+				assert.Nil(t, result.numberPlan)
+				return
+			}
+			if assert.NotNil(t, result.numberPlan) {
+				assert.Equal(t, tc.numberPlanName, *result.numberPlan.Name)
+			}
+			assert.Equal(t, tc.normalizedNumber, result.normalizedNumber)
+
+			if tc.routeName == "" {
+				assert.Nil(t, result.outboundRoute)
+				return
+			}
+			if assert.NotNil(t, result.outboundRoute) {
+				assert.Equal(t, tc.routeName, *result.outboundRoute.Name)
+			}
+			assert.Equal(t, []string{"trunk-1", "trunk-2"}, result.externalTrunkBaseIds)
+		})
+	}
+}
+
+func TestUnitEvaluateDialPlanInvalidRegex(t *testing.T) {
+	numberPlans := []platformclientv2.Numberplan{
+		{
+			Name:      platformclientv2.String("Broken"),
+			MatchType: platformclientv2.String("regex"),
+			Match:     platformclientv2.String("(\\d"),
+		},
+	}
+	_, err := evaluateDialPlan("1234", numberPlans, nil, "US")
+	assert.Error(t, err)
+}
+
+func TestAccDataSourceSiteDialPlanTest(t *testing.T) {
+	var (
+		siteRes         = "site"
+		name            = "site " + uuid.NewString()
+		description     = "TestAccDataSourceSiteDialPlanTest description 1"
+		mediaModel      = "Cloud"
+		locationRes     = "test-location1"
+		emergencyNumber = "+13173124745"
+		dataSourceRes   = "dial-plan"
+	)
+
+	err := DeleteLocationWithNumber(emergencyNumber, sdkConfig)
+	if err != nil {
+		t.Skipf("failed to delete location with number %s, %v", emergencyNumber, err)
+	}
+
+	location := gcloud.GenerateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		gcloud.GenerateLocationEmergencyNum(
+			emergencyNumber,
+			gcloud.NullValue,
+		),
+		gcloud.GenerateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { gcloud.TestAccPreCheck(t) },
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: location + GenerateSiteResourceWithCustomAttrs(
+					siteRes,
+					name,
+					description,
+					"genesyscloud_location."+locationRes+".id",
+					mediaModel,
+					false,
+					"[\"us-east-1\"]",
+					gcloud.NullValue,
+					gcloud.NullValue,
+				) + fmt.Sprintf(`
+data "%s" "%s" {
+  site_id        = genesyscloud_telephony_providers_edges_site.%s.id
+  dialed_numbers = ["911", "3175551234"]
+}
+`, dialPlanTestDataSourceName, dataSourceRes, siteRes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data."+dialPlanTestDataSourceName+"."+dataSourceRes, "results.#", "2"),
+					resource.TestCheckResourceAttr("data."+dialPlanTestDataSourceName+"."+dataSourceRes, "results.0.dialed_number", "911"),
+					resource.TestCheckResourceAttr("data."+dialPlanTestDataSourceName+"."+dataSourceRes, "results.0.classification", "Emergency"),
+					resource.TestCheckResourceAttr("data."+dialPlanTestDataSourceName+"."+dataSourceRes, "results.1.matched", "true"),
+					resource.TestCheckResourceAttr("data."+dialPlanTestDataSourceName+"."+dataSourceRes, "results.1.normalized_number", "+13175551234"),
+				),
+			},
+		},
+	})
+}
@@ -45,6 +45,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceSite()
+	providerDataSources[dialPlanTestDataSourceName] = DataSourceSiteDialPlanTest()
 	providerDataSources["genesyscloud_organizations_me"] = gcloud.DataSourceOrganizationsMe()
 }
 
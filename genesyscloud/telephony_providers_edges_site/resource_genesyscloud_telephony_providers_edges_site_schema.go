@@ -19,6 +19,7 @@ resource_genesyscloud_telephony_providers_edges_site_schema.go should hold four
 4.  The resource exporter configuration for the telephony_providers_edges_site exporter.
 */
 const resourceName = "genesyscloud_telephony_providers_edges_site"
+const dialPlanTestDataSourceName = "genesyscloud_telephony_providers_edges_site_dial_plan_test"
 
 // used in sdk authorization for tests
 var (
@@ -72,6 +73,7 @@ var (
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceSite())
+	l.RegisterDataSource(dialPlanTestDataSourceName, DataSourceSiteDialPlanTest())
 	l.RegisterResource(resourceName, ResourceSite())
 	l.RegisterExporter(resourceName, SiteExporter())
 }
@@ -310,3 +312,81 @@ func DataSourceSite() *schema.Resource {
 		},
 	}
 }
+
+// DataSourceSiteDialPlanTest registers the genesyscloud_telephony_providers_edges_site_dial_plan_test data source
+func DataSourceSiteDialPlanTest() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source that evaluates dialed numbers against the number plans and outbound routes of a Genesys Cloud Site. " +
+			"Number plans are evaluated locally in priority order, so changes to a dial plan can be tested without placing calls.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceSiteDialPlanTestRead),
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description: "The ID of the site whose dial plan is evaluated.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"dialed_numbers": {
+				Description: "The dialed strings to evaluate.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Description: "The result of the evaluation of each dialed string, in the order of `dialed_numbers`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dialed_number": {
+							Description: "The dialed string.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"matched": {
+							Description: "Whether the dialed string matched a number plan.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"number_plan_name": {
+							Description: "The name of the number plan that matched the dialed string.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"match_type": {
+							Description: "The match type of the number plan that matched the dialed string.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"classification": {
+							Description: "The classification of the number plan that matched the dialed string.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"normalized_number": {
+							Description: "The dialed string normalized by the number plan.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outbound_route_name": {
+							Description: "The name of the enabled outbound route that handles the classification.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"distribution": {
+							Description: "The distribution of the outbound route.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"external_trunk_base_ids": {
+							Description: "The trunk base settings of the outbound route, in the order they are used.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package telephony_providers_edges_trunk
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+const (
+	connectionStateConnected    = "CONNECTED"
+	connectionStateDisconnected = "DISCONNECTED"
+	trunkStateUnknown           = "UNKNOWN"
+
+	registrationStateRegistered          = "REGISTERED"
+	registrationStatePartiallyRegistered = "PARTIALLY_REGISTERED"
+	registrationStateUnregistered        = "UNREGISTERED"
+	registrationStateDisabled            = "DISABLED"
+)
+
+// trunkStatusFilter holds the filters of a trunk status data source. Empty filters match every trunk.
+type trunkStatusFilter struct {
+	trunkIds    []string
+	edgeId      string
+	trunkBaseId string
+	trunkType   string
+}
+
+// dataSourceTrunkStatusRead returns the connection and registration state of the trunks matching the filters
+func dataSourceTrunkStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	tp := getTrunkProxy(sdkConfig)
+
+	filter := trunkStatusFilter{
+		trunkIds:    *lists.SetToStringList(d.Get("trunk_ids").(*schema.Set)),
+		edgeId:      d.Get("edge_id").(string),
+		trunkBaseId: d.Get("trunk_base_settings_id").(string),
+		trunkType:   d.Get("trunk_type").(string),
+	}
+
+	trunks, err := getFilteredTrunks(ctx, tp, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allConnected := len(trunks) > 0
+	disconnectedIds := make([]string, 0)
+	flattenedTrunks := make([]interface{}, 0)
+	for _, trunk := range trunks {
+		if getConnectionState(trunk) != connectionStateConnected {
+			allConnected = false
+			disconnectedIds = append(disconnectedIds, *trunk.Id)
+		}
+		flattenedTrunks = append(flattenedTrunks, flattenTrunkStatus(trunk))
+	}
+
+	d.SetId(filter.id())
+	_ = d.Set("trunks", flattenedTrunks)
+	_ = d.Set("all_connected", allConnected)
+	_ = d.Set("disconnected_trunk_ids", disconnectedIds)
+	return nil
+}
+
+// getFilteredTrunks returns the trunks that are not deleted and match all of the filters, ordered by name
+func getFilteredTrunks(ctx context.Context, tp *trunkProxy, filter trunkStatusFilter) ([]platformclientv2.Trunk, error) {
+	var matches []platformclientv2.Trunk
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		trunks, _, getErr := tp.getTrunksByFilter(ctx, pageNum, pageSize, filter.edgeId, filter.trunkBaseId, filter.trunkType)
+		if getErr != nil {
+			return nil, fmt.Errorf("Failed to get page of trunks: %s", getErr)
+		}
+
+		if trunks.Entities == nil || len(*trunks.Entities) == 0 {
+			break
+		}
+
+		for _, trunk := range *trunks.Entities {
+			if trunk.Id != nil && filter.matches(trunk) {
+				matches = append(matches, trunk)
+			}
+		}
+
+		if trunks.PageCount == nil || pageNum >= *trunks.PageCount {
+			break
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return getTrunkName(matches[i]) < getTrunkName(matches[j])
+	})
+	return matches, nil
+}
+
+// matches reports whether the trunk is not deleted and matches all of the filters
+func (f trunkStatusFilter) matches(trunk platformclientv2.Trunk) bool {
+	if trunk.State != nil && *trunk.State == "deleted" {
+		return false
+	}
+	if len(f.trunkIds) > 0 && !lists.ItemInSlice(*trunk.Id, f.trunkIds) {
+		return false
+	}
+	if f.edgeId != "" && (trunk.Edge == nil || trunk.Edge.Id == nil || *trunk.Edge.Id != f.edgeId) {
+		return false
+	}
+	if f.trunkBaseId != "" && (trunk.TrunkBase == nil || trunk.TrunkBase.Id == nil || *trunk.TrunkBase.Id != f.trunkBaseId) {
+		return false
+	}
+	if f.trunkType != "" && (trunk.TrunkType == nil || *trunk.TrunkType != f.trunkType) {
+		return false
+	}
+	return true
+}
+
+// id returns a data source ID that is stable for the same set of filters
+func (f trunkStatusFilter) id() string {
+	trunkIds := append([]string(nil), f.trunkIds...)
+	sort.Strings(trunkIds)
+	key := strings.Join([]string{strings.Join(trunkIds, ","), f.edgeId, f.trunkBaseId, f.trunkType}, "|")
+	return fmt.Sprintf("trunk-status-%x", sha256.Sum256([]byte(key)))
+}
+
+// getConnectionState returns CONNECTED or DISCONNECTED, or UNKNOWN if the trunk has not reported a connected status
+func getConnectionState(trunk platformclientv2.Trunk) string {
+	if trunk.ConnectedStatus == nil || trunk.ConnectedStatus.Connected == nil {
+		return trunkStateUnknown
+	}
+	if *trunk.ConnectedStatus.Connected {
+		return connectionStateConnected
+	}
+	return connectionStateDisconnected
+}
+
+// getRegistrationState summarises the state of every registration of the trunk
+func getRegistrationState(trunk platformclientv2.Trunk) string {
+	if trunk.RegistersEnabledStatus != nil && !strings.EqualFold(*trunk.RegistersEnabledStatus, "Enabled") {
+		return registrationStateDisabled
+	}
+	if trunk.RegistersStatus == nil || len(*trunk.RegistersStatus) == 0 {
+		return trunkStateUnknown
+	}
+
+	registered := 0
+	for _, register := range *trunk.RegistersStatus {
+		if register.RegisterState != nil && *register.RegisterState {
+			registered++
+		}
+	}
+	switch registered {
+	case len(*trunk.RegistersStatus):
+		return registrationStateRegistered
+	case 0:
+		return registrationStateUnregistered
+	default:
+		return registrationStatePartiallyRegistered
+	}
+}
+
+func flattenTrunkStatus(trunk platformclientv2.Trunk) map[string]interface{} {
+	inService := trunk.InService != nil && *trunk.InService
+	edgeInService := trunk.Enabled != nil && *trunk.Enabled
+	connectionState := getConnectionState(trunk)
+
+	// The API has no inbound or outbound flags, so inbound_enabled and outbound_enabled are derived from the trunk and Edge state
+	flattened := map[string]interface{}{
+		"id":                     *trunk.Id,
+		"name":                   getTrunkName(trunk),
+		"trunk_type":             "",
+		"edge_id":                "",
+		"edge_group_id":          "",
+		"trunk_base_settings_id": "",
+		"in_service":             inService,
+		"edge_in_service":        edgeInService,
+		"inbound_enabled":        inService && edgeInService,
+		"outbound_enabled":       inService && edgeInService && connectionState == connectionStateConnected,
+		"connection_state":       connectionState,
+		"connection_state_time":  "",
+		"registration_state":     getRegistrationState(trunk),
+	}
+	if trunk.TrunkType != nil {
+		flattened["trunk_type"] = *trunk.TrunkType
+	}
+	if trunk.Edge != nil && trunk.Edge.Id != nil {
+		flattened["edge_id"] = *trunk.Edge.Id
+	}
+	if trunk.EdgeGroup != nil && trunk.EdgeGroup.Id != nil {
+		flattened["edge_group_id"] = *trunk.EdgeGroup.Id
+	}
+	if trunk.TrunkBase != nil && trunk.TrunkBase.Id != nil {
+		flattened["trunk_base_settings_id"] = *trunk.TrunkBase.Id
+	}
+	if trunk.ConnectedStatus != nil && trunk.ConnectedStatus.ConnectedStateTime != nil {
+		flattened["connection_state_time"] = trunk.ConnectedStatus.ConnectedStateTime.UTC().Format(time.RFC3339)
+	}
+	return flattened
+}
+
+func getTrunkName(trunk platformclientv2.Trunk) string {
+	if trunk.Name == nil {
+		return ""
+	}
+	return *trunk.Name
+}
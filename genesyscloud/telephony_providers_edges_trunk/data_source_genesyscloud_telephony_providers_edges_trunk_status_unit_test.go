@@ -0,0 +1,185 @@
+package telephony_providers_edges_trunk
+
+import (
+	"context"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestTrunk(id, name, trunkType, edgeId string, connected bool) platformclientv2.Trunk {
+	return platformclientv2.Trunk{
+		Id:              platformclientv2.String(id),
+		Name:            platformclientv2.String(name),
+		State:           platformclientv2.String("active"),
+		TrunkType:       platformclientv2.String(trunkType),
+		Edge:            &platformclientv2.Domainentityref{Id: platformclientv2.String(edgeId)},
+		TrunkBase:       &platformclientv2.Domainentityref{Id: platformclientv2.String("base-" + id)},
+		InService:       platformclientv2.Bool(true),
+		Enabled:         platformclientv2.Bool(true),
+		ConnectedStatus: &platformclientv2.Trunkconnectedstatus{Connected: platformclientv2.Bool(connected)},
+	}
+}
+
+func buildTestRegisters(states ...bool) *[]platformclientv2.Trunkmetricsregisters {
+	registers := make([]platformclientv2.Trunkmetricsregisters, 0, len(states))
+	for _, state := range states {
+		registers = append(registers, platformclientv2.Trunkmetricsregisters{RegisterState: platformclientv2.Bool(state)})
+	}
+	return &registers
+}
+
+func TestUnitDataSourceTrunkStatusRead(t *testing.T) {
+	deletedTrunk := buildTestTrunk("trunk-d", "Trunk D", "EXTERNAL", "edge-1", true)
+	deletedTrunk.State = platformclientv2.String("deleted")
+	outOfServiceTrunk := buildTestTrunk("trunk-c", "Trunk C", "PHONE", "edge-2", true)
+	outOfServiceTrunk.InService = platformclientv2.Bool(false)
+	orgTrunks := []platformclientv2.Trunk{
+		buildTestTrunk("trunk-b", "Trunk B", "EXTERNAL", "edge-1", false),
+		buildTestTrunk("trunk-a", "Trunk A", "EXTERNAL", "edge-1", true),
+		outOfServiceTrunk,
+		deletedTrunk,
+	}
+
+	tp := &trunkProxy{}
+	tp.getTrunksByFilterAttr = func(ctx context.Context, p *trunkProxy, pageNum int, pageSize int, edgeId string, trunkBaseId string, trunkType string) (*platformclientv2.Trunkentitylisting, *platformclientv2.APIResponse, error) {
+		trunks := make([]platformclientv2.Trunk, 0)
+		if pageNum == 1 {
+			for _, trunk := range orgTrunks {
+				if (edgeId == "" || *trunk.Edge.Id == edgeId) && (trunkType == "" || *trunk.TrunkType == trunkType) {
+					trunks = append(trunks, trunk)
+				}
+			}
+		}
+		return &platformclientv2.Trunkentitylisting{Entities: &trunks, PageCount: platformclientv2.Int(1)}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = tp
+	defer func() { internalProxy = nil }()
+
+	testCases := []struct {
+		name                 string
+		filters              map[string]interface{}
+		expectedIds          []string
+		expectedAllConnected bool
+		expectedDisconnected []string
+	}{
+		{
+			name:                 "no filters",
+			filters:              map[string]interface{}{},
+			expectedIds:          []string{"trunk-a", "trunk-b", "trunk-c"},
+			expectedAllConnected: false,
+			expectedDisconnected: []string{"trunk-b"},
+		},
+		{
+			name:                 "edge",
+			filters:              map[string]interface{}{"edge_id": "edge-2"},
+			expectedIds:          []string{"trunk-c"},
+			expectedAllConnected: true,
+			expectedDisconnected: []string{},
+		},
+		{
+			name:                 "trunk ids",
+			filters:              map[string]interface{}{"trunk_ids": []interface{}{"trunk-a", "trunk-d"}},
+			expectedIds:          []string{"trunk-a"},
+			expectedAllConnected: true,
+			expectedDisconnected: []string{},
+		},
+		{
+			name:                 "no match",
+			filters:              map[string]interface{}{"trunk_type": "EDGE"},
+			expectedIds:          []string{},
+			expectedAllConnected: false,
+			expectedDisconnected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, DataSourceTrunkStatus().Schema, tc.filters)
+			diags := dataSourceTrunkStatusRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			ids := make([]string, 0)
+			for _, trunk := range d.Get("trunks").([]interface{}) {
+				ids = append(ids, trunk.(map[string]interface{})["id"].(string))
+			}
+			disconnected := make([]string, 0)
+			for _, id := range d.Get("disconnected_trunk_ids").([]interface{}) {
+				disconnected = append(disconnected, id.(string))
+			}
+			assert.Equal(t, tc.expectedIds, ids)
+			assert.Equal(t, tc.expectedAllConnected, d.Get("all_connected").(bool))
+			assert.Equal(t, tc.expectedDisconnected, disconnected)
+			assert.NotEmpty(t, d.Id())
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceTrunkStatus().Schema, map[string]interface{}{})
+	diags := dataSourceTrunkStatusRead(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError())
+	trunks := d.Get("trunks").([]interface{})
+	trunkA := trunks[0].(map[string]interface{})
+	assert.Equal(t, "edge-1", trunkA["edge_id"])
+	assert.Equal(t, "base-trunk-a", trunkA["trunk_base_settings_id"])
+	assert.Equal(t, connectionStateConnected, trunkA["connection_state"])
+	assert.Equal(t, true, trunkA["inbound_enabled"])
+	assert.Equal(t, true, trunkA["outbound_enabled"])
+	trunkB := trunks[1].(map[string]interface{})
+	assert.Equal(t, connectionStateDisconnected, trunkB["connection_state"])
+	assert.Equal(t, true, trunkB["inbound_enabled"])
+	assert.Equal(t, false, trunkB["outbound_enabled"])
+	trunkC := trunks[2].(map[string]interface{})
+	assert.Equal(t, false, trunkC["in_service"])
+	assert.Equal(t, false, trunkC["inbound_enabled"])
+	assert.Equal(t, false, trunkC["outbound_enabled"])
+}
+
+func TestUnitGetRegistrationState(t *testing.T) {
+	testCases := []struct {
+		name           string
+		enabledStatus  *string
+		registers      *[]platformclientv2.Trunkmetricsregisters
+		expectedStatus string
+	}{
+		{"disabled", platformclientv2.String("Disabled"), buildTestRegisters(true), registrationStateDisabled},
+		{"no registers", platformclientv2.String("Enabled"), nil, trunkStateUnknown},
+		{"registered", platformclientv2.String("Enabled"), buildTestRegisters(true, true), registrationStateRegistered},
+		{"partially registered", platformclientv2.String("Enabled"), buildTestRegisters(true, false), registrationStatePartiallyRegistered},
+		{"unregistered", nil, buildTestRegisters(false), registrationStateUnregistered},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trunk := platformclientv2.Trunk{RegistersEnabledStatus: tc.enabledStatus, RegistersStatus: tc.registers}
+			assert.Equal(t, tc.expectedStatus, getRegistrationState(trunk))
+		})
+	}
+}
+
+func TestUnitWaitForTrunkConnected(t *testing.T) {
+	reads := 0
+	tp := &trunkProxy{}
+	tp.getTrunkByIdAttr = func(ctx context.Context, p *trunkProxy, id string) (*platformclientv2.Trunk, *platformclientv2.APIResponse, error) {
+		reads++
+		trunk := buildTestTrunk(id, "Trunk A", "EXTERNAL", "edge-1", reads > 1)
+		return &trunk, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	diags := waitForTrunkConnected(context.Background(), tp, "trunk-a", time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, reads)
+
+	tp.getTrunkByIdAttr = func(ctx context.Context, p *trunkProxy, id string) (*platformclientv2.Trunk, *platformclientv2.APIResponse, error) {
+		trunk := buildTestTrunk(id, "Trunk A", "EXTERNAL", "edge-1", false)
+		return &trunk, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	diags = waitForTrunkConnected(context.Background(), tp, "trunk-a", time.Second)
+	assert.True(t, diags.HasError())
+}
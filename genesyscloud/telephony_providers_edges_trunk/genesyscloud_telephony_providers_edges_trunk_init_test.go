@@ -41,6 +41,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 
 	providerDataSources["genesyscloud_telephony_providers_edges_trunkbasesettings"] = telephony.DataSourceTrunkBaseSettings()
 	providerDataSources[resourceName] = DataSourceTrunk()
+	providerDataSources[trunkStatusDataSourceName] = DataSourceTrunkStatus()
 	// external package dependencies
 	providerDataSources["genesyscloud_telephony_providers_edges_site"] = edgeSite.DataSourceSite()
 
@@ -15,6 +15,7 @@ var internalProxy *trunkProxy
 
 type getTrunkByIdFunc func(ctx context.Context, p *trunkProxy, id string) (*platformclientv2.Trunk, *platformclientv2.APIResponse, error)
 type getAllTrunksFunc func(ctx context.Context, p *trunkProxy, pageNum int, pageSize int) (*platformclientv2.Trunkentitylisting, *platformclientv2.APIResponse, error)
+type getTrunksByFilterFunc func(ctx context.Context, p *trunkProxy, pageNum int, pageSize int, edgeId string, trunkBaseId string, trunkType string) (*platformclientv2.Trunkentitylisting, *platformclientv2.APIResponse, error)
 type getTrunkBaseSettingsFunc func(ctx context.Context, p *trunkProxy, trunkBaseSettingsId string) (*platformclientv2.Trunkbase, *platformclientv2.APIResponse, error)
 type getEdgeFunc func(ctx context.Context, p *trunkProxy, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error)
 type putEdgeFunc func(ctx context.Context, p *trunkProxy, edgeId string, edge platformclientv2.Edge) (*platformclientv2.Edge, *platformclientv2.APIResponse, error)
@@ -28,6 +29,7 @@ type trunkProxy struct {
 
 	getTrunkByIdAttr         getTrunkByIdFunc
 	getAllTrunksAttr         getAllTrunksFunc
+	getTrunksByFilterAttr    getTrunksByFilterFunc
 	getTrunkBaseSettingsAttr getTrunkBaseSettingsFunc
 	getEdgeAttr              getEdgeFunc
 	putEdgeAttr              putEdgeFunc
@@ -43,6 +45,7 @@ func newTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
 		edgesApi:                 edgesApi,
 		getTrunkByIdAttr:         getTrunkByIdFn,
 		getAllTrunksAttr:         getAllTrunksFn,
+		getTrunksByFilterAttr:    getTrunksByFilterFn,
 		getEdgeAttr:              getEdgeFn,
 		putEdgeAttr:              putEdgeFn,
 		getEdgeGroupAttr:         getEdgeGroupFn,
@@ -89,6 +92,10 @@ func (p *trunkProxy) getAllTrunks(ctx context.Context, pageNum int, pageSize int
 	return p.getAllTrunksAttr(ctx, p, pageNum, pageSize)
 }
 
+func (p *trunkProxy) getTrunksByFilter(ctx context.Context, pageNum int, pageSize int, edgeId string, trunkBaseId string, trunkType string) (*platformclientv2.Trunkentitylisting, *platformclientv2.APIResponse, error) {
+	return p.getTrunksByFilterAttr(ctx, p, pageNum, pageSize, edgeId, trunkBaseId, trunkType)
+}
+
 func getEdgeFn(ctx context.Context, p *trunkProxy, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
 	return p.edgesApi.GetTelephonyProvidersEdge(edgeId, nil)
 }
@@ -117,3 +124,7 @@ func getAllTrunksFn(ctx context.Context, p *trunkProxy, pageNum int, pageSize in
 
 	return p.edgesApi.GetTelephonyProvidersEdgesTrunks(pageNum, pageSize, "", "", "", "", "")
 }
+
+func getTrunksByFilterFn(ctx context.Context, p *trunkProxy, pageNum int, pageSize int, edgeId string, trunkBaseId string, trunkType string) (*platformclientv2.Trunkentitylisting, *platformclientv2.APIResponse, error) {
+	return p.edgesApi.GetTelephonyProvidersEdgesTrunks(pageNum, pageSize, "", "", edgeId, trunkBaseId, trunkType)
+}
@@ -5,12 +5,17 @@ import (
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-const resourceName = "genesyscloud_telephony_providers_edges_trunk"
+const (
+	resourceName              = "genesyscloud_telephony_providers_edges_trunk"
+	trunkStatusDataSourceName = "genesyscloud_telephony_providers_edges_trunk_status"
+)
 
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceTrunk())
+	l.RegisterDataSource(trunkStatusDataSourceName, DataSourceTrunkStatus())
 	l.RegisterResource(resourceName, ResourceTrunk())
 	l.RegisterExporter(resourceName, TrunkExporter())
 }
@@ -65,6 +70,131 @@ func ResourceTrunk() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"wait_for_connected": {
+				Description:      "If set, wait during create and update until the trunk reports a connected state, for at most this duration (e.g. \"5m\"). The apply fails if the trunk is not connected in time.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: gcloud.ValidateDuration,
+			},
+		},
+	}
+}
+
+var trunkStatusResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "ID of the trunk.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the trunk.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"trunk_type": {
+			Description: "Type of the trunk. EXTERNAL, PHONE or EDGE.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"edge_id": {
+			Description: "ID of the Edge the trunk is bound to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"edge_group_id": {
+			Description: "ID of the edge group the trunk belongs to. Empty if the trunk is not assigned through an edge group.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"trunk_base_settings_id": {
+			Description: "ID of the trunk base settings used by the trunk.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"in_service": {
+			Description: "True if the trunk is enabled in its trunk base settings.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"edge_in_service": {
+			Description: "True if the Edge the trunk is bound to is in service.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"inbound_enabled": {
+			Description: "Derived by the provider as in_service and edge_in_service. The API does not report whether a trunk can receive calls, so this is an estimate.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"outbound_enabled": {
+			Description: "Derived by the provider as in_service and edge_in_service and a CONNECTED connection_state. The API does not report whether a trunk can place calls, so this is an estimate.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"connection_state": {
+			Description: "Connection state of the trunk. CONNECTED, DISCONNECTED or UNKNOWN if the trunk has not reported a state.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"connection_state_time": {
+			Description: "Time of the last change of the connection state in ISO-8601 format. Empty if the trunk has not reported a state.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"registration_state": {
+			Description: "Registration state of the trunk. REGISTERED if every registration succeeded, PARTIALLY_REGISTERED if only some did, UNREGISTERED if none did, DISABLED if registration is not enabled for the trunk and UNKNOWN if no registration has been reported.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceTrunkStatus registers the genesyscloud_telephony_providers_edges_trunk_status data source
+func DataSourceTrunkStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the health of Genesys Cloud Trunks. Returns the connection and registration state of every trunk matching a set of filters. `inbound_enabled` and `outbound_enabled` are not reported by the API and are derived from the trunk and Edge state.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceTrunkStatusRead),
+		Schema: map[string]*schema.Schema{
+			"trunk_ids": {
+				Description: "Only return the trunks with these IDs.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"edge_id": {
+				Description: "Only return trunks bound to this Edge.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"trunk_base_settings_id": {
+				Description: "Only return trunks using these trunk base settings.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"trunk_type": {
+				Description:  "Only return trunks of this type. Valid values: EXTERNAL, PHONE, EDGE.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EXTERNAL", "PHONE", "EDGE"}, false),
+			},
+			"trunks": {
+				Description: "Status of the matching trunks, ordered by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        trunkStatusResource,
+			},
+			"all_connected": {
+				Description: "True if every matching trunk is connected. False if any trunk is not connected or no trunk matches.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"disconnected_trunk_ids": {
+				Description: "IDs of the matching trunks that are not connected.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
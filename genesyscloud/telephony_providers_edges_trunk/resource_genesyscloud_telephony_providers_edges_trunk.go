@@ -86,9 +86,35 @@ func createTrunk(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	log.Printf("Created trunk %s", *trunk.Id)
 
+	if waitFor, ok := d.GetOk("wait_for_connected"); ok {
+		timeout, err := time.ParseDuration(waitFor.(string))
+		if err != nil {
+			return diag.Errorf("Failed to parse wait_for_connected %s: %s", waitFor, err)
+		}
+		if diagErr := waitForTrunkConnected(ctx, tp, d.Id(), timeout); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	return readTrunk(ctx, d, meta)
 }
 
+// waitForTrunkConnected waits until the trunk reports a connected state
+func waitForTrunkConnected(ctx context.Context, tp *trunkProxy, trunkId string, timeout time.Duration) diag.Diagnostics {
+	log.Printf("Waiting up to %s for trunk %s to connect", timeout, trunkId)
+	return gcloud.WithRetries(ctx, timeout, func() *retry.RetryError {
+		trunk, _, getErr := tp.getTrunkById(ctx, trunkId)
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read trunk %s: %s", trunkId, getErr))
+		}
+		if state := getConnectionState(*trunk); state != connectionStateConnected {
+			return retry.RetryableError(fmt.Errorf("Trunk %s is not connected. Connection state: %s, registration state: %s", trunkId, state, getRegistrationState(*trunk)))
+		}
+		log.Printf("Trunk %s is connected", trunkId)
+		return nil
+	})
+}
+
 func getTrunkByTrunkBaseId(ctx context.Context, trunkBaseId string, meta interface{}) (*platformclientv2.Trunk, error) {
 	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
 	tp := getTrunkProxy(sdkConfig)
@@ -14,6 +14,14 @@ func generateTrunkDataSource(
 	}
 	`, resourceName, resourceID, name, dependsOnResource)
 }
+
+func generateTrunkStatusDataSource(resourceID string, trunkId string) string {
+	return fmt.Sprintf(`data "%s" "%s" {
+		trunk_ids = [%s]
+	}
+	`, trunkStatusDataSourceName, resourceID, trunkId)
+}
+
 func generateTrunk(
 	trunkRes,
 	trunkBaseSettingsId,
@@ -203,6 +203,14 @@ func (g *GenesysCloudResourceExporter) setUpExportDirPath() (diagErr diag.Diagno
 	return nil
 }
 
+// isIncludedByName returns true if the resource type is named in the include filter of the export
+func (g *GenesysCloudResourceExporter) isIncludedByName(resType string) bool {
+	if g.filterList == nil || (g.filterType != IncludeResources && g.filterType != LegacyInclude) {
+		return false
+	}
+	return lists.ItemInSlice(resType, formatFilter(*g.filterList))
+}
+
 // retrieveExporters will return a list of all the registered exporters. If the resource_type on the exporter contains any elements, only the defined
 // elements in the resource_type attribute will be returned.
 func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnostics) {
@@ -215,6 +223,13 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 		exports = g.resourceTypeFilter(exports, *g.filterList)
 	}
 
+	// Resource types that duplicate attributes of other resource types must be requested by name
+	for resType, exporter := range exports {
+		if exporter.ExportOnlyWhenIncluded && !g.isIncludedByName(resType) {
+			delete(exports, resType)
+		}
+	}
+
 	g.exporters = &exports
 
 	// Assign excluded attributes to the config Map
@@ -1068,7 +1083,7 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 			if phoneNumber, ok := configMap[key].(string); !ok || phoneNumber == "" {
 				continue
 			}
-			configMap[key] = sanitizeE164Number(configMap[key].(string))
+			configMap[key] = gcloud.SanitizeE164Number(configMap[key].(string))
 			continue
 		}
 
@@ -385,3 +385,27 @@ func TestUnitTfExportMergeExporters(t *testing.T) {
 		}
 	}
 }
+
+func TestUnitTfExportIsIncludedByName(t *testing.T) {
+	testCases := []struct {
+		filterType ExporterFilterType
+		filterList *[]string
+		expected   bool
+	}{
+		{filterType: IncludeResources, filterList: &[]string{"genesyscloud_routing_queue_members::queue1"}, expected: true},
+		{filterType: LegacyInclude, filterList: &[]string{"genesyscloud_routing_queue_members"}, expected: true},
+		{filterType: IncludeResources, filterList: &[]string{"genesyscloud_routing_queue"}, expected: false},
+		{filterType: ExcludeResources, filterList: &[]string{"genesyscloud_routing_queue_members"}, expected: false},
+		{filterType: IncludeResources, filterList: nil, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		exporter := GenesysCloudResourceExporter{
+			filterType: testCase.filterType,
+			filterList: testCase.filterList,
+		}
+		if actual := exporter.isIncludedByName("genesyscloud_routing_queue_members"); actual != testCase.expected {
+			t.Errorf("Expected %v for filter %v, got %v", testCase.expected, testCase.filterList, actual)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 	"testing"
 	"time"
 
@@ -177,7 +178,7 @@ func TestAccResourceTfExportByName(t *testing.T) {
 					userResource1,
 					userEmail1,
 					userName1,
-				) + gcloud.GenerateRoutingQueueResource(
+				) + routingQueue.GenerateRoutingQueueResource(
 					queueResource,
 					queueName,
 					queueDesc,
@@ -218,7 +219,7 @@ func TestAccResourceTfExportByName(t *testing.T) {
 					userResource1,
 					userEmail1,
 					userName1,
-				) + gcloud.GenerateRoutingQueueResource(
+				) + routingQueue.GenerateRoutingQueueResource(
 					queueResource,
 					queueName,
 					queueDesc,
@@ -272,7 +273,7 @@ func TestAccResourceTfExportByName(t *testing.T) {
 					userResource2,
 					userEmail2,
 					userName2,
-				) + gcloud.GenerateRoutingQueueResource(
+				) + routingQueue.GenerateRoutingQueueResource(
 					queueResource,
 					queueName,
 					queueDesc,
@@ -806,7 +807,7 @@ func TestAccResourceTfExportQueueAsHCL(t *testing.T) {
 		emailScriptID = uuid.NewString()
 	)
 
-	routingQueue := gcloud.GenerateRoutingQueueResource(
+	routingQueue := routingQueue.GenerateRoutingQueueResource(
 		queueID,
 		queueName,
 		description,
@@ -819,9 +820,9 @@ func TestAccResourceTfExportQueueAsHCL(t *testing.T) {
 		"true",
 		gcloud.TrueValue,
 		gcloud.FalseValue,
-		gcloud.GenerateMediaSettings("media_settings_call", alertTimeoutSec, gcloud.FalseValue, slPercentage, slDurationMs),
-		gcloud.GenerateRoutingRules(rrOperator, rrThreshold, rrWaitSeconds),
-		gcloud.GenerateDefaultScriptIDs(chatScriptID, emailScriptID),
+		routingQueue.GenerateMediaSettings("media_settings_call", alertTimeoutSec, gcloud.FalseValue, slPercentage, slDurationMs),
+		routingQueue.GenerateRoutingRules(rrOperator, rrThreshold, rrWaitSeconds),
+		routingQueue.GenerateDefaultScriptIDs(chatScriptID, emailScriptID),
 	)
 
 	resource.Test(t, resource.TestCase{
@@ -1898,7 +1899,7 @@ func validateRoutingRules(resourceName string, ringNum int, operator string, thr
 func buildQueueResources(queueExports []QueueExport) string {
 	queueResourceDefinitions := ""
 	for _, queueExport := range queueExports {
-		queueResourceDefinitions = queueResourceDefinitions + gcloud.GenerateRoutingQueueResource(
+		queueResourceDefinitions = queueResourceDefinitions + routingQueue.GenerateRoutingQueueResource(
 			queueExport.ResourceName,
 			queueExport.Name,
 			queueExport.Description,
@@ -0,0 +1,172 @@
+package user_routing_utilization
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_user_routing_utilization_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+Each proxy implementation:
+
+1.  Should provide a private package level variable that holds a instance of a proxy class.
+2.  A New... constructor function to initialize the proxy object. This constructor should only be used within
+    the proxy.
+3.  A get private constructor function that the classes in the package can be used to retrieve
+    the proxy. This proxy should check to see if the package level proxy instance is nil and
+    should initialize it, otherwise it should return the instance
+4.  Type definitions for each function that will be used in the proxy.  We use composition here
+    so that we can easily provide mocks for testing.
+5.  A struct for the proxy that holds an attribute for each function type.
+6.  Wrapper methods on each of the elements on the struct.
+7.  Function implementations for each function type definition.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *userRoutingUtilizationProxy
+
+// userUtilizationRequest is the body of a user utilization update. The SDK does not support label utilizations yet.
+// An empty map of label utilizations removes every label utilization from the user.
+type userUtilizationRequest struct {
+	Utilization       map[string]gcloud.MediaUtilization `json:"utilization"`
+	LabelUtilizations map[string]gcloud.LabelUtilization `json:"labelUtilizations"`
+}
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getUserRoutingUtilizationFunc func(ctx context.Context, p *userRoutingUtilizationProxy, userId string) (*gcloud.AgentUtilizationWithLabels, *platformclientv2.APIResponse, error)
+type updateUserRoutingUtilizationFunc func(ctx context.Context, p *userRoutingUtilizationProxy, userId string, request *userUtilizationRequest) (*platformclientv2.APIResponse, error)
+type deleteUserRoutingUtilizationFunc func(ctx context.Context, p *userRoutingUtilizationProxy, userId string) (*platformclientv2.APIResponse, error)
+type getAllUtilizationLabelsFunc func(ctx context.Context, p *userRoutingUtilizationProxy) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error)
+
+// userRoutingUtilizationProxy contains all of the methods that call genesys cloud APIs.
+type userRoutingUtilizationProxy struct {
+	clientConfig *platformclientv2.Configuration
+	routingApi   *platformclientv2.RoutingApi
+
+	getUserRoutingUtilizationAttr    getUserRoutingUtilizationFunc
+	updateUserRoutingUtilizationAttr updateUserRoutingUtilizationFunc
+	deleteUserRoutingUtilizationAttr deleteUserRoutingUtilizationFunc
+	getAllUtilizationLabelsAttr      getAllUtilizationLabelsFunc
+}
+
+// newUserRoutingUtilizationProxy initializes the user routing utilization proxy with all of the data needed to communicate with Genesys Cloud
+func newUserRoutingUtilizationProxy(clientConfig *platformclientv2.Configuration) *userRoutingUtilizationProxy {
+	return &userRoutingUtilizationProxy{
+		clientConfig: clientConfig,
+		routingApi:   platformclientv2.NewRoutingApiWithConfig(clientConfig),
+
+		getUserRoutingUtilizationAttr:    getUserRoutingUtilizationFn,
+		updateUserRoutingUtilizationAttr: updateUserRoutingUtilizationFn,
+		deleteUserRoutingUtilizationAttr: deleteUserRoutingUtilizationFn,
+		getAllUtilizationLabelsAttr:      getAllUtilizationLabelsFn,
+	}
+}
+
+// getUserRoutingUtilizationProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getUserRoutingUtilizationProxy(clientConfig *platformclientv2.Configuration) *userRoutingUtilizationProxy {
+	if internalProxy == nil {
+		internalProxy = newUserRoutingUtilizationProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getUserRoutingUtilization retrieves the utilization settings of a user, including label utilizations
+func (p *userRoutingUtilizationProxy) getUserRoutingUtilization(ctx context.Context, userId string) (*gcloud.AgentUtilizationWithLabels, *platformclientv2.APIResponse, error) {
+	return p.getUserRoutingUtilizationAttr(ctx, p, userId)
+}
+
+// updateUserRoutingUtilization replaces the utilization settings of a user
+func (p *userRoutingUtilizationProxy) updateUserRoutingUtilization(ctx context.Context, userId string, request *userUtilizationRequest) (*platformclientv2.APIResponse, error) {
+	return p.updateUserRoutingUtilizationAttr(ctx, p, userId, request)
+}
+
+// deleteUserRoutingUtilization resets the utilization settings of a user to the org-wide settings
+func (p *userRoutingUtilizationProxy) deleteUserRoutingUtilization(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserRoutingUtilizationAttr(ctx, p, userId)
+}
+
+// getAllUtilizationLabels retrieves all utilization labels in the org
+func (p *userRoutingUtilizationProxy) getAllUtilizationLabels(ctx context.Context) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+	return p.getAllUtilizationLabelsAttr(ctx, p)
+}
+
+// getUserRoutingUtilizationFn is the implementation for retrieving the utilization settings of a user in Genesys Cloud
+func getUserRoutingUtilizationFn(_ context.Context, p *userRoutingUtilizationProxy, userId string) (*gcloud.AgentUtilizationWithLabels, *platformclientv2.APIResponse, error) {
+	apiClient := &p.routingApi.Configuration.APIClient
+	path := fmt.Sprintf("%s/api/v2/routing/users/%s/utilization", p.routingApi.Configuration.BasePath, userId)
+
+	var successPayload *gcloud.AgentUtilizationWithLabels
+	response, err := apiClient.CallAPI(path, http.MethodGet, nil, p.buildHeaderParams(), nil, nil, "", nil)
+	if err != nil {
+		// Nothing special to do here, but do avoid processing the response
+	} else if response.Error != nil {
+		err = errors.New(response.ErrorMessage)
+	} else {
+		err = json.Unmarshal(response.RawBody, &successPayload)
+	}
+	return successPayload, response, err
+}
+
+// updateUserRoutingUtilizationFn is the implementation for updating the utilization settings of a user in Genesys Cloud
+func updateUserRoutingUtilizationFn(_ context.Context, p *userRoutingUtilizationProxy, userId string, request *userUtilizationRequest) (*platformclientv2.APIResponse, error) {
+	apiClient := &p.routingApi.Configuration.APIClient
+	path := fmt.Sprintf("%s/api/v2/routing/users/%s/utilization", p.routingApi.Configuration.BasePath, userId)
+
+	response, err := apiClient.CallAPI(path, http.MethodPut, request, p.buildHeaderParams(), nil, nil, "", nil)
+	if err == nil && response.Error != nil {
+		err = errors.New(response.ErrorMessage)
+	}
+	return response, err
+}
+
+// deleteUserRoutingUtilizationFn is the implementation for resetting the utilization settings of a user in Genesys Cloud
+func deleteUserRoutingUtilizationFn(_ context.Context, p *userRoutingUtilizationProxy, userId string) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.DeleteRoutingUserUtilization(userId)
+}
+
+// getAllUtilizationLabelsFn is the implementation for retrieving all utilization labels in Genesys Cloud
+func getAllUtilizationLabelsFn(_ context.Context, p *userRoutingUtilizationProxy) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+	var allLabels []platformclientv2.Utilizationlabel
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		labels, apiResp, err := p.routingApi.GetRoutingUtilizationLabels(pageSize, pageNum, "", "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get utilization labels: %v", err)
+		}
+		if labels.Entities == nil || len(*labels.Entities) == 0 {
+			break
+		}
+		allLabels = append(allLabels, *labels.Entities...)
+		if labels.PageCount == nil || pageNum >= *labels.PageCount {
+			break
+		}
+	}
+	return &allLabels, resp, nil
+}
+
+func (p *userRoutingUtilizationProxy) buildHeaderParams() map[string]string {
+	headerParams := make(map[string]string)
+
+	// add default headers if any
+	for key := range p.routingApi.Configuration.DefaultHeader {
+		headerParams[key] = p.routingApi.Configuration.DefaultHeader[key]
+	}
+
+	headerParams["Authorization"] = "Bearer " + p.routingApi.Configuration.AccessToken
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+	return headerParams
+}
@@ -0,0 +1,175 @@
+package user_routing_utilization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_user_routing_utilization.go contains all of the methods that perform the core logic for a resource.
+*/
+
+// createUserRoutingUtilization sets the utilization of a user, or resets it to the org-wide settings
+func createUserRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userId := d.Get("user_id").(string)
+	d.SetId(userId)
+
+	log.Printf("Creating routing utilization for user %s", userId)
+	if diagErr := applyUserRoutingUtilization(ctx, d, meta); diagErr != nil {
+		d.SetId("")
+		return diagErr
+	}
+	log.Printf("Created routing utilization for user %s", userId)
+	return readUserRoutingUtilization(ctx, d, meta)
+}
+
+// readUserRoutingUtilization reads the utilization of a user and whether it is inherited from the org-wide settings
+func readUserRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getUserRoutingUtilizationProxy(sdkConfig)
+
+	log.Printf("Reading routing utilization for user %s", d.Id())
+	return gcloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		utilization, resp, getErr := proxy.getUserRoutingUtilization(ctx, d.Id())
+		if getErr != nil {
+			if gcloud.IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read routing utilization for user %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read routing utilization for user %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceUserRoutingUtilization())
+
+		_ = d.Set("user_id", d.Id())
+		_ = d.Set("level", utilization.Level)
+		_ = d.Set("inherit_org_defaults", utilization.Level == utilizationLevelOrganization)
+
+		for _, mediaType := range mediaTypes {
+			if mediaSettings, ok := utilization.Utilization[mediaType]; ok {
+				_ = d.Set(mediaType, flattenMediaUtilization(mediaSettings))
+			} else {
+				_ = d.Set(mediaType, nil)
+			}
+		}
+		_ = d.Set("label_utilizations", flattenLabelUtilizations(utilization.LabelUtilizations, d.Get("label_utilizations").([]interface{})))
+
+		log.Printf("Read routing utilization for user %s at %s level", d.Id(), utilization.Level)
+		return cc.CheckState()
+	})
+}
+
+// updateUserRoutingUtilization updates the utilization of a user, or resets it to the org-wide settings
+func updateUserRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating routing utilization for user %s", d.Id())
+	if diagErr := applyUserRoutingUtilization(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Updated routing utilization for user %s", d.Id())
+	return readUserRoutingUtilization(ctx, d, meta)
+}
+
+// deleteUserRoutingUtilization resets the utilization of a user to the org-wide settings
+func deleteUserRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getUserRoutingUtilizationProxy(sdkConfig)
+
+	log.Printf("Resetting routing utilization for user %s", d.Id())
+	resp, err := proxy.deleteUserRoutingUtilization(ctx, d.Id())
+	if err != nil {
+		if gcloud.IsStatus404(resp) {
+			// The user no longer exists
+			return nil
+		}
+		return diag.Errorf("Failed to reset routing utilization for user %s: %s", d.Id(), err)
+	}
+	log.Printf("Reset routing utilization for user %s", d.Id())
+	return nil
+}
+
+// applyUserRoutingUtilization resets the user to the org-wide settings or replaces the user settings with the configured ones
+func applyUserRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getUserRoutingUtilizationProxy(sdkConfig)
+
+	if d.Get("inherit_org_defaults").(bool) {
+		if _, err := proxy.deleteUserRoutingUtilization(ctx, d.Id()); err != nil {
+			return diag.Errorf("Failed to reset routing utilization for user %s: %s", d.Id(), err)
+		}
+		return nil
+	}
+
+	// A label created right before the update can cause a conflict while utilizations are updated for the new label
+	return gcloud.RetryWhen(gcloud.IsStatus409, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, err := proxy.updateUserRoutingUtilization(ctx, d.Id(), buildUserUtilizationRequest(d))
+		if err != nil {
+			return resp, diag.Errorf("Failed to update routing utilization for user %s: %s", d.Id(), err)
+		}
+		return resp, nil
+	})
+}
+
+// customizeUserRoutingUtilizationDiff plans the removal of label utilizations that are no longer configured and
+// validates the configured label utilizations against the labels in the org
+func customizeUserRoutingUtilizationDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("inherit_org_defaults").(bool) && !labelUtilizationsConfigured(diff) {
+		// label_utilizations is computed, so without this the labels of the user would be kept
+		if current, _ := diff.Get("label_utilizations").([]interface{}); len(current) > 0 {
+			return diff.SetNew("label_utilizations", []interface{}{})
+		}
+		return nil
+	}
+
+	labelUtilizations, ok := diff.Get("label_utilizations").([]interface{})
+	if !ok || len(labelUtilizations) == 0 || !diff.HasChange("label_utilizations") {
+		return nil
+	}
+
+	// Labels created in the same apply are not known yet and are not checked against the org
+	knownLabelUtilizations := make([]interface{}, 0, len(labelUtilizations))
+	for i, labelUtilization := range labelUtilizations {
+		if diff.NewValueKnown(fmt.Sprintf("label_utilizations.%d.label_id", i)) &&
+			diff.NewValueKnown(fmt.Sprintf("label_utilizations.%d.interrupting_label_ids", i)) {
+			knownLabelUtilizations = append(knownLabelUtilizations, labelUtilization)
+		}
+	}
+	if len(knownLabelUtilizations) == 0 {
+		return nil
+	}
+
+	sdkConfig := meta.(*gcloud.ProviderMeta).ClientConfig
+	proxy := getUserRoutingUtilizationProxy(sdkConfig)
+	labels, _, err := proxy.getAllUtilizationLabels(ctx)
+	if err != nil {
+		return err
+	}
+	existingLabelIds := make(map[string]bool)
+	for _, label := range *labels {
+		if label.Id != nil {
+			existingLabelIds[*label.Id] = true
+		}
+	}
+
+	return validateLabelUtilizations(knownLabelUtilizations, existingLabelIds)
+}
+
+// labelUtilizationsConfigured returns whether any label_utilizations block is set in the configuration. A configuration
+// that is not available is treated as configured so that the label utilizations are left as they are.
+func labelUtilizationsConfigured(diff *schema.ResourceDiff) bool {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return true
+	}
+	labelUtilizations := rawConfig.GetAttr("label_utilizations")
+	if !labelUtilizations.IsKnown() {
+		return true
+	}
+	return !labelUtilizations.IsNull() && labelUtilizations.LengthInt() > 0
+}
@@ -0,0 +1,145 @@
+package user_routing_utilization
+
+import (
+	"fmt"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_user_routing_utilization_schema.go holds two functions within it:
+
+1.  The registration code that registers the Resource for the package.
+2.  The resource schema definitions for the user_routing_utilization resource.
+*/
+const (
+	resourceName = "genesyscloud_user_routing_utilization"
+
+	utilizationLevelOrganization = "Organization"
+	utilizationLevelAgent        = "Agent"
+)
+
+// mediaTypes are the media types with utilization settings. The schema attribute has the same name as the API media type.
+var mediaTypes = []string{"call", "callback", "chat", "email", "message"}
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceUserRoutingUtilization())
+}
+
+var (
+	mediaUtilizationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"maximum_capacity": {
+				Description:  "Maximum capacity of conversations of this media type. Value must be between 0 and 25.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 25),
+			},
+			"interruptible_media_types": {
+				Description: fmt.Sprintf("Set of other media types that can interrupt this media type (%s).", strings.Join(mediaTypes, " | ")),
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(mediaTypes, false),
+				},
+			},
+			"include_non_acd": {
+				Description: "Block this media type when on a non-ACD conversation.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+
+	labelUtilizationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"label_id": {
+				Description: "ID of the genesyscloud_routing_utilization_label being configured.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"maximum_capacity": {
+				Description:  "Maximum capacity of conversations with this label. Value must be between 0 and 25.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 25),
+			},
+			"interrupting_label_ids": {
+				Description: "Set of IDs of other labels that can interrupt conversations with this label. A label cannot interrupt itself.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+)
+
+// ResourceUserRoutingUtilization registers the genesyscloud_user_routing_utilization resource with Terraform
+func ResourceUserRoutingUtilization() *schema.Resource {
+	mediaAttributes := append(append([]string{}, mediaTypes...), "label_utilizations")
+
+	resourceSchema := map[string]*schema.Schema{
+		"user_id": {
+			Description: "ID of the user.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"inherit_org_defaults": {
+			Description: "Reset the user to the org-wide utilization settings of genesyscloud_routing_utilization. " +
+				"The inherited settings are exposed in the media and label attributes. Cannot be used with media or label settings.",
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: mediaAttributes,
+		},
+		"level": {
+			Description: "Level the utilization settings of the user come from. `Organization` if the settings are inherited from the org-wide settings, otherwise `Agent`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"label_utilizations": {
+			Description: "Label utilization settings. Labels and interrupting labels must exist in the org. " +
+				"Every label utilization of the user is exposed, and labels that are not configured are removed from the user, including labels added outside of Terraform. " +
+				"When `inherit_org_defaults` is true the inherited label settings are exposed instead.",
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem:     labelUtilizationResource,
+		},
+	}
+	for _, mediaType := range mediaTypes {
+		resourceSchema[mediaType] = &schema.Schema{
+			Description: fmt.Sprintf("%s media settings. If not set, the current settings are kept.", strings.ToUpper(mediaType[:1])+mediaType[1:]),
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Computed:    true,
+			Elem:        mediaUtilizationResource,
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Genesys Cloud User Routing Utilization. Manages the utilization settings of a single user independently of genesyscloud_user. " +
+			"Destroying the resource resets the user to the org-wide settings. " +
+			"Do not also set routing_utilization on the genesyscloud_user resource of the same user.",
+
+		CreateContext: gcloud.CreateWithPooledClient(createUserRoutingUtilization),
+		ReadContext:   gcloud.ReadWithPooledClient(readUserRoutingUtilization),
+		UpdateContext: gcloud.UpdateWithPooledClient(updateUserRoutingUtilization),
+		DeleteContext: gcloud.DeleteWithPooledClient(deleteUserRoutingUtilization),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema:        resourceSchema,
+		CustomizeDiff: customizeUserRoutingUtilizationDiff,
+	}
+}
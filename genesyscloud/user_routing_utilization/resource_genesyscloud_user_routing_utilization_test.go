@@ -0,0 +1,159 @@
+package user_routing_utilization
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func TestAccResourceUserRoutingUtilization(t *testing.T) {
+	var (
+		userResource1        = "test-user"
+		utilizationResource1 = "test-utilization"
+		email1               = "terraform-" + uuid.NewString() + "@example.com"
+		redLabelResource     = "label_red"
+		blueLabelResource    = "label_blue"
+		redLabelName         = "Terraform Red " + uuid.NewString()
+		blueLabelName        = "Terraform Blue " + uuid.NewString()
+
+		baseConfig = gcloud.GenerateBasicUserResource(userResource1, email1, "Terraform Utilization") +
+			generateUtilizationLabelResource(redLabelResource, redLabelName) +
+			generateUtilizationLabelResource(blueLabelResource, blueLabelName)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			gcloud.TestAccPreCheck(t)
+			if err := checkIfLabelsAreEnabled(); err != nil {
+				t.Skipf("%v", err) // be sure to skip the test and not fail it
+			}
+		},
+		ProviderFactories: gcloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Set user level utilization
+				Config: baseConfig + generateUserRoutingUtilizationResource(utilizationResource1, userResource1,
+					generateMediaUtilization("call", "2", "false", "email"),
+					generateMediaUtilization("email", "1", "true"),
+					generateLabelUtilization(redLabelResource, "3"),
+					generateLabelUtilization(blueLabelResource, "1", redLabelResource),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_user_routing_utilization."+utilizationResource1, "user_id", "genesyscloud_user."+userResource1, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "level", utilizationLevelAgent),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "inherit_org_defaults", "false"),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "call.0.maximum_capacity", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "call.0.interruptible_media_types.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "email.0.include_non_acd", "true"),
+					resource.TestCheckResourceAttrPair("genesyscloud_user_routing_utilization."+utilizationResource1, "label_utilizations.0.label_id", "genesyscloud_routing_utilization_label."+redLabelResource, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "label_utilizations.0.maximum_capacity", "3"),
+					resource.TestCheckResourceAttrPair("genesyscloud_user_routing_utilization."+utilizationResource1, "label_utilizations.1.interrupting_label_ids.0", "genesyscloud_routing_utilization_label."+redLabelResource, "id"),
+				),
+			},
+			{
+				// Removing the label blocks removes the label utilizations of the user
+				Config: baseConfig + generateUserRoutingUtilizationResource(utilizationResource1, userResource1,
+					generateMediaUtilization("call", "2", "false", "email"),
+					generateMediaUtilization("email", "1", "true"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "level", utilizationLevelAgent),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "label_utilizations.#", "0"),
+				),
+			},
+			{
+				// Reset the user to the org-wide settings
+				Config: baseConfig + generateUserRoutingUtilizationResource(utilizationResource1, userResource1,
+					"inherit_org_defaults = true",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "level", utilizationLevelOrganization),
+					resource.TestCheckResourceAttr("genesyscloud_user_routing_utilization."+utilizationResource1, "inherit_org_defaults", "true"),
+					resource.TestCheckResourceAttrSet("genesyscloud_user_routing_utilization."+utilizationResource1, "call.0.maximum_capacity"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_user_routing_utilization." + utilizationResource1,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyUserRoutingUtilizationReset,
+	})
+}
+
+func generateUserRoutingUtilizationResource(resourceID string, userResource string, attrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_user_routing_utilization" "%s" {
+		user_id = genesyscloud_user.%s.id
+		%s
+	}
+	`, resourceID, userResource, strings.Join(attrs, "\n"))
+}
+
+func generateMediaUtilization(mediaType string, maxCapacity string, includeNonAcd string, interruptibleMediaTypes ...string) string {
+	return fmt.Sprintf(`%s {
+			maximum_capacity = %s
+			include_non_acd = %s
+			interruptible_media_types = %s
+		}
+		`, mediaType, maxCapacity, includeNonAcd, gcloud.GenerateStringArrayEnquote(interruptibleMediaTypes...))
+}
+
+func generateLabelUtilization(labelResource string, maxCapacity string, interruptingLabelResources ...string) string {
+	interruptingLabelIds := make([]string, 0, len(interruptingLabelResources))
+	for _, interruptingLabelResource := range interruptingLabelResources {
+		interruptingLabelIds = append(interruptingLabelIds, "genesyscloud_routing_utilization_label."+interruptingLabelResource+".id")
+	}
+	return fmt.Sprintf(`label_utilizations {
+			label_id = genesyscloud_routing_utilization_label.%s.id
+			maximum_capacity = %s
+			interrupting_label_ids = [%s]
+		}
+		`, labelResource, maxCapacity, strings.Join(interruptingLabelIds, ", "))
+}
+
+func generateUtilizationLabelResource(resourceID string, name string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_utilization_label" "%s" {
+		name = "%s"
+	}
+	`, resourceID, name)
+}
+
+func checkIfLabelsAreEnabled() error { // remove once the feature is globally enabled
+	api := platformclientv2.NewRoutingApi()
+	_, resp, _ := api.GetRoutingUtilizationLabels(100, 1, "", "")
+	if resp != nil && resp.StatusCode == http.StatusNotImplemented {
+		return fmt.Errorf("feature is not yet implemented in this org.")
+	}
+	return nil
+}
+
+func testVerifyUserRoutingUtilizationReset(state *terraform.State) error {
+	usersAPI := platformclientv2.NewUsersApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_user_routing_utilization" {
+			continue
+		}
+		utilization, resp, err := usersAPI.GetRoutingUserUtilization(rs.Primary.ID)
+		if err != nil {
+			if gcloud.IsStatus404(resp) {
+				// The user was destroyed with the utilization
+				continue
+			}
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+		if utilization.Level != nil && *utilization.Level != utilizationLevelOrganization {
+			return fmt.Errorf("Routing utilization of user %s was not reset to the org-wide settings", rs.Primary.ID)
+		}
+	}
+	// Success. All user utilizations reset
+	return nil
+}
@@ -0,0 +1,277 @@
+package user_routing_utilization
+
+import (
+	"context"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitUserRoutingUtilizationCreate(t *testing.T) {
+	userId := uuid.NewString()
+	redLabelId := uuid.NewString()
+	blueLabelId := uuid.NewString()
+
+	// The org has settings for every media type. Only call and email are configured for the user.
+	utilization := &gcloud.AgentUtilizationWithLabels{
+		Utilization: map[string]gcloud.MediaUtilization{
+			"callback": {MaximumCapacity: 1, InterruptableMediaTypes: []string{}},
+			"chat":     {MaximumCapacity: 4, InterruptableMediaTypes: []string{}},
+			"message":  {MaximumCapacity: 4, InterruptableMediaTypes: []string{}},
+		},
+		LabelUtilizations: map[string]gcloud.LabelUtilization{},
+		Level:             utilizationLevelOrganization,
+	}
+
+	proxy := &userRoutingUtilizationProxy{}
+	proxy.getUserRoutingUtilizationAttr = func(ctx context.Context, p *userRoutingUtilizationProxy, id string) (*gcloud.AgentUtilizationWithLabels, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		return utilization, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateUserRoutingUtilizationAttr = func(ctx context.Context, p *userRoutingUtilizationProxy, id string, request *userUtilizationRequest) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		assert.Equal(t, 2, len(request.Utilization))
+		assert.Equal(t, int32(2), request.Utilization["call"].MaximumCapacity)
+		assert.Equal(t, []string{"email"}, request.Utilization["call"].InterruptableMediaTypes)
+		assert.True(t, request.Utilization["email"].IncludeNonAcd)
+		assert.Equal(t, 2, len(request.LabelUtilizations))
+		assert.Equal(t, []string{redLabelId}, request.LabelUtilizations[blueLabelId].InterruptingLabelIds)
+
+		// The API echoes the update and keeps the inherited media types
+		for mediaType, settings := range request.Utilization {
+			utilization.Utilization[mediaType] = settings
+		}
+		utilization.LabelUtilizations = request.LabelUtilizations
+		utilization.Level = utilizationLevelAgent
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.deleteUserRoutingUtilizationAttr = func(ctx context.Context, p *userRoutingUtilizationProxy, id string) (*platformclientv2.APIResponse, error) {
+		t.Fatal("utilization should not be reset")
+		return nil, nil
+	}
+	proxy.getAllUtilizationLabelsAttr = func(ctx context.Context, p *userRoutingUtilizationProxy) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Utilizationlabel{{Id: &redLabelId}, {Id: &blueLabelId}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() {
+		internalProxy = nil
+	}()
+
+	resourceSchema := ResourceUserRoutingUtilization().Schema
+	resourceDataMap := map[string]interface{}{
+		"user_id": userId,
+		"call": []interface{}{map[string]interface{}{
+			"maximum_capacity":          2,
+			"interruptible_media_types": []interface{}{"email"},
+			"include_non_acd":           false,
+		}},
+		"email": []interface{}{map[string]interface{}{
+			"maximum_capacity": 1,
+			"include_non_acd":  true,
+		}},
+		"label_utilizations": []interface{}{
+			map[string]interface{}{
+				"label_id":         redLabelId,
+				"maximum_capacity": 3,
+			},
+			map[string]interface{}{
+				"label_id":               blueLabelId,
+				"maximum_capacity":       1,
+				"interrupting_label_ids": []interface{}{redLabelId},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, resourceDataMap)
+
+	diags := createUserRoutingUtilization(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, userId, d.Id())
+	assert.Equal(t, utilizationLevelAgent, d.Get("level").(string))
+	assert.False(t, d.Get("inherit_org_defaults").(bool))
+	assert.Equal(t, 2, d.Get("call.0.maximum_capacity").(int))
+	assert.Equal(t, 4, d.Get("chat.0.maximum_capacity").(int))
+	assert.Equal(t, redLabelId, d.Get("label_utilizations.0.label_id").(string))
+	assert.Equal(t, blueLabelId, d.Get("label_utilizations.1.label_id").(string))
+}
+
+func TestUnitUserRoutingUtilizationInheritOrgDefaults(t *testing.T) {
+	userId := uuid.NewString()
+	labelId := uuid.NewString()
+	resetCalls := 0
+
+	utilization := &gcloud.AgentUtilizationWithLabels{
+		Utilization: map[string]gcloud.MediaUtilization{
+			"call":  {MaximumCapacity: 3, InterruptableMediaTypes: []string{"email"}},
+			"email": {MaximumCapacity: 1, InterruptableMediaTypes: []string{}, IncludeNonAcd: true},
+		},
+		LabelUtilizations: map[string]gcloud.LabelUtilization{
+			labelId: {MaximumCapacity: 2, InterruptingLabelIds: []string{}},
+		},
+		Level: utilizationLevelAgent,
+	}
+
+	proxy := &userRoutingUtilizationProxy{}
+	proxy.getUserRoutingUtilizationAttr = func(ctx context.Context, p *userRoutingUtilizationProxy, id string) (*gcloud.AgentUtilizationWithLabels, *platformclientv2.APIResponse, error) {
+		return utilization, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateUserRoutingUtilizationAttr = func(ctx context.Context, p *userRoutingUtilizationProxy, id string, request *userUtilizationRequest) (*platformclientv2.APIResponse, error) {
+		t.Fatal("utilization should not be updated")
+		return nil, nil
+	}
+	proxy.deleteUserRoutingUtilizationAttr = func(ctx context.Context, p *userRoutingUtilizationProxy, id string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, userId, id)
+		resetCalls++
+		utilization.Level = utilizationLevelOrganization
+		return &platformclientv2.APIResponse{StatusCode: http.StatusNoContent}, nil
+	}
+	proxy.getAllUtilizationLabelsAttr = func(ctx context.Context, p *userRoutingUtilizationProxy) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Utilizationlabel{{Id: &labelId}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() {
+		internalProxy = nil
+	}()
+
+	resourceSchema := ResourceUserRoutingUtilization().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"user_id":              userId,
+		"inherit_org_defaults": true,
+	})
+
+	diags := createUserRoutingUtilization(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, 1, resetCalls)
+	assert.Equal(t, utilizationLevelOrganization, d.Get("level").(string))
+	assert.True(t, d.Get("inherit_org_defaults").(bool))
+
+	// The inherited settings are exposed
+	assert.Equal(t, 3, d.Get("call.0.maximum_capacity").(int))
+	assert.True(t, d.Get("email.0.include_non_acd").(bool))
+	assert.Equal(t, labelId, d.Get("label_utilizations.0.label_id").(string))
+
+	// Destroying the resource also resets the user to the org-wide settings
+	diags = deleteUserRoutingUtilization(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, 2, resetCalls)
+}
+
+func TestUnitUserRoutingUtilizationValidateLabels(t *testing.T) {
+	redLabelId := uuid.NewString()
+	blueLabelId := uuid.NewString()
+	missingLabelId := uuid.NewString()
+	existingLabelIds := map[string]bool{redLabelId: true, blueLabelId: true}
+
+	labelUtilization := func(labelId string, interruptingLabelIds ...string) map[string]interface{} {
+		ids := make([]interface{}, 0, len(interruptingLabelIds))
+		for _, id := range interruptingLabelIds {
+			ids = append(ids, id)
+		}
+		return map[string]interface{}{
+			"label_id":               labelId,
+			"maximum_capacity":       1,
+			"interrupting_label_ids": schema.NewSet(schema.HashString, ids),
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		labelUtilizations []interface{}
+		expectedErrors    []string
+	}{
+		{
+			name:              "valid",
+			labelUtilizations: []interface{}{labelUtilization(redLabelId), labelUtilization(blueLabelId, redLabelId)},
+		},
+		{
+			name:              "label interrupts itself",
+			labelUtilizations: []interface{}{labelUtilization(redLabelId, redLabelId)},
+			expectedErrors:    []string{"label " + redLabelId + " cannot interrupt itself"},
+		},
+		{
+			name:              "duplicate label",
+			labelUtilizations: []interface{}{labelUtilization(redLabelId), labelUtilization(redLabelId)},
+			expectedErrors:    []string{"label " + redLabelId + " is configured more than once"},
+		},
+		{
+			name:              "missing label",
+			labelUtilizations: []interface{}{labelUtilization(missingLabelId)},
+			expectedErrors:    []string{"label " + missingLabelId + " does not exist"},
+		},
+		{
+			name:              "missing interrupting label",
+			labelUtilizations: []interface{}{labelUtilization(redLabelId, missingLabelId)},
+			expectedErrors:    []string{"interrupting label " + missingLabelId + " of label " + redLabelId + " does not exist"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateLabelUtilizations(tc.labelUtilizations, existingLabelIds)
+			if len(tc.expectedErrors) == 0 {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+			for _, expectedError := range tc.expectedErrors {
+				assert.Contains(t, err.Error(), expectedError)
+			}
+		})
+	}
+}
+
+func TestUnitUserRoutingUtilizationLabelDriftAndRemoval(t *testing.T) {
+	// Labels added outside of terraform are flattened after the tracked labels so they show up as drift
+	flattened := flattenLabelUtilizations(map[string]gcloud.LabelUtilization{
+		"label-a": {MaximumCapacity: 1},
+		"label-b": {MaximumCapacity: 2},
+		"label-c": {MaximumCapacity: 3},
+	}, []interface{}{
+		map[string]interface{}{"label_id": "label-c"},
+		map[string]interface{}{"label_id": "label-removed"},
+	})
+	labelIds := make([]string, 0, len(flattened))
+	for _, labelUtilization := range flattened {
+		labelIds = append(labelIds, labelUtilization.(map[string]interface{})["label_id"].(string))
+	}
+	assert.Equal(t, []string{"label-c", "label-a", "label-b"}, labelIds)
+
+	// Removing every label_utilizations block plans the removal of the label utilizations of the user
+	userId := uuid.NewString()
+	state := &terraform.InstanceState{
+		ID: userId,
+		Attributes: map[string]string{
+			"id":                                    userId,
+			"user_id":                               userId,
+			"inherit_org_defaults":                  "false",
+			"label_utilizations.#":                  "1",
+			"label_utilizations.0.label_id":         "label-a",
+			"label_utilizations.0.maximum_capacity": "1",
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"label_utilizations": cty.ListValEmpty(cty.EmptyObject),
+		}),
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"user_id": userId})
+	meta := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	diff, err := ResourceUserRoutingUtilization().Diff(context.Background(), state, config, meta)
+	assert.Nil(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "0", diff.Attributes["label_utilizations.#"].New)
+	}
+
+	// The update sends an empty set of label utilizations to remove them
+	d := schema.TestResourceDataRaw(t, ResourceUserRoutingUtilization().Schema, map[string]interface{}{"user_id": userId})
+	request := buildUserUtilizationRequest(d)
+	assert.NotNil(t, request.LabelUtilizations)
+	assert.Equal(t, 0, len(request.LabelUtilizations))
+}
@@ -0,0 +1,152 @@
+package user_routing_utilization
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_user_routing_utilization_utils.go file contains various helper methods to marshal
+and unmarshal data into formats consumable by Terraform and/or Genesys Cloud.
+*/
+
+// buildUserUtilizationRequest builds the utilization update of a user from the resource data
+func buildUserUtilizationRequest(d *schema.ResourceData) *userUtilizationRequest {
+	request := &userUtilizationRequest{
+		Utilization:       make(map[string]gcloud.MediaUtilization),
+		LabelUtilizations: make(map[string]gcloud.LabelUtilization),
+	}
+
+	for _, mediaType := range mediaTypes {
+		mediaSettings, ok := d.Get(mediaType).([]interface{})
+		if !ok || len(mediaSettings) == 0 || mediaSettings[0] == nil {
+			continue
+		}
+		settingsMap := mediaSettings[0].(map[string]interface{})
+		interruptibleMediaTypes := make([]string, 0)
+		if types, ok := settingsMap["interruptible_media_types"].(*schema.Set); ok {
+			interruptibleMediaTypes = *lists.SetToStringList(types)
+		}
+		request.Utilization[mediaType] = gcloud.MediaUtilization{
+			MaximumCapacity:         int32(settingsMap["maximum_capacity"].(int)),
+			InterruptableMediaTypes: interruptibleMediaTypes,
+			IncludeNonAcd:           settingsMap["include_non_acd"].(bool),
+		}
+	}
+
+	// Labels that are not configured are removed from the user
+	labelUtilizations, _ := d.Get("label_utilizations").([]interface{})
+	for _, labelUtilization := range labelUtilizations {
+		labelMap := labelUtilization.(map[string]interface{})
+		interruptingLabelIds := make([]string, 0)
+		if labelIds, ok := labelMap["interrupting_label_ids"].(*schema.Set); ok {
+			interruptingLabelIds = *lists.SetToStringList(labelIds)
+		}
+		request.LabelUtilizations[labelMap["label_id"].(string)] = gcloud.LabelUtilization{
+			MaximumCapacity:      int32(labelMap["maximum_capacity"].(int)),
+			InterruptingLabelIds: interruptingLabelIds,
+		}
+	}
+
+	return request
+}
+
+func flattenMediaUtilization(settings gcloud.MediaUtilization) []interface{} {
+	settingsMap := map[string]interface{}{
+		"maximum_capacity": int(settings.MaximumCapacity),
+		"include_non_acd":  settings.IncludeNonAcd,
+	}
+	if settings.InterruptableMediaTypes != nil {
+		settingsMap["interruptible_media_types"] = lists.StringListToSet(settings.InterruptableMediaTypes)
+	}
+	return []interface{}{settingsMap}
+}
+
+// flattenLabelUtilizations flattens every label utilization of the user. Labels that are already tracked keep their
+// order so that terraform can match the new and old state. Labels added outside of terraform follow, ordered by label ID.
+func flattenLabelUtilizations(labelUtilizations map[string]gcloud.LabelUtilization, currentLabelUtilizations []interface{}) []interface{} {
+	flattened := make([]interface{}, 0, len(labelUtilizations))
+	flattenedLabelIds := make(map[string]bool, len(labelUtilizations))
+
+	for _, currentLabelUtilization := range currentLabelUtilizations {
+		labelMap, ok := currentLabelUtilization.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		labelId, _ := labelMap["label_id"].(string)
+		if labelUtilization, ok := labelUtilizations[labelId]; ok && !flattenedLabelIds[labelId] {
+			flattened = append(flattened, flattenLabelUtilization(labelId, labelUtilization))
+			flattenedLabelIds[labelId] = true
+		}
+	}
+
+	labelIds := make([]string, 0, len(labelUtilizations))
+	for labelId := range labelUtilizations {
+		if !flattenedLabelIds[labelId] {
+			labelIds = append(labelIds, labelId)
+		}
+	}
+	sort.Strings(labelIds)
+	for _, labelId := range labelIds {
+		flattened = append(flattened, flattenLabelUtilization(labelId, labelUtilizations[labelId]))
+	}
+	return flattened
+}
+
+func flattenLabelUtilization(labelId string, labelUtilization gcloud.LabelUtilization) map[string]interface{} {
+	labelMap := map[string]interface{}{
+		"label_id":         labelId,
+		"maximum_capacity": int(labelUtilization.MaximumCapacity),
+	}
+	if labelUtilization.InterruptingLabelIds != nil {
+		labelMap["interrupting_label_ids"] = lists.StringListToSet(labelUtilization.InterruptingLabelIds)
+	}
+	return labelMap
+}
+
+// validateLabelUtilizations checks that every label is configured once, does not interrupt itself
+// and that every label and interrupting label exists in the org
+func validateLabelUtilizations(labelUtilizations []interface{}, existingLabelIds map[string]bool) error {
+	var errs []string
+	configuredLabelIds := make(map[string]bool)
+
+	for _, labelUtilization := range labelUtilizations {
+		labelMap, ok := labelUtilization.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		labelId, _ := labelMap["label_id"].(string)
+
+		if configuredLabelIds[labelId] {
+			errs = append(errs, fmt.Sprintf("label %s is configured more than once", labelId))
+		}
+		configuredLabelIds[labelId] = true
+
+		if !existingLabelIds[labelId] {
+			errs = append(errs, fmt.Sprintf("label %s does not exist", labelId))
+		}
+
+		interruptingLabelIds, ok := labelMap["interrupting_label_ids"].(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, interruptingLabelId := range *lists.SetToStringList(interruptingLabelIds) {
+			if interruptingLabelId == labelId {
+				errs = append(errs, fmt.Sprintf("label %s cannot interrupt itself", labelId))
+			} else if !existingLabelIds[interruptingLabelId] {
+				errs = append(errs, fmt.Sprintf("interrupting label %s of label %s does not exist", interruptingLabelId, labelId))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("invalid label_utilizations: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package users
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_users.go contains the data source implementation
+   for the users data source.
+*/
+
+// usersFilter holds the filters of a users data source. Empty filters match every user.
+type usersFilter struct {
+	divisionId string
+	department string
+	managerId  string
+	skillId    string
+	groupId    string
+	state      string
+	titleRegex *regexp.Regexp
+}
+
+// dataSourceUsersRead returns the IDs and emails of all cached users matching the filters
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*gcloud.ProviderMeta)
+	proxy := getUsersProxy(providerMeta.ClientConfig)
+
+	filter := usersFilter{
+		divisionId: d.Get("division_id").(string),
+		department: d.Get("department").(string),
+		managerId:  d.Get("manager_id").(string),
+		skillId:    d.Get("skill_id").(string),
+		groupId:    d.Get("group_id").(string),
+		state:      d.Get("state").(string),
+	}
+	if titleRegex := d.Get("title_regex").(string); titleRegex != "" {
+		compiled, err := regexp.Compile(titleRegex)
+		if err != nil {
+			return diag.Errorf("Invalid title_regex %s: %s", titleRegex, err)
+		}
+		filter.titleRegex = compiled
+	}
+
+	users, _, err := proxy.getAllUsers(ctx, providerMeta.UserCache)
+	if err != nil {
+		return diag.Errorf("Failed to get users: %s", err)
+	}
+
+	matches := filterUsers(*users, filter)
+	ids := make([]string, 0, len(matches))
+	emails := make([]string, 0, len(matches))
+	for _, user := range matches {
+		ids = append(ids, *user.Id)
+		emails = append(emails, getUserEmail(user))
+	}
+
+	d.SetId(filter.id())
+	_ = d.Set("ids", ids)
+	_ = d.Set("emails", emails)
+	return nil
+}
+
+// filterUsers returns the users matching all of the filters, ordered by email
+func filterUsers(users []platformclientv2.User, filter usersFilter) []platformclientv2.User {
+	var matches []platformclientv2.User
+	for _, user := range users {
+		if user.Id != nil && filter.matches(user) {
+			matches = append(matches, user)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return getUserEmail(matches[i]) < getUserEmail(matches[j])
+	})
+	return matches
+}
+
+// matches reports whether the user matches all of the filters
+func (f usersFilter) matches(user platformclientv2.User) bool {
+	if f.divisionId != "" && (user.Division == nil || user.Division.Id == nil || *user.Division.Id != f.divisionId) {
+		return false
+	}
+	if f.department != "" && (user.Department == nil || !strings.EqualFold(*user.Department, f.department)) {
+		return false
+	}
+	if f.managerId != "" && (user.Manager == nil || *user.Manager == nil || (*user.Manager).Id == nil || *(*user.Manager).Id != f.managerId) {
+		return false
+	}
+	if f.state != "" && (user.State == nil || *user.State != f.state) {
+		return false
+	}
+	if f.titleRegex != nil && (user.Title == nil || !f.titleRegex.MatchString(*user.Title)) {
+		return false
+	}
+	if f.skillId != "" && !hasSkill(user, f.skillId) {
+		return false
+	}
+	if f.groupId != "" && !inGroup(user, f.groupId) {
+		return false
+	}
+	return true
+}
+
+// id returns a data source ID that is stable for the same set of filters
+func (f usersFilter) id() string {
+	titleRegex := ""
+	if f.titleRegex != nil {
+		titleRegex = f.titleRegex.String()
+	}
+	key := strings.Join([]string{f.divisionId, f.department, f.managerId, f.skillId, f.groupId, f.state, titleRegex}, "|")
+	return fmt.Sprintf("users-%x", sha256.Sum256([]byte(key)))
+}
+
+func hasSkill(user platformclientv2.User, skillId string) bool {
+	if user.Skills == nil {
+		return false
+	}
+	for _, skill := range *user.Skills {
+		if skill.Id != nil && *skill.Id == skillId {
+			return true
+		}
+	}
+	return false
+}
+
+func inGroup(user platformclientv2.User, groupId string) bool {
+	if user.Groups == nil {
+		return false
+	}
+	for _, group := range *user.Groups {
+		if group.Id != nil && *group.Id == groupId {
+			return true
+		}
+	}
+	return false
+}
+
+func getUserEmail(user platformclientv2.User) string {
+	if user.Email == nil {
+		return ""
+	}
+	return *user.Email
+}
@@ -0,0 +1,56 @@
+package users
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceUsers(t *testing.T) {
+	var (
+		userResource1  = "test-user-1"
+		userResource2  = "test-user-2"
+		userResource3  = "test-user-3"
+		usersDataSrc   = "test-users"
+		suffix         = strings.Replace(uuid.NewString(), "-", "", -1)
+		department     = "Terraform Users " + suffix
+		email1         = "terraform-users-1-" + suffix + "@example.com"
+		email2         = "terraform-users-2-" + suffix + "@example.com"
+		email3         = "terraform-users-3-" + suffix + "@example.com"
+		userDependsOns = fmt.Sprintf("genesyscloud_user.%s, genesyscloud_user.%s, genesyscloud_user.%s", userResource1, userResource2, userResource3)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { genesyscloud.TestAccPreCheck(t) },
+		ProviderFactories: genesyscloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: genesyscloud.GenerateUserResource(userResource1, email1, "Terraform Users 1", genesyscloud.NullValue, strconv.Quote("Agent"), strconv.Quote(department), genesyscloud.NullValue, genesyscloud.NullValue, "", "") +
+					genesyscloud.GenerateUserResource(userResource2, email2, "Terraform Users 2", genesyscloud.NullValue, strconv.Quote("Senior Agent"), strconv.Quote(department), genesyscloud.NullValue, genesyscloud.NullValue, "", "") +
+					genesyscloud.GenerateUserResource(userResource3, email3, "Terraform Users 3", genesyscloud.NullValue, strconv.Quote("Supervisor"), strconv.Quote(department), genesyscloud.NullValue, genesyscloud.NullValue, "", "") +
+					generateUsersDataSource(usersDataSrc, department, "Agent$", userDependsOns),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_users."+usersDataSrc, "ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_users."+usersDataSrc, "ids.0", "genesyscloud_user."+userResource1, "id"),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_users."+usersDataSrc, "ids.1", "genesyscloud_user."+userResource2, "id"),
+					resource.TestCheckResourceAttr("data.genesyscloud_users."+usersDataSrc, "emails.0", email1),
+					resource.TestCheckResourceAttr("data.genesyscloud_users."+usersDataSrc, "emails.1", email2),
+				),
+			},
+		},
+	})
+}
+
+func generateUsersDataSource(resourceID string, department string, titleRegex string, dependsOn string) string {
+	return fmt.Sprintf(`data "genesyscloud_users" "%s" {
+		department = "%s"
+		title_regex = "%s"
+		depends_on = [%s]
+	}
+	`, resourceID, department, titleRegex, dependsOn)
+}
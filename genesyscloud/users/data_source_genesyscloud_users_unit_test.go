@@ -0,0 +1,146 @@
+package users
+
+import (
+	"context"
+	"net/http"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/usercache"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestUser(email string, state string, divisionId string, department string, title string, managerId string, skillIds []string, groupIds []string) platformclientv2.User {
+	id := uuid.NewString()
+	user := platformclientv2.User{
+		Id:         &id,
+		Email:      platformclientv2.String(email),
+		State:      platformclientv2.String(state),
+		Division:   &platformclientv2.Division{Id: platformclientv2.String(divisionId)},
+		Department: platformclientv2.String(department),
+		Title:      platformclientv2.String(title),
+	}
+	if managerId != "" {
+		manager := &platformclientv2.User{Id: platformclientv2.String(managerId)}
+		user.Manager = &manager
+	}
+	skills := make([]platformclientv2.Userroutingskill, 0, len(skillIds))
+	for _, skillId := range skillIds {
+		skills = append(skills, platformclientv2.Userroutingskill{Id: platformclientv2.String(skillId)})
+	}
+	user.Skills = &skills
+	groups := make([]platformclientv2.Group, 0, len(groupIds))
+	for _, groupId := range groupIds {
+		groups = append(groups, platformclientv2.Group{Id: platformclientv2.String(groupId)})
+	}
+	user.Groups = &groups
+	return user
+}
+
+func TestUnitDataSourceUsersFilters(t *testing.T) {
+	divisionId := uuid.NewString()
+	skillId := uuid.NewString()
+	groupId := uuid.NewString()
+
+	manager := buildTestUser("manager@example.com", "active", divisionId, "Support", "Support Manager", "", nil, nil)
+	agent1 := buildTestUser("agent1@example.com", "active", divisionId, "support", "Agent", *manager.Id, []string{skillId}, []string{groupId})
+	agent2 := buildTestUser("agent2@example.com", "inactive", uuid.NewString(), "Sales", "Senior Agent", *manager.Id, nil, []string{groupId})
+	agent3 := buildTestUser("agent3@example.com", "active", divisionId, "Sales", "Agent", "", []string{skillId}, nil)
+	orgUsers := []platformclientv2.User{agent3, manager, agent2, agent1}
+
+	getAllUsersCalls := 0
+	proxy := &usersProxy{}
+	proxy.getAllUsersAttr = func(ctx context.Context, p *usersProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return userCache.GetUsers(ctx, func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+			getAllUsersCalls++
+			return &orgUsers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		})
+	}
+	internalProxy = proxy
+	defer func() {
+		internalProxy = nil
+	}()
+
+	testCases := []struct {
+		name           string
+		filters        map[string]interface{}
+		expectedEmails []string
+	}{
+		{
+			name:           "no filters",
+			filters:        map[string]interface{}{},
+			expectedEmails: []string{"agent1@example.com", "agent2@example.com", "agent3@example.com", "manager@example.com"},
+		},
+		{
+			name:           "division",
+			filters:        map[string]interface{}{"division_id": divisionId},
+			expectedEmails: []string{"agent1@example.com", "agent3@example.com", "manager@example.com"},
+		},
+		{
+			name:           "department is case insensitive",
+			filters:        map[string]interface{}{"department": "SUPPORT"},
+			expectedEmails: []string{"agent1@example.com", "manager@example.com"},
+		},
+		{
+			name:           "manager",
+			filters:        map[string]interface{}{"manager_id": *manager.Id},
+			expectedEmails: []string{"agent1@example.com", "agent2@example.com"},
+		},
+		{
+			name:           "skill",
+			filters:        map[string]interface{}{"skill_id": skillId},
+			expectedEmails: []string{"agent1@example.com", "agent3@example.com"},
+		},
+		{
+			name:           "group",
+			filters:        map[string]interface{}{"group_id": groupId},
+			expectedEmails: []string{"agent1@example.com", "agent2@example.com"},
+		},
+		{
+			name:           "state",
+			filters:        map[string]interface{}{"state": "inactive"},
+			expectedEmails: []string{"agent2@example.com"},
+		},
+		{
+			name:           "title regex",
+			filters:        map[string]interface{}{"title_regex": "^Agent$"},
+			expectedEmails: []string{"agent1@example.com", "agent3@example.com"},
+		},
+		{
+			name:           "combined filters",
+			filters:        map[string]interface{}{"division_id": divisionId, "skill_id": skillId, "group_id": groupId},
+			expectedEmails: []string{"agent1@example.com"},
+		},
+		{
+			name:           "no matches",
+			filters:        map[string]interface{}{"department": "Billing"},
+			expectedEmails: []string{},
+		},
+	}
+
+	providerMeta := &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}, UserCache: &usercache.UserCache{}}
+	dataSourceSchema := DataSourceUsers().Schema
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceSchema, tc.filters)
+			diags := dataSourceUsersRead(context.Background(), d, providerMeta)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			emails := make([]string, 0)
+			for _, email := range d.Get("emails").([]interface{}) {
+				emails = append(emails, email.(string))
+			}
+			assert.Equal(t, tc.expectedEmails, emails)
+			assert.Equal(t, len(tc.expectedEmails), len(d.Get("ids").([]interface{})))
+			assert.NotEmpty(t, d.Id())
+		})
+	}
+
+	// Every data source read must share the users loaded by the first read
+	assert.Equal(t, 1, getAllUsersCalls)
+}
@@ -0,0 +1,74 @@
+package users
+
+import (
+	"context"
+	"terraform-provider-genesyscloud/genesyscloud/util/usercache"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_users_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+Each proxy implementation:
+
+1.  Should provide a private package level variable that holds a instance of a proxy class.
+2.  A New... constructor function to initialize the proxy object. This constructor should only be used within
+    the proxy.
+3.  A get private constructor function that the classes in the package can be used to retrieve
+    the proxy. This proxy should check to see if the package level proxy instance is nil and
+    should initialize it, otherwise it should return the instance
+4.  Type definitions for each function that will be used in the proxy.  We use composition here
+    so that we can easily provide mocks for testing.
+5.  A struct for the proxy that holds an attribute for each function type.
+6.  Wrapper methods on each of the elements on the struct.
+7.  Function implementations for each function type definition.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *usersProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllUsersFunc func(ctx context.Context, p *usersProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+
+// usersProxy contains all of the methods that call genesys cloud APIs.
+type usersProxy struct {
+	clientConfig *platformclientv2.Configuration
+	usersApi     *platformclientv2.UsersApi
+
+	getAllUsersAttr getAllUsersFunc
+}
+
+// newUsersProxy initializes the users proxy with all of the data needed to communicate with Genesys Cloud
+func newUsersProxy(clientConfig *platformclientv2.Configuration) *usersProxy {
+	return &usersProxy{
+		clientConfig: clientConfig,
+		usersApi:     platformclientv2.NewUsersApiWithConfig(clientConfig),
+
+		getAllUsersAttr: getAllUsersFn,
+	}
+}
+
+// getUsersProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getUsersProxy(clientConfig *platformclientv2.Configuration) *usersProxy {
+	if internalProxy == nil {
+		internalProxy = newUsersProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllUsers retrieves all active and inactive Genesys Cloud users from the provider's user cache
+func (p *usersProxy) getAllUsers(ctx context.Context, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getAllUsersAttr(ctx, p, userCache)
+}
+
+// getAllUsersFn is the implementation for retrieving all users in Genesys Cloud. The users are only
+// paged through when the cache is empty.
+func getAllUsersFn(ctx context.Context, p *usersProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return userCache.GetUsers(ctx, func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return usercache.GetAllUsers(ctx, p.usersApi)
+	})
+}
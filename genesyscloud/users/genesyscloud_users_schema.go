@@ -0,0 +1,84 @@
+package users
+
+import (
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+genesyscloud_users_schema.go holds two functions within it:
+
+1.  The registration code that registers the Datasource for the package.
+2.  The datasource schema definitions for the users datasource.
+*/
+const resourceName = "genesyscloud_users"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(resourceName, DataSourceUsers())
+}
+
+// DataSourceUsers registers the genesyscloud_users data source
+func DataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for Genesys Cloud Users. Select all users matching a set of filters. " +
+			"Every user in the org is loaded once per run into a cache shared by all resources and data sources that list users, " +
+			"so referencing many users does not require a search per user. " +
+			"The cache is cleared whenever a user is created, updated or deleted by this provider.",
+		ReadContext: gcloud.ReadWithPooledClient(dataSourceUsersRead),
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Description: "Only return users in this division.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"department": {
+				Description: "Only return users in this department. Case insensitive.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"manager_id": {
+				Description: "Only return users that report to this user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"skill_id": {
+				Description: "Only return users that have this routing skill.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"group_id": {
+				Description: "Only return users that are members of this group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"state": {
+				Description:  "Only return users in this state. Valid values: active, inactive. If not set, active and inactive users are returned.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
+			"title_regex": {
+				Description:  "Only return users with a title matching this regular expression.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"ids": {
+				Description: "IDs of the matching users, ordered by email.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"emails": {
+				Description: "Emails of the matching users. Emails are in the same order as `ids`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
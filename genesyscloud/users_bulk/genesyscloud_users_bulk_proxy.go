@@ -0,0 +1,496 @@
+package users_bulk
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/util/usercache"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+/*
+The genesyscloud_users_bulk_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *usersBulkProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllUsersFunc func(ctx context.Context, p *usersBulkProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+type getUsersByIdsFunc func(ctx context.Context, p *usersBulkProxy, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+type getDeletedUserIdFunc func(ctx context.Context, p *usersBulkProxy, email string) (string, *platformclientv2.APIResponse, error)
+type restoreDeletedUserFunc func(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type createUserFunc func(ctx context.Context, p *usersBulkProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type updateUserFunc func(ctx context.Context, p *usersBulkProxy, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error)
+type patchUsersBulkFunc func(ctx context.Context, p *usersBulkProxy, users []platformclientv2.Patchuser) (*platformclientv2.APIResponse, error)
+type deleteUserFunc func(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error)
+type moveUsersToDivisionFunc func(ctx context.Context, p *usersBulkProxy, divisionId string, userIds []string) (*platformclientv2.APIResponse, error)
+type patchUserRoutingSkillsFunc func(ctx context.Context, p *usersBulkProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error)
+type deleteUserRoutingSkillFunc func(ctx context.Context, p *usersBulkProxy, userId string, skillId string) (*platformclientv2.APIResponse, error)
+type patchUserRoutingLanguagesFunc func(ctx context.Context, p *usersBulkProxy, userId string, languages []platformclientv2.Userroutinglanguagepost) (*platformclientv2.APIResponse, error)
+type deleteUserRoutingLanguageFunc func(ctx context.Context, p *usersBulkProxy, userId string, languageId string) (*platformclientv2.APIResponse, error)
+type getUserRoleGrantsFunc func(ctx context.Context, p *usersBulkProxy, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error)
+type addUserRoleGrantsFunc func(ctx context.Context, p *usersBulkProxy, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error)
+type removeUserRoleGrantsFunc func(ctx context.Context, p *usersBulkProxy, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error)
+type getAllRoutingSkillsFunc func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Routingskill, *platformclientv2.APIResponse, error)
+type getAllRoutingLanguagesFunc func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Language, *platformclientv2.APIResponse, error)
+type getAllAuthorizationRolesFunc func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
+type getAllAuthorizationDivisionsFunc func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Authzdivision, *platformclientv2.APIResponse, error)
+
+// usersBulkProxy contains all of the methods that call genesys cloud APIs.
+type usersBulkProxy struct {
+	clientConfig     *platformclientv2.Configuration
+	usersApi         *platformclientv2.UsersApi
+	routingApi       *platformclientv2.RoutingApi
+	authorizationApi *platformclientv2.AuthorizationApi
+
+	getAllUsersAttr                  getAllUsersFunc
+	getUsersByIdsAttr                getUsersByIdsFunc
+	getDeletedUserIdAttr             getDeletedUserIdFunc
+	restoreDeletedUserAttr           restoreDeletedUserFunc
+	createUserAttr                   createUserFunc
+	updateUserAttr                   updateUserFunc
+	patchUsersBulkAttr               patchUsersBulkFunc
+	deleteUserAttr                   deleteUserFunc
+	moveUsersToDivisionAttr          moveUsersToDivisionFunc
+	patchUserRoutingSkillsAttr       patchUserRoutingSkillsFunc
+	deleteUserRoutingSkillAttr       deleteUserRoutingSkillFunc
+	patchUserRoutingLanguagesAttr    patchUserRoutingLanguagesFunc
+	deleteUserRoutingLanguageAttr    deleteUserRoutingLanguageFunc
+	getUserRoleGrantsAttr            getUserRoleGrantsFunc
+	addUserRoleGrantsAttr            addUserRoleGrantsFunc
+	removeUserRoleGrantsAttr         removeUserRoleGrantsFunc
+	getAllRoutingSkillsAttr          getAllRoutingSkillsFunc
+	getAllRoutingLanguagesAttr       getAllRoutingLanguagesFunc
+	getAllAuthorizationRolesAttr     getAllAuthorizationRolesFunc
+	getAllAuthorizationDivisionsAttr getAllAuthorizationDivisionsFunc
+}
+
+// newUsersBulkProxy initializes the users bulk proxy with all of the data needed to communicate with Genesys Cloud
+func newUsersBulkProxy(clientConfig *platformclientv2.Configuration) *usersBulkProxy {
+	return &usersBulkProxy{
+		clientConfig:     clientConfig,
+		usersApi:         platformclientv2.NewUsersApiWithConfig(clientConfig),
+		routingApi:       platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		authorizationApi: platformclientv2.NewAuthorizationApiWithConfig(clientConfig),
+
+		getAllUsersAttr:                  getAllUsersFn,
+		getUsersByIdsAttr:                getUsersByIdsFn,
+		getDeletedUserIdAttr:             getDeletedUserIdFn,
+		restoreDeletedUserAttr:           restoreDeletedUserFn,
+		createUserAttr:                   createUserFn,
+		updateUserAttr:                   updateUserFn,
+		patchUsersBulkAttr:               patchUsersBulkFn,
+		deleteUserAttr:                   deleteUserFn,
+		moveUsersToDivisionAttr:          moveUsersToDivisionFn,
+		patchUserRoutingSkillsAttr:       patchUserRoutingSkillsFn,
+		deleteUserRoutingSkillAttr:       deleteUserRoutingSkillFn,
+		patchUserRoutingLanguagesAttr:    patchUserRoutingLanguagesFn,
+		deleteUserRoutingLanguageAttr:    deleteUserRoutingLanguageFn,
+		getUserRoleGrantsAttr:            getUserRoleGrantsFn,
+		addUserRoleGrantsAttr:            addUserRoleGrantsFn,
+		removeUserRoleGrantsAttr:         removeUserRoleGrantsFn,
+		getAllRoutingSkillsAttr:          getAllRoutingSkillsFn,
+		getAllRoutingLanguagesAttr:       getAllRoutingLanguagesFn,
+		getAllAuthorizationRolesAttr:     getAllAuthorizationRolesFn,
+		getAllAuthorizationDivisionsAttr: getAllAuthorizationDivisionsFn,
+	}
+}
+
+// getUsersBulkProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getUsersBulkProxy(clientConfig *platformclientv2.Configuration) *usersBulkProxy {
+	if internalProxy == nil {
+		internalProxy = newUsersBulkProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getAllUsers retrieves all active and inactive users in the org with their skills, languages and roles from the provider's user cache
+func (p *usersBulkProxy) getAllUsers(ctx context.Context, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getAllUsersAttr(ctx, p, userCache)
+}
+
+// getUsersByIds retrieves the active and inactive users with the given IDs. Users that do not exist are not returned.
+func (p *usersBulkProxy) getUsersByIds(ctx context.Context, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getUsersByIdsAttr(ctx, p, userIds)
+}
+
+// getDeletedUserId returns the ID of a deleted user with the given email, or an empty string if there is none
+func (p *usersBulkProxy) getDeletedUserId(ctx context.Context, email string) (string, *platformclientv2.APIResponse, error) {
+	return p.getDeletedUserIdAttr(ctx, p, email)
+}
+
+// restoreDeletedUser sets the state of a deleted user back to active
+func (p *usersBulkProxy) restoreDeletedUser(ctx context.Context, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.restoreDeletedUserAttr(ctx, p, userId)
+}
+
+// createUser creates a Genesys Cloud user
+func (p *usersBulkProxy) createUser(ctx context.Context, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.createUserAttr(ctx, p, createUser)
+}
+
+// updateUser patches a Genesys Cloud user using its current version
+func (p *usersBulkProxy) updateUser(ctx context.Context, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.updateUserAttr(ctx, p, userId, updateUser)
+}
+
+// patchUsersBulk updates the ACD auto answer setting of several users in one request
+func (p *usersBulkProxy) patchUsersBulk(ctx context.Context, users []platformclientv2.Patchuser) (*platformclientv2.APIResponse, error) {
+	return p.patchUsersBulkAttr(ctx, p, users)
+}
+
+// deleteUser deletes a Genesys Cloud user
+func (p *usersBulkProxy) deleteUser(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserAttr(ctx, p, userId)
+}
+
+// moveUsersToDivision moves several users to a division in one request
+func (p *usersBulkProxy) moveUsersToDivision(ctx context.Context, divisionId string, userIds []string) (*platformclientv2.APIResponse, error) {
+	return p.moveUsersToDivisionAttr(ctx, p, divisionId, userIds)
+}
+
+// patchUserRoutingSkills adds or updates routing skills of a user
+func (p *usersBulkProxy) patchUserRoutingSkills(ctx context.Context, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+	return p.patchUserRoutingSkillsAttr(ctx, p, userId, skills)
+}
+
+// deleteUserRoutingSkill removes a routing skill from a user
+func (p *usersBulkProxy) deleteUserRoutingSkill(ctx context.Context, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserRoutingSkillAttr(ctx, p, userId, skillId)
+}
+
+// patchUserRoutingLanguages adds or updates routing languages of a user
+func (p *usersBulkProxy) patchUserRoutingLanguages(ctx context.Context, userId string, languages []platformclientv2.Userroutinglanguagepost) (*platformclientv2.APIResponse, error) {
+	return p.patchUserRoutingLanguagesAttr(ctx, p, userId, languages)
+}
+
+// deleteUserRoutingLanguage removes a routing language from a user
+func (p *usersBulkProxy) deleteUserRoutingLanguage(ctx context.Context, userId string, languageId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserRoutingLanguageAttr(ctx, p, userId, languageId)
+}
+
+// getUserRoleGrants retrieves the role grants of a user in every division
+func (p *usersBulkProxy) getUserRoleGrants(ctx context.Context, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	return p.getUserRoleGrantsAttr(ctx, p, userId)
+}
+
+// addUserRoleGrants grants roles to a user
+func (p *usersBulkProxy) addUserRoleGrants(ctx context.Context, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error) {
+	return p.addUserRoleGrantsAttr(ctx, p, userId, grants)
+}
+
+// removeUserRoleGrants removes role grants from a user
+func (p *usersBulkProxy) removeUserRoleGrants(ctx context.Context, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error) {
+	return p.removeUserRoleGrantsAttr(ctx, p, userId, grants)
+}
+
+// getAllRoutingSkills retrieves all routing skills in the org
+func (p *usersBulkProxy) getAllRoutingSkills(ctx context.Context) (*[]platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+	return p.getAllRoutingSkillsAttr(ctx, p)
+}
+
+// getAllRoutingLanguages retrieves all routing languages in the org
+func (p *usersBulkProxy) getAllRoutingLanguages(ctx context.Context) (*[]platformclientv2.Language, *platformclientv2.APIResponse, error) {
+	return p.getAllRoutingLanguagesAttr(ctx, p)
+}
+
+// getAllAuthorizationRoles retrieves all roles in the org
+func (p *usersBulkProxy) getAllAuthorizationRoles(ctx context.Context) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+	return p.getAllAuthorizationRolesAttr(ctx, p)
+}
+
+// getAllAuthorizationDivisions retrieves all divisions in the org
+func (p *usersBulkProxy) getAllAuthorizationDivisions(ctx context.Context) (*[]platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
+	return p.getAllAuthorizationDivisionsAttr(ctx, p)
+}
+
+// getAllUsersFn is the implementation for retrieving all users in Genesys Cloud. The users are only
+// paged through when the cache is empty.
+func getAllUsersFn(ctx context.Context, p *usersBulkProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return userCache.GetUsers(ctx, func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return usercache.GetAllUsers(ctx, p.usersApi)
+	})
+}
+
+// getUsersByIdsFn is the implementation for retrieving users by ID in Genesys Cloud
+func getUsersByIdsFn(_ context.Context, p *usersBulkProxy, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	var allUsers []platformclientv2.User
+	var resp *platformclientv2.APIResponse
+
+	// The users API accepts up to 100 IDs in a single request
+	const maxIds = 100
+	for start := 0; start < len(userIds); start += maxIds {
+		end := start + maxIds
+		if end > len(userIds) {
+			end = len(userIds)
+		}
+		for _, state := range []string{"active", "inactive"} {
+			users, apiResp, err := p.usersApi.GetUsers(maxIds, 1, userIds[start:end], nil, "", nil, "", state)
+			resp = apiResp
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get %s users: %v", state, err)
+			}
+			if users.Entities != nil {
+				allUsers = append(allUsers, *users.Entities...)
+			}
+		}
+	}
+	return &allUsers, resp, nil
+}
+
+// getDeletedUserIdFn is the implementation for searching for a deleted user by email in Genesys Cloud
+func getDeletedUserIdFn(_ context.Context, p *usersBulkProxy, email string) (string, *platformclientv2.APIResponse, error) {
+	exactType := "EXACT"
+	results, resp, err := p.usersApi.PostUsersSearch(platformclientv2.Usersearchrequest{
+		Query: &[]platformclientv2.Usersearchcriteria{
+			{
+				Fields:  &[]string{"email"},
+				Value:   &email,
+				VarType: &exactType,
+			},
+			{
+				Fields:  &[]string{"state"},
+				Values:  &[]string{"deleted"},
+				VarType: &exactType,
+			},
+		},
+	})
+	if err != nil {
+		return "", resp, fmt.Errorf("failed to search for deleted user %s: %v", email, err)
+	}
+	if results.Results != nil && len(*results.Results) > 0 {
+		return *(*results.Results)[0].Id, resp, nil
+	}
+	return "", resp, nil
+}
+
+// restoreDeletedUserFn is the implementation for restoring a deleted user in Genesys Cloud
+func restoreDeletedUserFn(_ context.Context, p *usersBulkProxy, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	deletedUser, resp, err := p.usersApi.GetUser(userId, nil, "", "deleted")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to read deleted user %s: %v", userId, err)
+	}
+
+	state := "active"
+	user, resp, err := p.usersApi.PatchUser(userId, platformclientv2.Updateuser{
+		State:   &state,
+		Version: deletedUser.Version,
+	})
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to restore deleted user %s: %v", userId, err)
+	}
+	return user, resp, nil
+}
+
+// createUserFn is the implementation for creating a user in Genesys Cloud
+func createUserFn(_ context.Context, p *usersBulkProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	user, resp, err := p.usersApi.PostUsers(*createUser)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to create user %s: %v", *createUser.Email, err)
+	}
+	return user, resp, nil
+}
+
+// updateUserFn is the implementation for updating a user in Genesys Cloud
+func updateUserFn(_ context.Context, p *usersBulkProxy, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	currentUser, resp, err := p.usersApi.GetUser(userId, nil, "", "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to read user %s: %v", userId, err)
+	}
+
+	updateUser.Version = currentUser.Version
+	user, resp, err := p.usersApi.PatchUser(userId, *updateUser)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to update user %s: %v", userId, err)
+	}
+	return user, resp, nil
+}
+
+// patchUsersBulkFn is the implementation for updating several users in Genesys Cloud
+func patchUsersBulkFn(_ context.Context, p *usersBulkProxy, users []platformclientv2.Patchuser) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.PatchUsersBulk(users)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update %d users: %v", len(users), err)
+	}
+	return resp, nil
+}
+
+// deleteUserFn is the implementation for deleting a user in Genesys Cloud
+func deleteUserFn(_ context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.DeleteUser(userId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete user %s: %v", userId, err)
+	}
+	return resp, nil
+}
+
+// moveUsersToDivisionFn is the implementation for moving users to a division in Genesys Cloud
+func moveUsersToDivisionFn(_ context.Context, p *usersBulkProxy, divisionId string, userIds []string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.authorizationApi.PostAuthorizationDivisionObject(divisionId, "USER", userIds)
+	if err != nil {
+		return resp, fmt.Errorf("failed to move %d users to division %s: %v", len(userIds), divisionId, err)
+	}
+	return resp, nil
+}
+
+// patchUserRoutingSkillsFn is the implementation for adding routing skills to a user in Genesys Cloud
+func patchUserRoutingSkillsFn(_ context.Context, p *usersBulkProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.PatchUserRoutingskillsBulk(userId, skills)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update skills for user %s: %v", userId, err)
+	}
+	return resp, nil
+}
+
+// deleteUserRoutingSkillFn is the implementation for removing a routing skill from a user in Genesys Cloud
+func deleteUserRoutingSkillFn(_ context.Context, p *usersBulkProxy, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.usersApi.DeleteUserRoutingskill(userId, skillId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove skill %s from user %s: %v", skillId, userId, err)
+	}
+	return resp, nil
+}
+
+// patchUserRoutingLanguagesFn is the implementation for adding routing languages to a user in Genesys Cloud
+func patchUserRoutingLanguagesFn(_ context.Context, p *usersBulkProxy, userId string, languages []platformclientv2.Userroutinglanguagepost) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.PatchUserRoutinglanguagesBulk(userId, languages)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update languages for user %s: %v", userId, err)
+	}
+	return resp, nil
+}
+
+// deleteUserRoutingLanguageFn is the implementation for removing a routing language from a user in Genesys Cloud
+func deleteUserRoutingLanguageFn(_ context.Context, p *usersBulkProxy, userId string, languageId string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.usersApi.DeleteUserRoutinglanguage(userId, languageId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove language %s from user %s: %v", languageId, userId, err)
+	}
+	return resp, nil
+}
+
+// getUserRoleGrantsFn is the implementation for reading the role grants of a user in Genesys Cloud
+func getUserRoleGrantsFn(_ context.Context, p *usersBulkProxy, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(userId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get roles for user %s: %v", userId, err)
+	}
+	if subject.Grants == nil {
+		return &[]platformclientv2.Authzgrant{}, resp, nil
+	}
+	return subject.Grants, resp, nil
+}
+
+// addUserRoleGrantsFn is the implementation for granting roles to a user in Genesys Cloud
+func addUserRoleGrantsFn(_ context.Context, p *usersBulkProxy, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error) {
+	resp, err := p.authorizationApi.PostAuthorizationSubjectBulkadd(userId, platformclientv2.Roledivisiongrants{Grants: &grants}, "PC_USER")
+	if err != nil {
+		return resp, fmt.Errorf("failed to grant roles to user %s: %v", userId, err)
+	}
+	return resp, nil
+}
+
+// removeUserRoleGrantsFn is the implementation for removing role grants from a user in Genesys Cloud
+func removeUserRoleGrantsFn(_ context.Context, p *usersBulkProxy, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error) {
+	resp, err := p.authorizationApi.PostAuthorizationSubjectBulkremove(userId, platformclientv2.Roledivisiongrants{Grants: &grants})
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove roles from user %s: %v", userId, err)
+	}
+	return resp, nil
+}
+
+// getAllRoutingSkillsFn is the implementation for retrieving all routing skills in Genesys Cloud
+func getAllRoutingSkillsFn(_ context.Context, p *usersBulkProxy) (*[]platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+	var allSkills []platformclientv2.Routingskill
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		skills, apiResp, err := p.routingApi.GetRoutingSkills(pageSize, pageNum, "", nil)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get routing skills: %v", err)
+		}
+		if skills.Entities == nil || len(*skills.Entities) == 0 {
+			break
+		}
+		allSkills = append(allSkills, *skills.Entities...)
+		if skills.PageCount == nil || pageNum >= *skills.PageCount {
+			break
+		}
+	}
+	return &allSkills, resp, nil
+}
+
+// getAllRoutingLanguagesFn is the implementation for retrieving all routing languages in Genesys Cloud
+func getAllRoutingLanguagesFn(_ context.Context, p *usersBulkProxy) (*[]platformclientv2.Language, *platformclientv2.APIResponse, error) {
+	var allLanguages []platformclientv2.Language
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		languages, apiResp, err := p.routingApi.GetRoutingLanguages(pageSize, pageNum, "", "", nil)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get routing languages: %v", err)
+		}
+		if languages.Entities == nil || len(*languages.Entities) == 0 {
+			break
+		}
+		allLanguages = append(allLanguages, *languages.Entities...)
+		if languages.PageCount == nil || pageNum >= *languages.PageCount {
+			break
+		}
+	}
+	return &allLanguages, resp, nil
+}
+
+// getAllAuthorizationRolesFn is the implementation for retrieving all roles in Genesys Cloud
+func getAllAuthorizationRolesFn(_ context.Context, p *usersBulkProxy) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+	var allRoles []platformclientv2.Domainorganizationrole
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		roles, apiResp, err := p.authorizationApi.GetAuthorizationRoles(pageSize, pageNum, "", nil, "", "", "", nil, nil, false, nil)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get roles: %v", err)
+		}
+		if roles.Entities == nil || len(*roles.Entities) == 0 {
+			break
+		}
+		allRoles = append(allRoles, *roles.Entities...)
+		if roles.PageCount == nil || pageNum >= *roles.PageCount {
+			break
+		}
+	}
+	return &allRoles, resp, nil
+}
+
+// getAllAuthorizationDivisionsFn is the implementation for retrieving all divisions in Genesys Cloud
+func getAllAuthorizationDivisionsFn(_ context.Context, p *usersBulkProxy) (*[]platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
+	var allDivisions []platformclientv2.Authzdivision
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		divisions, apiResp, err := p.authorizationApi.GetAuthorizationDivisions(pageSize, pageNum, "", nil, "", "", false, nil, "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get divisions: %v", err)
+		}
+		if divisions.Entities == nil || len(*divisions.Entities) == 0 {
+			break
+		}
+		allDivisions = append(allDivisions, *divisions.Entities...)
+		if divisions.PageCount == nil || pageNum >= *divisions.PageCount {
+			break
+		}
+	}
+	return &allDivisions, resp, nil
+}
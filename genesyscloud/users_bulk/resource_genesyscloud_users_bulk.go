@@ -0,0 +1,608 @@
+package users_bulk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// usersBulkChunkSize is the maximum number of items sent in a single bulk request
+const usersBulkChunkSize = 50
+
+func createUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+
+	log.Printf("Creating users from %s", d.Get("filepath").(string))
+	diags := syncUsersBulk(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	log.Printf("Created users from %s", d.Get("filepath").(string))
+	return append(diags, readUsersBulk(ctx, d, meta)...)
+}
+
+// readUsersBulk removes users that no longer exist from the managed users. Changes to the managed users are detected
+// during plan so the consistency checker is not used here.
+func readUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*genesyscloud.ProviderMeta).ClientConfig
+	proxy := getUsersBulkProxy(sdkConfig)
+	managedUsers := bulk.GetManaged(d, usersBulkAttributes)
+
+	log.Printf("Reading %d users for %s", len(managedUsers), d.Id())
+
+	return genesyscloud.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		userIds := make([]string, 0, len(managedUsers))
+		for _, id := range managedUsers {
+			userIds = append(userIds, id)
+		}
+
+		existingIds := make(map[string]bool, len(userIds))
+		if len(userIds) > 0 {
+			existingUsers, _, err := proxy.getUsersByIds(ctx, userIds)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("Failed to read users for %s: %s", d.Id(), err))
+			}
+			for _, user := range *existingUsers {
+				existingIds[*user.Id] = true
+			}
+		}
+
+		users := make(map[string]string, len(managedUsers))
+		for email, id := range managedUsers {
+			if existingIds[id] {
+				users[email] = id
+			} else {
+				log.Printf("User %s %s no longer exists", email, id)
+			}
+		}
+		bulk.SetManaged(d, usersBulkAttributes, users, bulk.GetCreated(d, usersBulkAttributes))
+
+		log.Printf("Read %d users for %s", len(users), d.Id())
+		return nil
+	})
+}
+
+func updateUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating users from %s", d.Get("filepath").(string))
+	diags := syncUsersBulk(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("Updated users from %s", d.Get("filepath").(string))
+	return append(diags, readUsersBulk(ctx, d, meta)...)
+}
+
+func deleteUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*genesyscloud.ProviderMeta)
+	proxy := getUsersBulkProxy(providerMeta.ClientConfig)
+	defer providerMeta.UserCache.Invalidate()
+	managedUsers := bulk.GetManaged(d, usersBulkAttributes)
+	createdUsers := bulk.GetCreated(d, usersBulkAttributes)
+	deletionBehavior := d.Get("deletion_behavior").(string)
+
+	log.Printf("Deleting %d users for %s", len(managedUsers), d.Id())
+
+	var deleteErrors []string
+	for _, email := range bulk.SortedKeys(managedUsers) {
+		id := managedUsers[email]
+		if !createdUsers[id] || deletionBehavior == deletionBehaviorRetain {
+			log.Printf("Releasing user %s. Removing it from the state only", email)
+			continue
+		}
+		if err := deleteBulkUser(ctx, proxy, id, deletionBehavior); err != nil {
+			deleteErrors = append(deleteErrors, fmt.Sprintf("%s: %v", email, err))
+		}
+	}
+	if len(deleteErrors) > 0 {
+		return diag.Errorf("Failed to delete %d users:\n%s", len(deleteErrors), strings.Join(deleteErrors, "\n"))
+	}
+
+	log.Printf("Deleted users for %s", d.Id())
+	return nil
+}
+
+// syncUsersBulk compares the users file with the org and applies the differences. Users that fail to
+// sync are reported as warnings and in the row_errors attribute.
+func syncUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*genesyscloud.ProviderMeta)
+	proxy := getUsersBulkProxy(providerMeta.ClientConfig)
+	filePath := d.Get("filepath").(string)
+
+	rows, err := readUsersBulkFile(filePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	refs, err := getUsersBulkReferences(ctx, proxy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orgUsers, _, err := proxy.getAllUsers(ctx, providerMeta.UserCache)
+	if err != nil {
+		return diag.Errorf("Failed to read users: %s", err)
+	}
+	defer providerMeta.UserCache.Invalidate()
+
+	previousUsers := bulk.GetManaged(d, usersBulkAttributes)
+	createdUsers := bulk.GetCreated(d, usersBulkAttributes)
+	deletionBehavior := d.Get("deletion_behavior").(string)
+	usersDiff := diffUsersBulk(rows, refs, *orgUsers, previousUsers, createdUsers, deletionBehavior)
+	log.Printf("Syncing %s will create %d, update %d, delete %d and release %d users", filePath, len(usersDiff.Created), len(usersDiff.Updated), len(usersDiff.Deleted), len(usersDiff.Released))
+
+	managedUsers, rowErrors := applyUsersBulkDiff(ctx, proxy, usersDiff, refs, *orgUsers, deletionBehavior)
+	for _, change := range usersDiff.Created {
+		if change.existing != nil {
+			createdUsers[*change.existing.Id] = true
+		}
+	}
+
+	return bulk.SaveSync(d, usersBulkAttributes, filePath, usersDiff, previousUsers, managedUsers, createdUsers, rowErrors)
+}
+
+// applyUsersBulkDiff creates, updates and deletes users. It returns the users that are now managed and the users that failed to sync.
+func applyUsersBulkDiff(ctx context.Context, proxy *usersBulkProxy, usersDiff *usersBulkDiff, refs *usersBulkReferences, orgUsers []platformclientv2.User, deletionBehavior string) (map[string]string, []bulk.RowError) {
+	managedUsers := make(map[string]string, len(usersDiff.Unchanged))
+	for email, id := range usersDiff.Unchanged {
+		managedUsers[email] = id
+	}
+	rowErrors := append([]bulk.RowError{}, usersDiff.RowErrors...)
+	failed := make(map[string]bool)
+	fail := func(user *bulkUser, err error) {
+		if failed[user.row.email] {
+			return
+		}
+		failed[user.row.email] = true
+		rowErrors = append(rowErrors, bulk.RowError{Position: user.row.position, Key: user.row.email, Message: err.Error()})
+	}
+
+	orgUsersByEmail := make(map[string]*platformclientv2.User, len(orgUsers))
+	for i, orgUser := range orgUsers {
+		if orgUser.Email != nil && orgUser.Id != nil {
+			orgUsersByEmail[strings.ToLower(*orgUser.Email)] = &orgUsers[i]
+		}
+	}
+
+	// Create all new users first so they can be referenced as managers
+	createdIds := make(map[string]string, len(usersDiff.Created))
+	for _, change := range usersDiff.Created {
+		user, err := createBulkUser(ctx, proxy, change.user)
+		if err != nil {
+			fail(change.user, err)
+			continue
+		}
+		change.existing = user
+		createdIds[strings.ToLower(change.user.row.email)] = *user.Id
+	}
+
+	changes := append([]*bulkUserChange{}, usersDiff.Updated...)
+	for _, change := range usersDiff.Created {
+		if change.existing == nil {
+			continue
+		}
+		managedUsers[change.user.row.email] = *change.existing.Id
+		change.changes = compareBulkUser(change.user, change.existing, orgUsersByEmail, createdIds)
+		changes = append(changes, change)
+	}
+	for _, change := range usersDiff.Updated {
+		managedUsers[change.user.row.email] = *change.existing.Id
+	}
+
+	moveBulkUsersToDivisions(ctx, proxy, changes, fail)
+
+	for _, change := range changes {
+		if failed[change.user.row.email] {
+			continue
+		}
+		if err := updateBulkUser(ctx, proxy, change, orgUsersByEmail, createdIds); err != nil {
+			fail(change.user, err)
+		}
+	}
+
+	updateBulkUsersAcdAutoAnswer(ctx, proxy, changes, failed, fail)
+
+	for _, change := range changes {
+		if failed[change.user.row.email] {
+			continue
+		}
+		if err := updateBulkUserSkills(ctx, proxy, change); err != nil {
+			fail(change.user, err)
+			continue
+		}
+		if err := updateBulkUserLanguages(ctx, proxy, change); err != nil {
+			fail(change.user, err)
+			continue
+		}
+		if err := updateBulkUserRoles(ctx, proxy, change, refs.homeDivisionId); err != nil {
+			fail(change.user, err)
+		}
+	}
+
+	for _, released := range usersDiff.Released {
+		log.Printf("Releasing user %s. Removing it from the state only", released.Key)
+	}
+	for _, deleted := range usersDiff.Deleted {
+		if err := deleteBulkUser(ctx, proxy, deleted.Id, deletionBehavior); err != nil {
+			// Keep managing the user so the delete is retried on the next apply
+			managedUsers[deleted.Key] = deleted.Id
+			rowErrors = append(rowErrors, bulk.RowError{Position: "removed from file", Key: deleted.Key, Message: err.Error()})
+		}
+	}
+
+	return managedUsers, rowErrors
+}
+
+// createBulkUser creates a user, or restores a deleted user with the same email
+func createBulkUser(ctx context.Context, proxy *usersBulkProxy, user *bulkUser) (*platformclientv2.User, error) {
+	row := user.row
+
+	deletedId, _, err := proxy.getDeletedUserId(ctx, row.email)
+	if err != nil {
+		return nil, err
+	}
+	if deletedId != "" {
+		log.Printf("Restoring deleted user %s", row.email)
+		restoredUser, _, err := proxy.restoreDeletedUser(ctx, deletedId)
+		return restoredUser, err
+	}
+
+	state := "active"
+	createUser := platformclientv2.Createuser{
+		Email:      &row.email,
+		Name:       &row.name,
+		State:      &state,
+		Department: row.department,
+		Title:      row.title,
+		DivisionId: user.divisionId,
+	}
+
+	log.Printf("Creating user %s", row.email)
+	createdUser, _, err := proxy.createUser(ctx, &createUser)
+	return createdUser, err
+}
+
+// moveBulkUsersToDivisions moves users to their new divisions with one request per division
+func moveBulkUsersToDivisions(ctx context.Context, proxy *usersBulkProxy, changes []*bulkUserChange, fail func(*bulkUser, error)) {
+	changesByDivision := make(map[string][]*bulkUserChange)
+	for _, change := range changes {
+		if lists.ItemInSlice("division", change.changes) {
+			changesByDivision[*change.user.divisionId] = append(changesByDivision[*change.user.divisionId], change)
+		}
+	}
+
+	for _, divisionId := range bulk.SortedKeys(changesByDivision) {
+		for _, chunk := range chunks.ChunkBy(changesByDivision[divisionId], usersBulkChunkSize) {
+			userIds := make([]string, len(chunk))
+			for i, change := range chunk {
+				userIds[i] = *change.existing.Id
+			}
+
+			log.Printf("Moving %d users to division %s", len(userIds), divisionId)
+			if _, err := proxy.moveUsersToDivision(ctx, divisionId, userIds); err != nil {
+				for _, change := range chunk {
+					fail(change.user, err)
+				}
+			}
+		}
+	}
+}
+
+// updateBulkUser patches the name, department, title and manager of a user
+func updateBulkUser(ctx context.Context, proxy *usersBulkProxy, change *bulkUserChange, orgUsersByEmail map[string]*platformclientv2.User, createdIds map[string]string) error {
+	if !lists.ItemInSlice("name", change.changes) && !lists.ItemInSlice("department", change.changes) &&
+		!lists.ItemInSlice("title", change.changes) && !lists.ItemInSlice("manager", change.changes) {
+		return nil
+	}
+
+	row := change.user.row
+	updateUser := platformclientv2.Updateuser{
+		Name:       &row.name,
+		Department: row.department,
+		Title:      row.title,
+	}
+	if row.manager != nil {
+		managerId, found := resolveManagerId(*row.manager, orgUsersByEmail, createdIds)
+		if !found {
+			return fmt.Errorf("manager %q was not created", *row.manager)
+		}
+		updateUser.Manager = &managerId
+	}
+
+	log.Printf("Updating %s for user %s", strings.Join(change.changes, ", "), row.email)
+	return retryVersionMismatch(func() (*platformclientv2.APIResponse, error) {
+		_, resp, err := proxy.updateUser(ctx, *change.existing.Id, &updateUser)
+		return resp, err
+	})
+}
+
+// updateBulkUsersAcdAutoAnswer updates the ACD auto answer setting of users in batches
+func updateBulkUsersAcdAutoAnswer(ctx context.Context, proxy *usersBulkProxy, changes []*bulkUserChange, failed map[string]bool, fail func(*bulkUser, error)) {
+	var autoAnswerChanges []*bulkUserChange
+	for _, change := range changes {
+		if !failed[change.user.row.email] && lists.ItemInSlice("acd_auto_answer", change.changes) {
+			autoAnswerChanges = append(autoAnswerChanges, change)
+		}
+	}
+
+	for _, chunk := range chunks.ChunkBy(autoAnswerChanges, usersBulkChunkSize) {
+		if len(chunk) == 0 {
+			continue
+		}
+		patchUsers := make([]platformclientv2.Patchuser, len(chunk))
+		for i, change := range chunk {
+			patchUsers[i] = platformclientv2.Patchuser{
+				Id:            change.existing.Id,
+				AcdAutoAnswer: change.user.row.acdAutoAnswer,
+			}
+		}
+
+		log.Printf("Updating ACD auto answer for %d users", len(patchUsers))
+		if _, err := proxy.patchUsersBulk(ctx, patchUsers); err != nil {
+			for _, change := range chunk {
+				fail(change.user, err)
+			}
+		}
+	}
+}
+
+// updateBulkUserSkills removes routing skills that are not in the file and adds or updates the rest
+func updateBulkUserSkills(ctx context.Context, proxy *usersBulkProxy, change *bulkUserChange) error {
+	if !lists.ItemInSlice("skills", change.changes) {
+		return nil
+	}
+	userId := *change.existing.Id
+
+	existingSkills := make(map[string]float64)
+	if change.existing.Skills != nil {
+		for _, skill := range *change.existing.Skills {
+			existingSkills[*skill.Id] = floatValue(skill.Proficiency)
+		}
+	}
+
+	for _, skillId := range bulk.SortedKeys(existingSkills) {
+		if _, ok := (*change.user.skills)[skillId]; ok {
+			continue
+		}
+		if err := retryVersionMismatch(func() (*platformclientv2.APIResponse, error) {
+			return proxy.deleteUserRoutingSkill(ctx, userId, skillId)
+		}); err != nil {
+			return err
+		}
+	}
+
+	var skills []platformclientv2.Userroutingskillpost
+	for _, skillId := range bulk.SortedKeys(*change.user.skills) {
+		proficiency := (*change.user.skills)[skillId]
+		if existing, ok := existingSkills[skillId]; ok && existing == proficiency {
+			continue
+		}
+		id := skillId
+		skills = append(skills, platformclientv2.Userroutingskillpost{Id: &id, Proficiency: &proficiency})
+	}
+
+	for _, chunk := range chunks.ChunkBy(skills, usersBulkChunkSize) {
+		if len(chunk) == 0 {
+			continue
+		}
+		if err := retryVersionMismatch(func() (*platformclientv2.APIResponse, error) {
+			return proxy.patchUserRoutingSkills(ctx, userId, chunk)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateBulkUserLanguages removes routing languages that are not in the file and adds or updates the rest
+func updateBulkUserLanguages(ctx context.Context, proxy *usersBulkProxy, change *bulkUserChange) error {
+	if !lists.ItemInSlice("languages", change.changes) {
+		return nil
+	}
+	userId := *change.existing.Id
+
+	existingLanguages := make(map[string]float64)
+	if change.existing.Languages != nil {
+		for _, language := range *change.existing.Languages {
+			existingLanguages[*language.Id] = floatValue(language.Proficiency)
+		}
+	}
+
+	for _, languageId := range bulk.SortedKeys(existingLanguages) {
+		if _, ok := (*change.user.languages)[languageId]; ok {
+			continue
+		}
+		if err := retryVersionMismatch(func() (*platformclientv2.APIResponse, error) {
+			return proxy.deleteUserRoutingLanguage(ctx, userId, languageId)
+		}); err != nil {
+			return err
+		}
+	}
+
+	var languages []platformclientv2.Userroutinglanguagepost
+	for _, languageId := range bulk.SortedKeys(*change.user.languages) {
+		proficiency := (*change.user.languages)[languageId]
+		if existing, ok := existingLanguages[languageId]; ok && existing == proficiency {
+			continue
+		}
+		id := languageId
+		languages = append(languages, platformclientv2.Userroutinglanguagepost{Id: &id, Proficiency: &proficiency})
+	}
+
+	for _, chunk := range chunks.ChunkBy(languages, usersBulkChunkSize) {
+		if len(chunk) == 0 {
+			continue
+		}
+		if err := retryVersionMismatch(func() (*platformclientv2.APIResponse, error) {
+			return proxy.patchUserRoutingLanguages(ctx, userId, chunk)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateBulkUserRoles removes grants of roles that are not in the file from every division and grants
+// the missing roles in the home division
+func updateBulkUserRoles(ctx context.Context, proxy *usersBulkProxy, change *bulkUserChange, homeDivisionId string) error {
+	if !lists.ItemInSlice("roles", change.changes) {
+		return nil
+	}
+	userId := *change.existing.Id
+
+	grants, _, err := proxy.getUserRoleGrants(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	grantedRoles := make(map[string]bool)
+	var grantsToRemove []platformclientv2.Roledivisionpair
+	for _, grant := range *grants {
+		if grant.Role == nil || grant.Role.Id == nil || grant.Division == nil || grant.Division.Id == nil {
+			continue
+		}
+		if lists.ItemInSlice(*grant.Role.Id, *change.user.roleIds) {
+			grantedRoles[*grant.Role.Id] = true
+			continue
+		}
+		grantsToRemove = append(grantsToRemove, platformclientv2.Roledivisionpair{RoleId: grant.Role.Id, DivisionId: grant.Division.Id})
+	}
+
+	var grantsToAdd []platformclientv2.Roledivisionpair
+	for _, roleId := range *change.user.roleIds {
+		if !grantedRoles[roleId] {
+			id := roleId
+			grantsToAdd = append(grantsToAdd, platformclientv2.Roledivisionpair{RoleId: &id, DivisionId: &homeDivisionId})
+		}
+	}
+
+	if len(grantsToRemove) > 0 {
+		if _, err := proxy.removeUserRoleGrants(ctx, userId, grantsToRemove); err != nil {
+			return err
+		}
+	}
+	if len(grantsToAdd) > 0 {
+		// New roles may not be in the auth service cache yet
+		diagErr := genesyscloud.RetryWhen(genesyscloud.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			resp, err := proxy.addUserRoleGrants(ctx, userId, grantsToAdd)
+			if err != nil {
+				return resp, diag.FromErr(err)
+			}
+			return nil, nil
+		})
+		if diagErr != nil {
+			return fmt.Errorf("%s", diagErr[0].Summary)
+		}
+	}
+	return nil
+}
+
+// deleteBulkUser deletes or deactivates a user created by the resource. Users that are already deleted are ignored.
+func deleteBulkUser(ctx context.Context, proxy *usersBulkProxy, userId string, deletionBehavior string) error {
+	if deletionBehavior == deletionBehaviorDeactivate {
+		log.Printf("Deactivating user %s", userId)
+		inactive := "inactive"
+		return retryVersionMismatch(func() (*platformclientv2.APIResponse, error) {
+			_, resp, err := proxy.updateUser(ctx, userId, &platformclientv2.Updateuser{State: &inactive})
+			if err != nil && genesyscloud.IsStatus404(resp) {
+				return nil, nil
+			}
+			return resp, err
+		})
+	}
+	return retryVersionMismatch(func() (*platformclientv2.APIResponse, error) {
+		resp, err := proxy.deleteUser(ctx, userId)
+		if err != nil && genesyscloud.IsStatus404(resp) {
+			return nil, nil
+		}
+		return resp, err
+	})
+}
+
+// retryVersionMismatch retries a user update while directory returns version mismatch errors
+func retryVersionMismatch(callSdk func() (*platformclientv2.APIResponse, error)) error {
+	diagErr := genesyscloud.RetryWhen(genesyscloud.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, err := callSdk()
+		if err != nil {
+			return resp, diag.FromErr(err)
+		}
+		return nil, nil
+	})
+	if diagErr != nil {
+		return fmt.Errorf("%s", diagErr[0].Summary)
+	}
+	return nil
+}
+
+// getUsersBulkReferences reads the divisions, roles, skills and languages that can be referenced in a users file
+func getUsersBulkReferences(ctx context.Context, proxy *usersBulkProxy) (*usersBulkReferences, error) {
+	divisions, _, err := proxy.getAllAuthorizationDivisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, _, err := proxy.getAllAuthorizationRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	skills, _, err := proxy.getAllRoutingSkills(ctx)
+	if err != nil {
+		return nil, err
+	}
+	languages, _, err := proxy.getAllRoutingLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildUsersBulkReferences(*divisions, *roles, *skills, *languages), nil
+}
+
+// customizeUsersBulkDiff validates the users file and compares it with the org. Any difference between the
+// file and the org, including changes made outside of Terraform, causes the users to be synced on apply.
+func customizeUsersBulkDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("filepath") || !diff.NewValueKnown("file_content_hash") {
+		// The file is not known until apply. The users will be validated then.
+		return bulk.SetDiffComputed(diff, usersBulkAttributes)
+	}
+
+	filePath := diff.Get("filepath").(string)
+	rows, err := readUsersBulkFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	providerMeta := meta.(*genesyscloud.ProviderMeta)
+	proxy := getUsersBulkProxy(providerMeta.ClientConfig)
+
+	refs, err := getUsersBulkReferences(ctx, proxy)
+	if err != nil {
+		return err
+	}
+
+	orgUsers, _, err := proxy.getAllUsers(ctx, providerMeta.UserCache)
+	if err != nil {
+		return fmt.Errorf("Failed to read users: %s", err)
+	}
+
+	managedUsers := bulk.GetManaged(diff, usersBulkAttributes)
+	createdUsers := bulk.GetCreated(diff, usersBulkAttributes)
+
+	usersDiff := diffUsersBulk(rows, refs, *orgUsers, managedUsers, createdUsers, diff.Get("deletion_behavior").(string))
+	log.Printf("Syncing %s will create %d, update %d, delete %d and release %d users", filePath, len(usersDiff.Created), len(usersDiff.Updated), len(usersDiff.Deleted), len(usersDiff.Released))
+	for _, rowError := range usersDiff.RowErrors {
+		log.Printf("User %s at %s in %s will not be synced: %s", rowError.Key, rowError.Position, filePath, rowError.Message)
+	}
+
+	return bulk.PlanSync(diff, usersBulkAttributes, usersDiff, false)
+}
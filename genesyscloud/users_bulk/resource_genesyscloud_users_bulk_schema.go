@@ -0,0 +1,126 @@
+package users_bulk
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_users_bulk_schema.go holds two functions within it:
+
+1.  The registration code that registers the Resource for the package.
+2.  The resource schema definitions for the users_bulk resource.
+*/
+const resourceName = "genesyscloud_users_bulk"
+
+const (
+	deletionBehaviorDelete     = "delete"
+	deletionBehaviorDeactivate = "deactivate"
+	deletionBehaviorRetain     = "retain"
+)
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(resourceName, ResourceUsersBulk())
+}
+
+var rowErrorResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"row": {
+			Description: "Position of the user in the file, e.g. `line 3` for CSV files or `user 2` for JSON files.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"email": {
+			Description: "Email of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"message": {
+			Description: "Reason the user could not be synced.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// ResourceUsersBulk registers the genesyscloud_users_bulk resource with Terraform
+func ResourceUsersBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Users Bulk. Manages many users from a single CSV or SCIM shaped JSON file. " +
+			"Users are matched to existing users in the org by email. Users created by this resource are deleted or deactivated, as set by `deletion_behavior`, " +
+			"when they are removed from the file or the resource is destroyed. Existing users that were matched by email are only removed from the state. " +
+			"Users that fail to sync are reported in `row_errors` and as warnings without failing the apply, and are retried on the next apply. " +
+			"Do not manage the same users with genesyscloud_user.",
+
+		CreateContext: genesyscloud.CreateWithPooledClient(createUsersBulk),
+		ReadContext:   genesyscloud.ReadWithPooledClient(readUsersBulk),
+		UpdateContext: genesyscloud.UpdateWithPooledClient(updateUsersBulk),
+		DeleteContext: genesyscloud.DeleteWithPooledClient(deleteUsersBulk),
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"filepath": {
+				Description: "Path to a CSV or JSON file describing the users. " +
+					"Files ending in `.json` must contain an array of SCIM user objects or a SCIM `ListResponse`. " +
+					"Any other file is read as CSV with a header row. The `email` and `name` columns are required. " +
+					"The optional columns are `division`, `department`, `title`, `manager`, `acd_auto_answer`, `roles`, `skills` and `languages`. " +
+					"Divisions, roles, skills and languages are referenced by name and managers by email. " +
+					"Lists are separated by `;` and skills and languages are written as `name:proficiency`. " +
+					"Attributes without a column are not managed.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: genesyscloud.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the users file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"users": {
+				Description: "Map of email to user ID for every user managed by this resource.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_users": {
+				Description: "IDs of the managed users that were created or restored by this resource. Only these users are deleted or deactivated by this resource.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"deletion_behavior": {
+				Description: "What happens to users created by this resource when they are removed from the file or the resource is destroyed (delete | deactivate | retain). " +
+					"`delete` deletes the users, `deactivate` sets their state to inactive and `retain` only removes them from the Terraform state. Default is 'delete'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      deletionBehaviorDelete,
+				ValidateFunc: validation.StringInSlice([]string{deletionBehaviorDelete, deletionBehaviorDeactivate, deletionBehaviorRetain}, false),
+			},
+			"users_created": {
+				Description: "Number of users created by the most recent sync. Calculated during plan.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"users_updated": {
+				Description: "Number of existing users changed by the most recent sync. Calculated during plan.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"users_deleted": {
+				Description: "Number of users created by this resource that were deleted or deactivated by the most recent sync. Calculated during plan.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"row_errors": {
+				Description: "Users in the file that could not be synced by the most recent apply.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        rowErrorResource,
+			},
+		},
+		CustomizeDiff: customizeUsersBulkDiff,
+	}
+}
@@ -0,0 +1,119 @@
+package users_bulk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func TestAccResourceUsersBulk(t *testing.T) {
+	var (
+		usersResource1 = "test-users"
+		skillResource1 = "test-skill"
+		skillName1     = "Terraform Users Bulk Skill-" + uuid.NewString()
+		suffix         = strings.Replace(uuid.NewString(), "-", "", -1)
+		email1         = "terraform-bulk-1-" + suffix + "@example.com"
+		email2         = "terraform-bulk-2-" + suffix + "@example.com"
+		email3         = "terraform-bulk-3-" + suffix + "@example.com"
+
+		usersFile1 = filepath.Join(t.TempDir(), "users1.csv")
+		usersFile2 = filepath.Join(t.TempDir(), "users2.json")
+
+		skillConfig = genesyscloud.GenerateRoutingSkillResource(skillResource1, skillName1)
+	)
+
+	csvContent := "email,name,title,manager,acd_auto_answer,skills\n" +
+		fmt.Sprintf("%s,Bulk Manager,Manager,,false,%s:5\n", email1, skillName1) +
+		fmt.Sprintf("%s,Bulk Agent,Agent,%s,true,%s:3\n", email2, email1, skillName1)
+	if err := os.WriteFile(usersFile1, []byte(csvContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	jsonContent := fmt.Sprintf(`[
+		{"userName": %q, "displayName": "Bulk Manager", "title": "Manager"},
+		{
+			"userName": %q,
+			"displayName": "Bulk Agent Updated",
+			"title": "Agent",
+			"urn:ietf:params:scim:schemas:extension:genesys:purecloud:2.0:User": {"routingSkills": []}
+		}
+	]`, email1, email3)
+	if err := os.WriteFile(usersFile2, []byte(jsonContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { genesyscloud.TestAccPreCheck(t) },
+		ProviderFactories: genesyscloud.GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create two users from a CSV file
+				Config: skillConfig + generateUsersBulkResource(usersResource1, usersFile1, "genesyscloud_routing_skill."+skillResource1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "users.%", "2"),
+					resource.TestCheckResourceAttrSet("genesyscloud_users_bulk."+usersResource1, "users."+email1),
+					resource.TestCheckResourceAttrSet("genesyscloud_users_bulk."+usersResource1, "users."+email2),
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "users_created", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "created_users.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "row_errors.#", "0"),
+				),
+			},
+			{
+				// Replace the users with a SCIM JSON file. One user is kept, one deleted and one created.
+				Config: skillConfig + generateUsersBulkResource(usersResource1, usersFile2, "genesyscloud_routing_skill."+skillResource1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "users.%", "2"),
+					resource.TestCheckResourceAttrSet("genesyscloud_users_bulk."+usersResource1, "users."+email3),
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "users_created", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "users_deleted", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_users_bulk."+usersResource1, "row_errors.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersBulkDestroyed,
+	})
+}
+
+func generateUsersBulkResource(resourceID string, filePath string, dependsOn string) string {
+	return fmt.Sprintf(`resource "genesyscloud_users_bulk" "%s" {
+		filepath = "%s"
+		file_content_hash = filesha256("%s")
+		depends_on = [%s]
+	}
+	`, resourceID, filePath, filePath, dependsOn)
+}
+
+func testVerifyUsersBulkDestroyed(state *terraform.State) error {
+	usersAPI := platformclientv2.NewUsersApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_users_bulk" {
+			continue
+		}
+
+		for attr, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(attr, "users.") || attr == "users.%" {
+				continue
+			}
+			user, resp, err := usersAPI.GetUser(id, nil, "", "")
+			if user != nil {
+				return fmt.Errorf("User (%s) still exists", id)
+			} else if genesyscloud.IsStatus404(resp) {
+				// User not found as expected
+				continue
+			} else {
+				// Unexpected error
+				return fmt.Errorf("Unexpected error: %s", err)
+			}
+		}
+	}
+	// Success. All users destroyed
+	return nil
+}
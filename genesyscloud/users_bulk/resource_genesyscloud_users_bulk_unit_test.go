@@ -0,0 +1,524 @@
+package users_bulk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	gcloud "terraform-provider-genesyscloud/genesyscloud"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"terraform-provider-genesyscloud/genesyscloud/util/usercache"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestUsersBulkReferences() *usersBulkReferences {
+	return &usersBulkReferences{
+		divisions:      map[string]string{"home": "division-home", "sales": "division-sales"},
+		homeDivisionId: "division-home",
+		roles:          map[string]string{"employee": "role-employee", "supervisor": "role-supervisor"},
+		skills:         map[string]string{"billing": "skill-billing", "support": "skill-support"},
+		languages:      map[string]string{"english": "language-english", "spanish": "language-spanish"},
+	}
+}
+
+func buildTestOrgUser(id string, email string, name string) platformclientv2.User {
+	divisionId := "division-home"
+	return platformclientv2.User{
+		Id:       &id,
+		Email:    &email,
+		Name:     &name,
+		Division: &platformclientv2.Division{Id: &divisionId},
+	}
+}
+
+func TestUnitUsersBulkCsvParsing(t *testing.T) {
+	rows, err := parseUsersBulkCsv(strings.NewReader(
+		"email,name,division,title,manager,acd_auto_answer,roles,skills,languages\n" +
+			"alice@example.com,Alice,Sales,Agent,bob@example.com,true,Employee;Supervisor,Billing:4.5;Support:2,English:5\n" +
+			"bob@example.com,Bob,,,,,,,\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rows))
+
+	alice := rows[0]
+	assert.Equal(t, "line 2", alice.position)
+	assert.Equal(t, "alice@example.com", alice.email)
+	assert.Equal(t, "Alice", alice.name)
+	assert.Equal(t, "Sales", *alice.division)
+	assert.Equal(t, "Agent", *alice.title)
+	assert.Equal(t, "bob@example.com", *alice.manager)
+	assert.Equal(t, true, *alice.acdAutoAnswer)
+	assert.Equal(t, []string{"Employee", "Supervisor"}, *alice.roles)
+	assert.Equal(t, map[string]float64{"Billing": 4.5, "Support": 2}, *alice.skills)
+	assert.Equal(t, map[string]float64{"English": 5}, *alice.languages)
+
+	// Columns that are not in the file are not managed
+	assert.Nil(t, alice.department)
+
+	// Empty cells clear the attribute except for the division
+	bob := rows[1]
+	assert.Nil(t, bob.division)
+	assert.Equal(t, "", *bob.title)
+	assert.Equal(t, "", *bob.manager)
+	assert.Equal(t, false, *bob.acdAutoAnswer)
+	assert.Equal(t, []string{}, *bob.roles)
+	assert.Equal(t, map[string]float64{}, *bob.skills)
+}
+
+func TestUnitUsersBulkScimJsonParsing(t *testing.T) {
+	content := `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+		"Resources": [
+			{
+				"userName": "alice@example.com",
+				"displayName": "Alice",
+				"title": "Agent",
+				"roles": [{"value": "Employee"}],
+				"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {
+					"department": "Support",
+					"division": "Sales",
+					"manager": {"value": "bob@example.com"}
+				},
+				"urn:ietf:params:scim:schemas:extension:genesys:purecloud:2.0:User": {
+					"routingSkills": [{"name": "Billing", "proficiency": 3.5}],
+					"routingLanguages": [{"name": "English", "proficiency": 4}]
+				}
+			},
+			{
+				"userName": "bob",
+				"displayName": "Bob",
+				"emails": [{"value": "bob@other.com"}, {"value": "bob@example.com", "primary": true}]
+			}
+		]
+	}`
+
+	rows, err := parseUsersBulkJson(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rows))
+
+	alice := rows[0]
+	assert.Equal(t, "user 1", alice.position)
+	assert.Equal(t, "alice@example.com", alice.email)
+	assert.Equal(t, "Agent", *alice.title)
+	assert.Equal(t, "Support", *alice.department)
+	assert.Equal(t, "Sales", *alice.division)
+	assert.Equal(t, "bob@example.com", *alice.manager)
+	assert.Equal(t, []string{"Employee"}, *alice.roles)
+	assert.Equal(t, map[string]float64{"Billing": 3.5}, *alice.skills)
+	assert.Equal(t, map[string]float64{"English": 4}, *alice.languages)
+
+	// The primary email is used when the user name is not an email. Missing attributes are not managed.
+	bob := rows[1]
+	assert.Equal(t, "bob@example.com", bob.email)
+	assert.Nil(t, bob.title)
+	assert.Nil(t, bob.manager)
+	assert.Nil(t, bob.roles)
+	assert.Nil(t, bob.skills)
+
+	// A plain array of SCIM users is also accepted
+	rows, err = parseUsersBulkJson(strings.NewReader(`[{"userName": "carol@example.com", "displayName": "Carol"}]`))
+	assert.Nil(t, err)
+	assert.Equal(t, "carol@example.com", rows[0].email)
+}
+
+func TestUnitUsersBulkFileErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		json     bool
+		expected []string
+	}{
+		{
+			name:     "unknown column",
+			content:  "email,name,nickname\n",
+			expected: []string{`unknown column "nickname"`},
+		},
+		{
+			name:     "missing name column",
+			content:  "email,title\n",
+			expected: []string{`a "name" column is required`},
+		},
+		{
+			name: "invalid rows",
+			content: "email,name,skills,languages,acd_auto_answer\n" +
+				"alice,Alice,,,\n" +
+				"bob@example.com,,Billing:6,English:2.5,maybe\n" +
+				"carol@example.com,Carol,Billing,,\n" +
+				"Carol@example.com,Carol,,,\n",
+			expected: []string{
+				`line 2: "alice" is not a valid email`,
+				"line 3: acd_auto_answer must be true or false",
+				`line 3: skill "Billing" proficiency must be between 0 and 5`,
+				`line 3: language "English" proficiency must be a whole number`,
+				"line 3: name must not be empty",
+				`line 4: skill "Billing" must be written as name:proficiency`,
+				`line 5: duplicate email "Carol@example.com", first defined at line 4`,
+			},
+		},
+		{
+			name:     "invalid json",
+			content:  `{"userName": "alice@example.com"}`,
+			json:     true,
+			expected: []string{"must contain a JSON array of SCIM users or a SCIM ListResponse"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			if tc.json {
+				_, err = parseUsersBulkJson(strings.NewReader(tc.content))
+			} else {
+				_, err = parseUsersBulkCsv(strings.NewReader(tc.content))
+			}
+			if !assert.NotNil(t, err) {
+				return
+			}
+			for _, expected := range tc.expected {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
+
+func TestUnitUsersBulkDiff(t *testing.T) {
+	refs := buildTestUsersBulkReferences()
+	rows, err := parseUsersBulkCsv(strings.NewReader(
+		"email,name,division,manager,roles,skills,languages\n" +
+			"alice@example.com,Alice,Home,,Employee,Billing:4,English:5\n" +
+			"bob@example.com,Bob,Sales,alice@example.com,Employee,Billing:4,English:5\n" +
+			"carol@example.com,Carol,Home,dave@example.com,Employee,Billing:4,English:5\n" +
+			"erin@example.com,Erin,Home,,Employee,Cooking:4,English:5\n" +
+			"frank@example.com,Frank,Home,,Employee,Billing:4,English:5\n"))
+	assert.Nil(t, err)
+
+	alice := buildTestOrgUser("alice-id", "Alice@example.com", "Alice")
+	alice.Authorization = &platformclientv2.Userauthorization{Roles: &[]platformclientv2.Domainrole{{Id: platformclientv2.String("role-employee")}}}
+	alice.Skills = &[]platformclientv2.Userroutingskill{{Id: platformclientv2.String("skill-billing"), Proficiency: platformclientv2.Float64(4)}}
+	alice.Languages = &[]platformclientv2.Userroutinglanguage{{Id: platformclientv2.String("language-english"), Proficiency: platformclientv2.Float64(5)}}
+
+	bob := buildTestOrgUser("bob-id", "bob@example.com", "Bob")
+	bob.Skills = &[]platformclientv2.Userroutingskill{{Id: platformclientv2.String("skill-support"), Proficiency: platformclientv2.Float64(4)}}
+
+	removed := buildTestOrgUser("removed-id", "removed@example.com", "Removed")
+	adopted := buildTestOrgUser("adopted-id", "adopted@example.com", "Adopted")
+	orgUsers := []platformclientv2.User{alice, bob, removed, adopted}
+	managedUsers := map[string]string{
+		"alice@example.com":   "alice-id",
+		"removed@example.com": "removed-id",
+		"adopted@example.com": "adopted-id",
+		"gone@example.com":    "gone-id",
+	}
+	createdUsers := map[string]bool{"removed-id": true, "gone-id": true}
+
+	usersDiff := diffUsersBulk(rows, refs, orgUsers, managedUsers, createdUsers, deletionBehaviorDelete)
+
+	// Alice matches the org
+	assert.Equal(t, map[string]string{"alice@example.com": "alice-id"}, usersDiff.Unchanged)
+
+	// Bob is moved, given a manager, roles and languages, and his skills are replaced
+	if assert.Equal(t, 1, len(usersDiff.Updated)) {
+		assert.Equal(t, "bob@example.com", usersDiff.Updated[0].user.row.email)
+		assert.Equal(t, []string{"division", "manager", "roles", "skills", "languages"}, usersDiff.Updated[0].changes)
+	}
+
+	// Frank is new. Carol's manager and Erin's skill do not exist.
+	if assert.Equal(t, 1, len(usersDiff.Created)) {
+		assert.Equal(t, "frank@example.com", usersDiff.Created[0].user.row.email)
+	}
+	assert.Equal(t, []bulk.RowError{
+		{Position: "line 4", Key: "carol@example.com", Message: `manager "dave@example.com" not found`},
+		{Position: "line 5", Key: "erin@example.com", Message: `skill "Cooking" not found`},
+	}, usersDiff.RowErrors)
+
+	// Created users removed from the file are deleted unless they were already deleted.
+	// Users that existed before the resource matched them are only released.
+	assert.Equal(t, []*bulk.Removal{{Key: "removed@example.com", Id: "removed-id"}}, usersDiff.Deleted)
+	assert.Equal(t, []*bulk.Removal{{Key: "adopted@example.com", Id: "adopted-id"}}, usersDiff.Released)
+	assert.Equal(t, map[string]int{"users_created": 1, "users_updated": 1, "users_deleted": 1}, usersDiff.Counts(usersBulkAttributes))
+
+	// Created users are also released when the deletion behavior is retain
+	usersDiff = diffUsersBulk(rows, refs, orgUsers, managedUsers, createdUsers, deletionBehaviorRetain)
+	assert.Equal(t, 0, len(usersDiff.Deleted))
+	assert.Equal(t, 3, len(usersDiff.Released))
+}
+
+func TestUnitUsersBulkDiffManagerCreatedInSameSync(t *testing.T) {
+	refs := buildTestUsersBulkReferences()
+	rows, err := parseUsersBulkCsv(strings.NewReader(
+		"email,name,manager\n" +
+			"alice@example.com,Alice,newmanager@example.com\n" +
+			"newmanager@example.com,New Manager,\n"))
+	assert.Nil(t, err)
+
+	usersDiff := diffUsersBulk(rows, refs, []platformclientv2.User{buildTestOrgUser("alice-id", "alice@example.com", "Alice")}, nil, nil, deletionBehaviorDelete)
+
+	assert.Equal(t, 0, len(usersDiff.RowErrors))
+	assert.Equal(t, 1, len(usersDiff.Created))
+	if assert.Equal(t, 1, len(usersDiff.Updated)) {
+		assert.Equal(t, []string{"manager"}, usersDiff.Updated[0].changes)
+	}
+}
+
+// usersBulkRecorder records the changes made through the mock users bulk proxy
+type usersBulkRecorder struct {
+	created        []string
+	updated        map[string]platformclientv2.Updateuser
+	moved          map[string][]string
+	autoAnswer     []platformclientv2.Patchuser
+	skillsAdded    map[string][]platformclientv2.Userroutingskillpost
+	skillsRemoved  map[string][]string
+	rolesAdded     map[string][]platformclientv2.Roledivisionpair
+	rolesRemoved   map[string][]platformclientv2.Roledivisionpair
+	deleted        []string
+	languagesAdded map[string][]platformclientv2.Userroutinglanguagepost
+}
+
+func buildUsersBulkMockProxy(orgUsers []platformclientv2.User, failCreate string) (*usersBulkProxy, *usersBulkRecorder) {
+	recorder := &usersBulkRecorder{
+		updated:        make(map[string]platformclientv2.Updateuser),
+		moved:          make(map[string][]string),
+		skillsAdded:    make(map[string][]platformclientv2.Userroutingskillpost),
+		skillsRemoved:  make(map[string][]string),
+		rolesAdded:     make(map[string][]platformclientv2.Roledivisionpair),
+		rolesRemoved:   make(map[string][]platformclientv2.Roledivisionpair),
+		languagesAdded: make(map[string][]platformclientv2.Userroutinglanguagepost),
+	}
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+
+	proxy := &usersBulkProxy{}
+	proxy.getAllUsersAttr = func(ctx context.Context, p *usersBulkProxy, userCache *usercache.UserCache) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &orgUsers, ok, nil
+	}
+	proxy.getUsersByIdsAttr = func(ctx context.Context, p *usersBulkProxy, userIds []string) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		var users []platformclientv2.User
+		for _, id := range userIds {
+			users = append(users, platformclientv2.User{Id: platformclientv2.String(id)})
+		}
+		return &users, ok, nil
+	}
+	proxy.getDeletedUserIdAttr = func(ctx context.Context, p *usersBulkProxy, email string) (string, *platformclientv2.APIResponse, error) {
+		return "", ok, nil
+	}
+	proxy.createUserAttr = func(ctx context.Context, p *usersBulkProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		if *createUser.Email == failCreate {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("failed to create user %s: bad request", *createUser.Email)
+		}
+		recorder.created = append(recorder.created, *createUser.Email)
+		user := buildTestOrgUser(*createUser.Email+"-id", *createUser.Email, *createUser.Name)
+		if createUser.DivisionId != nil {
+			user.Division.Id = createUser.DivisionId
+		}
+		return &user, ok, nil
+	}
+	proxy.updateUserAttr = func(ctx context.Context, p *usersBulkProxy, userId string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		recorder.updated[userId] = *updateUser
+		return &platformclientv2.User{Id: &userId}, ok, nil
+	}
+	proxy.patchUsersBulkAttr = func(ctx context.Context, p *usersBulkProxy, users []platformclientv2.Patchuser) (*platformclientv2.APIResponse, error) {
+		recorder.autoAnswer = append(recorder.autoAnswer, users...)
+		return ok, nil
+	}
+	proxy.deleteUserAttr = func(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+		recorder.deleted = append(recorder.deleted, userId)
+		return ok, nil
+	}
+	proxy.moveUsersToDivisionAttr = func(ctx context.Context, p *usersBulkProxy, divisionId string, userIds []string) (*platformclientv2.APIResponse, error) {
+		recorder.moved[divisionId] = append(recorder.moved[divisionId], userIds...)
+		return ok, nil
+	}
+	proxy.patchUserRoutingSkillsAttr = func(ctx context.Context, p *usersBulkProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+		recorder.skillsAdded[userId] = append(recorder.skillsAdded[userId], skills...)
+		return ok, nil
+	}
+	proxy.deleteUserRoutingSkillAttr = func(ctx context.Context, p *usersBulkProxy, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+		recorder.skillsRemoved[userId] = append(recorder.skillsRemoved[userId], skillId)
+		return ok, nil
+	}
+	proxy.patchUserRoutingLanguagesAttr = func(ctx context.Context, p *usersBulkProxy, userId string, languages []platformclientv2.Userroutinglanguagepost) (*platformclientv2.APIResponse, error) {
+		recorder.languagesAdded[userId] = append(recorder.languagesAdded[userId], languages...)
+		return ok, nil
+	}
+	proxy.deleteUserRoutingLanguageAttr = func(ctx context.Context, p *usersBulkProxy, userId string, languageId string) (*platformclientv2.APIResponse, error) {
+		return ok, nil
+	}
+	proxy.getUserRoleGrantsAttr = func(ctx context.Context, p *usersBulkProxy, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+		grants := []platformclientv2.Authzgrant{
+			{
+				Role:     &platformclientv2.Authzgrantrole{Id: platformclientv2.String("role-employee")},
+				Division: &platformclientv2.Authzdivision{Id: platformclientv2.String("division-home")},
+			},
+			{
+				Role:     &platformclientv2.Authzgrantrole{Id: platformclientv2.String("role-old")},
+				Division: &platformclientv2.Authzdivision{Id: platformclientv2.String("division-sales")},
+			},
+		}
+		return &grants, ok, nil
+	}
+	proxy.addUserRoleGrantsAttr = func(ctx context.Context, p *usersBulkProxy, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error) {
+		recorder.rolesAdded[userId] = append(recorder.rolesAdded[userId], grants...)
+		return ok, nil
+	}
+	proxy.removeUserRoleGrantsAttr = func(ctx context.Context, p *usersBulkProxy, userId string, grants []platformclientv2.Roledivisionpair) (*platformclientv2.APIResponse, error) {
+		recorder.rolesRemoved[userId] = append(recorder.rolesRemoved[userId], grants...)
+		return ok, nil
+	}
+	proxy.getAllAuthorizationDivisionsAttr = func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Authzdivision, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Authzdivision{
+			{Id: platformclientv2.String("division-home"), Name: platformclientv2.String("Home"), HomeDivision: platformclientv2.Bool(true)},
+			{Id: platformclientv2.String("division-sales"), Name: platformclientv2.String("Sales")},
+		}, ok, nil
+	}
+	proxy.getAllAuthorizationRolesAttr = func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Domainorganizationrole{
+			{Id: platformclientv2.String("role-employee"), Name: platformclientv2.String("Employee")},
+			{Id: platformclientv2.String("role-supervisor"), Name: platformclientv2.String("Supervisor")},
+		}, ok, nil
+	}
+	proxy.getAllRoutingSkillsAttr = func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Routingskill{
+			{Id: platformclientv2.String("skill-billing"), Name: platformclientv2.String("Billing")},
+			{Id: platformclientv2.String("skill-support"), Name: platformclientv2.String("Support")},
+		}, ok, nil
+	}
+	proxy.getAllRoutingLanguagesAttr = func(ctx context.Context, p *usersBulkProxy) (*[]platformclientv2.Language, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Language{
+			{Id: platformclientv2.String("language-english"), Name: platformclientv2.String("English")},
+		}, ok, nil
+	}
+	return proxy, recorder
+}
+
+func TestUnitUsersBulkSync(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "users.csv")
+	err := os.WriteFile(filePath, []byte(
+		"email,name,division,manager,acd_auto_answer,roles,skills,languages\n"+
+			"manager@example.com,Manager,Home,,false,Employee;Supervisor,Support:5,English:5\n"+
+			"agent@example.com,Agent,Sales,manager@example.com,true,Employee,Billing:3,English:4\n"+
+			"failed@example.com,Failed,Home,,false,Employee,,\n"+
+			"existing@example.com,Existing,Sales,manager@example.com,true,Employee,Billing:4,\n"), 0644)
+	assert.Nil(t, err)
+
+	existing := buildTestOrgUser("existing-id", "existing@example.com", "Old Name")
+	existing.Skills = &[]platformclientv2.Userroutingskill{{Id: platformclientv2.String("skill-support"), Proficiency: platformclientv2.Float64(1)}}
+	existing.Authorization = &platformclientv2.Userauthorization{Roles: &[]platformclientv2.Domainrole{{Id: platformclientv2.String("role-old")}}}
+	removed := buildTestOrgUser("removed-id", "removed@example.com", "Removed")
+	adopted := buildTestOrgUser("adopted-id", "adopted@example.com", "Adopted")
+
+	proxy, recorder := buildUsersBulkMockProxy([]platformclientv2.User{existing, removed, adopted}, "failed@example.com")
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUsersBulk().Schema, map[string]interface{}{
+		"filepath":          filePath,
+		"file_content_hash": "hash",
+	})
+	d.SetId(uuid.NewString())
+	_ = d.Set("users", map[string]interface{}{"removed@example.com": "removed-id", "existing@example.com": "existing-id", "adopted@example.com": "adopted-id"})
+	_ = d.Set("created_users", []interface{}{"removed-id"})
+
+	userCache := &usercache.UserCache{}
+	loadCachedUsers := func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.User{existing}, nil, nil
+	}
+	_, _, _ = userCache.GetUsers(context.Background(), loadCachedUsers)
+
+	diags := syncUsersBulk(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}, UserCache: userCache})
+
+	// The failed user is reported as a warning without failing the sync
+	assert.False(t, diags.HasError())
+	if assert.Equal(t, 1, len(diags)) {
+		assert.Equal(t, "1 users in "+filePath+" could not be synced", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "line 4 (failed@example.com): failed to create user failed@example.com: bad request")
+	}
+	assert.Equal(t, 1, d.Get("row_errors.#").(int))
+	assert.Equal(t, "line 4", d.Get("row_errors.0.row").(string))
+
+	assert.Equal(t, []string{"manager@example.com", "agent@example.com"}, recorder.created)
+	assert.Equal(t, map[string]interface{}{
+		"manager@example.com":  "manager@example.com-id",
+		"agent@example.com":    "agent@example.com-id",
+		"existing@example.com": "existing-id",
+	}, d.Get("users").(map[string]interface{}))
+	// The existing user was matched by email and is not tracked as created
+	assert.ElementsMatch(t, []interface{}{"manager@example.com-id", "agent@example.com-id"}, d.Get("created_users").(*schema.Set).List())
+	assert.Equal(t, 3, d.Get("users_created").(int))
+	assert.Equal(t, 1, d.Get("users_updated").(int))
+	assert.Equal(t, 1, d.Get("users_deleted").(int))
+
+	// The existing user is moved to the sales division with a batched request
+	assert.Equal(t, map[string][]string{"division-sales": {"existing-id"}}, recorder.moved)
+
+	// Managers created in the same sync are resolved after creation
+	assert.Equal(t, "manager@example.com-id", *recorder.updated["agent@example.com-id"].Manager)
+	assert.Equal(t, "Existing", *recorder.updated["existing-id"].Name)
+	assert.Equal(t, "manager@example.com-id", *recorder.updated["existing-id"].Manager)
+
+	// ACD auto answer is only sent for users where it changed
+	assert.Equal(t, 2, len(recorder.autoAnswer))
+
+	// Skills that are not in the file are removed
+	assert.Equal(t, []string{"skill-support"}, recorder.skillsRemoved["existing-id"])
+	assert.Equal(t, "skill-billing", *recorder.skillsAdded["existing-id"][0].Id)
+	assert.Equal(t, float64(4), *recorder.skillsAdded["existing-id"][0].Proficiency)
+	assert.Equal(t, 1, len(recorder.languagesAdded["agent@example.com-id"]))
+
+	// Roles that are not in the file are removed from every division and missing roles are granted in the home division
+	assert.Equal(t, []platformclientv2.Roledivisionpair{{RoleId: platformclientv2.String("role-old"), DivisionId: platformclientv2.String("division-sales")}}, recorder.rolesRemoved["existing-id"])
+	assert.Equal(t, []platformclientv2.Roledivisionpair{{RoleId: platformclientv2.String("role-supervisor"), DivisionId: platformclientv2.String("division-home")}}, recorder.rolesAdded["manager@example.com-id"])
+	assert.Equal(t, 0, len(recorder.rolesAdded["agent@example.com-id"]))
+
+	assert.Equal(t, []string{"removed-id"}, recorder.deleted)
+
+	// The shared user cache is cleared so other resources see the synced users
+	reloaded := false
+	_, _, _ = userCache.GetUsers(context.Background(), func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		reloaded = true
+		return loadCachedUsers(ctx)
+	})
+	assert.True(t, reloaded)
+}
+
+func TestUnitUsersBulkDelete(t *testing.T) {
+	proxy, recorder := buildUsersBulkMockProxy(nil, "")
+	proxy.deleteUserAttr = func(ctx context.Context, p *usersBulkProxy, userId string) (*platformclientv2.APIResponse, error) {
+		if userId == "gone-id" {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("failed to delete user %s: not found", userId)
+		}
+		recorder.deleted = append(recorder.deleted, userId)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceUsersBulk().Schema, map[string]interface{}{
+		"filepath":          "users.csv",
+		"file_content_hash": "hash",
+	})
+	d.SetId(uuid.NewString())
+	_ = d.Set("users", map[string]interface{}{"alice@example.com": "alice-id", "gone@example.com": "gone-id", "adopted@example.com": "adopted-id"})
+	_ = d.Set("created_users", []interface{}{"alice-id", "gone-id"})
+
+	diags := deleteUsersBulk(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+
+	// Users that were already deleted are ignored and users the resource did not create are released
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"alice-id"}, recorder.deleted)
+
+	// Created users are deactivated instead of deleted when the deletion behavior is deactivate
+	recorder.deleted = nil
+	_ = d.Set("deletion_behavior", deletionBehaviorDeactivate)
+	diags = deleteUsersBulk(context.Background(), d, &gcloud.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, len(recorder.deleted))
+	assert.Equal(t, "inactive", *recorder.updated["alice-id"].State)
+	assert.Equal(t, "inactive", *recorder.updated["gone-id"].State)
+	_, adoptedUpdated := recorder.updated["adopted-id"]
+	assert.False(t, adoptedUpdated)
+}
@@ -0,0 +1,688 @@
+package users_bulk
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/bulk"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// maxProficiency is the highest proficiency that can be set on a routing skill or language
+const maxProficiency = 5
+
+// usersBulkCsvColumns are the columns allowed in a users CSV file
+var usersBulkCsvColumns = []string{"email", "name", "division", "department", "title", "manager", "acd_auto_answer", "roles", "skills", "languages"}
+
+// bulkUserRow is a single user read from a users file. A nil attribute is not managed for the user.
+type bulkUserRow struct {
+	position      string
+	email         string
+	name          string
+	division      *string
+	department    *string
+	title         *string
+	manager       *string
+	acdAutoAnswer *bool
+	roles         *[]string
+	skills        *map[string]float64
+	languages     *map[string]float64
+}
+
+// bulkUser is a user from a users file with its division, roles, skills and languages resolved to IDs
+type bulkUser struct {
+	row        *bulkUserRow
+	divisionId *string
+	roleIds    *[]string
+	skills     *map[string]float64
+	languages  *map[string]float64
+}
+
+// bulkUserChange is a user in the file that needs to be created or updated along with the attributes that differ from the org
+type bulkUserChange struct {
+	user     *bulkUser
+	existing *platformclientv2.User
+	changes  []string
+}
+
+// usersBulkDiff describes the changes a sync will make to the users in the org. Users are keyed by email.
+type usersBulkDiff = bulk.Diff[*bulkUserChange]
+
+// usersBulkAttributes names the users, created_users and count attributes of the resource
+var usersBulkAttributes = bulk.Attributes{Kind: "users", Key: "email"}
+
+// usersBulkReferences maps the lower case names of objects referenced in a users file to their IDs
+type usersBulkReferences struct {
+	divisions      map[string]string
+	homeDivisionId string
+	roles          map[string]string
+	skills         map[string]string
+	languages      map[string]string
+}
+
+// readUsersBulkFile reads a CSV or SCIM JSON users file. The format is determined by the file extension.
+func readUsersBulkFile(filePath string) ([]*bulkUserRow, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read users file %s: %v", filePath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	var rows []*bulkUserRow
+	if strings.EqualFold(filepath.Ext(filePath), ".json") {
+		rows, err = parseUsersBulkJson(reader)
+	} else {
+		rows, err = parseUsersBulkCsv(reader)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid users file %s: %v", filePath, err)
+	}
+	return rows, nil
+}
+
+// parseUsersBulkCsv parses a CSV file with a header row of column names
+func parseUsersBulkCsv(reader io.Reader) ([]*bulkUserRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("users file is empty, a header row is required")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %v", err)
+	}
+	if len(header) > 0 {
+		// Strip a UTF-8 byte order mark written by spreadsheet tools
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !lists.ItemInSlice(column, usersBulkCsvColumns) {
+			return nil, fmt.Errorf("unknown column %q. Valid columns are %s", header[i], strings.Join(usersBulkCsvColumns, ", "))
+		}
+		if _, exists := columns[column]; exists {
+			return nil, fmt.Errorf("duplicate column %q", header[i])
+		}
+		columns[column] = i
+	}
+	for _, required := range []string{"email", "name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("a %q column is required", required)
+		}
+	}
+
+	var rows []*bulkUserRow
+	var rowErrors []string
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read line %d: %v", line, err)
+		}
+
+		cell := func(column string) *string {
+			if i, ok := columns[column]; ok {
+				value := strings.TrimSpace(record[i])
+				return &value
+			}
+			return nil
+		}
+
+		row := &bulkUserRow{
+			position:   fmt.Sprintf("line %d", line),
+			email:      *cell("email"),
+			name:       *cell("name"),
+			department: cell("department"),
+			title:      cell("title"),
+			manager:    cell("manager"),
+		}
+		if division := cell("division"); division != nil && *division != "" {
+			// An empty division leaves the user in the division it is already in
+			row.division = division
+		}
+
+		var errs []error
+		if value := cell("acd_auto_answer"); value != nil {
+			autoAnswer := false
+			if *value != "" {
+				if autoAnswer, err = strconv.ParseBool(*value); err != nil {
+					errs = append(errs, fmt.Errorf("acd_auto_answer must be true or false"))
+				}
+			}
+			row.acdAutoAnswer = &autoAnswer
+		}
+		if value := cell("roles"); value != nil {
+			roles := splitUsersBulkList(*value)
+			row.roles = &roles
+		}
+		if value := cell("skills"); value != nil {
+			skills, err := parseUsersBulkProficiencies(*value, "skill", false)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			row.skills = &skills
+		}
+		if value := cell("languages"); value != nil {
+			languages, err := parseUsersBulkProficiencies(*value, "language", true)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			row.languages = &languages
+		}
+
+		for _, err := range errs {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: %v", row.position, err))
+		}
+		rows = append(rows, row)
+	}
+
+	if err := validateUsersBulkRows(rows, rowErrors); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// splitUsersBulkList splits a ; separated list from a CSV cell and drops empty items
+func splitUsersBulkList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseUsersBulkProficiencies parses a ; separated list of name:proficiency pairs
+func parseUsersBulkProficiencies(value string, kind string, wholeNumbers bool) (map[string]float64, error) {
+	proficiencies := make(map[string]float64)
+	for _, item := range splitUsersBulkList(value) {
+		separator := strings.LastIndex(item, ":")
+		if separator < 0 {
+			return proficiencies, fmt.Errorf("%s %q must be written as name:proficiency", kind, item)
+		}
+		name := strings.TrimSpace(item[:separator])
+		proficiency, err := strconv.ParseFloat(strings.TrimSpace(item[separator+1:]), 64)
+		if err != nil {
+			return proficiencies, fmt.Errorf("%s %q has an invalid proficiency", kind, name)
+		}
+		if err := validateUsersBulkProficiency(kind, name, proficiency, wholeNumbers); err != nil {
+			return proficiencies, err
+		}
+		proficiencies[name] = proficiency
+	}
+	return proficiencies, nil
+}
+
+func validateUsersBulkProficiency(kind string, name string, proficiency float64, wholeNumbers bool) error {
+	if name == "" {
+		return fmt.Errorf("%s name must not be empty", kind)
+	}
+	if proficiency < 0 || proficiency > maxProficiency {
+		return fmt.Errorf("%s %q proficiency must be between 0 and %d", kind, name, maxProficiency)
+	}
+	if wholeNumbers && proficiency != math.Trunc(proficiency) {
+		return fmt.Errorf("%s %q proficiency must be a whole number", kind, name)
+	}
+	return nil
+}
+
+// scimUser holds the attributes of a SCIM user that can be managed with a users file
+type scimUser struct {
+	UserName    string              `json:"userName"`
+	DisplayName string              `json:"displayName"`
+	Title       *string             `json:"title"`
+	Emails      []scimMultiValue    `json:"emails"`
+	Roles       *[]scimMultiValue   `json:"roles"`
+	Enterprise  *scimEnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"`
+	Genesys     *scimGenesysUser    `json:"urn:ietf:params:scim:schemas:extension:genesys:purecloud:2.0:User"`
+}
+
+type scimMultiValue struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type scimEnterpriseUser struct {
+	Department *string         `json:"department"`
+	Division   *string         `json:"division"`
+	Manager    *scimMultiValue `json:"manager"`
+}
+
+type scimGenesysUser struct {
+	RoutingSkills    *[]scimProficiency `json:"routingSkills"`
+	RoutingLanguages *[]scimProficiency `json:"routingLanguages"`
+}
+
+type scimProficiency struct {
+	Name        string      `json:"name"`
+	Proficiency json.Number `json:"proficiency"`
+}
+
+// email returns the user name if it is an email address, otherwise the primary email of the SCIM user
+func (u *scimUser) email() string {
+	if strings.Contains(u.UserName, "@") {
+		return strings.TrimSpace(u.UserName)
+	}
+	for _, email := range u.Emails {
+		if email.Primary {
+			return strings.TrimSpace(email.Value)
+		}
+	}
+	if len(u.Emails) > 0 {
+		return strings.TrimSpace(u.Emails[0].Value)
+	}
+	return strings.TrimSpace(u.UserName)
+}
+
+// parseUsersBulkJson parses a JSON array of SCIM users or a SCIM ListResponse
+func parseUsersBulkJson(reader io.Reader) ([]*bulkUserRow, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var scimUsers []scimUser
+	if trimmed := strings.TrimSpace(string(content)); strings.HasPrefix(trimmed, "{") {
+		var listResponse struct {
+			Resources *[]scimUser `json:"Resources"`
+		}
+		if err := json.Unmarshal(content, &listResponse); err != nil || listResponse.Resources == nil {
+			return nil, fmt.Errorf("users file must contain a JSON array of SCIM users or a SCIM ListResponse with Resources")
+		}
+		scimUsers = *listResponse.Resources
+	} else if err := json.Unmarshal(content, &scimUsers); err != nil {
+		return nil, fmt.Errorf("users file must contain a JSON array of SCIM users or a SCIM ListResponse with Resources: %v", err)
+	}
+
+	var rows []*bulkUserRow
+	var rowErrors []string
+	for i, user := range scimUsers {
+		row := &bulkUserRow{
+			position: fmt.Sprintf("user %d", i+1),
+			email:    user.email(),
+			name:     strings.TrimSpace(user.DisplayName),
+			title:    user.Title,
+		}
+
+		if user.Roles != nil {
+			roles := make([]string, 0, len(*user.Roles))
+			for _, role := range *user.Roles {
+				if role.Value != "" {
+					roles = append(roles, role.Value)
+				}
+			}
+			row.roles = &roles
+		}
+
+		if user.Enterprise != nil {
+			row.department = user.Enterprise.Department
+			if user.Enterprise.Division != nil && *user.Enterprise.Division != "" {
+				row.division = user.Enterprise.Division
+			}
+			if user.Enterprise.Manager != nil {
+				manager := user.Enterprise.Manager.Value
+				row.manager = &manager
+			}
+		}
+
+		if user.Genesys != nil {
+			var err error
+			if user.Genesys.RoutingSkills != nil {
+				if row.skills, err = buildScimProficiencies(*user.Genesys.RoutingSkills, "skill", false); err != nil {
+					rowErrors = append(rowErrors, fmt.Sprintf("%s: %v", row.position, err))
+				}
+			}
+			if user.Genesys.RoutingLanguages != nil {
+				if row.languages, err = buildScimProficiencies(*user.Genesys.RoutingLanguages, "language", true); err != nil {
+					rowErrors = append(rowErrors, fmt.Sprintf("%s: %v", row.position, err))
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if err := validateUsersBulkRows(rows, rowErrors); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func buildScimProficiencies(values []scimProficiency, kind string, wholeNumbers bool) (*map[string]float64, error) {
+	proficiencies := make(map[string]float64, len(values))
+	for _, value := range values {
+		name := strings.TrimSpace(value.Name)
+		proficiency, err := value.Proficiency.Float64()
+		if err != nil {
+			return &proficiencies, fmt.Errorf("%s %q has an invalid proficiency", kind, name)
+		}
+		if err := validateUsersBulkProficiency(kind, name, proficiency, wholeNumbers); err != nil {
+			return &proficiencies, err
+		}
+		proficiencies[name] = proficiency
+	}
+	return &proficiencies, nil
+}
+
+// validateUsersBulkRows checks that every user has a name and a unique email and combines the errors found while parsing
+func validateUsersBulkRows(rows []*bulkUserRow, rowErrors []string) error {
+	firstPositions := make(map[string]string, len(rows))
+	for _, row := range rows {
+		if !strings.Contains(row.email, "@") {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: %q is not a valid email", row.position, row.email))
+			continue
+		}
+		if row.name == "" {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: name must not be empty", row.position))
+		}
+
+		email := strings.ToLower(row.email)
+		if firstPosition, exists := firstPositions[email]; exists {
+			rowErrors = append(rowErrors, fmt.Sprintf("%s: duplicate email %q, first defined at %s", row.position, row.email, firstPosition))
+			continue
+		}
+		firstPositions[email] = row.position
+	}
+
+	return bulk.JoinRowErrors(rowErrors)
+}
+
+// buildUsersBulkReferences maps the names of the divisions, roles, skills and languages in the org to their IDs
+func buildUsersBulkReferences(divisions []platformclientv2.Authzdivision, roles []platformclientv2.Domainorganizationrole, skills []platformclientv2.Routingskill, languages []platformclientv2.Language) *usersBulkReferences {
+	refs := &usersBulkReferences{
+		divisions: make(map[string]string, len(divisions)),
+		roles:     make(map[string]string, len(roles)),
+		skills:    make(map[string]string, len(skills)),
+		languages: make(map[string]string, len(languages)),
+	}
+	for _, division := range divisions {
+		if division.Id == nil || division.Name == nil {
+			continue
+		}
+		refs.divisions[strings.ToLower(*division.Name)] = *division.Id
+		if division.HomeDivision != nil && *division.HomeDivision {
+			refs.homeDivisionId = *division.Id
+		}
+	}
+	for _, role := range roles {
+		if role.Id != nil && role.Name != nil {
+			refs.roles[strings.ToLower(*role.Name)] = *role.Id
+		}
+	}
+	for _, skill := range skills {
+		if skill.Id != nil && skill.Name != nil {
+			refs.skills[strings.ToLower(*skill.Name)] = *skill.Id
+		}
+	}
+	for _, language := range languages {
+		if language.Id != nil && language.Name != nil {
+			refs.languages[strings.ToLower(*language.Name)] = *language.Id
+		}
+	}
+	return refs
+}
+
+// resolveBulkUserRow resolves the names referenced by a user to IDs
+func resolveBulkUserRow(row *bulkUserRow, refs *usersBulkReferences) (*bulkUser, error) {
+	user := &bulkUser{row: row}
+	var missing []string
+
+	if row.division != nil {
+		if id, ok := refs.divisions[strings.ToLower(*row.division)]; ok {
+			user.divisionId = &id
+		} else {
+			missing = append(missing, fmt.Sprintf("division %q", *row.division))
+		}
+	}
+	if row.roles != nil {
+		roleIds := make([]string, 0, len(*row.roles))
+		for _, name := range *row.roles {
+			if id, ok := refs.roles[strings.ToLower(name)]; ok {
+				roleIds = append(roleIds, id)
+			} else {
+				missing = append(missing, fmt.Sprintf("role %q", name))
+			}
+		}
+		user.roleIds = &roleIds
+	}
+	if row.skills != nil {
+		skills, notFound := resolveBulkUserProficiencies(*row.skills, refs.skills, "skill")
+		user.skills = &skills
+		missing = append(missing, notFound...)
+	}
+	if row.languages != nil {
+		languages, notFound := resolveBulkUserProficiencies(*row.languages, refs.languages, "language")
+		user.languages = &languages
+		missing = append(missing, notFound...)
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%s not found", strings.Join(missing, ", "))
+	}
+	return user, nil
+}
+
+func resolveBulkUserProficiencies(proficiencies map[string]float64, ids map[string]string, kind string) (map[string]float64, []string) {
+	resolved := make(map[string]float64, len(proficiencies))
+	var missing []string
+	for name, proficiency := range proficiencies {
+		if id, ok := ids[strings.ToLower(name)]; ok {
+			resolved[id] = proficiency
+		} else {
+			missing = append(missing, fmt.Sprintf("%s %q", kind, name))
+		}
+	}
+	return resolved, missing
+}
+
+// diffUsersBulk compares the users in a file with the users in the org. Users created by the resource that are
+// no longer in the file are deleted or deactivated according to the deletion behavior. Other users that are no
+// longer in the file are released from the state. Users that cannot be resolved are reported as row errors.
+func diffUsersBulk(rows []*bulkUserRow, refs *usersBulkReferences, orgUsers []platformclientv2.User, managedUsers map[string]string, createdUsers map[string]bool, deletionBehavior string) *usersBulkDiff {
+	diff := bulk.NewDiff[*bulkUserChange]()
+
+	orgUsersByEmail := make(map[string]*platformclientv2.User, len(orgUsers))
+	for i, orgUser := range orgUsers {
+		if orgUser.Email != nil && orgUser.Id != nil {
+			orgUsersByEmail[strings.ToLower(*orgUser.Email)] = &orgUsers[i]
+		}
+	}
+	fileEmails := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		fileEmails[strings.ToLower(row.email)] = true
+	}
+
+	for _, row := range rows {
+		user, err := resolveBulkUserRow(row, refs)
+		if err != nil {
+			diff.RowErrors = append(diff.RowErrors, bulk.RowError{Position: row.position, Key: row.email, Message: err.Error()})
+			continue
+		}
+		if row.manager != nil && *row.manager != "" && strings.Contains(*row.manager, "@") {
+			managerEmail := strings.ToLower(*row.manager)
+			if _, ok := orgUsersByEmail[managerEmail]; !ok && !fileEmails[managerEmail] {
+				diff.RowErrors = append(diff.RowErrors, bulk.RowError{Position: row.position, Key: row.email, Message: fmt.Sprintf("manager %q not found", *row.manager)})
+				continue
+			}
+		}
+
+		existing, ok := orgUsersByEmail[strings.ToLower(row.email)]
+		if !ok {
+			diff.Created = append(diff.Created, &bulkUserChange{user: user})
+			continue
+		}
+
+		changes := compareBulkUser(user, existing, orgUsersByEmail, nil)
+		if len(changes) > 0 {
+			diff.Updated = append(diff.Updated, &bulkUserChange{user: user, existing: existing, changes: changes})
+		} else {
+			diff.Unchanged[row.email] = *existing.Id
+		}
+	}
+
+	for email, id := range managedUsers {
+		if fileEmails[strings.ToLower(email)] {
+			continue
+		}
+		removed := &bulk.Removal{Key: email, Id: id}
+		if !createdUsers[id] || deletionBehavior == deletionBehaviorRetain {
+			// Users that existed before the resource matched them are never deleted
+			diff.Released = append(diff.Released, removed)
+			continue
+		}
+		// Users that were deleted outside of Terraform do not need to be deleted again
+		orgUser, ok := orgUsersByEmail[strings.ToLower(email)]
+		if !ok || *orgUser.Id != id {
+			continue
+		}
+		if deletionBehavior == deletionBehaviorDeactivate && stringValue(orgUser.State) == "inactive" {
+			diff.Released = append(diff.Released, removed)
+			continue
+		}
+		diff.Deleted = append(diff.Deleted, removed)
+	}
+	for _, removed := range [][]*bulk.Removal{diff.Deleted, diff.Released} {
+		sort.Slice(removed, func(i, j int) bool {
+			return removed[i].Key < removed[j].Key
+		})
+	}
+
+	return diff
+}
+
+// compareBulkUser returns the names of the managed attributes of a user that differ from the user in the org
+func compareBulkUser(user *bulkUser, existing *platformclientv2.User, orgUsersByEmail map[string]*platformclientv2.User, createdIds map[string]string) []string {
+	row := user.row
+	var changes []string
+
+	if stringValue(existing.Name) != row.name {
+		changes = append(changes, "name")
+	}
+	if user.divisionId != nil && (existing.Division == nil || stringValue(existing.Division.Id) != *user.divisionId) {
+		changes = append(changes, "division")
+	}
+	if row.department != nil && stringValue(existing.Department) != *row.department {
+		changes = append(changes, "department")
+	}
+	if row.title != nil && stringValue(existing.Title) != *row.title {
+		changes = append(changes, "title")
+	}
+	if row.manager != nil {
+		existingManagerId := ""
+		if existing.Manager != nil && *existing.Manager != nil {
+			existingManagerId = stringValue((*existing.Manager).Id)
+		}
+		// A manager created in the same sync is not known until the users are created
+		if managerId, found := resolveManagerId(*row.manager, orgUsersByEmail, createdIds); !found || managerId != existingManagerId {
+			changes = append(changes, "manager")
+		}
+	}
+	if row.acdAutoAnswer != nil && (existing.AcdAutoAnswer != nil && *existing.AcdAutoAnswer) != *row.acdAutoAnswer {
+		changes = append(changes, "acd_auto_answer")
+	}
+	if user.roleIds != nil {
+		var existingRoleIds []string
+		if existing.Authorization != nil && existing.Authorization.Roles != nil {
+			for _, role := range *existing.Authorization.Roles {
+				existingRoleIds = append(existingRoleIds, stringValue(role.Id))
+			}
+		}
+		if !sameStringSet(existingRoleIds, *user.roleIds) {
+			changes = append(changes, "roles")
+		}
+	}
+	if user.skills != nil {
+		existingSkills := make(map[string]float64)
+		if existing.Skills != nil {
+			for _, skill := range *existing.Skills {
+				existingSkills[stringValue(skill.Id)] = floatValue(skill.Proficiency)
+			}
+		}
+		if !sameProficiencies(existingSkills, *user.skills) {
+			changes = append(changes, "skills")
+		}
+	}
+	if user.languages != nil {
+		existingLanguages := make(map[string]float64)
+		if existing.Languages != nil {
+			for _, language := range *existing.Languages {
+				existingLanguages[stringValue(language.Id)] = floatValue(language.Proficiency)
+			}
+		}
+		if !sameProficiencies(existingLanguages, *user.languages) {
+			changes = append(changes, "languages")
+		}
+	}
+	return changes
+}
+
+// resolveManagerId returns the user ID of a manager referenced by email or ID. Users created in the same
+// sync are looked up in createdIds. An empty manager is always found and clears the manager of the user.
+func resolveManagerId(manager string, orgUsersByEmail map[string]*platformclientv2.User, createdIds map[string]string) (string, bool) {
+	if !strings.Contains(manager, "@") {
+		return manager, true
+	}
+	if orgUser, ok := orgUsersByEmail[strings.ToLower(manager)]; ok {
+		return *orgUser.Id, true
+	}
+	id, ok := createdIds[strings.ToLower(manager)]
+	return id, ok
+}
+
+func sameStringSet(a []string, b []string) bool {
+	setA := make(map[string]bool, len(a))
+	for _, item := range a {
+		setA[item] = true
+	}
+	setB := make(map[string]bool, len(b))
+	for _, item := range b {
+		if !setA[item] {
+			return false
+		}
+		setB[item] = true
+	}
+	return len(setA) == len(setB)
+}
+
+func sameProficiencies(a map[string]float64, b map[string]float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, proficiency := range a {
+		if other, ok := b[id]; !ok || other != proficiency {
+			return false
+		}
+	}
+	return true
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func floatValue(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,247 @@
+package bulk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// MaxReportedRowErrors limits the number of row level errors returned for a single file
+const MaxReportedRowErrors = 25
+
+// Attributes names the attributes of a resource that syncs the objects of a file with the org. Kind is the plural
+// name of the objects, e.g. users. The <kind> attribute maps the key of every managed object to its ID and the
+// created_<kind> attribute holds the IDs of the managed objects created by the resource. The <kind>_created,
+// <kind>_updated and <kind>_deleted attributes count the changes of a sync. Key is the attribute of row_errors that
+// identifies the object of a row, e.g. email.
+type Attributes struct {
+	Kind string
+	Key  string
+}
+
+func (attrs Attributes) managedAttr() string {
+	return attrs.Kind
+}
+
+func (attrs Attributes) createdAttr() string {
+	return "created_" + attrs.Kind
+}
+
+// RowError is an error syncing a single row of a file
+type RowError struct {
+	Position string
+	Key      string
+	Message  string
+}
+
+// Removal is a managed object that is no longer in the file. Objects created by the resource are deleted and
+// other objects are released.
+type Removal struct {
+	Key string
+	Id  string
+}
+
+// Diff describes the changes a sync will make to the objects in the org. C describes an object to create or update.
+type Diff[C any] struct {
+	Created   []C
+	Updated   []C
+	Deleted   []*Removal
+	Released  []*Removal
+	Unchanged map[string]string
+	RowErrors []RowError
+}
+
+// NewDiff returns a diff without changes
+func NewDiff[C any]() *Diff[C] {
+	return &Diff[C]{Unchanged: make(map[string]string)}
+}
+
+func (diff *Diff[C]) IsEmpty() bool {
+	return len(diff.Created) == 0 && len(diff.Updated) == 0 && len(diff.Deleted) == 0 && len(diff.Released) == 0
+}
+
+// Counts returns the number of objects a sync will create, update and delete
+func (diff *Diff[C]) Counts(attrs Attributes) map[string]int {
+	return map[string]int{
+		attrs.Kind + "_created": len(diff.Created),
+		attrs.Kind + "_updated": len(diff.Updated),
+		attrs.Kind + "_deleted": len(diff.Deleted),
+	}
+}
+
+// getter is implemented by both schema.ResourceData and schema.ResourceDiff
+type getter interface {
+	Get(key string) interface{}
+}
+
+// GetManaged returns the map of key to ID of the objects managed by the resource
+func GetManaged(d getter, attrs Attributes) map[string]string {
+	managed := make(map[string]string)
+	if objects, ok := d.Get(attrs.managedAttr()).(map[string]interface{}); ok {
+		for key, id := range objects {
+			managed[key] = id.(string)
+		}
+	}
+	return managed
+}
+
+// GetCreated returns the IDs of the managed objects that were created by the resource
+func GetCreated(d getter, attrs Attributes) map[string]bool {
+	created := make(map[string]bool)
+	if ids, ok := d.Get(attrs.createdAttr()).(*schema.Set); ok {
+		for _, id := range ids.List() {
+			created[id.(string)] = true
+		}
+	}
+	return created
+}
+
+// SetManaged sets the managed objects and the IDs of the managed objects that were created by the resource
+func SetManaged(d *schema.ResourceData, attrs Attributes, managed map[string]string, created map[string]bool) {
+	objects := make(map[string]interface{}, len(managed))
+	var createdIds []interface{}
+	for key, id := range managed {
+		objects[key] = id
+		if created[id] {
+			createdIds = append(createdIds, id)
+		}
+	}
+	_ = d.Set(attrs.managedAttr(), objects)
+	_ = d.Set(attrs.createdAttr(), schema.NewSet(schema.HashString, createdIds))
+}
+
+// SaveSync records the result of applying a diff. Objects that could not be resolved are still managed if they were
+// synced before. The rows that failed to sync are returned as a warning and set in the row_errors attribute.
+func SaveSync[C any](d *schema.ResourceData, attrs Attributes, filePath string, diff *Diff[C], previous map[string]string, managed map[string]string, created map[string]bool, rowErrors []RowError) diag.Diagnostics {
+	for _, rowError := range diff.RowErrors {
+		if id, ok := previous[rowError.Key]; ok {
+			managed[rowError.Key] = id
+		}
+	}
+
+	SetManaged(d, attrs, managed, created)
+	for attr, value := range diff.Counts(attrs) {
+		_ = d.Set(attr, value)
+	}
+	_ = d.Set("row_errors", FlattenRowErrors(attrs, rowErrors))
+
+	if len(rowErrors) == 0 {
+		return nil
+	}
+
+	details := make([]string, 0, len(rowErrors))
+	for _, rowError := range rowErrors {
+		details = append(details, fmt.Sprintf("%s (%s): %s", rowError.Position, rowError.Key, rowError.Message))
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%d %s in %s could not be synced", len(rowErrors), attrs.Kind, filePath),
+		Detail:   strings.Join(limitRowErrors(details), "\n"),
+	}}
+}
+
+// PlanSync sets the counts of a planned sync. An update is planned whenever the org differs from the file, including
+// changes made outside of Terraform. keysChanged is true when the sync renames managed objects.
+func PlanSync[C any](resourceDiff *schema.ResourceDiff, attrs Attributes, diff *Diff[C], keysChanged bool) error {
+	if diff.IsEmpty() && resourceDiff.Id() != "" {
+		if resourceDiff.HasChange("file_content_hash") || resourceDiff.HasChange("filepath") {
+			return setDiffCounts(resourceDiff, attrs, diff)
+		}
+		return nil
+	}
+
+	if err := setDiffCounts(resourceDiff, attrs, diff); err != nil {
+		return err
+	}
+	if len(diff.Created) > 0 || len(diff.Deleted) > 0 || len(diff.Released) > 0 || keysChanged {
+		if err := resourceDiff.SetNewComputed(attrs.managedAttr()); err != nil {
+			return err
+		}
+		if err := resourceDiff.SetNewComputed(attrs.createdAttr()); err != nil {
+			return err
+		}
+	}
+	return resourceDiff.SetNewComputed("row_errors")
+}
+
+// SetDiffComputed marks every attribute set by a sync as unknown until apply
+func SetDiffComputed(resourceDiff *schema.ResourceDiff, attrs Attributes) error {
+	for attr := range (&Diff[struct{}]{}).Counts(attrs) {
+		if err := resourceDiff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	for _, attr := range []string{attrs.managedAttr(), attrs.createdAttr(), "row_errors"} {
+		if err := resourceDiff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setDiffCounts[C any](resourceDiff *schema.ResourceDiff, attrs Attributes, diff *Diff[C]) error {
+	for attr, value := range diff.Counts(attrs) {
+		if err := resourceDiff.SetNew(attr, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FlattenRowErrors converts row errors to the row_errors attribute
+func FlattenRowErrors(attrs Attributes, rowErrors []RowError) []interface{} {
+	flattened := make([]interface{}, 0, len(rowErrors))
+	for _, rowError := range rowErrors {
+		flattened = append(flattened, map[string]interface{}{
+			"row":     rowError.Position,
+			attrs.Key: rowError.Key,
+			"message": rowError.Message,
+		})
+	}
+	return flattened
+}
+
+// JoinRowErrors combines the errors found while parsing a file, sorted by their position. Nil is returned if there
+// are no errors.
+func JoinRowErrors(rowErrors []string) error {
+	if len(rowErrors) == 0 {
+		return nil
+	}
+	sort.SliceStable(rowErrors, func(i, j int) bool {
+		return PositionNumber(rowErrors[i]) < PositionNumber(rowErrors[j])
+	})
+	return fmt.Errorf("\n%s", strings.Join(limitRowErrors(rowErrors), "\n"))
+}
+
+// limitRowErrors keeps the first MaxReportedRowErrors errors and counts the rest
+func limitRowErrors(rowErrors []string) []string {
+	if len(rowErrors) <= MaxReportedRowErrors {
+		return rowErrors
+	}
+	remaining := len(rowErrors) - MaxReportedRowErrors
+	return append(rowErrors[:MaxReportedRowErrors:MaxReportedRowErrors], fmt.Sprintf("... and %d more errors", remaining))
+}
+
+// PositionNumber returns the line or object number at the start of a row error, e.g. "line 2: ...", for sorting
+func PositionNumber(rowError string) int {
+	fields := strings.Fields(rowError)
+	if len(fields) < 2 {
+		return 0
+	}
+	number, _ := strconv.Atoi(strings.TrimSuffix(fields[1], ":"))
+	return number
+}
+
+// SortedKeys returns the keys of a map in ascending order
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
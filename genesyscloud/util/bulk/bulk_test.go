@@ -0,0 +1,44 @@
+package bulk
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestJoinRowErrorsSortsAndLimitsErrors(t *testing.T) {
+	if err := JoinRowErrors(nil); err != nil {
+		t.Errorf("Expected no error without row errors, got %v", err)
+	}
+
+	err := JoinRowErrors([]string{"line 10: b", "line 2: a"})
+	if err == nil || err.Error() != "\nline 2: a\nline 10: b" {
+		t.Errorf("Expected row errors sorted by line, got %v", err)
+	}
+
+	var rowErrors []string
+	for i := 0; i < MaxReportedRowErrors+3; i++ {
+		rowErrors = append(rowErrors, fmt.Sprintf("line %d: invalid", i+2))
+	}
+	err = JoinRowErrors(rowErrors)
+	if err == nil || !strings.HasSuffix(err.Error(), "... and 3 more errors") {
+		t.Errorf("Expected the remaining row errors to be counted, got %v", err)
+	}
+}
+
+func TestDiffCounts(t *testing.T) {
+	diff := NewDiff[string]()
+	if !diff.IsEmpty() {
+		t.Errorf("Expected a new diff to be empty")
+	}
+
+	diff.Created = []string{"a", "b"}
+	diff.Released = []*Removal{{Key: "c", Id: "id-c"}}
+	counts := diff.Counts(Attributes{Kind: "phones", Key: "name"})
+	if counts["phones_created"] != 2 || counts["phones_updated"] != 0 || counts["phones_deleted"] != 0 {
+		t.Errorf("Expected 2 created phones, got %v", counts)
+	}
+	if diff.IsEmpty() {
+		t.Errorf("Expected a diff with released objects not to be empty")
+	}
+}
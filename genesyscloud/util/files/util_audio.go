@@ -0,0 +1,151 @@
+package files
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// WAV audio format codes found in the fmt chunk
+const (
+	WavFormatPCM        = 1
+	WavFormatIEEEFloat  = 3
+	WavFormatALaw       = 6
+	WavFormatMuLaw      = 7
+	WavFormatExtensible = 0xFFFE
+)
+
+// AudioFileInfo describes the header of a local audio file
+type AudioFileInfo struct {
+	Format        string
+	FormatCode    int
+	Channels      int
+	SampleRate    int
+	BitsPerSample int
+	SizeBytes     int64
+	Duration      time.Duration
+}
+
+// ReadAudioFileInfo opens a local audio file and reads its header. The header of WAV files is read in full.
+// MP3, Ogg, FLAC and MP4 files are only recognised by their magic bytes so their other fields are left empty.
+func ReadAudioFileInfo(path string) (*AudioFileInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(file, header); err != nil {
+		return nil, fmt.Errorf("file is too short to be an audio file")
+	}
+
+	var info *AudioFileInfo
+	if bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WAVE")) {
+		info, err = ReadWavInfo(io.MultiReader(bytes.NewReader(header), file))
+		if err != nil {
+			return nil, err
+		}
+	} else if format := detectAudioFormat(header); format != "" {
+		info = &AudioFileInfo{Format: format}
+	} else {
+		return nil, fmt.Errorf("file is not a WAV, MP3, Ogg, FLAC or MP4 audio file")
+	}
+	info.SizeBytes = stat.Size()
+	return info, nil
+}
+
+// ReadWavInfo reads the RIFF header of a WAV stream and calculates the duration of its audio data
+func ReadWavInfo(reader io.Reader) (*AudioFileInfo, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, fmt.Errorf("file is too short to be an audio file")
+	}
+	if !bytes.Equal(header[0:4], []byte("RIFF")) || !bytes.Equal(header[8:12], []byte("WAVE")) {
+		return nil, fmt.Errorf("file is not a WAV audio file")
+	}
+
+	info := &AudioFileInfo{Format: "WAV"}
+	var (
+		byteRate int
+		foundFmt bool
+		dataSize int64 = -1
+	)
+
+	chunkHeader := make([]byte, 8)
+	for dataSize < 0 {
+		if _, err := io.ReadFull(reader, chunkHeader); err != nil {
+			break
+		}
+		chunkId := string(chunkHeader[0:4])
+		chunkSize := int64(binary.LittleEndian.Uint32(chunkHeader[4:8]))
+
+		switch chunkId {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, fmt.Errorf("WAV fmt chunk is too short")
+			}
+			fmtChunk := make([]byte, chunkSize)
+			if _, err := io.ReadFull(reader, fmtChunk); err != nil {
+				return nil, fmt.Errorf("failed to read WAV fmt chunk: %v", err)
+			}
+			info.FormatCode = int(binary.LittleEndian.Uint16(fmtChunk[0:2]))
+			info.Channels = int(binary.LittleEndian.Uint16(fmtChunk[2:4]))
+			info.SampleRate = int(binary.LittleEndian.Uint32(fmtChunk[4:8]))
+			byteRate = int(binary.LittleEndian.Uint32(fmtChunk[8:12]))
+			info.BitsPerSample = int(binary.LittleEndian.Uint16(fmtChunk[14:16]))
+			if info.FormatCode == WavFormatExtensible && chunkSize >= 26 {
+				// The real format code is the first two bytes of the sub-format GUID
+				info.FormatCode = int(binary.LittleEndian.Uint16(fmtChunk[24:26]))
+			}
+			foundFmt = true
+		case "data":
+			dataSize = chunkSize
+		default:
+			if _, err := io.CopyN(io.Discard, reader, chunkSize); err != nil {
+				return nil, fmt.Errorf("failed to read WAV %q chunk: %v", chunkId, err)
+			}
+		}
+		// Chunks are aligned to an even number of bytes
+		if chunkId != "data" && chunkSize%2 == 1 {
+			if _, err := io.CopyN(io.Discard, reader, 1); err != nil {
+				break
+			}
+		}
+	}
+
+	if !foundFmt {
+		return nil, fmt.Errorf("WAV file has no fmt chunk")
+	}
+	if dataSize < 0 {
+		return nil, fmt.Errorf("WAV file has no data chunk")
+	}
+	if byteRate <= 0 {
+		return nil, fmt.Errorf("WAV file has an invalid byte rate of %d", byteRate)
+	}
+	info.Duration = time.Duration(dataSize * int64(time.Second) / int64(byteRate))
+	return info, nil
+}
+
+// detectAudioFormat recognises common non-WAV audio files by their magic bytes
+func detectAudioFormat(header []byte) string {
+	switch {
+	case bytes.HasPrefix(header, []byte("ID3")), header[0] == 0xFF && header[1]&0xE0 == 0xE0:
+		return "MP3"
+	case bytes.HasPrefix(header, []byte("OggS")):
+		return "Ogg"
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return "FLAC"
+	case bytes.Equal(header[4:8], []byte("ftyp")):
+		return "MP4/M4A"
+	}
+	return ""
+}
@@ -0,0 +1,122 @@
+package files
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestWav(formatCode uint16, channels uint16, sampleRate uint32, bitsPerSample uint16, dataSize uint32, extraChunk bool) []byte {
+	blockAlign := channels * bitsPerSample / 8
+	body := &bytes.Buffer{}
+	body.WriteString("WAVE")
+	if extraChunk {
+		// Odd sized chunk to check padding is skipped
+		body.WriteString("LIST")
+		_ = binary.Write(body, binary.LittleEndian, uint32(3))
+		body.Write([]byte{1, 2, 3, 0})
+	}
+	body.WriteString("fmt ")
+	_ = binary.Write(body, binary.LittleEndian, uint32(16))
+	_ = binary.Write(body, binary.LittleEndian, formatCode)
+	_ = binary.Write(body, binary.LittleEndian, channels)
+	_ = binary.Write(body, binary.LittleEndian, sampleRate)
+	_ = binary.Write(body, binary.LittleEndian, sampleRate*uint32(blockAlign))
+	_ = binary.Write(body, binary.LittleEndian, blockAlign)
+	_ = binary.Write(body, binary.LittleEndian, bitsPerSample)
+	body.WriteString("data")
+	_ = binary.Write(body, binary.LittleEndian, dataSize)
+	body.Write(make([]byte, dataSize))
+
+	wav := &bytes.Buffer{}
+	wav.WriteString("RIFF")
+	_ = binary.Write(wav, binary.LittleEndian, uint32(body.Len()))
+	wav.Write(body.Bytes())
+	return wav.Bytes()
+}
+
+func TestReadWavInfo(t *testing.T) {
+	// 16 kHz mono 16-bit PCM, 1.5 seconds of audio
+	info, err := ReadWavInfo(bytes.NewReader(buildTestWav(WavFormatPCM, 1, 16000, 16, 48000, false)))
+	assert.Nil(t, err)
+	assert.Equal(t, "WAV", info.Format)
+	assert.Equal(t, WavFormatPCM, info.FormatCode)
+	assert.Equal(t, 1, info.Channels)
+	assert.Equal(t, 16000, info.SampleRate)
+	assert.Equal(t, 16, info.BitsPerSample)
+	assert.Equal(t, 1500*time.Millisecond, info.Duration)
+
+	// 8 kHz mu-law with a chunk before the fmt chunk
+	info, err = ReadWavInfo(bytes.NewReader(buildTestWav(WavFormatMuLaw, 1, 8000, 8, 4000, true)))
+	assert.Nil(t, err)
+	assert.Equal(t, WavFormatMuLaw, info.FormatCode)
+	assert.Equal(t, 500*time.Millisecond, info.Duration)
+}
+
+func TestReadWavInfoErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contents []byte
+		expected string
+	}{
+		{
+			name:     "text file",
+			contents: []byte("this is not an audio file"),
+			expected: "file is not a WAV audio file",
+		},
+		{
+			name:     "short file",
+			contents: []byte("RIFF"),
+			expected: "file is too short to be an audio file",
+		},
+		{
+			name:     "missing data chunk",
+			contents: buildTestWav(WavFormatPCM, 1, 8000, 16, 0, false)[:36],
+			expected: "WAV file has no data chunk",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ReadWavInfo(bytes.NewReader(tc.contents))
+			if assert.NotNil(t, err) {
+				assert.Equal(t, tc.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestReadAudioFileInfo(t *testing.T) {
+	wav := buildTestWav(WavFormatALaw, 1, 8000, 8, 16000, false)
+	path := filepath.Join(t.TempDir(), "prompt.wav")
+	assert.Nil(t, os.WriteFile(path, wav, 0644))
+
+	info, err := ReadAudioFileInfo(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(wav)), info.SizeBytes)
+	assert.Equal(t, WavFormatALaw, info.FormatCode)
+	assert.Equal(t, 2*time.Second, info.Duration)
+
+	// Other audio formats are recognised without reading their headers
+	mp3 := append([]byte("ID3"), make([]byte, 20)...)
+	path = filepath.Join(t.TempDir(), "prompt.mp3")
+	assert.Nil(t, os.WriteFile(path, mp3, 0644))
+
+	info, err = ReadAudioFileInfo(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "MP3", info.Format)
+	assert.Equal(t, int64(len(mp3)), info.SizeBytes)
+
+	path = filepath.Join(t.TempDir(), "prompt.txt")
+	assert.Nil(t, os.WriteFile(path, []byte("this is not an audio file"), 0644))
+
+	_, err = ReadAudioFileInfo(path)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "file is not a WAV, MP3, Ogg, FLAC or MP4 audio file", err.Error())
+	}
+}
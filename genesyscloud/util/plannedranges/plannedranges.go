@@ -0,0 +1,64 @@
+package plannedranges
+
+import "sync"
+
+// Range is an inclusive range of numbers planned by a resource. New resources have no ID. Replacing is set when the
+// resource with the ID is being replaced by one with this range.
+type Range struct {
+	Id        string
+	Start     int
+	End       int
+	Replacing bool
+}
+
+func (r Range) overlaps(other Range) bool {
+	return r.Start <= other.End && other.Start <= r.End
+}
+
+// Registry holds the ranges planned by every resource of one type in a provider run, so resources in the same
+// configuration can be checked against each other before any of them is created. A registry must only be used for
+// one provider run, so ranges are never shared between runs.
+type Registry struct {
+	ranges []Range
+	mutex  sync.Mutex
+}
+
+// Register records a planned range and returns any other planned range it overlaps. A resource is planned more than
+// once in a run, e.g. once created or, when it is replaced, with its ID and then again without it. The returned range
+// keeps the ID of the resource a range without an ID replaces. A nil registry records nothing and never reports an
+// overlap.
+func (r *Registry) Register(planned Range) (Range, *Range) {
+	if r == nil {
+		return planned, nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	registered := false
+	for i, other := range r.ranges {
+		samePlan := (planned.Id != "" && other.Id == planned.Id) || (other.Start == planned.Start && other.End == planned.End)
+		if samePlan {
+			if planned.Id == "" {
+				// Keep the ID the range was registered with
+				if other.Replacing {
+					planned.Id = other.Id
+					planned.Replacing = true
+				}
+				r.ranges[i].Start, r.ranges[i].End = planned.Start, planned.End
+			} else {
+				r.ranges[i] = planned
+			}
+			registered = true
+			continue
+		}
+		if other.overlaps(planned) {
+			conflict := other
+			return planned, &conflict
+		}
+	}
+	if !registered {
+		r.ranges = append(r.ranges, planned)
+	}
+	return planned, nil
+}
@@ -0,0 +1,58 @@
+package plannedranges
+
+import "testing"
+
+func TestRegistryReportsOverlappingRanges(t *testing.T) {
+	registry := &Registry{}
+
+	if _, conflict := registry.Register(Range{Start: 1000, End: 1099}); conflict != nil {
+		t.Errorf("Expected no overlap, got %v", conflict)
+	}
+	if _, conflict := registry.Register(Range{Start: 1100, End: 1199}); conflict != nil {
+		t.Errorf("Expected no overlap, got %v", conflict)
+	}
+
+	// Planning the same ranges again, e.g. on apply or once created, is not an overlap
+	if _, conflict := registry.Register(Range{Start: 1000, End: 1099}); conflict != nil {
+		t.Errorf("Expected no overlap when a range is planned again, got %v", conflict)
+	}
+	if _, conflict := registry.Register(Range{Id: "pool-1", Start: 1000, End: 1099}); conflict != nil {
+		t.Errorf("Expected no overlap when a created range is planned again, got %v", conflict)
+	}
+	if len(registry.ranges) != 2 {
+		t.Errorf("Expected 2 planned ranges, got %d", len(registry.ranges))
+	}
+
+	_, conflict := registry.Register(Range{Start: 1050, End: 1150})
+	if conflict == nil || conflict.Id != "pool-1" {
+		t.Errorf("Expected an overlap with pool-1, got %v", conflict)
+	}
+}
+
+func TestRegistryKeepsIdOfReplacedRange(t *testing.T) {
+	registry := &Registry{}
+
+	// A replaced resource is planned with its ID and new range, then again without an ID
+	registry.Register(Range{Id: "pool-1", Start: 1000, End: 1199, Replacing: true})
+	planned, conflict := registry.Register(Range{Start: 1000, End: 1199})
+	if conflict != nil {
+		t.Errorf("Expected no overlap, got %v", conflict)
+	}
+	if planned.Id != "pool-1" {
+		t.Errorf("Expected the replacement to keep the ID pool-1, got %q", planned.Id)
+	}
+
+	// A new resource with the same range as one that is not replaced does not take its ID
+	registry.Register(Range{Id: "pool-2", Start: 2000, End: 2099})
+	if planned, _ := registry.Register(Range{Start: 2000, End: 2099}); planned.Id != "" {
+		t.Errorf("Expected a new range to have no ID, got %q", planned.Id)
+	}
+}
+
+func TestNilRegistryRecordsNothing(t *testing.T) {
+	var registry *Registry
+	registry.Register(Range{Start: 1000, End: 1099})
+	if _, conflict := registry.Register(Range{Start: 1000, End: 1199}); conflict != nil {
+		t.Errorf("Expected a nil registry to never report an overlap, got %v", conflict)
+	}
+}
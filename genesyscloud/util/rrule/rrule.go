@@ -0,0 +1,379 @@
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Package rrule expands the iCal recurrence rules (RFC 5545) used by Architect schedules. All times are wall clock
+times without a time zone, which is how the API stores schedules.
+
+Supported rule parts: FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY and WKST.
+*/
+
+const (
+	// Stop expanding a rule after this many periods so a rule that never matches cannot loop forever
+	maxRecurrencePeriods = 100000
+
+	// How far after its start a rule is searched for a first occurrence
+	neverMatchesHorizon = 10 * 366 * 24 * time.Hour
+)
+
+type weekdayNum struct {
+	weekday time.Weekday
+	// Ordinal of the weekday within the month, counted from the end when negative. 0 matches every week.
+	n int
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	freq       string
+	interval   int
+	count      int
+	until      *time.Time
+	byMonth    []int
+	byMonthDay []int
+	byDay      []weekdayNum
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var untilFormats = []string{"20060102T150405Z", "20060102T150405", "20060102"}
+
+// Parse parses an iCal RRULE string. Rule parts that cannot be evaluated locally are returned as errors.
+func Parse(rrule string) (*Rule, error) {
+	rule := &Rule{interval: 1}
+	rrule = strings.TrimPrefix(strings.TrimSpace(rrule), "RRULE:")
+
+	for _, part := range strings.Split(rrule, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		switch key {
+		case "FREQ":
+			switch value {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				rule.freq = value
+			default:
+				return nil, fmt.Errorf("FREQ=%s is not supported", value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("INTERVAL must be a positive integer, got %q", value)
+			}
+			rule.interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("COUNT must be a positive integer, got %q", value)
+			}
+			rule.count = count
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.until = &until
+		case "BYMONTH":
+			months, err := parseIntList(key, value, 1, 12, false)
+			if err != nil {
+				return nil, err
+			}
+			rule.byMonth = months
+		case "BYMONTHDAY":
+			days, err := parseIntList(key, value, -31, 31, true)
+			if err != nil {
+				return nil, err
+			}
+			rule.byMonthDay = days
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, err := parseWeekday(day)
+				if err != nil {
+					return nil, err
+				}
+				rule.byDay = append(rule.byDay, weekday)
+			}
+		case "WKST":
+			// Weeks always start on Monday, which is the default. Other week starts only change
+			// the result of WEEKLY rules with an interval over 1.
+			if _, ok := weekdayCodes[value]; !ok {
+				return nil, fmt.Errorf("invalid WKST value %q", value)
+			}
+		default:
+			return nil, fmt.Errorf("%s is not supported", key)
+		}
+	}
+
+	if rule.freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if rule.count > 0 && rule.until != nil {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot both be set")
+	}
+	if rule.freq == "WEEKLY" && len(rule.byMonthDay) > 0 {
+		return nil, fmt.Errorf("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	for _, day := range rule.byDay {
+		if day.n == 0 {
+			continue
+		}
+		if rule.freq != "MONTHLY" && rule.freq != "YEARLY" {
+			return nil, fmt.Errorf("numbered BYDAY values can only be used with FREQ=MONTHLY or FREQ=YEARLY")
+		}
+		if rule.freq == "YEARLY" && len(rule.byMonth) == 0 {
+			return nil, fmt.Errorf("numbered BYDAY values with FREQ=YEARLY require BYMONTH")
+		}
+	}
+	return rule, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, format := range untilFormats {
+		if until, err := time.Parse(format, value); err == nil {
+			if format == "20060102" {
+				// A date includes the whole day
+				until = until.Add(24*time.Hour - time.Second)
+			}
+			return until, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL value %q", value)
+}
+
+func parseIntList(key string, value string, lower int, upper int, allowNegative bool) ([]int, error) {
+	var result []int
+	for _, item := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(item)
+		if err != nil || number == 0 || number > upper || number < lower || (!allowNegative && number < 0) {
+			return nil, fmt.Errorf("invalid %s value %q", key, item)
+		}
+		result = append(result, number)
+	}
+	return result, nil
+}
+
+func parseWeekday(value string) (weekdayNum, error) {
+	if len(value) < 2 {
+		return weekdayNum{}, fmt.Errorf("invalid BYDAY value %q", value)
+	}
+	weekday, ok := weekdayCodes[value[len(value)-2:]]
+	if !ok {
+		return weekdayNum{}, fmt.Errorf("invalid BYDAY value %q", value)
+	}
+	result := weekdayNum{weekday: weekday}
+	if ordinal := value[:len(value)-2]; ordinal != "" {
+		n, err := strconv.Atoi(ordinal)
+		if err != nil || n == 0 || n > 5 || n < -5 {
+			return weekdayNum{}, fmt.Errorf("invalid BYDAY value %q", value)
+		}
+		result.n = n
+	}
+	return result, nil
+}
+
+// Occurrences returns the start of each occurrence of the rule from dtstart up to and including limit.
+// At most maxResults occurrences are returned when maxResults is above 0.
+func (r *Rule) Occurrences(dtstart time.Time, limit time.Time, maxResults int) []time.Time {
+	var result []time.Time
+	emitted := 0
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		periodStart := r.periodStart(dtstart, period)
+		if periodStart.After(limit) || (r.until != nil && periodStart.After(*r.until)) {
+			break
+		}
+
+		for _, candidate := range r.periodCandidates(dtstart, periodStart) {
+			if candidate.Before(dtstart) {
+				continue
+			}
+			if candidate.After(limit) || (r.until != nil && candidate.After(*r.until)) {
+				return result
+			}
+			if (r.count > 0 && emitted >= r.count) || (maxResults > 0 && len(result) >= maxResults) {
+				return result
+			}
+			// COUNT includes occurrences before the start of the range
+			emitted++
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
+
+// NeverMatches is true when the rule has no occurrence in the ten years after dtstart
+func (r *Rule) NeverMatches(dtstart time.Time) bool {
+	return len(r.Occurrences(dtstart, dtstart.Add(neverMatchesHorizon), 1)) == 0
+}
+
+// periodStart returns midnight at the start of the nth period of the rule
+func (r *Rule) periodStart(dtstart time.Time, n int) time.Time {
+	year, month, day := dtstart.Date()
+	switch r.freq {
+	case "DAILY":
+		return time.Date(year, month, day+n*r.interval, 0, 0, 0, 0, time.UTC)
+	case "WEEKLY":
+		// Weeks start on Monday
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset+7*n*r.interval, 0, 0, 0, 0, time.UTC)
+	case "MONTHLY":
+		return time.Date(year, month+time.Month(n*r.interval), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(year+n*r.interval, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// periodCandidates returns the sorted occurrence starts within a period before COUNT and UNTIL are applied
+func (r *Rule) periodCandidates(dtstart time.Time, periodStart time.Time) []time.Time {
+	var days []time.Time
+	switch r.freq {
+	case "DAILY":
+		if r.matchesMonth(periodStart.Month()) && r.matchesMonthDay(periodStart) && r.matchesWeekday(periodStart.Weekday()) {
+			days = append(days, periodStart)
+		}
+	case "WEEKLY":
+		weekdays := []time.Weekday{dtstart.Weekday()}
+		if len(r.byDay) > 0 {
+			weekdays = nil
+			for _, day := range r.byDay {
+				weekdays = append(weekdays, day.weekday)
+			}
+		}
+		for _, weekday := range weekdays {
+			day := periodStart.AddDate(0, 0, (int(weekday)+6)%7)
+			if r.matchesMonth(day.Month()) {
+				days = append(days, day)
+			}
+		}
+	case "MONTHLY":
+		if r.matchesMonth(periodStart.Month()) {
+			days = r.monthCandidates(dtstart, periodStart.Year(), periodStart.Month())
+		}
+	case "YEARLY":
+		var months []time.Month
+		if len(r.byMonth) > 0 {
+			for _, month := range r.byMonth {
+				months = append(months, time.Month(month))
+			}
+		} else if len(r.byMonthDay) > 0 || len(r.byDay) > 0 {
+			for month := time.January; month <= time.December; month++ {
+				months = append(months, month)
+			}
+		} else {
+			months = []time.Month{dtstart.Month()}
+		}
+		for _, month := range months {
+			days = append(days, r.monthCandidates(dtstart, periodStart.Year(), month)...)
+		}
+	}
+
+	hour, minute, second := dtstart.Clock()
+	seen := make(map[time.Time]bool)
+	var result []time.Time
+	for _, day := range days {
+		candidate := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, dtstart.Nanosecond(), time.UTC)
+		if !seen[candidate] {
+			seen[candidate] = true
+			result = append(result, candidate)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
+
+// monthCandidates returns the days in a month matched by BYMONTHDAY and BYDAY. Without either, the day of dtstart is used.
+func (r *Rule) monthCandidates(dtstart time.Time, year int, month time.Month) []time.Time {
+	daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		if dtstart.Day() > daysInMonth {
+			return nil
+		}
+		return []time.Time{time.Date(year, month, dtstart.Day(), 0, 0, 0, 0, time.UTC)}
+	}
+
+	var days []time.Time
+	for day := 1; day <= daysInMonth; day++ {
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		if len(r.byMonthDay) > 0 && !r.matchesMonthDay(date) {
+			continue
+		}
+		if len(r.byDay) > 0 && !r.matchesMonthWeekday(date, daysInMonth) {
+			continue
+		}
+		days = append(days, date)
+	}
+	return days
+}
+
+func (r *Rule) matchesMonth(month time.Month) bool {
+	if len(r.byMonth) == 0 {
+		return true
+	}
+	for _, m := range r.byMonth {
+		if time.Month(m) == month {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesMonthDay(date time.Time) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, day := range r.byMonthDay {
+		if day == date.Day() || (day < 0 && daysInMonth+1+day == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesWeekday(weekday time.Weekday) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, day := range r.byDay {
+		if day.weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMonthWeekday checks BYDAY values against a date, including ordinals such as 2MO or -1FR
+func (r *Rule) matchesMonthWeekday(date time.Time, daysInMonth int) bool {
+	for _, day := range r.byDay {
+		if day.weekday != date.Weekday() {
+			continue
+		}
+		switch {
+		case day.n == 0:
+			return true
+		case day.n > 0 && (date.Day()-1)/7+1 == day.n:
+			return true
+		case day.n < 0 && (daysInMonth-date.Day())/7+1 == -day.n:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseLocal(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse("2006-01-02T15:04", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func formatOccurrences(occurrences []time.Time) []string {
+	result := make([]string, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		result = append(result, occurrence.Format("2006-01-02T15:04"))
+	}
+	return result
+}
+
+func TestOccurrences(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rrule    string
+		dtstart  string
+		limit    string
+		expected []string
+	}{
+		{
+			name:     "daily",
+			rrule:    "FREQ=DAILY;INTERVAL=2",
+			dtstart:  "2024-01-30T08:00",
+			limit:    "2024-02-05T00:00",
+			expected: []string{"2024-01-30T08:00", "2024-02-01T08:00", "2024-02-03T08:00"},
+		},
+		{
+			name:     "weekdays",
+			rrule:    "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+			dtstart:  "2024-03-01T09:00",
+			limit:    "2024-03-06T00:00",
+			expected: []string{"2024-03-01T09:00", "2024-03-04T09:00", "2024-03-05T09:00"},
+		},
+		{
+			name:     "last friday of the month",
+			rrule:    "FREQ=MONTHLY;BYDAY=-1FR",
+			dtstart:  "2024-01-01T00:00",
+			limit:    "2024-04-01T00:00",
+			expected: []string{"2024-01-26T00:00", "2024-02-23T00:00", "2024-03-29T00:00"},
+		},
+		{
+			name:     "thanksgiving",
+			rrule:    "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			dtstart:  "2023-01-01T00:00",
+			limit:    "2025-12-31T00:00",
+			expected: []string{"2023-11-23T00:00", "2024-11-28T00:00", "2025-11-27T00:00"},
+		},
+		{
+			name:     "leap day",
+			rrule:    "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29",
+			dtstart:  "2021-01-01T00:00",
+			limit:    "2029-01-01T00:00",
+			expected: []string{"2024-02-29T00:00", "2028-02-29T00:00"},
+		},
+		{
+			name:     "yearly on the day of the start",
+			rrule:    "FREQ=YEARLY",
+			dtstart:  "2023-12-25T00:00",
+			limit:    "2025-12-25T00:00",
+			expected: []string{"2023-12-25T00:00", "2024-12-25T00:00", "2025-12-25T00:00"},
+		},
+		{
+			name:     "monthly on the 31st skips short months",
+			rrule:    "FREQ=MONTHLY",
+			dtstart:  "2024-01-31T10:00",
+			limit:    "2024-06-01T00:00",
+			expected: []string{"2024-01-31T10:00", "2024-03-31T10:00", "2024-05-31T10:00"},
+		},
+		{
+			name:     "count",
+			rrule:    "FREQ=DAILY;COUNT=2",
+			dtstart:  "2024-01-01T08:00",
+			limit:    "2024-02-01T00:00",
+			expected: []string{"2024-01-01T08:00", "2024-01-02T08:00"},
+		},
+		{
+			name:     "until",
+			rrule:    "FREQ=WEEKLY;UNTIL=20240115T080000Z",
+			dtstart:  "2024-01-01T08:00",
+			limit:    "2024-02-01T00:00",
+			expected: []string{"2024-01-01T08:00", "2024-01-08T08:00", "2024-01-15T08:00"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := Parse(tc.rrule)
+			if !assert.Nil(t, err) {
+				return
+			}
+			occurrences := rule.Occurrences(mustParseLocal(t, tc.dtstart), mustParseLocal(t, tc.limit), 0)
+			assert.Equal(t, tc.expected, formatOccurrences(occurrences))
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := map[string]string{
+		"FREQ=HOURLY":                   "FREQ=HOURLY is not supported",
+		"INTERVAL=2":                    "FREQ is required",
+		"FREQ=DAILY;BYSETPOS=1":         "BYSETPOS is not supported",
+		"FREQ=DAILY;COUNT=2;UNTIL=2024": "invalid UNTIL value \"2024\"",
+		"FREQ=DAILY;BYDAY=1MO":          "numbered BYDAY values can only be used with FREQ=MONTHLY or FREQ=YEARLY",
+		"FREQ=YEARLY;BYDAY=1MO":         "numbered BYDAY values with FREQ=YEARLY require BYMONTH",
+		"FREQ=MONTHLY;BYMONTHDAY=32":    "invalid BYMONTHDAY value \"32\"",
+		"FREQ=WEEKLY;BYDAY=XX":          "invalid BYDAY value \"XX\"",
+	}
+	for rrule, expected := range testCases {
+		_, err := Parse(rrule)
+		if assert.NotNil(t, err, rrule) {
+			assert.Equal(t, expected, err.Error(), rrule)
+		}
+	}
+}
+
+func TestNeverMatches(t *testing.T) {
+	dtstart := mustParseLocal(t, "2024-01-01T00:00")
+
+	rule, err := Parse("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	assert.Nil(t, err)
+	assert.True(t, rule.NeverMatches(dtstart))
+
+	// Leap days only occur every four years
+	rule, err = Parse("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29")
+	assert.Nil(t, err)
+	assert.False(t, rule.NeverMatches(dtstart))
+}
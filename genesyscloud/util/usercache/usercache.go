@@ -0,0 +1,85 @@
+package usercache
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+// Expands are the user properties loaded by GetAllUsers that are not returned by default
+var Expands = []string{"skills", "languages", "authorization", "groups", "station"}
+
+// LoadUsersFunc reads every user in the org when the cache is empty
+type LoadUsersFunc func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error)
+
+// UserCache holds every active and inactive user in the org. One cache is created when the provider is configured
+// and is shared by every resource and data source that needs the full list of users. Resources that create, update
+// or delete users must call Invalidate so the next reader sees the change.
+type UserCache struct {
+	users *[]platformclientv2.User
+	mutex sync.Mutex
+}
+
+// GetUsers returns the cached users, calling load only when the cache is empty. Concurrent callers wait for the
+// first load to finish instead of loading the users themselves. A nil cache always calls load.
+func (c *UserCache) GetUsers(ctx context.Context, load LoadUsersFunc) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	if c == nil {
+		return load(ctx)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.users != nil {
+		log.Printf("found %d users in cache", len(*c.users))
+		return c.users, nil, nil
+	}
+
+	users, resp, err := load(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	log.Printf("loaded %d users into cache", len(*users))
+	c.users = users
+	return users, resp, nil
+}
+
+// Invalidate clears the cache so the users are loaded again by the next call to GetUsers
+func (c *UserCache) Invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.users = nil
+}
+
+// GetAllUsers pages through all active and inactive users in the org with the Expands. Newly created users are
+// inactive until they log in.
+func GetAllUsers(_ context.Context, usersApi *platformclientv2.UsersApi) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	var allUsers []platformclientv2.User
+	var resp *platformclientv2.APIResponse
+	const pageSize = 100
+
+	for _, state := range []string{"active", "inactive"} {
+		for pageNum := 1; ; pageNum++ {
+			users, apiResp, err := usersApi.GetUsers(pageSize, pageNum, nil, nil, "", Expands, "", state)
+			resp = apiResp
+			if err != nil {
+				return nil, resp, fmt.Errorf("failed to get %s users: %v", state, err)
+			}
+			if users.Entities == nil || len(*users.Entities) == 0 {
+				break
+			}
+			allUsers = append(allUsers, *users.Entities...)
+			if users.PageCount == nil || pageNum >= *users.PageCount {
+				break
+			}
+		}
+	}
+	return &allUsers, resp, nil
+}
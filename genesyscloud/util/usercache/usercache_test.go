@@ -0,0 +1,61 @@
+package usercache
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+func TestUserCacheLoadsOnceUntilInvalidated(t *testing.T) {
+	var callsMutex sync.Mutex
+	loadCalls := 0
+	load := func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		callsMutex.Lock()
+		defer callsMutex.Unlock()
+		loadCalls++
+		return &[]platformclientv2.User{{Id: platformclientv2.String("user-1")}}, nil, nil
+	}
+
+	cache := &UserCache{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			users, _, err := cache.GetUsers(context.Background(), load)
+			if err != nil || len(*users) != 1 {
+				t.Errorf("Expected 1 user, got %v, %v", users, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if loadCalls != 1 {
+		t.Errorf("Expected concurrent reads to load the users once, got %d loads", loadCalls)
+	}
+
+	cache.Invalidate()
+	_, _, _ = cache.GetUsers(context.Background(), load)
+	if loadCalls != 2 {
+		t.Errorf("Expected the users to be loaded again after Invalidate, got %d loads", loadCalls)
+	}
+}
+
+func TestNilUserCacheAlwaysLoads(t *testing.T) {
+	loadCalls := 0
+	load := func(ctx context.Context) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		loadCalls++
+		return &[]platformclientv2.User{}, nil, nil
+	}
+
+	var cache *UserCache
+	_, _, _ = cache.GetUsers(context.Background(), load)
+	_, _, _ = cache.GetUsers(context.Background(), load)
+	cache.Invalidate()
+
+	if loadCalls != 2 {
+		t.Errorf("Expected a nil cache to load the users on every call, got %d loads", loadCalls)
+	}
+}
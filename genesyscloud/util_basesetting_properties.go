@@ -42,14 +42,18 @@ func CustomizePhoneBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.
 		return nil
 	}
 
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	if err := validateBaseSettingsPropertiesDiff(diff, "phone_meta_base_id", sdkConfig, getPhoneMetaBaseUri(edgesAPI)); err != nil {
+		return err
+	}
+
 	id := diff.Id()
 	if id == "" {
 		return nil
 	}
 
-	sdkConfig := meta.(*ProviderMeta).ClientConfig
-	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
-
 	// Retrieve defaults from the settings
 	phoneBaseSetting, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesPhonebasesetting(id)
 	if getErr != nil {
@@ -69,14 +73,21 @@ func CustomizeTrunkBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.
 		return nil
 	}
 
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	if diff.NewValueKnown("trunk_type") {
+		trunkType := diff.Get("trunk_type").(string)
+		if err := validateBaseSettingsPropertiesDiff(diff, "trunk_meta_base_id", sdkConfig, getTrunkMetaBaseUri(edgesAPI, trunkType)); err != nil {
+			return err
+		}
+	}
+
 	id := diff.Id()
 	if id == "" {
 		return nil
 	}
 
-	sdkConfig := meta.(*ProviderMeta).ClientConfig
-	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
-
 	// Retrieve defaults from the settings
 	trunkBaseSetting, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesTrunkbasesetting(id, true)
 	if getErr != nil {
@@ -0,0 +1,351 @@
+package genesyscloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v119/platformclientv2"
+)
+
+var (
+	// metaBasePropertySchemas caches the property schemas of each meta-base by ID. A nil entry means the meta-base has no usable schema.
+	metaBasePropertySchemas      = make(map[string]map[string]interface{})
+	metaBasePropertySchemasMutex sync.Mutex
+)
+
+// validateBaseSettingsPropertiesDiff validates the configured properties against the JSON schema of the meta-base in metaBaseAttr.
+// getMetaBaseUri returns the URI of the meta-base schema and is only called when the schema is not cached yet.
+func validateBaseSettingsPropertiesDiff(diff *schema.ResourceDiff, metaBaseAttr string, sdkConfig *platformclientv2.Configuration, getMetaBaseUri func(metaBaseId string) (string, error)) error {
+	if !diff.NewValueKnown(metaBaseAttr) || !diff.HasChanges("properties", metaBaseAttr) {
+		return nil
+	}
+	if rawConfig := diff.GetRawConfig(); rawConfig.IsNull() || rawConfig.GetAttr("properties").IsNull() {
+		// Properties are read from the API and not set in the configuration
+		return nil
+	}
+
+	propertiesJson := diff.Get("properties").(string)
+	if propertiesJson == "" {
+		return nil
+	}
+	properties := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(propertiesJson), &properties); err != nil {
+		return fmt.Errorf("properties must be a JSON object: %s", err)
+	}
+
+	metaBaseId := diff.Get(metaBaseAttr).(string)
+	propertySchemas, err := getMetaBasePropertySchemas(sdkConfig, metaBaseId, getMetaBaseUri)
+	if err != nil {
+		// Fail open so that a missing permission or an unavailable schema does not block the plan
+		log.Printf("Skipping validation of properties against meta-base %s: %s", metaBaseId, err)
+		return nil
+	}
+	if propertySchemas == nil {
+		return nil
+	}
+
+	if errs := validateBaseSettingsProperties(properties, propertySchemas); len(errs) > 0 {
+		return fmt.Errorf("invalid properties for meta-base %s:\n%s", metaBaseId, strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// getMetaBasePropertySchemas returns the JSON schemas of the properties of a meta-base. The schema of each meta-base is fetched once and cached.
+func getMetaBasePropertySchemas(sdkConfig *platformclientv2.Configuration, metaBaseId string, getMetaBaseUri func(metaBaseId string) (string, error)) (map[string]interface{}, error) {
+	metaBasePropertySchemasMutex.Lock()
+	defer metaBasePropertySchemasMutex.Unlock()
+
+	if propertySchemas, ok := metaBasePropertySchemas[metaBaseId]; ok {
+		return propertySchemas, nil
+	}
+
+	uri, err := getMetaBaseUri(metaBaseId)
+	if err != nil {
+		return nil, err
+	}
+	if uri == "" {
+		metaBasePropertySchemas[metaBaseId] = nil
+		return nil, nil
+	}
+
+	metaBaseSchema, err := getJsonDocument(sdkConfig, uri)
+	if err != nil {
+		return nil, err
+	}
+	propertySchemas := findPropertySchemas(metaBaseSchema)
+	metaBasePropertySchemas[metaBaseId] = propertySchemas
+	return propertySchemas, nil
+}
+
+// getTrunkMetaBaseUri returns the URI of the schema of a trunk meta-base
+func getTrunkMetaBaseUri(edgesAPI *platformclientv2.TelephonyProvidersEdgeApi, trunkType string) func(string) (string, error) {
+	return func(metaBaseId string) (string, error) {
+		const pageSize = 100
+		for pageNum := 1; ; pageNum++ {
+			metaBases, _, err := edgesAPI.GetTelephonyProvidersEdgesTrunkbasesettingsAvailablemetabases(trunkType, pageSize, pageNum)
+			if err != nil {
+				return "", fmt.Errorf("failed to get trunk meta-bases: %s", err)
+			}
+			if metaBases.Entities == nil || len(*metaBases.Entities) == 0 {
+				return "", nil
+			}
+			if uri := findMetaBaseUri(*metaBases.Entities, metaBaseId); uri != "" {
+				return uri, nil
+			}
+		}
+	}
+}
+
+// getPhoneMetaBaseUri returns the URI of the schema of a phone meta-base
+func getPhoneMetaBaseUri(edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) func(string) (string, error) {
+	return func(metaBaseId string) (string, error) {
+		const pageSize = 100
+		for pageNum := 1; ; pageNum++ {
+			metaBases, _, err := edgesAPI.GetTelephonyProvidersEdgesPhonebasesettingsAvailablemetabases(pageSize, pageNum)
+			if err != nil {
+				return "", fmt.Errorf("failed to get phone meta-bases: %s", err)
+			}
+			if metaBases.Entities == nil || len(*metaBases.Entities) == 0 {
+				return "", nil
+			}
+			if uri := findMetaBaseUri(*metaBases.Entities, metaBaseId); uri != "" {
+				return uri, nil
+			}
+		}
+	}
+}
+
+func findMetaBaseUri(metaBases []platformclientv2.Metabase, metaBaseId string) string {
+	for _, metaBase := range metaBases {
+		if metaBase.Id != nil && *metaBase.Id == metaBaseId && metaBase.SelfUri != nil {
+			return *metaBase.SelfUri
+		}
+	}
+	return ""
+}
+
+// getJsonDocument reads a JSON document from the API. The SDK has no operation for meta-base schemas.
+func getJsonDocument(sdkConfig *platformclientv2.Configuration, uri string) (map[string]interface{}, error) {
+	apiClient := &sdkConfig.APIClient
+
+	path := uri
+	if strings.HasPrefix(uri, "/") {
+		path = sdkConfig.BasePath + uri
+	}
+
+	headerParams := make(map[string]string)
+	if sdkConfig.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + sdkConfig.AccessToken
+	}
+	for key := range sdkConfig.DefaultHeader {
+		headerParams[key] = sdkConfig.DefaultHeader[key]
+	}
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	response, err := apiClient.CallAPI(path, http.MethodGet, nil, headerParams, nil, nil, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("failed to get %s: %s", uri, response.ErrorMessage)
+	}
+
+	document := map[string]interface{}{}
+	if err := json.Unmarshal(response.RawBody, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", uri, err)
+	}
+	return document, nil
+}
+
+// findPropertySchemas returns the schemas of the entries of the properties object described by a meta-base schema
+func findPropertySchemas(metaBaseSchema map[string]interface{}) map[string]interface{} {
+	fields, _ := metaBaseSchema["properties"].(map[string]interface{})
+	propertiesSchema, _ := fields["properties"].(map[string]interface{})
+	propertySchemas, _ := propertiesSchema["properties"].(map[string]interface{})
+	if len(propertySchemas) == 0 {
+		return nil
+	}
+	return propertySchemas
+}
+
+// validateBaseSettingsProperties validates base settings properties against the schemas of the properties of a meta-base.
+// It returns one message per problem, prefixed with the path of the offending property.
+func validateBaseSettingsProperties(properties map[string]interface{}, propertySchemas map[string]interface{}) []string {
+	var errs []string
+	for _, name := range sortedKeys(properties) {
+		path := "properties." + name
+		propertySchema, ok := propertySchemas[name].(map[string]interface{})
+		if !ok {
+			msg := fmt.Sprintf("%s: unknown property", path)
+			if suggestion := closestPropertyName(name, propertySchemas); suggestion != "" {
+				msg += fmt.Sprintf(", did you mean %s?", suggestion)
+			}
+			errs = append(errs, msg)
+			continue
+		}
+		errs = append(errs, validateJsonSchemaValue(path, properties[name], propertySchema)...)
+	}
+	return errs
+}
+
+// validateJsonSchemaValue validates the type, enum values and bounds of a value against a JSON schema.
+// Keys of objects that are not described by the schema are allowed unless additionalProperties is false.
+func validateJsonSchemaValue(path string, value interface{}, valueSchema map[string]interface{}) []string {
+	if types := jsonSchemaTypes(valueSchema); len(types) > 0 && !jsonValueHasType(value, types) {
+		return []string{fmt.Sprintf("%s: expected %s, got %s", path, strings.Join(types, " or "), jsonValueType(value))}
+	}
+
+	if enum, ok := valueSchema["enum"].([]interface{}); ok && len(enum) > 0 {
+		found := false
+		for _, enumValue := range enum {
+			if reflect.DeepEqual(enumValue, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return []string{fmt.Sprintf("%s: value %v must be one of %v", path, value, enum)}
+		}
+	}
+
+	var errs []string
+	switch v := value.(type) {
+	case float64:
+		if minimum, ok := valueSchema["minimum"].(float64); ok && v < minimum {
+			errs = append(errs, fmt.Sprintf("%s: value %v must be at least %v", path, v, minimum))
+		}
+		if maximum, ok := valueSchema["maximum"].(float64); ok && v > maximum {
+			errs = append(errs, fmt.Sprintf("%s: value %v must be at most %v", path, v, maximum))
+		}
+	case map[string]interface{}:
+		childSchemas, _ := valueSchema["properties"].(map[string]interface{})
+		additionalProperties, _ := valueSchema["additionalProperties"].(bool)
+		_, additionalPropertiesSet := valueSchema["additionalProperties"]
+		for _, key := range sortedKeys(v) {
+			childSchema, ok := childSchemas[key].(map[string]interface{})
+			if !ok {
+				if additionalPropertiesSet && !additionalProperties {
+					errs = append(errs, fmt.Sprintf("%s.%s: unknown property", path, key))
+				}
+				continue
+			}
+			errs = append(errs, validateJsonSchemaValue(path+"."+key, v[key], childSchema)...)
+		}
+	case []interface{}:
+		if itemSchema, ok := valueSchema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				errs = append(errs, validateJsonSchemaValue(fmt.Sprintf("%s[%d]", path, i), item, itemSchema)...)
+			}
+		}
+	}
+	return errs
+}
+
+func jsonSchemaTypes(valueSchema map[string]interface{}) []string {
+	switch schemaType := valueSchema["type"].(type) {
+	case string:
+		return []string{schemaType}
+	case []interface{}:
+		var types []string
+		for _, t := range schemaType {
+			if typeStr, ok := t.(string); ok {
+				types = append(types, typeStr)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func jsonValueHasType(value interface{}, types []string) bool {
+	valueType := jsonValueType(value)
+	for _, t := range types {
+		if t == valueType || (t == "number" && valueType == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonValueType returns the JSON schema type of a value decoded by encoding/json
+func jsonValueType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// closestPropertyName returns the known property name closest to name, or an empty string if none is close enough
+func closestPropertyName(name string, propertySchemas map[string]interface{}) string {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+	}
+	closest := ""
+	closestDistance := 4
+	for _, candidate := range sortedKeys(propertySchemas) {
+		distance := levenshteinDistance(normalize(name), normalize(candidate))
+		if distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j] + 1
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+			if previous[j-1]+cost < current[j] {
+				current[j] = previous[j-1] + cost
+			}
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
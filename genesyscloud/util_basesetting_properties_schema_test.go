@@ -0,0 +1,118 @@
+package genesyscloud
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testTrunkMetaBaseSchema = `{
+	"properties": {
+		"properties": {
+			"type": "object",
+			"properties": {
+				"trunk_label": {
+					"type": "object",
+					"properties": {
+						"value": {
+							"type": "object",
+							"properties": {
+								"instance": {"type": "string"}
+							}
+						}
+					}
+				},
+				"trunk_transport_sipRetries": {
+					"type": "object",
+					"properties": {
+						"value": {
+							"type": "object",
+							"additionalProperties": false,
+							"properties": {
+								"instance": {"type": "integer", "minimum": 1, "maximum": 10}
+							}
+						}
+					}
+				},
+				"trunk_media_codec": {
+					"type": "object",
+					"properties": {
+						"value": {
+							"type": "object",
+							"properties": {
+								"instance": {
+									"type": "array",
+									"items": {"type": "string", "enum": ["audio/opus", "audio/PCMU", "audio/PCMA"]}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+func TestUnitValidateBaseSettingsProperties(t *testing.T) {
+	metaBaseSchema := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(testTrunkMetaBaseSchema), &metaBaseSchema); err != nil {
+		t.Fatal(err)
+	}
+	propertySchemas := findPropertySchemas(metaBaseSchema)
+	assert.Len(t, propertySchemas, 3)
+
+	testCases := []struct {
+		name       string
+		properties string
+		expected   []string
+	}{
+		{
+			name:       "valid properties",
+			properties: `{"trunk_label": {"value": {"instance": "label"}}, "trunk_transport_sipRetries": {"value": {"instance": 3}}, "trunk_media_codec": {"value": {"instance": ["audio/opus"]}}}`,
+		},
+		{
+			name:       "unknown property",
+			properties: `{"trunk_lable": {"value": {"instance": "label"}}}`,
+			expected:   []string{"properties.trunk_lable: unknown property, did you mean trunk_label?"},
+		},
+		{
+			name:       "wrong type",
+			properties: `{"trunk_label": {"value": {"instance": 5}}, "trunk_transport_sipRetries": {"value": {"instance": 2.5}}}`,
+			expected: []string{
+				"properties.trunk_label.value.instance: expected string, got integer",
+				"properties.trunk_transport_sipRetries.value.instance: expected integer, got number",
+			},
+		},
+		{
+			name:       "out of range",
+			properties: `{"trunk_transport_sipRetries": {"value": {"instance": 11}}}`,
+			expected:   []string{"properties.trunk_transport_sipRetries.value.instance: value 11 must be at most 10"},
+		},
+		{
+			name:       "enum value",
+			properties: `{"trunk_media_codec": {"value": {"instance": ["audio/opus", "audio/g729"]}}}`,
+			expected:   []string{"properties.trunk_media_codec.value.instance[1]: value audio/g729 must be one of [audio/opus audio/PCMU audio/PCMA]"},
+		},
+		{
+			name:       "unknown nested property",
+			properties: `{"trunk_label": {"value": {"instance": "label", "extra": 1}}, "trunk_transport_sipRetries": {"value": {"instance": 3, "extra": 1}}}`,
+			expected:   []string{"properties.trunk_transport_sipRetries.value.extra: unknown property"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			properties := map[string]interface{}{}
+			if err := json.Unmarshal([]byte(tc.properties), &properties); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.expected, validateBaseSettingsProperties(properties, propertySchemas))
+		})
+	}
+}
+
+func TestUnitFindPropertySchemasMissing(t *testing.T) {
+	assert.Nil(t, findPropertySchemas(map[string]interface{}{}))
+	assert.Nil(t, findPropertySchemas(map[string]interface{}{"properties": "invalid"}))
+}
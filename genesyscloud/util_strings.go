@@ -27,3 +27,12 @@ func ToCamelCase(str string) string {
 	}
 	return camel
 }
+
+// SanitizeE164Number corrects an e164 number e.g. +(1) 111-222-333 --> +1111222333
+func SanitizeE164Number(number string) string {
+	charactersToRemove := []string{" ", "-", "(", ")"}
+	for _, c := range charactersToRemove {
+		number = strings.Replace(number, c, "", -1)
+	}
+	return number
+}
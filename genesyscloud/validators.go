@@ -2,6 +2,7 @@ package genesyscloud
 
 import (
 	"fmt"
+	"net/mail"
 	"regexp"
 	"time"
 
@@ -114,6 +115,21 @@ func ValidateCountryCode(code interface{}, _ cty.Path) diag.Diagnostics {
 	return diag.Errorf("Country code %v is not of format ISO 3166-1 alpha-2", code)
 }
 
+// Validates an email address is a bare address such as user@example.com
+func ValidateEmailAddress(email interface{}, _ cty.Path) diag.Diagnostics {
+	if emailStr, ok := email.(string); ok {
+		address, err := mail.ParseAddress(emailStr)
+		if err != nil {
+			return diag.Errorf("Failed to parse email address %s: %s", emailStr, err)
+		}
+		if address.Address != emailStr {
+			return diag.Errorf("Email address %s must not contain a display name. Expected: %s", emailStr, address.Address)
+		}
+		return nil
+	}
+	return diag.Errorf("Email address %v is not a string", email)
+}
+
 // Validates a date string is in format hh:mm:ss
 func ValidateTime(time interface{}, _ cty.Path) diag.Diagnostics {
 	timeStr := time.(string)
@@ -153,6 +169,21 @@ func ValidateLocalDateTimes(date interface{}, _ cty.Path) diag.Diagnostics {
 	return diag.Errorf("Date %v is not a string", date)
 }
 
+// Validates a duration string such as 30s or 5m
+func ValidateDuration(duration interface{}, _ cty.Path) diag.Diagnostics {
+	if durationStr, ok := duration.(string); ok {
+		parsed, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return diag.Errorf("Failed to parse duration %s: %s", durationStr, err)
+		}
+		if parsed <= 0 {
+			return diag.Errorf("Duration %s must be greater than zero", durationStr)
+		}
+		return nil
+	}
+	return diag.Errorf("Duration %v is not a string", duration)
+}
+
 // Validates a file path or URL
 func ValidatePath(i interface{}, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
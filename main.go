@@ -10,6 +10,7 @@ import (
 	grammar "terraform-provider-genesyscloud/genesyscloud/architect_grammar"
 	grammarLanguage "terraform-provider-genesyscloud/genesyscloud/architect_grammar_language"
 	archIvr "terraform-provider-genesyscloud/genesyscloud/architect_ivr"
+	scheduleGroupEvaluation "terraform-provider-genesyscloud/genesyscloud/architect_schedulegroup_evaluation"
 	authorizatioProduct "terraform-provider-genesyscloud/genesyscloud/authorization_product"
 	externalContacts "terraform-provider-genesyscloud/genesyscloud/external_contacts"
 	flowMilestone "terraform-provider-genesyscloud/genesyscloud/flow_milestone"
@@ -32,6 +33,8 @@ import (
 	recMediaRetPolicy "terraform-provider-genesyscloud/genesyscloud/recording_media_retention_policy"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	routingSkillGroupMembers "terraform-provider-genesyscloud/genesyscloud/routing_skill_group_members"
 	smsAddresses "terraform-provider-genesyscloud/genesyscloud/routing_sms_addresses"
 	"terraform-provider-genesyscloud/genesyscloud/scripts"
 	"terraform-provider-genesyscloud/genesyscloud/station"
@@ -41,16 +44,22 @@ import (
 	worktype "terraform-provider-genesyscloud/genesyscloud/task_management_worktype"
 	"terraform-provider-genesyscloud/genesyscloud/team"
 	"terraform-provider-genesyscloud/genesyscloud/telephony"
+	didInventory "terraform-provider-genesyscloud/genesyscloud/telephony_did_inventory"
 	did "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did"
 	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
+	edgeEdge "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_edge"
 	edgeGroup "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_edge_group"
 	extPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_extension_pool"
 	lineBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_linebasesettings"
 	edgePhone "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phone"
 	phoneBaseSettings "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phonebasesettings"
+	edgePhonesBulk "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_phones_bulk"
 	edgeSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
 	edgesTrunk "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_trunk"
 	tfexp "terraform-provider-genesyscloud/genesyscloud/tfexporter"
+	userRoutingUtilization "terraform-provider-genesyscloud/genesyscloud/user_routing_utilization"
+	users "terraform-provider-genesyscloud/genesyscloud/users"
+	usersBulk "terraform-provider-genesyscloud/genesyscloud/users_bulk"
 	webDeployConfig "terraform-provider-genesyscloud/genesyscloud/webdeployments_configuration"
 	webDeployDeploy "terraform-provider-genesyscloud/genesyscloud/webdeployments_deployment"
 
@@ -119,7 +128,9 @@ func registerResources() {
 	emergencyGroup.SetRegistrar(regInstance)                //Registering architect emergency group
 	grammar.SetRegistrar(regInstance)                       //Registering architect grammar
 	grammarLanguage.SetRegistrar(regInstance)               //Registering architect grammar language
+	edgeEdge.SetRegistrar(regInstance)                      //Registering telephony providers edges edge
 	edgePhone.SetRegistrar(regInstance)                     //Registering telephony providers edges phone
+	edgePhonesBulk.SetRegistrar(regInstance)                //Registering telephony providers edges phones bulk
 	edgeSite.SetRegistrar(regInstance)                      //Registering telephony providers edges site
 	flowMilestone.SetRegistrar(regInstance)                 //Registering flow milestone
 	flowOutcome.SetRegistrar(regInstance)                   //Registering flow outcome
@@ -152,6 +163,7 @@ func registerResources() {
 	externalContacts.SetRegistrar(regInstance)              //Registering external contacts
 	team.SetRegistrar(regInstance)                          //Registering team
 	telephony.SetRegistrar(regInstance)                     //Registering telephony package
+	didInventory.SetRegistrar(regInstance)                  //Registering telephony did inventory
 	edgeGroup.SetRegistrar(regInstance)                     //Registering edges edge group
 	webDeployConfig.SetRegistrar(regInstance)               //Registering webdeployments_config
 	webDeployDeploy.SetRegistrar(regInstance)               //Registering webdeployments_deploy
@@ -160,6 +172,12 @@ func registerResources() {
 	phoneBaseSettings.SetRegistrar(regInstance)             //Registering Phone Base Settings
 	lineBaseSettings.SetRegistrar(regInstance)              //Registering Line Base Settings
 	edgesTrunk.SetRegistrar(regInstance)                    //Registering Edges Trunk Settings
+	scheduleGroupEvaluation.SetRegistrar(regInstance)       //Registering architect schedule group evaluation
+	routingQueue.SetRegistrar(regInstance)                  //Registering routing queue
+	usersBulk.SetRegistrar(regInstance)                     //Registering users bulk
+	users.SetRegistrar(regInstance)                         //Registering users
+	userRoutingUtilization.SetRegistrar(regInstance)        //Registering user routing utilization
+	routingSkillGroupMembers.SetRegistrar(regInstance)      //Registering routing skill group members
 	resourceExporter.SetRegisterExporter(resourceExporters) //Registering register exporters
 	// setting resources for Use cases  like TF export where provider is used in resource classes.
 	tfexp.SetRegistrar(regInstance) //Registering tf exporter